@@ -8,4 +8,5 @@ func init() {
 	typeurl.Register(&MicroVMSpecCreated{}, "microvm.services.api.events.microvmspeccreated")
 	typeurl.Register(&MicroVMSpecUpdated{}, "microvm.services.api.events.microvmspecupdated")
 	typeurl.Register(&MicroVMSpecDeleted{}, "microvm.services.api.events.microvmspecdeleted")
+	typeurl.Register(&MicroVMStatusUpdated{}, "microvm.services.api.events.microvmstatusupdated")
 }
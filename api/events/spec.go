@@ -29,3 +29,13 @@ type MicroVMSpecDeleted struct {
 	// UID is the unique id of the deleted microvm.
 	UID string
 }
+
+// MicroVMStatusUpdated is an event for when the status of a microvm is saved after reconciliation.
+type MicroVMStatusUpdated struct {
+	// ID is the identifier of the updated microvm.
+	ID string
+	// Namespace is the namespace of the updated microvm.
+	Namespace string
+	// UID is the unique id of the updated microvm.
+	UID string
+}
@@ -27,29 +27,1570 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListMicroVMsRequest_OrderBy int32
+
+const (
+	// NAME sorts the microvms by namespace and then name.
+	ListMicroVMsRequest_NAME ListMicroVMsRequest_OrderBy = 0
+	// CREATED_AT sorts the microvms by the time they were created.
+	ListMicroVMsRequest_CREATED_AT ListMicroVMsRequest_OrderBy = 1
+)
+
+// Enum value maps for ListMicroVMsRequest_OrderBy.
+var (
+	ListMicroVMsRequest_OrderBy_name = map[int32]string{
+		0: "NAME",
+		1: "CREATED_AT",
+	}
+	ListMicroVMsRequest_OrderBy_value = map[string]int32{
+		"NAME":       0,
+		"CREATED_AT": 1,
+	}
+)
+
+func (x ListMicroVMsRequest_OrderBy) Enum() *ListMicroVMsRequest_OrderBy {
+	p := new(ListMicroVMsRequest_OrderBy)
+	*p = x
+	return p
+}
+
+func (x ListMicroVMsRequest_OrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMicroVMsRequest_OrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_microvm_v1alpha1_microvms_proto_enumTypes[0].Descriptor()
+}
+
+func (ListMicroVMsRequest_OrderBy) Type() protoreflect.EnumType {
+	return &file_services_microvm_v1alpha1_microvms_proto_enumTypes[0]
+}
+
+func (x ListMicroVMsRequest_OrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListMicroVMsRequest_OrderBy.Descriptor instead.
+func (ListMicroVMsRequest_OrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type WatchMicroVMsResponse_EventType int32
+
+const (
+	// ADDED is sent the first time the watch sees a microvm.
+	WatchMicroVMsResponse_ADDED WatchMicroVMsResponse_EventType = 0
+	// MODIFIED is sent when a new version of the microvm is saved.
+	WatchMicroVMsResponse_MODIFIED WatchMicroVMsResponse_EventType = 1
+	// DELETED is sent when the microvm is deleted or no longer matches the watch.
+	WatchMicroVMsResponse_DELETED WatchMicroVMsResponse_EventType = 2
+)
+
+// Enum value maps for WatchMicroVMsResponse_EventType.
+var (
+	WatchMicroVMsResponse_EventType_name = map[int32]string{
+		0: "ADDED",
+		1: "MODIFIED",
+		2: "DELETED",
+	}
+	WatchMicroVMsResponse_EventType_value = map[string]int32{
+		"ADDED":    0,
+		"MODIFIED": 1,
+		"DELETED":  2,
+	}
+)
+
+func (x WatchMicroVMsResponse_EventType) Enum() *WatchMicroVMsResponse_EventType {
+	p := new(WatchMicroVMsResponse_EventType)
+	*p = x
+	return p
+}
+
+func (x WatchMicroVMsResponse_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchMicroVMsResponse_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_microvm_v1alpha1_microvms_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchMicroVMsResponse_EventType) Type() protoreflect.EnumType {
+	return &file_services_microvm_v1alpha1_microvms_proto_enumTypes[1]
+}
+
+func (x WatchMicroVMsResponse_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchMicroVMsResponse_EventType.Descriptor instead.
+func (WatchMicroVMsResponse_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type CreateMicroVMRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Microvm       *types.MicroVMSpec     `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
-	Metadata      map[string]*anypb.Any  `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Microvm       *types.MicroVMSpec     `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	Metadata      map[string]*anypb.Any  `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMicroVMRequest) Reset() {
+	*x = CreateMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMicroVMRequest) ProtoMessage() {}
+
+func (x *CreateMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*CreateMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateMicroVMRequest) GetMicrovm() *types.MicroVMSpec {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+func (x *CreateMicroVMRequest) GetMetadata() map[string]*anypb.Any {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CreateMicroVMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMicroVMResponse) Reset() {
+	*x = CreateMicroVMResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMicroVMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMicroVMResponse) ProtoMessage() {}
+
+func (x *CreateMicroVMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMicroVMResponse.ProtoReflect.Descriptor instead.
+func (*CreateMicroVMResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateMicroVMResponse) GetMicrovm() *types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type UpdateMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Microvm       *types.MicroVMSpec     `protobuf:"bytes,2,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMicroVMRequest) Reset() {
+	*x = UpdateMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMicroVMRequest) ProtoMessage() {}
+
+func (x *UpdateMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdateMicroVMRequest) GetMicrovm() *types.MicroVMSpec {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type UpdateMicroVMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMicroVMResponse) Reset() {
+	*x = UpdateMicroVMResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMicroVMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMicroVMResponse) ProtoMessage() {}
+
+func (x *UpdateMicroVMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMicroVMResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMicroVMResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateMicroVMResponse) GetMicrovm() *types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type StopMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopMicroVMRequest) Reset() {
+	*x = StopMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopMicroVMRequest) ProtoMessage() {}
+
+func (x *StopMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*StopMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type StartMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMicroVMRequest) Reset() {
+	*x = StartMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMicroVMRequest) ProtoMessage() {}
+
+func (x *StartMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*StartMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StartMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type RestartMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartMicroVMRequest) Reset() {
+	*x = RestartMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartMicroVMRequest) ProtoMessage() {}
+
+func (x *RestartMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*RestartMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RestartMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type PauseMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseMicroVMRequest) Reset() {
+	*x = PauseMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMicroVMRequest) ProtoMessage() {}
+
+func (x *PauseMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*PauseMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PauseMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ResumeMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeMicroVMRequest) Reset() {
+	*x = ResumeMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeMicroVMRequest) ProtoMessage() {}
+
+func (x *ResumeMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*ResumeMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResumeMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type DeleteMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMicroVMRequest) Reset() {
+	*x = DeleteMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMicroVMRequest) ProtoMessage() {}
+
+func (x *DeleteMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetMicroVMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMicroVMRequest) Reset() {
+	*x = GetMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMicroVMRequest) ProtoMessage() {}
+
+func (x *GetMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*GetMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetMicroVMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMicroVMResponse) Reset() {
+	*x = GetMicroVMResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMicroVMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMicroVMResponse) ProtoMessage() {}
+
+func (x *GetMicroVMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMicroVMResponse.ProtoReflect.Descriptor instead.
+func (*GetMicroVMResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMicroVMResponse) GetMicrovm() *types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type GetMicroVMHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMicroVMHistoryRequest) Reset() {
+	*x = GetMicroVMHistoryRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMicroVMHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMicroVMHistoryRequest) ProtoMessage() {}
+
+func (x *GetMicroVMHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMicroVMHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMicroVMHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMicroVMHistoryRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type GetMicroVMHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Executions are the most recent plan executions for the microvm, most recent first.
+	Executions    []*types.PlanExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMicroVMHistoryResponse) Reset() {
+	*x = GetMicroVMHistoryResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMicroVMHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMicroVMHistoryResponse) ProtoMessage() {}
+
+func (x *GetMicroVMHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMicroVMHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMicroVMHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMicroVMHistoryResponse) GetExecutions() []*types.PlanExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type ListMicroVMsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// LabelSelector restricts the microvms to ones whose labels match a Kubernetes style
+	// selector, for example "env=prod,tier!=db,zone in (a,b),!legacy".
+	LabelSelector *string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	// States restricts the microvms to ones in any of these states. If empty microvms in
+	// any state are listed.
+	States []types.MicroVMStatus_MicroVMState `protobuf:"varint,4,rep,packed,name=states,proto3,enum=flintlock.types.MicroVMStatus_MicroVMState" json:"states,omitempty"`
+	// OrderBy is the field to sort the microvms by.
+	OrderBy ListMicroVMsRequest_OrderBy `protobuf:"varint,5,opt,name=order_by,json=orderBy,proto3,enum=microvm.services.api.v1alpha1.ListMicroVMsRequest_OrderBy" json:"order_by,omitempty"`
+	// Descending reverses the sort order.
+	Descending bool `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	// PageSize is the maximum number of microvms to return. Zero means no limit. Paging
+	// isn't supported by ListMicroVMsStream.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// PageToken is the next_page_token of the previous page to carry on listing from. The
+	// rest of the request must be the same as for the previous page.
+	PageToken     *string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMicroVMsRequest) Reset() {
+	*x = ListMicroVMsRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMicroVMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMicroVMsRequest) ProtoMessage() {}
+
+func (x *ListMicroVMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMicroVMsRequest.ProtoReflect.Descriptor instead.
+func (*ListMicroVMsRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMicroVMsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListMicroVMsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListMicroVMsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListMicroVMsRequest) GetStates() []types.MicroVMStatus_MicroVMState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListMicroVMsRequest) GetOrderBy() ListMicroVMsRequest_OrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ListMicroVMsRequest_NAME
+}
+
+func (x *ListMicroVMsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListMicroVMsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMicroVMsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListMicroVMsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Microvm []*types.MicroVM       `protobuf:"bytes,1,rep,name=microvm,proto3" json:"microvm,omitempty"`
+	// NextPageToken is set when there may be more microvms to list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMicroVMsResponse) Reset() {
+	*x = ListMicroVMsResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMicroVMsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMicroVMsResponse) ProtoMessage() {}
+
+func (x *ListMicroVMsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMicroVMsResponse.ProtoReflect.Descriptor instead.
+func (*ListMicroVMsResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMicroVMsResponse) GetMicrovm() []*types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+func (x *ListMicroVMsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessage) Reset() {
+	*x = ListMessage{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessage) ProtoMessage() {}
+
+func (x *ListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessage.ProtoReflect.Descriptor instead.
+func (*ListMessage) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMessage) GetMicrovm() *types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type WatchMicroVMsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace restricts the watch to a single namespace. If empty all namespaces are watched.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Labels restricts the watch to microvms that have all of these labels.
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ResumeFromVersions is the last version seen for each microvm, keyed by uid. Microvms
+	// still at these versions aren't sent again and any that have since been deleted are
+	// sent as DELETED events. Leave empty to receive an ADDED event for every microvm.
+	ResumeFromVersions map[string]int32 `protobuf:"bytes,3,rep,name=resume_from_versions,json=resumeFromVersions,proto3" json:"resume_from_versions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WatchMicroVMsRequest) Reset() {
+	*x = WatchMicroVMsRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMicroVMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMicroVMsRequest) ProtoMessage() {}
+
+func (x *WatchMicroVMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMicroVMsRequest.ProtoReflect.Descriptor instead.
+func (*WatchMicroVMsRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchMicroVMsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchMicroVMsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *WatchMicroVMsRequest) GetResumeFromVersions() map[string]int32 {
+	if x != nil {
+		return x.ResumeFromVersions
+	}
+	return nil
+}
+
+type WatchMicroVMsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Type          WatchMicroVMsResponse_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=microvm.services.api.v1alpha1.WatchMicroVMsResponse_EventType" json:"type,omitempty"`
+	Microvm       *types.MicroVM                  `protobuf:"bytes,2,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMicroVMsResponse) Reset() {
+	*x = WatchMicroVMsResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMicroVMsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMicroVMsResponse) ProtoMessage() {}
+
+func (x *WatchMicroVMsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMicroVMsResponse.ProtoReflect.Descriptor instead.
+func (*WatchMicroVMsResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchMicroVMsResponse) GetType() WatchMicroVMsResponse_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchMicroVMsResponse_ADDED
+}
+
+func (x *WatchMicroVMsResponse) GetMicrovm() *types.MicroVM {
+	if x != nil {
+		return x.Microvm
+	}
+	return nil
+}
+
+type GetConsoleLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// TailLines is the number of lines from the end of the console output to start from. If
+	// not set all of the output is returned.
+	TailLines *int32 `protobuf:"varint,2,opt,name=tail_lines,json=tailLines,proto3,oneof" json:"tail_lines,omitempty"`
+	// Follow will keep the stream open and send new output as the guest writes it.
+	Follow        bool `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsoleLogRequest) Reset() {
+	*x = GetConsoleLogRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogRequest) ProtoMessage() {}
+
+func (x *GetConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetConsoleLogRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GetConsoleLogRequest) GetTailLines() int32 {
+	if x != nil && x.TailLines != nil {
+		return *x.TailLines
+	}
+	return 0
+}
+
+func (x *GetConsoleLogRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type AttachConsoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Uid of the microvm to attach to. Only needs to be set in the first message.
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Data is input to send to the console.
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachConsoleRequest) Reset() {
+	*x = AttachConsoleRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachConsoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachConsoleRequest) ProtoMessage() {}
+
+func (x *AttachConsoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachConsoleRequest.ProtoReflect.Descriptor instead.
+func (*AttachConsoleRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AttachConsoleRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AttachConsoleRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ConsoleOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsoleOutput) Reset() {
+	*x = ConsoleOutput{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsoleOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleOutput) ProtoMessage() {}
+
+func (x *ConsoleOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleOutput.ProtoReflect.Descriptor instead.
+func (*ConsoleOutput) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConsoleOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExecInMicroVMRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Uid of the microvm to run the command in. The uid, command, env and working_dir only
+	// need to be set in the first message.
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Command is the command to run and its arguments.
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	// Env is additional environment variables for the command.
+	Env map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// WorkingDir is the directory in the guest to run the command in.
+	WorkingDir *string `protobuf:"bytes,4,opt,name=working_dir,json=workingDir,proto3,oneof" json:"working_dir,omitempty"`
+	// Stdin is data to send to the stdin of the command.
+	Stdin []byte `protobuf:"bytes,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// CloseStdin closes the stdin of the command once any stdin in this message has been sent.
+	// Closing the client side of the stream also closes stdin.
+	CloseStdin    bool `protobuf:"varint,6,opt,name=close_stdin,json=closeStdin,proto3" json:"close_stdin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecInMicroVMRequest) Reset() {
+	*x = ExecInMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecInMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInMicroVMRequest) ProtoMessage() {}
+
+func (x *ExecInMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*ExecInMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExecInMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ExecInMicroVMRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ExecInMicroVMRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ExecInMicroVMRequest) GetWorkingDir() string {
+	if x != nil && x.WorkingDir != nil {
+		return *x.WorkingDir
+	}
+	return ""
+}
+
+func (x *ExecInMicroVMRequest) GetStdin() []byte {
+	if x != nil {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *ExecInMicroVMRequest) GetCloseStdin() bool {
+	if x != nil {
+		return x.CloseStdin
+	}
+	return false
+}
+
+type ExecInMicroVMResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Stdout []byte                 `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr []byte                 `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// ExitCode is set in the last message, once the command has finished.
+	ExitCode      *int32 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecInMicroVMResponse) Reset() {
+	*x = ExecInMicroVMResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecInMicroVMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInMicroVMResponse) ProtoMessage() {}
+
+func (x *ExecInMicroVMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInMicroVMResponse.ProtoReflect.Descriptor instead.
+func (*ExecInMicroVMResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExecInMicroVMResponse) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecInMicroVMResponse) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecInMicroVMResponse) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+type CopyToMicroVMRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Uid of the microvm to copy the file to. The uid, path and mode only need to be set
+	// in the first message.
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Path is the path of the file in the guest.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Mode is the permissions of the file if it's created.
+	Mode *uint32 `protobuf:"varint,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	// Data is the next chunk of the file content.
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyToMicroVMRequest) Reset() {
+	*x = CopyToMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyToMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyToMicroVMRequest) ProtoMessage() {}
+
+func (x *CopyToMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyToMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*CopyToMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CopyToMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CopyToMicroVMRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CopyToMicroVMRequest) GetMode() uint32 {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return 0
+}
+
+func (x *CopyToMicroVMRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CopyFromMicroVMRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Path is the path of the file in the guest.
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyFromMicroVMRequest) Reset() {
+	*x = CopyFromMicroVMRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyFromMicroVMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFromMicroVMRequest) ProtoMessage() {}
+
+func (x *CopyFromMicroVMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFromMicroVMRequest.ProtoReflect.Descriptor instead.
+func (*CopyFromMicroVMRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CopyFromMicroVMRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CopyFromMicroVMRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CopyFromMicroVMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyFromMicroVMResponse) Reset() {
+	*x = CopyFromMicroVMResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyFromMicroVMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFromMicroVMResponse) ProtoMessage() {}
+
+func (x *CopyFromMicroVMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFromMicroVMResponse.ProtoReflect.Descriptor instead.
+func (*CopyFromMicroVMResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CopyFromMicroVMResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MicrovmUid    string                 `protobuf:"bytes,1,opt,name=microvm_uid,json=microvmUid,proto3" json:"microvm_uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMicroVMRequest) Reset() {
-	*x = CreateMicroVMRequest{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[0]
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMicroVMRequest) String() string {
+func (x *CreateSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMicroVMRequest) ProtoMessage() {}
+func (*CreateSnapshotRequest) ProtoMessage() {}
 
-func (x *CreateMicroVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[0]
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,47 +1601,47 @@ func (x *CreateMicroVMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMicroVMRequest.ProtoReflect.Descriptor instead.
-func (*CreateMicroVMRequest) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateMicroVMRequest) GetMicrovm() *types.MicroVMSpec {
+func (x *CreateSnapshotRequest) GetMicrovmUid() string {
 	if x != nil {
-		return x.Microvm
+		return x.MicrovmUid
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateMicroVMRequest) GetMetadata() map[string]*anypb.Any {
+func (x *CreateSnapshotRequest) GetName() string {
 	if x != nil {
-		return x.Metadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateMicroVMResponse struct {
+type CreateSnapshotResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+	Snapshot      *types.Snapshot        `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMicroVMResponse) Reset() {
-	*x = CreateMicroVMResponse{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[1]
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMicroVMResponse) String() string {
+func (x *CreateSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMicroVMResponse) ProtoMessage() {}
+func (*CreateSnapshotResponse) ProtoMessage() {}
 
-func (x *CreateMicroVMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[1]
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,40 +1652,41 @@ func (x *CreateMicroVMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMicroVMResponse.ProtoReflect.Descriptor instead.
-func (*CreateMicroVMResponse) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateMicroVMResponse) GetMicrovm() *types.MicroVM {
+func (x *CreateSnapshotResponse) GetSnapshot() *types.Snapshot {
 	if x != nil {
-		return x.Microvm
+		return x.Snapshot
 	}
 	return nil
 }
 
-type DeleteMicroVMRequest struct {
+type ListSnapshotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MicrovmUid    *string                `protobuf:"bytes,2,opt,name=microvm_uid,json=microvmUid,proto3,oneof" json:"microvm_uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMicroVMRequest) Reset() {
-	*x = DeleteMicroVMRequest{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[2]
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMicroVMRequest) String() string {
+func (x *ListSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMicroVMRequest) ProtoMessage() {}
+func (*ListSnapshotsRequest) ProtoMessage() {}
 
-func (x *DeleteMicroVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[2]
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,40 +1697,91 @@ func (x *DeleteMicroVMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMicroVMRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMicroVMRequest) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DeleteMicroVMRequest) GetUid() string {
+func (x *ListSnapshotsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Uid
+		return x.Namespace
 	}
 	return ""
 }
 
-type GetMicroVMRequest struct {
+func (x *ListSnapshotsRequest) GetMicrovmUid() string {
+	if x != nil && x.MicrovmUid != nil {
+		return *x.MicrovmUid
+	}
+	return ""
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*types.Snapshot      `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*types.Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DeleteSnapshotRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMicroVMRequest) Reset() {
-	*x = GetMicroVMRequest{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[3]
+func (x *DeleteSnapshotRequest) Reset() {
+	*x = DeleteSnapshotRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMicroVMRequest) String() string {
+func (x *DeleteSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMicroVMRequest) ProtoMessage() {}
+func (*DeleteSnapshotRequest) ProtoMessage() {}
 
-func (x *GetMicroVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[3]
+func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,40 +1792,47 @@ func (x *GetMicroVMRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMicroVMRequest.ProtoReflect.Descriptor instead.
-func (*GetMicroVMRequest) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetMicroVMRequest) GetUid() string {
+func (x *DeleteSnapshotRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-type GetMicroVMResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+type GetHostCapacityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total is the resources that can be allocated to microvms, after the reserved
+	// resources are removed and the overcommit ratios are applied.
+	Total *types.HostResources `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Allocated is the resources requested by the existing microvms.
+	Allocated *types.HostResources `protobuf:"bytes,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	// Free is the resources still available for new microvms. It can be negative
+	// if the host is overcommitted.
+	Free          *types.HostResources `protobuf:"bytes,3,opt,name=free,proto3" json:"free,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMicroVMResponse) Reset() {
-	*x = GetMicroVMResponse{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[4]
+func (x *GetHostCapacityResponse) Reset() {
+	*x = GetHostCapacityResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMicroVMResponse) String() string {
+func (x *GetHostCapacityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMicroVMResponse) ProtoMessage() {}
+func (*GetHostCapacityResponse) ProtoMessage() {}
 
-func (x *GetMicroVMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[4]
+func (x *GetHostCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -243,41 +1843,54 @@ func (x *GetMicroVMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMicroVMResponse.ProtoReflect.Descriptor instead.
-func (*GetMicroVMResponse) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetHostCapacityResponse.ProtoReflect.Descriptor instead.
+func (*GetHostCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetMicroVMResponse) GetMicrovm() *types.MicroVM {
+func (x *GetHostCapacityResponse) GetTotal() *types.HostResources {
 	if x != nil {
-		return x.Microvm
+		return x.Total
 	}
 	return nil
 }
 
-type ListMicroVMsRequest struct {
+func (x *GetHostCapacityResponse) GetAllocated() *types.HostResources {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+func (x *GetHostCapacityResponse) GetFree() *types.HostResources {
+	if x != nil {
+		return x.Free
+	}
+	return nil
+}
+
+type SetNamespaceQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Quota         *types.NamespaceQuota  `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMicroVMsRequest) Reset() {
-	*x = ListMicroVMsRequest{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[5]
+func (x *SetNamespaceQuotaRequest) Reset() {
+	*x = SetNamespaceQuotaRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMicroVMsRequest) String() string {
+func (x *SetNamespaceQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMicroVMsRequest) ProtoMessage() {}
+func (*SetNamespaceQuotaRequest) ProtoMessage() {}
 
-func (x *ListMicroVMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[5]
+func (x *SetNamespaceQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,47 +1901,84 @@ func (x *ListMicroVMsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMicroVMsRequest.ProtoReflect.Descriptor instead.
-func (*ListMicroVMsRequest) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SetNamespaceQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListMicroVMsRequest) GetNamespace() string {
+func (x *SetNamespaceQuotaRequest) GetQuota() *types.NamespaceQuota {
 	if x != nil {
-		return x.Namespace
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMicroVMsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+type SetNamespaceQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *types.NamespaceQuota  `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNamespaceQuotaResponse) Reset() {
+	*x = SetNamespaceQuotaResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNamespaceQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceQuotaResponse) ProtoMessage() {}
+
+func (x *SetNamespaceQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ListMicroVMsResponse struct {
+// Deprecated: Use SetNamespaceQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetNamespaceQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetNamespaceQuotaResponse) GetQuota() *types.NamespaceQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type GetNamespaceQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Microvm       []*types.MicroVM       `protobuf:"bytes,1,rep,name=microvm,proto3" json:"microvm,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMicroVMsResponse) Reset() {
-	*x = ListMicroVMsResponse{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[6]
+func (x *GetNamespaceQuotaRequest) Reset() {
+	*x = GetNamespaceQuotaRequest{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMicroVMsResponse) String() string {
+func (x *GetNamespaceQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMicroVMsResponse) ProtoMessage() {}
+func (*GetNamespaceQuotaRequest) ProtoMessage() {}
 
-func (x *ListMicroVMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[6]
+func (x *GetNamespaceQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,40 +1989,44 @@ func (x *ListMicroVMsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMicroVMsResponse.ProtoReflect.Descriptor instead.
-func (*ListMicroVMsResponse) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetNamespaceQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListMicroVMsResponse) GetMicrovm() []*types.MicroVM {
+func (x *GetNamespaceQuotaRequest) GetNamespace() string {
 	if x != nil {
-		return x.Microvm
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type ListMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Microvm       *types.MicroVM         `protobuf:"bytes,1,opt,name=microvm,proto3" json:"microvm,omitempty"`
+type GetNamespaceQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quota is the quota of the namespace. All the limits are 0 if the namespace
+	// doesn't have a quota.
+	Quota *types.NamespaceQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Used is the resources used by the microvms in the namespace.
+	Used          *types.QuotaResources `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMessage) Reset() {
-	*x = ListMessage{}
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[7]
+func (x *GetNamespaceQuotaResponse) Reset() {
+	*x = GetNamespaceQuotaResponse{}
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMessage) String() string {
+func (x *GetNamespaceQuotaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMessage) ProtoMessage() {}
+func (*GetNamespaceQuotaResponse) ProtoMessage() {}
 
-func (x *ListMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[7]
+func (x *GetNamespaceQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_microvm_v1alpha1_microvms_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,14 +2037,21 @@ func (x *ListMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMessage.ProtoReflect.Descriptor instead.
-func (*ListMessage) Descriptor() ([]byte, []int) {
-	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetNamespaceQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListMessage) GetMicrovm() *types.MicroVM {
+func (x *GetNamespaceQuotaResponse) GetQuota() *types.NamespaceQuota {
 	if x != nil {
-		return x.Microvm
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetNamespaceQuotaResponse) GetUsed() *types.QuotaResources {
+	if x != nil {
+		return x.Used
 	}
 	return nil
 }
@@ -435,91 +2096,471 @@ var file_services_microvm_v1alpha1_microvms_proto_rawDesc = string([]byte{
 	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d,
-	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x28, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x60, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
 	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x22, 0x25, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x12, 0x36, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x27, 0x0a,
+	0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x25,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22,
+	0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x5b, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
 	0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
-	0x6d, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69, 0x63,
-	0x72, 0x6f, 0x76, 0x6d, 0x22, 0x41, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07,
-	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x32, 0xd7, 0x05, 0x0a, 0x07, 0x4d, 0x69, 0x63, 0x72,
-	0x6f, 0x56, 0x4d, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72,
-	0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x63,
-	0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
-	0x6d, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63,
-	0x72, 0x6f, 0x76, 0x6d, 0x12, 0x7d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x22, 0x23, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x72, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x76, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c,
+	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x52, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x8e, 0x03, 0x0a, 0x14, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x57, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x7d, 0x0a, 0x14, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x15, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x76, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x69, 0x6e,
+	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x52, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x31, 0x0a, 0x09,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x69,
+	0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x23, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63,
+	0x49, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x03,
+	0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x24, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69,
+	0x72, 0x22, 0x77, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x20, 0x0a, 0x09, 0x65, 0x78,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x43, 0x6f,
+	0x70, 0x79, 0x54, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x3e,
+	0x0a, 0x16, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2d,
+	0x0a, 0x17, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4c, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x55, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x6a, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x76, 0x6d, 0x55, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x5f, 0x75, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x69,
+	0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x22, 0x51, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x33, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x32, 0xe5, 0x1a, 0x0a, 0x07, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x22, 0x11,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x12, 0xa4, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x07, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x1a,
+	0x17, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x31, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x69, 0x63, 0x72,
 	0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x61,
+	0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x75,
-	0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f,
-	0x56, 0x4d, 0x12, 0x30, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x81, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x32, 0x2e, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d,
+	0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x87, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12,
+	0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x32, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b,
+	0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x12, 0x7d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x56, 0x4d, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d,
+	0x12, 0x92, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12,
+	0x30, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f,
+	0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x2e, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
-	0x17, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f,
-	0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x12, 0x32, 0x2e, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x12, 0x32, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x76, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x32,
+	0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01,
+	0x12, 0x7c, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x73, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x74,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x12,
+	0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x30, 0x01, 0x12, 0x76, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x28, 0x01, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x0d,
+	0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x49, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x0d,
+	0x43, 0x6f, 0x70, 0x79, 0x54, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x12, 0x33, 0x2e,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x70, 0x79, 0x54, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x82, 0x01, 0x0a,
+	0x0f, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x12, 0x35, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x72, 0x6f, 0x6d,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0xb2, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x34, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f,
+	0x7b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x5f, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
 	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x76, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x32, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30,
-	0x01, 0x42, 0xdf, 0x01, 0x92, 0x41, 0x97, 0x01, 0x12, 0x71, 0x0a, 0x15, 0x46, 0x6c, 0x69, 0x6e,
-	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x20, 0x41, 0x50,
-	0x49, 0x12, 0x53, 0x54, 0x68, 0x65, 0x20, 0x46, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
-	0x20, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x20, 0x41, 0x50, 0x49, 0x20, 0x68, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-	0x66, 0x6f, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x69,
-	0x63, 0x72, 0x6f, 0x76, 0x6d, 0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x32, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a,
-	0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x6c, 0x69, 0x6e,
-	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x34,
+	0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x82,
+	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x63, 0x72,
+	0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x1a, 0x21, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2f, 0x7b, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0xab,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x42, 0xdf, 0x01, 0x92,
+	0x41, 0x97, 0x01, 0x12, 0x71, 0x0a, 0x15, 0x46, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
+	0x20, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x20, 0x41, 0x50, 0x49, 0x12, 0x53, 0x54, 0x68,
+	0x65, 0x20, 0x46, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x20, 0x41, 0x50, 0x49, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x20,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d,
+	0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x6d, 0x65, 0x74,
+	0x61, 0x6c, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -534,45 +2575,143 @@ func file_services_microvm_v1alpha1_microvms_proto_rawDescGZIP() []byte {
 	return file_services_microvm_v1alpha1_microvms_proto_rawDescData
 }
 
-var file_services_microvm_v1alpha1_microvms_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_services_microvm_v1alpha1_microvms_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_services_microvm_v1alpha1_microvms_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_services_microvm_v1alpha1_microvms_proto_goTypes = []any{
-	(*CreateMicroVMRequest)(nil),  // 0: microvm.services.api.v1alpha1.CreateMicroVMRequest
-	(*CreateMicroVMResponse)(nil), // 1: microvm.services.api.v1alpha1.CreateMicroVMResponse
-	(*DeleteMicroVMRequest)(nil),  // 2: microvm.services.api.v1alpha1.DeleteMicroVMRequest
-	(*GetMicroVMRequest)(nil),     // 3: microvm.services.api.v1alpha1.GetMicroVMRequest
-	(*GetMicroVMResponse)(nil),    // 4: microvm.services.api.v1alpha1.GetMicroVMResponse
-	(*ListMicroVMsRequest)(nil),   // 5: microvm.services.api.v1alpha1.ListMicroVMsRequest
-	(*ListMicroVMsResponse)(nil),  // 6: microvm.services.api.v1alpha1.ListMicroVMsResponse
-	(*ListMessage)(nil),           // 7: microvm.services.api.v1alpha1.ListMessage
-	nil,                           // 8: microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry
-	(*types.MicroVMSpec)(nil),     // 9: flintlock.types.MicroVMSpec
-	(*types.MicroVM)(nil),         // 10: flintlock.types.MicroVM
-	(*anypb.Any)(nil),             // 11: google.protobuf.Any
-	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+	(ListMicroVMsRequest_OrderBy)(0),      // 0: microvm.services.api.v1alpha1.ListMicroVMsRequest.OrderBy
+	(WatchMicroVMsResponse_EventType)(0),  // 1: microvm.services.api.v1alpha1.WatchMicroVMsResponse.EventType
+	(*CreateMicroVMRequest)(nil),          // 2: microvm.services.api.v1alpha1.CreateMicroVMRequest
+	(*CreateMicroVMResponse)(nil),         // 3: microvm.services.api.v1alpha1.CreateMicroVMResponse
+	(*UpdateMicroVMRequest)(nil),          // 4: microvm.services.api.v1alpha1.UpdateMicroVMRequest
+	(*UpdateMicroVMResponse)(nil),         // 5: microvm.services.api.v1alpha1.UpdateMicroVMResponse
+	(*StopMicroVMRequest)(nil),            // 6: microvm.services.api.v1alpha1.StopMicroVMRequest
+	(*StartMicroVMRequest)(nil),           // 7: microvm.services.api.v1alpha1.StartMicroVMRequest
+	(*RestartMicroVMRequest)(nil),         // 8: microvm.services.api.v1alpha1.RestartMicroVMRequest
+	(*PauseMicroVMRequest)(nil),           // 9: microvm.services.api.v1alpha1.PauseMicroVMRequest
+	(*ResumeMicroVMRequest)(nil),          // 10: microvm.services.api.v1alpha1.ResumeMicroVMRequest
+	(*DeleteMicroVMRequest)(nil),          // 11: microvm.services.api.v1alpha1.DeleteMicroVMRequest
+	(*GetMicroVMRequest)(nil),             // 12: microvm.services.api.v1alpha1.GetMicroVMRequest
+	(*GetMicroVMResponse)(nil),            // 13: microvm.services.api.v1alpha1.GetMicroVMResponse
+	(*GetMicroVMHistoryRequest)(nil),      // 14: microvm.services.api.v1alpha1.GetMicroVMHistoryRequest
+	(*GetMicroVMHistoryResponse)(nil),     // 15: microvm.services.api.v1alpha1.GetMicroVMHistoryResponse
+	(*ListMicroVMsRequest)(nil),           // 16: microvm.services.api.v1alpha1.ListMicroVMsRequest
+	(*ListMicroVMsResponse)(nil),          // 17: microvm.services.api.v1alpha1.ListMicroVMsResponse
+	(*ListMessage)(nil),                   // 18: microvm.services.api.v1alpha1.ListMessage
+	(*WatchMicroVMsRequest)(nil),          // 19: microvm.services.api.v1alpha1.WatchMicroVMsRequest
+	(*WatchMicroVMsResponse)(nil),         // 20: microvm.services.api.v1alpha1.WatchMicroVMsResponse
+	(*GetConsoleLogRequest)(nil),          // 21: microvm.services.api.v1alpha1.GetConsoleLogRequest
+	(*AttachConsoleRequest)(nil),          // 22: microvm.services.api.v1alpha1.AttachConsoleRequest
+	(*ConsoleOutput)(nil),                 // 23: microvm.services.api.v1alpha1.ConsoleOutput
+	(*ExecInMicroVMRequest)(nil),          // 24: microvm.services.api.v1alpha1.ExecInMicroVMRequest
+	(*ExecInMicroVMResponse)(nil),         // 25: microvm.services.api.v1alpha1.ExecInMicroVMResponse
+	(*CopyToMicroVMRequest)(nil),          // 26: microvm.services.api.v1alpha1.CopyToMicroVMRequest
+	(*CopyFromMicroVMRequest)(nil),        // 27: microvm.services.api.v1alpha1.CopyFromMicroVMRequest
+	(*CopyFromMicroVMResponse)(nil),       // 28: microvm.services.api.v1alpha1.CopyFromMicroVMResponse
+	(*CreateSnapshotRequest)(nil),         // 29: microvm.services.api.v1alpha1.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),        // 30: microvm.services.api.v1alpha1.CreateSnapshotResponse
+	(*ListSnapshotsRequest)(nil),          // 31: microvm.services.api.v1alpha1.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),         // 32: microvm.services.api.v1alpha1.ListSnapshotsResponse
+	(*DeleteSnapshotRequest)(nil),         // 33: microvm.services.api.v1alpha1.DeleteSnapshotRequest
+	(*GetHostCapacityResponse)(nil),       // 34: microvm.services.api.v1alpha1.GetHostCapacityResponse
+	(*SetNamespaceQuotaRequest)(nil),      // 35: microvm.services.api.v1alpha1.SetNamespaceQuotaRequest
+	(*SetNamespaceQuotaResponse)(nil),     // 36: microvm.services.api.v1alpha1.SetNamespaceQuotaResponse
+	(*GetNamespaceQuotaRequest)(nil),      // 37: microvm.services.api.v1alpha1.GetNamespaceQuotaRequest
+	(*GetNamespaceQuotaResponse)(nil),     // 38: microvm.services.api.v1alpha1.GetNamespaceQuotaResponse
+	nil,                                   // 39: microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry
+	nil,                                   // 40: microvm.services.api.v1alpha1.WatchMicroVMsRequest.LabelsEntry
+	nil,                                   // 41: microvm.services.api.v1alpha1.WatchMicroVMsRequest.ResumeFromVersionsEntry
+	nil,                                   // 42: microvm.services.api.v1alpha1.ExecInMicroVMRequest.EnvEntry
+	(*types.MicroVMSpec)(nil),             // 43: flintlock.types.MicroVMSpec
+	(*types.MicroVM)(nil),                 // 44: flintlock.types.MicroVM
+	(*types.PlanExecution)(nil),           // 45: flintlock.types.PlanExecution
+	(types.MicroVMStatus_MicroVMState)(0), // 46: flintlock.types.MicroVMStatus.MicroVMState
+	(*types.Snapshot)(nil),                // 47: flintlock.types.Snapshot
+	(*types.HostResources)(nil),           // 48: flintlock.types.HostResources
+	(*types.NamespaceQuota)(nil),          // 49: flintlock.types.NamespaceQuota
+	(*types.QuotaResources)(nil),          // 50: flintlock.types.QuotaResources
+	(*anypb.Any)(nil),                     // 51: google.protobuf.Any
+	(*emptypb.Empty)(nil),                 // 52: google.protobuf.Empty
 }
 var file_services_microvm_v1alpha1_microvms_proto_depIdxs = []int32{
-	9,  // 0: microvm.services.api.v1alpha1.CreateMicroVMRequest.microvm:type_name -> flintlock.types.MicroVMSpec
-	8,  // 1: microvm.services.api.v1alpha1.CreateMicroVMRequest.metadata:type_name -> microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry
-	10, // 2: microvm.services.api.v1alpha1.CreateMicroVMResponse.microvm:type_name -> flintlock.types.MicroVM
-	10, // 3: microvm.services.api.v1alpha1.GetMicroVMResponse.microvm:type_name -> flintlock.types.MicroVM
-	10, // 4: microvm.services.api.v1alpha1.ListMicroVMsResponse.microvm:type_name -> flintlock.types.MicroVM
-	10, // 5: microvm.services.api.v1alpha1.ListMessage.microvm:type_name -> flintlock.types.MicroVM
-	11, // 6: microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry.value:type_name -> google.protobuf.Any
-	0,  // 7: microvm.services.api.v1alpha1.MicroVM.CreateMicroVM:input_type -> microvm.services.api.v1alpha1.CreateMicroVMRequest
-	2,  // 8: microvm.services.api.v1alpha1.MicroVM.DeleteMicroVM:input_type -> microvm.services.api.v1alpha1.DeleteMicroVMRequest
-	3,  // 9: microvm.services.api.v1alpha1.MicroVM.GetMicroVM:input_type -> microvm.services.api.v1alpha1.GetMicroVMRequest
-	5,  // 10: microvm.services.api.v1alpha1.MicroVM.ListMicroVMs:input_type -> microvm.services.api.v1alpha1.ListMicroVMsRequest
-	5,  // 11: microvm.services.api.v1alpha1.MicroVM.ListMicroVMsStream:input_type -> microvm.services.api.v1alpha1.ListMicroVMsRequest
-	1,  // 12: microvm.services.api.v1alpha1.MicroVM.CreateMicroVM:output_type -> microvm.services.api.v1alpha1.CreateMicroVMResponse
-	12, // 13: microvm.services.api.v1alpha1.MicroVM.DeleteMicroVM:output_type -> google.protobuf.Empty
-	4,  // 14: microvm.services.api.v1alpha1.MicroVM.GetMicroVM:output_type -> microvm.services.api.v1alpha1.GetMicroVMResponse
-	6,  // 15: microvm.services.api.v1alpha1.MicroVM.ListMicroVMs:output_type -> microvm.services.api.v1alpha1.ListMicroVMsResponse
-	7,  // 16: microvm.services.api.v1alpha1.MicroVM.ListMicroVMsStream:output_type -> microvm.services.api.v1alpha1.ListMessage
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	43, // 0: microvm.services.api.v1alpha1.CreateMicroVMRequest.microvm:type_name -> flintlock.types.MicroVMSpec
+	39, // 1: microvm.services.api.v1alpha1.CreateMicroVMRequest.metadata:type_name -> microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry
+	44, // 2: microvm.services.api.v1alpha1.CreateMicroVMResponse.microvm:type_name -> flintlock.types.MicroVM
+	43, // 3: microvm.services.api.v1alpha1.UpdateMicroVMRequest.microvm:type_name -> flintlock.types.MicroVMSpec
+	44, // 4: microvm.services.api.v1alpha1.UpdateMicroVMResponse.microvm:type_name -> flintlock.types.MicroVM
+	44, // 5: microvm.services.api.v1alpha1.GetMicroVMResponse.microvm:type_name -> flintlock.types.MicroVM
+	45, // 6: microvm.services.api.v1alpha1.GetMicroVMHistoryResponse.executions:type_name -> flintlock.types.PlanExecution
+	46, // 7: microvm.services.api.v1alpha1.ListMicroVMsRequest.states:type_name -> flintlock.types.MicroVMStatus.MicroVMState
+	0,  // 8: microvm.services.api.v1alpha1.ListMicroVMsRequest.order_by:type_name -> microvm.services.api.v1alpha1.ListMicroVMsRequest.OrderBy
+	44, // 9: microvm.services.api.v1alpha1.ListMicroVMsResponse.microvm:type_name -> flintlock.types.MicroVM
+	44, // 10: microvm.services.api.v1alpha1.ListMessage.microvm:type_name -> flintlock.types.MicroVM
+	40, // 11: microvm.services.api.v1alpha1.WatchMicroVMsRequest.labels:type_name -> microvm.services.api.v1alpha1.WatchMicroVMsRequest.LabelsEntry
+	41, // 12: microvm.services.api.v1alpha1.WatchMicroVMsRequest.resume_from_versions:type_name -> microvm.services.api.v1alpha1.WatchMicroVMsRequest.ResumeFromVersionsEntry
+	1,  // 13: microvm.services.api.v1alpha1.WatchMicroVMsResponse.type:type_name -> microvm.services.api.v1alpha1.WatchMicroVMsResponse.EventType
+	44, // 14: microvm.services.api.v1alpha1.WatchMicroVMsResponse.microvm:type_name -> flintlock.types.MicroVM
+	42, // 15: microvm.services.api.v1alpha1.ExecInMicroVMRequest.env:type_name -> microvm.services.api.v1alpha1.ExecInMicroVMRequest.EnvEntry
+	47, // 16: microvm.services.api.v1alpha1.CreateSnapshotResponse.snapshot:type_name -> flintlock.types.Snapshot
+	47, // 17: microvm.services.api.v1alpha1.ListSnapshotsResponse.snapshots:type_name -> flintlock.types.Snapshot
+	48, // 18: microvm.services.api.v1alpha1.GetHostCapacityResponse.total:type_name -> flintlock.types.HostResources
+	48, // 19: microvm.services.api.v1alpha1.GetHostCapacityResponse.allocated:type_name -> flintlock.types.HostResources
+	48, // 20: microvm.services.api.v1alpha1.GetHostCapacityResponse.free:type_name -> flintlock.types.HostResources
+	49, // 21: microvm.services.api.v1alpha1.SetNamespaceQuotaRequest.quota:type_name -> flintlock.types.NamespaceQuota
+	49, // 22: microvm.services.api.v1alpha1.SetNamespaceQuotaResponse.quota:type_name -> flintlock.types.NamespaceQuota
+	49, // 23: microvm.services.api.v1alpha1.GetNamespaceQuotaResponse.quota:type_name -> flintlock.types.NamespaceQuota
+	50, // 24: microvm.services.api.v1alpha1.GetNamespaceQuotaResponse.used:type_name -> flintlock.types.QuotaResources
+	51, // 25: microvm.services.api.v1alpha1.CreateMicroVMRequest.MetadataEntry.value:type_name -> google.protobuf.Any
+	2,  // 26: microvm.services.api.v1alpha1.MicroVM.CreateMicroVM:input_type -> microvm.services.api.v1alpha1.CreateMicroVMRequest
+	4,  // 27: microvm.services.api.v1alpha1.MicroVM.UpdateMicroVM:input_type -> microvm.services.api.v1alpha1.UpdateMicroVMRequest
+	6,  // 28: microvm.services.api.v1alpha1.MicroVM.StopMicroVM:input_type -> microvm.services.api.v1alpha1.StopMicroVMRequest
+	7,  // 29: microvm.services.api.v1alpha1.MicroVM.StartMicroVM:input_type -> microvm.services.api.v1alpha1.StartMicroVMRequest
+	8,  // 30: microvm.services.api.v1alpha1.MicroVM.RestartMicroVM:input_type -> microvm.services.api.v1alpha1.RestartMicroVMRequest
+	9,  // 31: microvm.services.api.v1alpha1.MicroVM.PauseMicroVM:input_type -> microvm.services.api.v1alpha1.PauseMicroVMRequest
+	10, // 32: microvm.services.api.v1alpha1.MicroVM.ResumeMicroVM:input_type -> microvm.services.api.v1alpha1.ResumeMicroVMRequest
+	11, // 33: microvm.services.api.v1alpha1.MicroVM.DeleteMicroVM:input_type -> microvm.services.api.v1alpha1.DeleteMicroVMRequest
+	12, // 34: microvm.services.api.v1alpha1.MicroVM.GetMicroVM:input_type -> microvm.services.api.v1alpha1.GetMicroVMRequest
+	14, // 35: microvm.services.api.v1alpha1.MicroVM.GetMicroVMHistory:input_type -> microvm.services.api.v1alpha1.GetMicroVMHistoryRequest
+	16, // 36: microvm.services.api.v1alpha1.MicroVM.ListMicroVMs:input_type -> microvm.services.api.v1alpha1.ListMicroVMsRequest
+	16, // 37: microvm.services.api.v1alpha1.MicroVM.ListMicroVMsStream:input_type -> microvm.services.api.v1alpha1.ListMicroVMsRequest
+	19, // 38: microvm.services.api.v1alpha1.MicroVM.WatchMicroVMs:input_type -> microvm.services.api.v1alpha1.WatchMicroVMsRequest
+	21, // 39: microvm.services.api.v1alpha1.MicroVM.GetConsoleLog:input_type -> microvm.services.api.v1alpha1.GetConsoleLogRequest
+	22, // 40: microvm.services.api.v1alpha1.MicroVM.AttachConsole:input_type -> microvm.services.api.v1alpha1.AttachConsoleRequest
+	24, // 41: microvm.services.api.v1alpha1.MicroVM.ExecInMicroVM:input_type -> microvm.services.api.v1alpha1.ExecInMicroVMRequest
+	26, // 42: microvm.services.api.v1alpha1.MicroVM.CopyToMicroVM:input_type -> microvm.services.api.v1alpha1.CopyToMicroVMRequest
+	27, // 43: microvm.services.api.v1alpha1.MicroVM.CopyFromMicroVM:input_type -> microvm.services.api.v1alpha1.CopyFromMicroVMRequest
+	29, // 44: microvm.services.api.v1alpha1.MicroVM.CreateSnapshot:input_type -> microvm.services.api.v1alpha1.CreateSnapshotRequest
+	31, // 45: microvm.services.api.v1alpha1.MicroVM.ListSnapshots:input_type -> microvm.services.api.v1alpha1.ListSnapshotsRequest
+	33, // 46: microvm.services.api.v1alpha1.MicroVM.DeleteSnapshot:input_type -> microvm.services.api.v1alpha1.DeleteSnapshotRequest
+	52, // 47: microvm.services.api.v1alpha1.MicroVM.GetHostCapacity:input_type -> google.protobuf.Empty
+	35, // 48: microvm.services.api.v1alpha1.MicroVM.SetNamespaceQuota:input_type -> microvm.services.api.v1alpha1.SetNamespaceQuotaRequest
+	37, // 49: microvm.services.api.v1alpha1.MicroVM.GetNamespaceQuota:input_type -> microvm.services.api.v1alpha1.GetNamespaceQuotaRequest
+	3,  // 50: microvm.services.api.v1alpha1.MicroVM.CreateMicroVM:output_type -> microvm.services.api.v1alpha1.CreateMicroVMResponse
+	5,  // 51: microvm.services.api.v1alpha1.MicroVM.UpdateMicroVM:output_type -> microvm.services.api.v1alpha1.UpdateMicroVMResponse
+	52, // 52: microvm.services.api.v1alpha1.MicroVM.StopMicroVM:output_type -> google.protobuf.Empty
+	52, // 53: microvm.services.api.v1alpha1.MicroVM.StartMicroVM:output_type -> google.protobuf.Empty
+	52, // 54: microvm.services.api.v1alpha1.MicroVM.RestartMicroVM:output_type -> google.protobuf.Empty
+	52, // 55: microvm.services.api.v1alpha1.MicroVM.PauseMicroVM:output_type -> google.protobuf.Empty
+	52, // 56: microvm.services.api.v1alpha1.MicroVM.ResumeMicroVM:output_type -> google.protobuf.Empty
+	52, // 57: microvm.services.api.v1alpha1.MicroVM.DeleteMicroVM:output_type -> google.protobuf.Empty
+	13, // 58: microvm.services.api.v1alpha1.MicroVM.GetMicroVM:output_type -> microvm.services.api.v1alpha1.GetMicroVMResponse
+	15, // 59: microvm.services.api.v1alpha1.MicroVM.GetMicroVMHistory:output_type -> microvm.services.api.v1alpha1.GetMicroVMHistoryResponse
+	17, // 60: microvm.services.api.v1alpha1.MicroVM.ListMicroVMs:output_type -> microvm.services.api.v1alpha1.ListMicroVMsResponse
+	18, // 61: microvm.services.api.v1alpha1.MicroVM.ListMicroVMsStream:output_type -> microvm.services.api.v1alpha1.ListMessage
+	20, // 62: microvm.services.api.v1alpha1.MicroVM.WatchMicroVMs:output_type -> microvm.services.api.v1alpha1.WatchMicroVMsResponse
+	23, // 63: microvm.services.api.v1alpha1.MicroVM.GetConsoleLog:output_type -> microvm.services.api.v1alpha1.ConsoleOutput
+	23, // 64: microvm.services.api.v1alpha1.MicroVM.AttachConsole:output_type -> microvm.services.api.v1alpha1.ConsoleOutput
+	25, // 65: microvm.services.api.v1alpha1.MicroVM.ExecInMicroVM:output_type -> microvm.services.api.v1alpha1.ExecInMicroVMResponse
+	52, // 66: microvm.services.api.v1alpha1.MicroVM.CopyToMicroVM:output_type -> google.protobuf.Empty
+	28, // 67: microvm.services.api.v1alpha1.MicroVM.CopyFromMicroVM:output_type -> microvm.services.api.v1alpha1.CopyFromMicroVMResponse
+	30, // 68: microvm.services.api.v1alpha1.MicroVM.CreateSnapshot:output_type -> microvm.services.api.v1alpha1.CreateSnapshotResponse
+	32, // 69: microvm.services.api.v1alpha1.MicroVM.ListSnapshots:output_type -> microvm.services.api.v1alpha1.ListSnapshotsResponse
+	52, // 70: microvm.services.api.v1alpha1.MicroVM.DeleteSnapshot:output_type -> google.protobuf.Empty
+	34, // 71: microvm.services.api.v1alpha1.MicroVM.GetHostCapacity:output_type -> microvm.services.api.v1alpha1.GetHostCapacityResponse
+	36, // 72: microvm.services.api.v1alpha1.MicroVM.SetNamespaceQuota:output_type -> microvm.services.api.v1alpha1.SetNamespaceQuotaResponse
+	38, // 73: microvm.services.api.v1alpha1.MicroVM.GetNamespaceQuota:output_type -> microvm.services.api.v1alpha1.GetNamespaceQuotaResponse
+	50, // [50:74] is the sub-list for method output_type
+	26, // [26:50] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_services_microvm_v1alpha1_microvms_proto_init() }
@@ -580,19 +2719,25 @@ func file_services_microvm_v1alpha1_microvms_proto_init() {
 	if File_services_microvm_v1alpha1_microvms_proto != nil {
 		return
 	}
-	file_services_microvm_v1alpha1_microvms_proto_msgTypes[5].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[14].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[19].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[22].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[23].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[24].OneofWrappers = []any{}
+	file_services_microvm_v1alpha1_microvms_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_microvm_v1alpha1_microvms_proto_rawDesc), len(file_services_microvm_v1alpha1_microvms_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_services_microvm_v1alpha1_microvms_proto_goTypes,
 		DependencyIndexes: file_services_microvm_v1alpha1_microvms_proto_depIdxs,
+		EnumInfos:         file_services_microvm_v1alpha1_microvms_proto_enumTypes,
 		MessageInfos:      file_services_microvm_v1alpha1_microvms_proto_msgTypes,
 	}.Build()
 	File_services_microvm_v1alpha1_microvms_proto = out.File
@@ -21,10 +21,29 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MicroVM_CreateMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/CreateMicroVM"
+	MicroVM_UpdateMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/UpdateMicroVM"
+	MicroVM_StopMicroVM_FullMethodName        = "/microvm.services.api.v1alpha1.MicroVM/StopMicroVM"
+	MicroVM_StartMicroVM_FullMethodName       = "/microvm.services.api.v1alpha1.MicroVM/StartMicroVM"
+	MicroVM_RestartMicroVM_FullMethodName     = "/microvm.services.api.v1alpha1.MicroVM/RestartMicroVM"
+	MicroVM_PauseMicroVM_FullMethodName       = "/microvm.services.api.v1alpha1.MicroVM/PauseMicroVM"
+	MicroVM_ResumeMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/ResumeMicroVM"
 	MicroVM_DeleteMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/DeleteMicroVM"
 	MicroVM_GetMicroVM_FullMethodName         = "/microvm.services.api.v1alpha1.MicroVM/GetMicroVM"
+	MicroVM_GetMicroVMHistory_FullMethodName  = "/microvm.services.api.v1alpha1.MicroVM/GetMicroVMHistory"
 	MicroVM_ListMicroVMs_FullMethodName       = "/microvm.services.api.v1alpha1.MicroVM/ListMicroVMs"
 	MicroVM_ListMicroVMsStream_FullMethodName = "/microvm.services.api.v1alpha1.MicroVM/ListMicroVMsStream"
+	MicroVM_WatchMicroVMs_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/WatchMicroVMs"
+	MicroVM_GetConsoleLog_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/GetConsoleLog"
+	MicroVM_AttachConsole_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/AttachConsole"
+	MicroVM_ExecInMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/ExecInMicroVM"
+	MicroVM_CopyToMicroVM_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/CopyToMicroVM"
+	MicroVM_CopyFromMicroVM_FullMethodName    = "/microvm.services.api.v1alpha1.MicroVM/CopyFromMicroVM"
+	MicroVM_CreateSnapshot_FullMethodName     = "/microvm.services.api.v1alpha1.MicroVM/CreateSnapshot"
+	MicroVM_ListSnapshots_FullMethodName      = "/microvm.services.api.v1alpha1.MicroVM/ListSnapshots"
+	MicroVM_DeleteSnapshot_FullMethodName     = "/microvm.services.api.v1alpha1.MicroVM/DeleteSnapshot"
+	MicroVM_GetHostCapacity_FullMethodName    = "/microvm.services.api.v1alpha1.MicroVM/GetHostCapacity"
+	MicroVM_SetNamespaceQuota_FullMethodName  = "/microvm.services.api.v1alpha1.MicroVM/SetNamespaceQuota"
+	MicroVM_GetNamespaceQuota_FullMethodName  = "/microvm.services.api.v1alpha1.MicroVM/GetNamespaceQuota"
 )
 
 // MicroVMClient is the client API for MicroVM service.
@@ -34,10 +53,33 @@ const (
 // MicroVM providers a service to create and manage the lifecycle of microvms.
 type MicroVMClient interface {
 	CreateMicroVM(ctx context.Context, in *CreateMicroVMRequest, opts ...grpc.CallOption) (*CreateMicroVMResponse, error)
+	// UpdateMicroVM changes the network interfaces, volumes, metadata and labels of a microvm.
+	// The changes are applied to the running microvm without rebooting the guest. Adding or
+	// removing network interfaces and volumes, and changing metadata or network rate limits,
+	// is rejected if the provider can't do it while the microvm is running.
+	UpdateMicroVM(ctx context.Context, in *UpdateMicroVMRequest, opts ...grpc.CallOption) (*UpdateMicroVMResponse, error)
+	StopMicroVM(ctx context.Context, in *StopMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartMicroVM(ctx context.Context, in *StartMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestartMicroVM(ctx context.Context, in *RestartMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PauseMicroVM(ctx context.Context, in *PauseMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumeMicroVM(ctx context.Context, in *ResumeMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteMicroVM(ctx context.Context, in *DeleteMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetMicroVM(ctx context.Context, in *GetMicroVMRequest, opts ...grpc.CallOption) (*GetMicroVMResponse, error)
+	GetMicroVMHistory(ctx context.Context, in *GetMicroVMHistoryRequest, opts ...grpc.CallOption) (*GetMicroVMHistoryResponse, error)
 	ListMicroVMs(ctx context.Context, in *ListMicroVMsRequest, opts ...grpc.CallOption) (*ListMicroVMsResponse, error)
 	ListMicroVMsStream(ctx context.Context, in *ListMicroVMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListMessage], error)
+	WatchMicroVMs(ctx context.Context, in *WatchMicroVMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMicroVMsResponse], error)
+	GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConsoleOutput], error)
+	AttachConsole(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AttachConsoleRequest, ConsoleOutput], error)
+	ExecInMicroVM(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInMicroVMRequest, ExecInMicroVMResponse], error)
+	CopyToMicroVM(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CopyToMicroVMRequest, emptypb.Empty], error)
+	CopyFromMicroVM(ctx context.Context, in *CopyFromMicroVMRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyFromMicroVMResponse], error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHostCapacity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHostCapacityResponse, error)
+	SetNamespaceQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*SetNamespaceQuotaResponse, error)
+	GetNamespaceQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*GetNamespaceQuotaResponse, error)
 }
 
 type microVMClient struct {
@@ -58,6 +100,66 @@ func (c *microVMClient) CreateMicroVM(ctx context.Context, in *CreateMicroVMRequ
 	return out, nil
 }
 
+func (c *microVMClient) UpdateMicroVM(ctx context.Context, in *UpdateMicroVMRequest, opts ...grpc.CallOption) (*UpdateMicroVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMicroVMResponse)
+	err := c.cc.Invoke(ctx, MicroVM_UpdateMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) StopMicroVM(ctx context.Context, in *StopMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_StopMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) StartMicroVM(ctx context.Context, in *StartMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_StartMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) RestartMicroVM(ctx context.Context, in *RestartMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_RestartMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) PauseMicroVM(ctx context.Context, in *PauseMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_PauseMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) ResumeMicroVM(ctx context.Context, in *ResumeMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_ResumeMicroVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *microVMClient) DeleteMicroVM(ctx context.Context, in *DeleteMicroVMRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -78,6 +180,16 @@ func (c *microVMClient) GetMicroVM(ctx context.Context, in *GetMicroVMRequest, o
 	return out, nil
 }
 
+func (c *microVMClient) GetMicroVMHistory(ctx context.Context, in *GetMicroVMHistoryRequest, opts ...grpc.CallOption) (*GetMicroVMHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMicroVMHistoryResponse)
+	err := c.cc.Invoke(ctx, MicroVM_GetMicroVMHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *microVMClient) ListMicroVMs(ctx context.Context, in *ListMicroVMsRequest, opts ...grpc.CallOption) (*ListMicroVMsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMicroVMsResponse)
@@ -107,6 +219,162 @@ func (c *microVMClient) ListMicroVMsStream(ctx context.Context, in *ListMicroVMs
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MicroVM_ListMicroVMsStreamClient = grpc.ServerStreamingClient[ListMessage]
 
+func (c *microVMClient) WatchMicroVMs(ctx context.Context, in *WatchMicroVMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMicroVMsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[1], MicroVM_WatchMicroVMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMicroVMsRequest, WatchMicroVMsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_WatchMicroVMsClient = grpc.ServerStreamingClient[WatchMicroVMsResponse]
+
+func (c *microVMClient) GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConsoleOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[2], MicroVM_GetConsoleLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetConsoleLogRequest, ConsoleOutput]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_GetConsoleLogClient = grpc.ServerStreamingClient[ConsoleOutput]
+
+func (c *microVMClient) AttachConsole(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AttachConsoleRequest, ConsoleOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[3], MicroVM_AttachConsole_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AttachConsoleRequest, ConsoleOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_AttachConsoleClient = grpc.BidiStreamingClient[AttachConsoleRequest, ConsoleOutput]
+
+func (c *microVMClient) ExecInMicroVM(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInMicroVMRequest, ExecInMicroVMResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[4], MicroVM_ExecInMicroVM_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecInMicroVMRequest, ExecInMicroVMResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_ExecInMicroVMClient = grpc.BidiStreamingClient[ExecInMicroVMRequest, ExecInMicroVMResponse]
+
+func (c *microVMClient) CopyToMicroVM(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CopyToMicroVMRequest, emptypb.Empty], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[5], MicroVM_CopyToMicroVM_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyToMicroVMRequest, emptypb.Empty]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_CopyToMicroVMClient = grpc.ClientStreamingClient[CopyToMicroVMRequest, emptypb.Empty]
+
+func (c *microVMClient) CopyFromMicroVM(ctx context.Context, in *CopyFromMicroVMRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyFromMicroVMResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MicroVM_ServiceDesc.Streams[6], MicroVM_CopyFromMicroVM_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyFromMicroVMRequest, CopyFromMicroVMResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_CopyFromMicroVMClient = grpc.ServerStreamingClient[CopyFromMicroVMResponse]
+
+func (c *microVMClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, MicroVM_CreateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, MicroVM_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MicroVM_DeleteSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) GetHostCapacity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHostCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHostCapacityResponse)
+	err := c.cc.Invoke(ctx, MicroVM_GetHostCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) SetNamespaceQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*SetNamespaceQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNamespaceQuotaResponse)
+	err := c.cc.Invoke(ctx, MicroVM_SetNamespaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *microVMClient) GetNamespaceQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*GetNamespaceQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceQuotaResponse)
+	err := c.cc.Invoke(ctx, MicroVM_GetNamespaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MicroVMServer is the server API for MicroVM service.
 // All implementations should embed UnimplementedMicroVMServer
 // for forward compatibility.
@@ -114,10 +382,33 @@ type MicroVM_ListMicroVMsStreamClient = grpc.ServerStreamingClient[ListMessage]
 // MicroVM providers a service to create and manage the lifecycle of microvms.
 type MicroVMServer interface {
 	CreateMicroVM(context.Context, *CreateMicroVMRequest) (*CreateMicroVMResponse, error)
+	// UpdateMicroVM changes the network interfaces, volumes, metadata and labels of a microvm.
+	// The changes are applied to the running microvm without rebooting the guest. Adding or
+	// removing network interfaces and volumes, and changing metadata or network rate limits,
+	// is rejected if the provider can't do it while the microvm is running.
+	UpdateMicroVM(context.Context, *UpdateMicroVMRequest) (*UpdateMicroVMResponse, error)
+	StopMicroVM(context.Context, *StopMicroVMRequest) (*emptypb.Empty, error)
+	StartMicroVM(context.Context, *StartMicroVMRequest) (*emptypb.Empty, error)
+	RestartMicroVM(context.Context, *RestartMicroVMRequest) (*emptypb.Empty, error)
+	PauseMicroVM(context.Context, *PauseMicroVMRequest) (*emptypb.Empty, error)
+	ResumeMicroVM(context.Context, *ResumeMicroVMRequest) (*emptypb.Empty, error)
 	DeleteMicroVM(context.Context, *DeleteMicroVMRequest) (*emptypb.Empty, error)
 	GetMicroVM(context.Context, *GetMicroVMRequest) (*GetMicroVMResponse, error)
+	GetMicroVMHistory(context.Context, *GetMicroVMHistoryRequest) (*GetMicroVMHistoryResponse, error)
 	ListMicroVMs(context.Context, *ListMicroVMsRequest) (*ListMicroVMsResponse, error)
 	ListMicroVMsStream(*ListMicroVMsRequest, grpc.ServerStreamingServer[ListMessage]) error
+	WatchMicroVMs(*WatchMicroVMsRequest, grpc.ServerStreamingServer[WatchMicroVMsResponse]) error
+	GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[ConsoleOutput]) error
+	AttachConsole(grpc.BidiStreamingServer[AttachConsoleRequest, ConsoleOutput]) error
+	ExecInMicroVM(grpc.BidiStreamingServer[ExecInMicroVMRequest, ExecInMicroVMResponse]) error
+	CopyToMicroVM(grpc.ClientStreamingServer[CopyToMicroVMRequest, emptypb.Empty]) error
+	CopyFromMicroVM(*CopyFromMicroVMRequest, grpc.ServerStreamingServer[CopyFromMicroVMResponse]) error
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*emptypb.Empty, error)
+	GetHostCapacity(context.Context, *emptypb.Empty) (*GetHostCapacityResponse, error)
+	SetNamespaceQuota(context.Context, *SetNamespaceQuotaRequest) (*SetNamespaceQuotaResponse, error)
+	GetNamespaceQuota(context.Context, *GetNamespaceQuotaRequest) (*GetNamespaceQuotaResponse, error)
 }
 
 // UnimplementedMicroVMServer should be embedded to have
@@ -130,18 +421,75 @@ type UnimplementedMicroVMServer struct{}
 func (UnimplementedMicroVMServer) CreateMicroVM(context.Context, *CreateMicroVMRequest) (*CreateMicroVMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMicroVM not implemented")
 }
+func (UnimplementedMicroVMServer) UpdateMicroVM(context.Context, *UpdateMicroVMRequest) (*UpdateMicroVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) StopMicroVM(context.Context, *StopMicroVMRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) StartMicroVM(context.Context, *StartMicroVMRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) RestartMicroVM(context.Context, *RestartMicroVMRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) PauseMicroVM(context.Context, *PauseMicroVMRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) ResumeMicroVM(context.Context, *ResumeMicroVMRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMicroVM not implemented")
+}
 func (UnimplementedMicroVMServer) DeleteMicroVM(context.Context, *DeleteMicroVMRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMicroVM not implemented")
 }
 func (UnimplementedMicroVMServer) GetMicroVM(context.Context, *GetMicroVMRequest) (*GetMicroVMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMicroVM not implemented")
 }
+func (UnimplementedMicroVMServer) GetMicroVMHistory(context.Context, *GetMicroVMHistoryRequest) (*GetMicroVMHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMicroVMHistory not implemented")
+}
 func (UnimplementedMicroVMServer) ListMicroVMs(context.Context, *ListMicroVMsRequest) (*ListMicroVMsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMicroVMs not implemented")
 }
 func (UnimplementedMicroVMServer) ListMicroVMsStream(*ListMicroVMsRequest, grpc.ServerStreamingServer[ListMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method ListMicroVMsStream not implemented")
 }
+func (UnimplementedMicroVMServer) WatchMicroVMs(*WatchMicroVMsRequest, grpc.ServerStreamingServer[WatchMicroVMsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMicroVMs not implemented")
+}
+func (UnimplementedMicroVMServer) GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[ConsoleOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsoleLog not implemented")
+}
+func (UnimplementedMicroVMServer) AttachConsole(grpc.BidiStreamingServer[AttachConsoleRequest, ConsoleOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method AttachConsole not implemented")
+}
+func (UnimplementedMicroVMServer) ExecInMicroVM(grpc.BidiStreamingServer[ExecInMicroVMRequest, ExecInMicroVMResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExecInMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) CopyToMicroVM(grpc.ClientStreamingServer[CopyToMicroVMRequest, emptypb.Empty]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyToMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) CopyFromMicroVM(*CopyFromMicroVMRequest, grpc.ServerStreamingServer[CopyFromMicroVMResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyFromMicroVM not implemented")
+}
+func (UnimplementedMicroVMServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedMicroVMServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedMicroVMServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedMicroVMServer) GetHostCapacity(context.Context, *emptypb.Empty) (*GetHostCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostCapacity not implemented")
+}
+func (UnimplementedMicroVMServer) SetNamespaceQuota(context.Context, *SetNamespaceQuotaRequest) (*SetNamespaceQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceQuota not implemented")
+}
+func (UnimplementedMicroVMServer) GetNamespaceQuota(context.Context, *GetNamespaceQuotaRequest) (*GetNamespaceQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceQuota not implemented")
+}
 func (UnimplementedMicroVMServer) testEmbeddedByValue() {}
 
 // UnsafeMicroVMServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +528,114 @@ func _MicroVM_CreateMicroVM_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MicroVM_UpdateMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).UpdateMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_UpdateMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).UpdateMicroVM(ctx, req.(*UpdateMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_StopMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).StopMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_StopMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).StopMicroVM(ctx, req.(*StopMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_StartMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).StartMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_StartMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).StartMicroVM(ctx, req.(*StartMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_RestartMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).RestartMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_RestartMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).RestartMicroVM(ctx, req.(*RestartMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_PauseMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).PauseMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_PauseMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).PauseMicroVM(ctx, req.(*PauseMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_ResumeMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeMicroVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).ResumeMicroVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_ResumeMicroVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).ResumeMicroVM(ctx, req.(*ResumeMicroVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MicroVM_DeleteMicroVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMicroVMRequest)
 	if err := dec(in); err != nil {
@@ -216,6 +672,24 @@ func _MicroVM_GetMicroVM_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MicroVM_GetMicroVMHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMicroVMHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).GetMicroVMHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_GetMicroVMHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).GetMicroVMHistory(ctx, req.(*GetMicroVMHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MicroVM_ListMicroVMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMicroVMsRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +719,168 @@ func _MicroVM_ListMicroVMsStream_Handler(srv interface{}, stream grpc.ServerStre
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MicroVM_ListMicroVMsStreamServer = grpc.ServerStreamingServer[ListMessage]
 
+func _MicroVM_WatchMicroVMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMicroVMsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MicroVMServer).WatchMicroVMs(m, &grpc.GenericServerStream[WatchMicroVMsRequest, WatchMicroVMsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_WatchMicroVMsServer = grpc.ServerStreamingServer[WatchMicroVMsResponse]
+
+func _MicroVM_GetConsoleLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsoleLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MicroVMServer).GetConsoleLog(m, &grpc.GenericServerStream[GetConsoleLogRequest, ConsoleOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_GetConsoleLogServer = grpc.ServerStreamingServer[ConsoleOutput]
+
+func _MicroVM_AttachConsole_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MicroVMServer).AttachConsole(&grpc.GenericServerStream[AttachConsoleRequest, ConsoleOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_AttachConsoleServer = grpc.BidiStreamingServer[AttachConsoleRequest, ConsoleOutput]
+
+func _MicroVM_ExecInMicroVM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MicroVMServer).ExecInMicroVM(&grpc.GenericServerStream[ExecInMicroVMRequest, ExecInMicroVMResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_ExecInMicroVMServer = grpc.BidiStreamingServer[ExecInMicroVMRequest, ExecInMicroVMResponse]
+
+func _MicroVM_CopyToMicroVM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MicroVMServer).CopyToMicroVM(&grpc.GenericServerStream[CopyToMicroVMRequest, emptypb.Empty]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_CopyToMicroVMServer = grpc.ClientStreamingServer[CopyToMicroVMRequest, emptypb.Empty]
+
+func _MicroVM_CopyFromMicroVM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromMicroVMRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MicroVMServer).CopyFromMicroVM(m, &grpc.GenericServerStream[CopyFromMicroVMRequest, CopyFromMicroVMResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MicroVM_CopyFromMicroVMServer = grpc.ServerStreamingServer[CopyFromMicroVMResponse]
+
+func _MicroVM_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_GetHostCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).GetHostCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_GetHostCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).GetHostCapacity(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_SetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).SetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_SetNamespaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).SetNamespaceQuota(ctx, req.(*SetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MicroVM_GetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MicroVMServer).GetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MicroVM_GetNamespaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MicroVMServer).GetNamespaceQuota(ctx, req.(*GetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MicroVM_ServiceDesc is the grpc.ServiceDesc for MicroVM service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -256,6 +892,30 @@ var MicroVM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMicroVM",
 			Handler:    _MicroVM_CreateMicroVM_Handler,
 		},
+		{
+			MethodName: "UpdateMicroVM",
+			Handler:    _MicroVM_UpdateMicroVM_Handler,
+		},
+		{
+			MethodName: "StopMicroVM",
+			Handler:    _MicroVM_StopMicroVM_Handler,
+		},
+		{
+			MethodName: "StartMicroVM",
+			Handler:    _MicroVM_StartMicroVM_Handler,
+		},
+		{
+			MethodName: "RestartMicroVM",
+			Handler:    _MicroVM_RestartMicroVM_Handler,
+		},
+		{
+			MethodName: "PauseMicroVM",
+			Handler:    _MicroVM_PauseMicroVM_Handler,
+		},
+		{
+			MethodName: "ResumeMicroVM",
+			Handler:    _MicroVM_ResumeMicroVM_Handler,
+		},
 		{
 			MethodName: "DeleteMicroVM",
 			Handler:    _MicroVM_DeleteMicroVM_Handler,
@@ -264,10 +924,38 @@ var MicroVM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMicroVM",
 			Handler:    _MicroVM_GetMicroVM_Handler,
 		},
+		{
+			MethodName: "GetMicroVMHistory",
+			Handler:    _MicroVM_GetMicroVMHistory_Handler,
+		},
 		{
 			MethodName: "ListMicroVMs",
 			Handler:    _MicroVM_ListMicroVMs_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _MicroVM_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _MicroVM_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _MicroVM_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "GetHostCapacity",
+			Handler:    _MicroVM_GetHostCapacity_Handler,
+		},
+		{
+			MethodName: "SetNamespaceQuota",
+			Handler:    _MicroVM_SetNamespaceQuota_Handler,
+		},
+		{
+			MethodName: "GetNamespaceQuota",
+			Handler:    _MicroVM_GetNamespaceQuota_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -275,6 +963,38 @@ var MicroVM_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MicroVM_ListMicroVMsStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchMicroVMs",
+			Handler:       _MicroVM_WatchMicroVMs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetConsoleLog",
+			Handler:       _MicroVM_GetConsoleLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AttachConsole",
+			Handler:       _MicroVM_AttachConsole_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExecInMicroVM",
+			Handler:       _MicroVM_ExecInMicroVM_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyToMicroVM",
+			Handler:       _MicroVM_CopyToMicroVM_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFromMicroVM",
+			Handler:       _MicroVM_CopyFromMicroVM_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "services/microvm/v1alpha1/microvms.proto",
 }
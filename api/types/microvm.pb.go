@@ -9,6 +9,7 @@ package types
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MicroVMSpec_PowerState int32
+
+const (
+	// RUNNING indicates the microvm should be running.
+	MicroVMSpec_RUNNING MicroVMSpec_PowerState = 0
+	// STOPPED indicates the microvm should be stopped. Its network interfaces, volumes
+	// and state are kept so it can be started again.
+	MicroVMSpec_STOPPED MicroVMSpec_PowerState = 1
+	// PAUSED indicates the microvm should be paused (frozen) in memory.
+	MicroVMSpec_PAUSED MicroVMSpec_PowerState = 2
+)
+
+// Enum value maps for MicroVMSpec_PowerState.
+var (
+	MicroVMSpec_PowerState_name = map[int32]string{
+		0: "RUNNING",
+		1: "STOPPED",
+		2: "PAUSED",
+	}
+	MicroVMSpec_PowerState_value = map[string]int32{
+		"RUNNING": 0,
+		"STOPPED": 1,
+		"PAUSED":  2,
+	}
+)
+
+func (x MicroVMSpec_PowerState) Enum() *MicroVMSpec_PowerState {
+	p := new(MicroVMSpec_PowerState)
+	*p = x
+	return p
+}
+
+func (x MicroVMSpec_PowerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MicroVMSpec_PowerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[0].Descriptor()
+}
+
+func (MicroVMSpec_PowerState) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[0]
+}
+
+func (x MicroVMSpec_PowerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MicroVMSpec_PowerState.Descriptor instead.
+func (MicroVMSpec_PowerState) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type NetworkInterface_IfaceType int32
 
 const (
@@ -54,11 +108,11 @@ func (x NetworkInterface_IfaceType) String() string {
 }
 
 func (NetworkInterface_IfaceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_microvm_proto_enumTypes[0].Descriptor()
+	return file_types_microvm_proto_enumTypes[1].Descriptor()
 }
 
 func (NetworkInterface_IfaceType) Type() protoreflect.EnumType {
-	return &file_types_microvm_proto_enumTypes[0]
+	return &file_types_microvm_proto_enumTypes[1]
 }
 
 func (x NetworkInterface_IfaceType) Number() protoreflect.EnumNumber {
@@ -70,6 +124,170 @@ func (NetworkInterface_IfaceType) EnumDescriptor() ([]byte, []int) {
 	return file_types_microvm_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type FirewallRule_Protocol int32
+
+const (
+	// ANY matches any protocol.
+	FirewallRule_ANY FirewallRule_Protocol = 0
+	// TCP matches TCP.
+	FirewallRule_TCP FirewallRule_Protocol = 1
+	// UDP matches UDP.
+	FirewallRule_UDP FirewallRule_Protocol = 2
+	// ICMP matches ICMP, or ICMPv6 for IPv6 traffic.
+	FirewallRule_ICMP FirewallRule_Protocol = 3
+)
+
+// Enum value maps for FirewallRule_Protocol.
+var (
+	FirewallRule_Protocol_name = map[int32]string{
+		0: "ANY",
+		1: "TCP",
+		2: "UDP",
+		3: "ICMP",
+	}
+	FirewallRule_Protocol_value = map[string]int32{
+		"ANY":  0,
+		"TCP":  1,
+		"UDP":  2,
+		"ICMP": 3,
+	}
+)
+
+func (x FirewallRule_Protocol) Enum() *FirewallRule_Protocol {
+	p := new(FirewallRule_Protocol)
+	*p = x
+	return p
+}
+
+func (x FirewallRule_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirewallRule_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[2].Descriptor()
+}
+
+func (FirewallRule_Protocol) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[2]
+}
+
+func (x FirewallRule_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirewallRule_Protocol.Descriptor instead.
+func (FirewallRule_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type Volume_CacheMode int32
+
+const (
+	// DEFAULT_CACHE_MODE uses the default of the provider.
+	Volume_DEFAULT_CACHE_MODE Volume_CacheMode = 0
+	// UNSAFE uses the page cache of the host and ignores flushes from the microvm.
+	Volume_UNSAFE Volume_CacheMode = 1
+	// WRITEBACK uses the page cache of the host and syncs it on flushes from the microvm.
+	Volume_WRITEBACK Volume_CacheMode = 2
+	// DIRECT bypasses the page cache of the host.
+	Volume_DIRECT Volume_CacheMode = 3
+)
+
+// Enum value maps for Volume_CacheMode.
+var (
+	Volume_CacheMode_name = map[int32]string{
+		0: "DEFAULT_CACHE_MODE",
+		1: "UNSAFE",
+		2: "WRITEBACK",
+		3: "DIRECT",
+	}
+	Volume_CacheMode_value = map[string]int32{
+		"DEFAULT_CACHE_MODE": 0,
+		"UNSAFE":             1,
+		"WRITEBACK":          2,
+		"DIRECT":             3,
+	}
+)
+
+func (x Volume_CacheMode) Enum() *Volume_CacheMode {
+	p := new(Volume_CacheMode)
+	*p = x
+	return p
+}
+
+func (x Volume_CacheMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Volume_CacheMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[3].Descriptor()
+}
+
+func (Volume_CacheMode) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[3]
+}
+
+func (x Volume_CacheMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Volume_CacheMode.Descriptor instead.
+func (Volume_CacheMode) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Volume_IOEngine int32
+
+const (
+	// DEFAULT_IO_ENGINE uses the default of the provider.
+	Volume_DEFAULT_IO_ENGINE Volume_IOEngine = 0
+	// SYNC uses blocking system calls.
+	Volume_SYNC Volume_IOEngine = 1
+	// ASYNC uses io_uring.
+	Volume_ASYNC Volume_IOEngine = 2
+)
+
+// Enum value maps for Volume_IOEngine.
+var (
+	Volume_IOEngine_name = map[int32]string{
+		0: "DEFAULT_IO_ENGINE",
+		1: "SYNC",
+		2: "ASYNC",
+	}
+	Volume_IOEngine_value = map[string]int32{
+		"DEFAULT_IO_ENGINE": 0,
+		"SYNC":              1,
+		"ASYNC":             2,
+	}
+)
+
+func (x Volume_IOEngine) Enum() *Volume_IOEngine {
+	p := new(Volume_IOEngine)
+	*p = x
+	return p
+}
+
+func (x Volume_IOEngine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Volume_IOEngine) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[4].Descriptor()
+}
+
+func (Volume_IOEngine) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[4]
+}
+
+func (x Volume_IOEngine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Volume_IOEngine.Descriptor instead.
+func (Volume_IOEngine) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{12, 1}
+}
+
 type MicroVMStatus_MicroVMState int32
 
 const (
@@ -77,6 +295,8 @@ const (
 	MicroVMStatus_CREATED  MicroVMStatus_MicroVMState = 1
 	MicroVMStatus_FAILED   MicroVMStatus_MicroVMState = 2
 	MicroVMStatus_DELETING MicroVMStatus_MicroVMState = 3
+	MicroVMStatus_STOPPED  MicroVMStatus_MicroVMState = 4
+	MicroVMStatus_PAUSED   MicroVMStatus_MicroVMState = 5
 )
 
 // Enum value maps for MicroVMStatus_MicroVMState.
@@ -86,12 +306,16 @@ var (
 		1: "CREATED",
 		2: "FAILED",
 		3: "DELETING",
+		4: "STOPPED",
+		5: "PAUSED",
 	}
 	MicroVMStatus_MicroVMState_value = map[string]int32{
 		"PENDING":  0,
 		"CREATED":  1,
 		"FAILED":   2,
 		"DELETING": 3,
+		"STOPPED":  4,
+		"PAUSED":   5,
 	}
 )
 
@@ -106,11 +330,11 @@ func (x MicroVMStatus_MicroVMState) String() string {
 }
 
 func (MicroVMStatus_MicroVMState) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_microvm_proto_enumTypes[1].Descriptor()
+	return file_types_microvm_proto_enumTypes[5].Descriptor()
 }
 
 func (MicroVMStatus_MicroVMState) Type() protoreflect.EnumType {
-	return &file_types_microvm_proto_enumTypes[1]
+	return &file_types_microvm_proto_enumTypes[5]
 }
 
 func (x MicroVMStatus_MicroVMState) Number() protoreflect.EnumNumber {
@@ -119,7 +343,56 @@ func (x MicroVMStatus_MicroVMState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MicroVMStatus_MicroVMState.Descriptor instead.
 func (MicroVMStatus_MicroVMState) EnumDescriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{10, 0}
+	return file_types_microvm_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type Condition_ConditionStatus int32
+
+const (
+	Condition_UNKNOWN Condition_ConditionStatus = 0
+	Condition_TRUE    Condition_ConditionStatus = 1
+	Condition_FALSE   Condition_ConditionStatus = 2
+)
+
+// Enum value maps for Condition_ConditionStatus.
+var (
+	Condition_ConditionStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "TRUE",
+		2: "FALSE",
+	}
+	Condition_ConditionStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"TRUE":    1,
+		"FALSE":   2,
+	}
+)
+
+func (x Condition_ConditionStatus) Enum() *Condition_ConditionStatus {
+	p := new(Condition_ConditionStatus)
+	*p = x
+	return p
+}
+
+func (x Condition_ConditionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Condition_ConditionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[6].Descriptor()
+}
+
+func (Condition_ConditionStatus) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[6]
+}
+
+func (x Condition_ConditionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Condition_ConditionStatus.Descriptor instead.
+func (Condition_ConditionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type Mount_MountType int32
@@ -152,11 +425,11 @@ func (x Mount_MountType) String() string {
 }
 
 func (Mount_MountType) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_microvm_proto_enumTypes[2].Descriptor()
+	return file_types_microvm_proto_enumTypes[7].Descriptor()
 }
 
 func (Mount_MountType) Type() protoreflect.EnumType {
-	return &file_types_microvm_proto_enumTypes[2]
+	return &file_types_microvm_proto_enumTypes[7]
 }
 
 func (x Mount_MountType) Number() protoreflect.EnumNumber {
@@ -165,7 +438,67 @@ func (x Mount_MountType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Mount_MountType.Descriptor instead.
 func (Mount_MountType) EnumDescriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{12, 0}
+	return file_types_microvm_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type StepExecution_Outcome int32
+
+const (
+	// SUCCEEDED means the step was run and verified.
+	StepExecution_SUCCEEDED StepExecution_Outcome = 0
+	// SKIPPED means there was nothing for the step to do.
+	StepExecution_SKIPPED StepExecution_Outcome = 1
+	// SHOULD_DO_FAILED means checking if the step should be run failed.
+	StepExecution_SHOULD_DO_FAILED StepExecution_Outcome = 2
+	// DO_FAILED means running the step failed.
+	StepExecution_DO_FAILED StepExecution_Outcome = 3
+	// VERIFY_FAILED means the step ran but verifying it failed.
+	StepExecution_VERIFY_FAILED StepExecution_Outcome = 4
+)
+
+// Enum value maps for StepExecution_Outcome.
+var (
+	StepExecution_Outcome_name = map[int32]string{
+		0: "SUCCEEDED",
+		1: "SKIPPED",
+		2: "SHOULD_DO_FAILED",
+		3: "DO_FAILED",
+		4: "VERIFY_FAILED",
+	}
+	StepExecution_Outcome_value = map[string]int32{
+		"SUCCEEDED":        0,
+		"SKIPPED":          1,
+		"SHOULD_DO_FAILED": 2,
+		"DO_FAILED":        3,
+		"VERIFY_FAILED":    4,
+	}
+)
+
+func (x StepExecution_Outcome) Enum() *StepExecution_Outcome {
+	p := new(StepExecution_Outcome)
+	*p = x
+	return p
+}
+
+func (x StepExecution_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StepExecution_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_microvm_proto_enumTypes[8].Descriptor()
+}
+
+func (StepExecution_Outcome) Type() protoreflect.EnumType {
+	return &file_types_microvm_proto_enumTypes[8]
+}
+
+func (x StepExecution_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StepExecution_Outcome.Descriptor instead.
+func (StepExecution_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{25, 0}
 }
 
 // MicroVM represents a microvm machine that is created via a provider.
@@ -274,8 +607,18 @@ type MicroVMSpec struct {
 	// AllowGuestAgent, when true, attaches a vsock device to the microvm so the in-guest
 	// guest-agent (https://github.com/liquidmetal-dev/guest-agent) can communicate with the host.
 	AllowGuestAgent bool `protobuf:"varint,17,opt,name=allow_guest_agent,json=allowGuestAgent,proto3" json:"allow_guest_agent,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// PowerState is the desired power state of the microvm. Defaults to running.
+	PowerState MicroVMSpec_PowerState `protobuf:"varint,18,opt,name=power_state,json=powerState,proto3,enum=flintlock.types.MicroVMSpec_PowerState" json:"power_state,omitempty"`
+	// RestoreFromSnapshot is the UID of a snapshot to restore the microvm from instead
+	// of booting it. The vcpu, memory, kernel, initrd, volumes, network interfaces and
+	// guest agent setting are taken from the snapshotted microvm and any values for them
+	// are ignored. The provider, if set, must be the provider that created the snapshot.
+	// The volumes are restored to their contents when the snapshot was taken. The guest
+	// keeps the mac addresses of the snapshotted microvm, so it can't be restored while
+	// another microvm has them.
+	RestoreFromSnapshot *string `protobuf:"bytes,19,opt,name=restore_from_snapshot,json=restoreFromSnapshot,proto3,oneof" json:"restore_from_snapshot,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *MicroVMSpec) Reset() {
@@ -427,6 +770,20 @@ func (x *MicroVMSpec) GetAllowGuestAgent() bool {
 	return false
 }
 
+func (x *MicroVMSpec) GetPowerState() MicroVMSpec_PowerState {
+	if x != nil {
+		return x.PowerState
+	}
+	return MicroVMSpec_RUNNING
+}
+
+func (x *MicroVMSpec) GetRestoreFromSnapshot() string {
+	if x != nil && x.RestoreFromSnapshot != nil {
+		return *x.RestoreFromSnapshot
+	}
+	return ""
+}
+
 // Kernel represents the configuration for a kernel.
 type Kernel struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -573,7 +930,28 @@ type NetworkInterface struct {
 	// If not supplied then DHCP will be used.
 	Address *StaticAddress `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
 	// Overrides is optional overrides applicable for network configuration.
-	Overrides     *NetworkOverrides `protobuf:"bytes,6,opt,name=overrides,proto3,oneof" json:"overrides,omitempty"`
+	Overrides *NetworkOverrides `protobuf:"bytes,6,opt,name=overrides,proto3,oneof" json:"overrides,omitempty"`
+	// Firewall is an optional firewall for the traffic to and from the interface. It's only
+	// supported on TAP interfaces with a guest MAC.
+	Firewall *Firewall `protobuf:"bytes,7,opt,name=firewall,proto3,oneof" json:"firewall,omitempty"`
+	// Network is the name of a network defined in the flintlockd config to attach the
+	// interface to. The network sets the bridge or parent device, VLAN and MTU of the interface.
+	Network *string `protobuf:"bytes,8,opt,name=network,proto3,oneof" json:"network,omitempty"`
+	// VlanId is the VLAN to put the interface on, overriding the VLAN of its network. A TAP is
+	// attached to its VLAN filtering bridge with the VLAN as its PVID, and a MACVTAP is created
+	// on a VLAN sub-interface of its parent device.
+	VlanId *uint32 `protobuf:"varint,9,opt,name=vlan_id,json=vlanId,proto3,oneof" json:"vlan_id,omitempty"`
+	// MTU is the MTU of the interface in the microvm and of its host device, overriding the
+	// MTU of its network.
+	Mtu *uint32 `protobuf:"varint,10,opt,name=mtu,proto3,oneof" json:"mtu,omitempty"`
+	// NumQueues is the number of queue pairs of the interface.
+	NumQueues *uint32 `protobuf:"varint,11,opt,name=num_queues,json=numQueues,proto3,oneof" json:"num_queues,omitempty"`
+	// Offloads are the offloads of the interface. If not supplied the defaults of the
+	// provider are used.
+	Offloads *NetworkOffloads `protobuf:"bytes,12,opt,name=offloads,proto3,oneof" json:"offloads,omitempty"`
+	// RateLimits are the rate limits of the traffic of the interface. They can be changed
+	// by updating the microvm.
+	RateLimits    *NetworkRateLimits `protobuf:"bytes,13,opt,name=rate_limits,json=rateLimits,proto3,oneof" json:"rate_limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -643,34 +1021,80 @@ func (x *NetworkInterface) GetOverrides() *NetworkOverrides {
 	return nil
 }
 
-// StaticAddress represents a static IPv4 or IPv6 address.
-type StaticAddress struct {
+func (x *NetworkInterface) GetFirewall() *Firewall {
+	if x != nil {
+		return x.Firewall
+	}
+	return nil
+}
+
+func (x *NetworkInterface) GetNetwork() string {
+	if x != nil && x.Network != nil {
+		return *x.Network
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetVlanId() uint32 {
+	if x != nil && x.VlanId != nil {
+		return *x.VlanId
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetMtu() uint32 {
+	if x != nil && x.Mtu != nil {
+		return *x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetNumQueues() uint32 {
+	if x != nil && x.NumQueues != nil {
+		return *x.NumQueues
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetOffloads() *NetworkOffloads {
+	if x != nil {
+		return x.Offloads
+	}
+	return nil
+}
+
+func (x *NetworkInterface) GetRateLimits() *NetworkRateLimits {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+// NetworkRateLimits are the rate limits of a network interface.
+type NetworkRateLimits struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Address is the static IP address (IPv4 or IPv6) to assign to this interface.
-	// Must be CIDR notation.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Gateway is used to optionaly set the default gateway for IPv4 or IPv6.
-	Gateway *string `protobuf:"bytes,2,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`
-	// Nameservers allows you to optionaly specify nameservers for the interface.
-	Nameservers   []string `protobuf:"bytes,3,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	// Rx limits the traffic received by the microvm.
+	Rx *RateLimit `protobuf:"bytes,1,opt,name=rx,proto3,oneof" json:"rx,omitempty"`
+	// Tx limits the traffic transmitted by the microvm.
+	Tx            *RateLimit `protobuf:"bytes,2,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StaticAddress) Reset() {
-	*x = StaticAddress{}
+func (x *NetworkRateLimits) Reset() {
+	*x = NetworkRateLimits{}
 	mi := &file_types_microvm_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StaticAddress) String() string {
+func (x *NetworkRateLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StaticAddress) ProtoMessage() {}
+func (*NetworkRateLimits) ProtoMessage() {}
 
-func (x *StaticAddress) ProtoReflect() protoreflect.Message {
+func (x *NetworkRateLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_types_microvm_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,66 +1106,50 @@ func (x *StaticAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StaticAddress.ProtoReflect.Descriptor instead.
-func (*StaticAddress) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkRateLimits.ProtoReflect.Descriptor instead.
+func (*NetworkRateLimits) Descriptor() ([]byte, []int) {
 	return file_types_microvm_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StaticAddress) GetAddress() string {
+func (x *NetworkRateLimits) GetRx() *RateLimit {
 	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *StaticAddress) GetGateway() string {
-	if x != nil && x.Gateway != nil {
-		return *x.Gateway
+		return x.Rx
 	}
-	return ""
+	return nil
 }
 
-func (x *StaticAddress) GetNameservers() []string {
+func (x *NetworkRateLimits) GetTx() *RateLimit {
 	if x != nil {
-		return x.Nameservers
+		return x.Tx
 	}
 	return nil
 }
 
-// Volume represents the configuration for a volume to be attached to a microvm.
-type Volume struct {
+// RateLimit limits the bandwidth and operations of a device with token buckets.
+type RateLimit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the uinique identifier of the volume.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// IsReadOnly specifies that the volume is to be mounted readonly.
-	IsReadOnly bool `protobuf:"varint,2,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
-	// MountPoint allows you to optionally specify a mount point for the volume. This only
-	// applied to additional volumes and it will use cloud-init to mount the volumes.
-	MountPoint *string `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3,oneof" json:"mount_point,omitempty"`
-	// Source is where the volume will be sourced from.
-	Source *VolumeSource `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	// PartitionID is the uuid of the boot partition.
-	PartitionId *string `protobuf:"bytes,5,opt,name=partition_id,json=partitionId,proto3,oneof" json:"partition_id,omitempty"`
-	// Size is the size to resize this volume to.
-	SizeInMb      *int32 `protobuf:"varint,6,opt,name=size_in_mb,json=sizeInMb,proto3,oneof" json:"size_in_mb,omitempty"` // TODO: add rate limiting
+	// Bandwidth limits the bytes per refill time.
+	Bandwidth *TokenBucket `protobuf:"bytes,1,opt,name=bandwidth,proto3,oneof" json:"bandwidth,omitempty"`
+	// Ops limits the operations per refill time.
+	Ops           *TokenBucket `protobuf:"bytes,2,opt,name=ops,proto3,oneof" json:"ops,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
 	mi := &file_types_microvm_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Volume) String() string {
+func (x *RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Volume) ProtoMessage() {}
+func (*RateLimit) ProtoMessage() {}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_types_microvm_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,79 +1161,324 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
 	return file_types_microvm_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Volume) GetId() string {
+func (x *RateLimit) GetBandwidth() *TokenBucket {
 	if x != nil {
-		return x.Id
+		return x.Bandwidth
 	}
-	return ""
+	return nil
 }
 
-func (x *Volume) GetIsReadOnly() bool {
+func (x *RateLimit) GetOps() *TokenBucket {
 	if x != nil {
-		return x.IsReadOnly
+		return x.Ops
+	}
+	return nil
+}
+
+// TokenBucket is a bucket of tokens, bytes or operations, that's refilled at a constant rate.
+type TokenBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Size is the number of tokens the bucket holds.
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// OneTimeBurst is the number of extra tokens available once at the start.
+	OneTimeBurst *int64 `protobuf:"varint,2,opt,name=one_time_burst,json=oneTimeBurst,proto3,oneof" json:"one_time_burst,omitempty"`
+	// RefillTimeMs is the time in milliseconds it takes to refill the bucket.
+	RefillTimeMs  int64 `protobuf:"varint,3,opt,name=refill_time_ms,json=refillTimeMs,proto3" json:"refill_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenBucket) Reset() {
+	*x = TokenBucket{}
+	mi := &file_types_microvm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenBucket) ProtoMessage() {}
+
+func (x *TokenBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenBucket.ProtoReflect.Descriptor instead.
+func (*TokenBucket) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenBucket) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TokenBucket) GetOneTimeBurst() int64 {
+	if x != nil && x.OneTimeBurst != nil {
+		return *x.OneTimeBurst
+	}
+	return 0
+}
+
+func (x *TokenBucket) GetRefillTimeMs() int64 {
+	if x != nil {
+		return x.RefillTimeMs
+	}
+	return 0
+}
+
+// NetworkOffloads are the offloads of a network interface.
+type NetworkOffloads struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TSO enables TCP segmentation offload.
+	Tso *bool `protobuf:"varint,1,opt,name=tso,proto3,oneof" json:"tso,omitempty"`
+	// UFO enables UDP fragmentation offload.
+	Ufo *bool `protobuf:"varint,2,opt,name=ufo,proto3,oneof" json:"ufo,omitempty"`
+	// Checksum enables checksum offload.
+	Checksum      *bool `protobuf:"varint,3,opt,name=checksum,proto3,oneof" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkOffloads) Reset() {
+	*x = NetworkOffloads{}
+	mi := &file_types_microvm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkOffloads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkOffloads) ProtoMessage() {}
+
+func (x *NetworkOffloads) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkOffloads.ProtoReflect.Descriptor instead.
+func (*NetworkOffloads) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NetworkOffloads) GetTso() bool {
+	if x != nil && x.Tso != nil {
+		return *x.Tso
 	}
 	return false
 }
 
-func (x *Volume) GetMountPoint() string {
-	if x != nil && x.MountPoint != nil {
-		return *x.MountPoint
+func (x *NetworkOffloads) GetUfo() bool {
+	if x != nil && x.Ufo != nil {
+		return *x.Ufo
 	}
-	return ""
+	return false
 }
 
-func (x *Volume) GetSource() *VolumeSource {
+func (x *NetworkOffloads) GetChecksum() bool {
+	if x != nil && x.Checksum != nil {
+		return *x.Checksum
+	}
+	return false
+}
+
+// Firewall is the firewall of a network interface.
+type Firewall struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ingress are the rules allowing traffic to the microvm. If there are any, traffic to the
+	// microvm that doesn't match one, or isn't a reply, is dropped.
+	Ingress []*FirewallRule `protobuf:"bytes,1,rep,name=ingress,proto3" json:"ingress,omitempty"`
+	// Egress are the rules allowing traffic from the microvm. If there are any, traffic from the
+	// microvm that doesn't match one, or isn't a reply, is dropped.
+	Egress []*FirewallRule `protobuf:"bytes,2,rep,name=egress,proto3" json:"egress,omitempty"`
+	// DisableAntiSpoofing turns off dropping traffic from the microvm with a source MAC or IP
+	// address other than the ones assigned to the interface.
+	DisableAntiSpoofing bool `protobuf:"varint,3,opt,name=disable_anti_spoofing,json=disableAntiSpoofing,proto3" json:"disable_anti_spoofing,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Firewall) Reset() {
+	*x = Firewall{}
+	mi := &file_types_microvm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Firewall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Firewall) ProtoMessage() {}
+
+func (x *Firewall) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[9]
 	if x != nil {
-		return x.Source
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Firewall.ProtoReflect.Descriptor instead.
+func (*Firewall) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Firewall) GetIngress() []*FirewallRule {
+	if x != nil {
+		return x.Ingress
 	}
 	return nil
 }
 
-func (x *Volume) GetPartitionId() string {
-	if x != nil && x.PartitionId != nil {
-		return *x.PartitionId
+func (x *Firewall) GetEgress() []*FirewallRule {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+func (x *Firewall) GetDisableAntiSpoofing() bool {
+	if x != nil {
+		return x.DisableAntiSpoofing
+	}
+	return false
+}
+
+// FirewallRule allows traffic to or from a microvm.
+type FirewallRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CIDR is the address range of the other end of the traffic. If not supplied any
+	// address matches.
+	Cidr *string `protobuf:"bytes,1,opt,name=cidr,proto3,oneof" json:"cidr,omitempty"`
+	// Protocol is the protocol of the traffic.
+	Protocol FirewallRule_Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=flintlock.types.FirewallRule_Protocol" json:"protocol,omitempty"`
+	// Port is the destination port of the traffic, or the first port of a range if end_port
+	// is set. It can only be used with TCP or UDP.
+	Port *uint32 `protobuf:"varint,3,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	// EndPort is the last destination port of a range starting at port.
+	EndPort       *uint32 `protobuf:"varint,4,opt,name=end_port,json=endPort,proto3,oneof" json:"end_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirewallRule) Reset() {
+	*x = FirewallRule{}
+	mi := &file_types_microvm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirewallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirewallRule) ProtoMessage() {}
+
+func (x *FirewallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirewallRule.ProtoReflect.Descriptor instead.
+func (*FirewallRule) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FirewallRule) GetCidr() string {
+	if x != nil && x.Cidr != nil {
+		return *x.Cidr
 	}
 	return ""
 }
 
-func (x *Volume) GetSizeInMb() int32 {
-	if x != nil && x.SizeInMb != nil {
-		return *x.SizeInMb
+func (x *FirewallRule) GetProtocol() FirewallRule_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return FirewallRule_ANY
+}
+
+func (x *FirewallRule) GetPort() uint32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
 	}
 	return 0
 }
 
-// VolumeSource is the source of a volume. Based loosely on the volumes in Kubernetes Pod specs.
-type VolumeSource struct {
+func (x *FirewallRule) GetEndPort() uint32 {
+	if x != nil && x.EndPort != nil {
+		return *x.EndPort
+	}
+	return 0
+}
+
+// StaticAddress represents a static IPv4 or IPv6 address.
+type StaticAddress struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Container is used to specify a source of a volume as a OCI container.
-	ContainerSource *string `protobuf:"bytes,1,opt,name=container_source,json=containerSource,proto3,oneof" json:"container_source,omitempty"`
-	// Used for the virtiofs source path
-	VirtiofsSource *string `protobuf:"bytes,2,opt,name=virtiofs_source,json=virtiofsSource,proto3,oneof" json:"virtiofs_source,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Address is the static IP address (IPv4 or IPv6) to assign to this interface.
+	// Must be CIDR notation.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Gateway is used to optionaly set the default gateway for IPv4 or IPv6.
+	Gateway *string `protobuf:"bytes,2,opt,name=gateway,proto3,oneof" json:"gateway,omitempty"`
+	// Nameservers allows you to optionaly specify nameservers for the interface.
+	Nameservers   []string `protobuf:"bytes,3,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeSource) Reset() {
-	*x = VolumeSource{}
-	mi := &file_types_microvm_proto_msgTypes[7]
+func (x *StaticAddress) Reset() {
+	*x = StaticAddress{}
+	mi := &file_types_microvm_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeSource) String() string {
+func (x *StaticAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeSource) ProtoMessage() {}
+func (*StaticAddress) ProtoMessage() {}
 
-func (x *VolumeSource) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[7]
+func (x *StaticAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,49 +1489,885 @@ func (x *VolumeSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeSource.ProtoReflect.Descriptor instead.
-func (*VolumeSource) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StaticAddress.ProtoReflect.Descriptor instead.
+func (*StaticAddress) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VolumeSource) GetContainerSource() string {
-	if x != nil && x.ContainerSource != nil {
-		return *x.ContainerSource
+func (x *StaticAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *VolumeSource) GetVirtiofsSource() string {
-	if x != nil && x.VirtiofsSource != nil {
-		return *x.VirtiofsSource
+func (x *StaticAddress) GetGateway() string {
+	if x != nil && x.Gateway != nil {
+		return *x.Gateway
+	}
+	return ""
+}
+
+func (x *StaticAddress) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+// Volume represents the configuration for a volume to be attached to a microvm.
+type Volume struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the uinique identifier of the volume.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// IsReadOnly specifies that the volume is to be mounted readonly.
+	IsReadOnly bool `protobuf:"varint,2,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// MountPoint allows you to optionally specify a mount point for the volume. This only
+	// applied to additional volumes and it will use cloud-init to mount the volumes.
+	MountPoint *string `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3,oneof" json:"mount_point,omitempty"`
+	// Source is where the volume will be sourced from.
+	Source *VolumeSource `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// PartitionID is the uuid of the boot partition.
+	PartitionId *string `protobuf:"bytes,5,opt,name=partition_id,json=partitionId,proto3,oneof" json:"partition_id,omitempty"`
+	// Size is the size to resize this volume to.
+	SizeInMb *int32 `protobuf:"varint,6,opt,name=size_in_mb,json=sizeInMb,proto3,oneof" json:"size_in_mb,omitempty"`
+	// CacheMode is how the writes of the microvm to the volume are cached on the host. If not
+	// supplied the default of the provider is used.
+	CacheMode Volume_CacheMode `protobuf:"varint,7,opt,name=cache_mode,json=cacheMode,proto3,enum=flintlock.types.Volume_CacheMode" json:"cache_mode,omitempty"`
+	// IOEngine is the engine used for the I/O of the volume on the host. If not supplied the
+	// default of the provider is used.
+	IoEngine Volume_IOEngine `protobuf:"varint,8,opt,name=io_engine,json=ioEngine,proto3,enum=flintlock.types.Volume_IOEngine" json:"io_engine,omitempty"`
+	// RateLimit is an optional limit of the bandwidth and operations of the volume.
+	RateLimit     *RateLimit `protobuf:"bytes,9,opt,name=rate_limit,json=rateLimit,proto3,oneof" json:"rate_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_types_microvm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Volume) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Volume) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *Volume) GetMountPoint() string {
+	if x != nil && x.MountPoint != nil {
+		return *x.MountPoint
+	}
+	return ""
+}
+
+func (x *Volume) GetSource() *VolumeSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Volume) GetPartitionId() string {
+	if x != nil && x.PartitionId != nil {
+		return *x.PartitionId
+	}
+	return ""
+}
+
+func (x *Volume) GetSizeInMb() int32 {
+	if x != nil && x.SizeInMb != nil {
+		return *x.SizeInMb
+	}
+	return 0
+}
+
+func (x *Volume) GetCacheMode() Volume_CacheMode {
+	if x != nil {
+		return x.CacheMode
+	}
+	return Volume_DEFAULT_CACHE_MODE
+}
+
+func (x *Volume) GetIoEngine() Volume_IOEngine {
+	if x != nil {
+		return x.IoEngine
+	}
+	return Volume_DEFAULT_IO_ENGINE
+}
+
+func (x *Volume) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// VolumeSource is the source of a volume. Based loosely on the volumes in Kubernetes Pod specs.
+type VolumeSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Container is used to specify a source of a volume as a OCI container.
+	ContainerSource *string `protobuf:"bytes,1,opt,name=container_source,json=containerSource,proto3,oneof" json:"container_source,omitempty"`
+	// Used for the virtiofs source path
+	VirtiofsSource *string `protobuf:"bytes,2,opt,name=virtiofs_source,json=virtiofsSource,proto3,oneof" json:"virtiofs_source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VolumeSource) Reset() {
+	*x = VolumeSource{}
+	mi := &file_types_microvm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeSource) ProtoMessage() {}
+
+func (x *VolumeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeSource.ProtoReflect.Descriptor instead.
+func (*VolumeSource) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VolumeSource) GetContainerSource() string {
+	if x != nil && x.ContainerSource != nil {
+		return *x.ContainerSource
+	}
+	return ""
+}
+
+func (x *VolumeSource) GetVirtiofsSource() string {
+	if x != nil && x.VirtiofsSource != nil {
+		return *x.VirtiofsSource
+	}
+	return ""
+}
+
+// VirtioFSVolumeSource represents the details of a volume coming from a OCI image.
+type VirtioFSVolumeSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path on the host machine to pass through.
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtioFSVolumeSource) Reset() {
+	*x = VirtioFSVolumeSource{}
+	mi := &file_types_microvm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtioFSVolumeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtioFSVolumeSource) ProtoMessage() {}
+
+func (x *VirtioFSVolumeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtioFSVolumeSource.ProtoReflect.Descriptor instead.
+func (*VirtioFSVolumeSource) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VirtioFSVolumeSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// ContainerVolumeSource represents the details of a volume coming from a OCI image.
+type ContainerVolumeSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Image specifies the conatiner image to use for the volume.
+	Image         string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerVolumeSource) Reset() {
+	*x = ContainerVolumeSource{}
+	mi := &file_types_microvm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerVolumeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerVolumeSource) ProtoMessage() {}
+
+func (x *ContainerVolumeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerVolumeSource.ProtoReflect.Descriptor instead.
+func (*ContainerVolumeSource) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ContainerVolumeSource) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// MicroVMStatus contains the runtime status of the microvm.
+type MicroVMStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State stores information about the last known state of the vm and the spec.
+	State MicroVMStatus_MicroVMState `protobuf:"varint,1,opt,name=state,proto3,enum=flintlock.types.MicroVMStatus_MicroVMState" json:"state,omitempty"`
+	// Volumes holds the status of the volumes.
+	Volumes map[string]*VolumeStatus `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// KernelMount holds the status of the kernel mount point.
+	KernelMount *Mount `protobuf:"bytes,3,opt,name=kernel_mount,json=kernelMount,proto3" json:"kernel_mount,omitempty"`
+	// InitrdMount holds the status of the initrd mount point.
+	InitrdMount *Mount `protobuf:"bytes,4,opt,name=initrd_mount,json=initrdMount,proto3" json:"initrd_mount,omitempty"`
+	// NetworkInterfaces holds the status of the network interfaces.
+	NetworkInterfaces map[string]*NetworkInterfaceStatus `protobuf:"bytes,5,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Retry is a counter about how many times we retried to reconcile.
+	Retry int32 `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	// VsockPath is the host unix-domain socket path for the guest-agent vsock device.
+	// Empty unless allow_guest_agent is set on the spec. Use with the vsock-connect host helper.
+	VsockPath string `protobuf:"bytes,7,opt,name=vsock_path,json=vsockPath,proto3" json:"vsock_path,omitempty"`
+	// Conditions describe the readiness of the different parts of the microvm.
+	Conditions []*Condition `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// LastError is the error from the last failed reconciliation. It's cleared when a
+	// reconciliation succeeds.
+	LastError *StepError `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// StepErrors holds the last error from each step that has failed, keyed by step name.
+	StepErrors map[string]*StepError `protobuf:"bytes,10,rep,name=step_errors,json=stepErrors,proto3" json:"step_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ExecutionID is the identifier of the last plan execution. It can be used to find
+	// the execution in the flintlockd logs.
+	ExecutionId string `protobuf:"bytes,11,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// GuestReadyAt is when the guest agent first responded after the microvm was last booted.
+	// It's only set for microvms with allow_guest_agent set, once the guest has booted.
+	GuestReadyAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=guest_ready_at,json=guestReadyAt,proto3" json:"guest_ready_at,omitempty"`
+	// NetworkNamespace is the name of the network namespace the network interfaces and vmm
+	// process of the microvm are isolated in. Empty if they're in the namespace of the host.
+	NetworkNamespace string `protobuf:"bytes,13,opt,name=network_namespace,json=networkNamespace,proto3" json:"network_namespace,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MicroVMStatus) Reset() {
+	*x = MicroVMStatus{}
+	mi := &file_types_microvm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MicroVMStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MicroVMStatus) ProtoMessage() {}
+
+func (x *MicroVMStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MicroVMStatus.ProtoReflect.Descriptor instead.
+func (*MicroVMStatus) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MicroVMStatus) GetState() MicroVMStatus_MicroVMState {
+	if x != nil {
+		return x.State
+	}
+	return MicroVMStatus_PENDING
+}
+
+func (x *MicroVMStatus) GetVolumes() map[string]*VolumeStatus {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetKernelMount() *Mount {
+	if x != nil {
+		return x.KernelMount
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetInitrdMount() *Mount {
+	if x != nil {
+		return x.InitrdMount
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetNetworkInterfaces() map[string]*NetworkInterfaceStatus {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetRetry() int32 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *MicroVMStatus) GetVsockPath() string {
+	if x != nil {
+		return x.VsockPath
+	}
+	return ""
+}
+
+func (x *MicroVMStatus) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetLastError() *StepError {
+	if x != nil {
+		return x.LastError
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetStepErrors() map[string]*StepError {
+	if x != nil {
+		return x.StepErrors
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *MicroVMStatus) GetGuestReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GuestReadyAt
+	}
+	return nil
+}
+
+func (x *MicroVMStatus) GetNetworkNamespace() string {
+	if x != nil {
+		return x.NetworkNamespace
+	}
+	return ""
+}
+
+// Condition describes the state of one aspect of a microvm.
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type is the type of the condition. One of ImagesReady, NetworkReady, VMMRunning
+	// or GuestReady.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status is the status of the condition.
+	Status Condition_ConditionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=flintlock.types.Condition_ConditionStatus" json:"status,omitempty"`
+	// Reason is a short, machine readable reason for the condition's last transition.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Message is a human readable message with details about the transition.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// LastTransitionTime is the time the condition last changed status.
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_types_microvm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Condition) GetStatus() Condition_ConditionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Condition_UNKNOWN
+}
+
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Condition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+// StepError is an error from a step of a reconciliation plan.
+type StepError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Step is the name of the step that failed.
+	Step string `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	// Message is the error message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// ExecutionID is the identifier of the plan execution the step was part of.
+	ExecutionId string `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// OccurredAt is the time the error occurred.
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepError) Reset() {
+	*x = StepError{}
+	mi := &file_types_microvm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepError) ProtoMessage() {}
+
+func (x *StepError) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepError.ProtoReflect.Descriptor instead.
+func (*StepError) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StepError) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *StepError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StepError) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *StepError) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type VolumeStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mount represents a volume mount point.
+	Mount         *Mount `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeStatus) Reset() {
+	*x = VolumeStatus{}
+	mi := &file_types_microvm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeStatus) ProtoMessage() {}
+
+func (x *VolumeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeStatus.ProtoReflect.Descriptor instead.
+func (*VolumeStatus) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VolumeStatus) GetMount() *Mount {
+	if x != nil {
+		return x.Mount
+	}
+	return nil
+}
+
+// Mount represents a volume mount point.
+type Mount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type specifies the type of the mount (e.g. device or directory).
+	Type Mount_MountType `protobuf:"varint,1,opt,name=type,proto3,enum=flintlock.types.Mount_MountType" json:"type,omitempty"`
+	// Source is the location of the mounted volume.
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mount) Reset() {
+	*x = Mount{}
+	mi := &file_types_microvm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mount) ProtoMessage() {}
+
+func (x *Mount) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mount.ProtoReflect.Descriptor instead.
+func (*Mount) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Mount) GetType() Mount_MountType {
+	if x != nil {
+		return x.Type
+	}
+	return Mount_DEV
+}
+
+func (x *Mount) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type NetworkInterfaceStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HostDeviceName is the name of the network interface used from the host. This will be
+	// a tuntap or macvtap interface.
+	HostDeviceName string `protobuf:"bytes,1,opt,name=host_device_name,json=hostDeviceName,proto3" json:"host_device_name,omitempty"`
+	// Index is the index of the network interface on the host.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// MACAddress is the MAC address of the host interface.
+	MacAddress string `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	// AllocatedAddress is the address allocated to the interface from the address pool
+	// of its bridge. It's only set for tap interfaces that don't have a static address.
+	AllocatedAddress *StaticAddress `protobuf:"bytes,4,opt,name=allocated_address,json=allocatedAddress,proto3,oneof" json:"allocated_address,omitempty"`
+	// BridgeName is the name of the bridge the TAP device is attached to, if any.
+	BridgeName    string `protobuf:"bytes,5,opt,name=bridge_name,json=bridgeName,proto3" json:"bridge_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInterfaceStatus) Reset() {
+	*x = NetworkInterfaceStatus{}
+	mi := &file_types_microvm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterfaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterfaceStatus) ProtoMessage() {}
+
+func (x *NetworkInterfaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterfaceStatus.ProtoReflect.Descriptor instead.
+func (*NetworkInterfaceStatus) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NetworkInterfaceStatus) GetHostDeviceName() string {
+	if x != nil {
+		return x.HostDeviceName
+	}
+	return ""
+}
+
+func (x *NetworkInterfaceStatus) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStatus) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *NetworkInterfaceStatus) GetAllocatedAddress() *StaticAddress {
+	if x != nil {
+		return x.AllocatedAddress
+	}
+	return nil
+}
+
+func (x *NetworkInterfaceStatus) GetBridgeName() string {
+	if x != nil {
+		return x.BridgeName
+	}
+	return ""
+}
+
+// NetworkOverrides represents override values for a network interface.
+type NetworkOverrides struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BridgeName is the name of the Linux bridge to attach TAP devices to. This overrides
+	// any value set at the overall flintlock level.
+	BridgeName    *string `protobuf:"bytes,1,opt,name=bridge_name,json=bridgeName,proto3,oneof" json:"bridge_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkOverrides) Reset() {
+	*x = NetworkOverrides{}
+	mi := &file_types_microvm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkOverrides) ProtoMessage() {}
+
+func (x *NetworkOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkOverrides.ProtoReflect.Descriptor instead.
+func (*NetworkOverrides) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NetworkOverrides) GetBridgeName() string {
+	if x != nil && x.BridgeName != nil {
+		return *x.BridgeName
 	}
 	return ""
 }
 
-// VirtioFSVolumeSource represents the details of a volume coming from a OCI image.
-type VirtioFSVolumeSource struct {
+// Snapshot represents a point in time copy of the memory and device state of a microvm.
+type Snapshot struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Path on the host machine to pass through.
-	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// UID is a globally unique identifier of the snapshot.
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Name is the name of the snapshot.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace is the name of the namespace the snapshot belongs to. This is the
+	// namespace of the microvm that was snapshotted.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// MicroVMUID is the UID of the microvm that was snapshotted.
+	MicrovmUid string `protobuf:"bytes,4,opt,name=microvm_uid,json=microvmUid,proto3" json:"microvm_uid,omitempty"`
+	// Provider is the name of the microvm provider that created the snapshot. A snapshot
+	// can only be restored with the same provider.
+	Provider string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Path is the directory on the host that holds the snapshot files.
+	Path string `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	// CreatedAt indicates the time the snapshot was created at.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Volumes are the IDs of the volumes whose contents were copied into the snapshot.
+	Volumes       []string `protobuf:"bytes,8,rep,name=volumes,proto3" json:"volumes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VirtioFSVolumeSource) Reset() {
-	*x = VirtioFSVolumeSource{}
-	mi := &file_types_microvm_proto_msgTypes[8]
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_types_microvm_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VirtioFSVolumeSource) String() string {
+func (x *Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VirtioFSVolumeSource) ProtoMessage() {}
+func (*Snapshot) ProtoMessage() {}
 
-func (x *VirtioFSVolumeSource) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[8]
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,101 +2378,101 @@ func (x *VirtioFSVolumeSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VirtioFSVolumeSource.ProtoReflect.Descriptor instead.
-func (*VirtioFSVolumeSource) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VirtioFSVolumeSource) GetPath() string {
+func (x *Snapshot) GetUid() string {
 	if x != nil {
-		return x.Path
+		return x.Uid
 	}
 	return ""
 }
 
-// ContainerVolumeSource represents the details of a volume coming from a OCI image.
-type ContainerVolumeSource struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Image specifies the conatiner image to use for the volume.
-	Image         string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Snapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ContainerVolumeSource) Reset() {
-	*x = ContainerVolumeSource{}
-	mi := &file_types_microvm_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Snapshot) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
 }
 
-func (x *ContainerVolumeSource) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Snapshot) GetMicrovmUid() string {
+	if x != nil {
+		return x.MicrovmUid
+	}
+	return ""
 }
 
-func (*ContainerVolumeSource) ProtoMessage() {}
+func (x *Snapshot) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
 
-func (x *ContainerVolumeSource) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[9]
+func (x *Snapshot) GetPath() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Path
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ContainerVolumeSource.ProtoReflect.Descriptor instead.
-func (*ContainerVolumeSource) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{9}
+func (x *Snapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ContainerVolumeSource) GetImage() string {
+func (x *Snapshot) GetVolumes() []string {
 	if x != nil {
-		return x.Image
+		return x.Volumes
 	}
-	return ""
+	return nil
 }
 
-// MicroVMStatus contains the runtime status of the microvm.
-type MicroVMStatus struct {
+// PlanExecution is a record of a reconciliation plan being executed against a microvm.
+type PlanExecution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// State stores information about the last known state of the vm and the spec.
-	State MicroVMStatus_MicroVMState `protobuf:"varint,1,opt,name=state,proto3,enum=flintlock.types.MicroVMStatus_MicroVMState" json:"state,omitempty"`
-	// Volumes holds the status of the volumes.
-	Volumes map[string]*VolumeStatus `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// KernelMount holds the status of the kernel mount point.
-	KernelMount *Mount `protobuf:"bytes,3,opt,name=kernel_mount,json=kernelMount,proto3" json:"kernel_mount,omitempty"`
-	// InitrdMount holds the status of the initrd mount point.
-	InitrdMount *Mount `protobuf:"bytes,4,opt,name=initrd_mount,json=initrdMount,proto3" json:"initrd_mount,omitempty"`
-	// NetworkInterfaces holds the status of the network interfaces.
-	NetworkInterfaces map[string]*NetworkInterfaceStatus `protobuf:"bytes,5,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Retry is a counter about how many times we retried to reconcile.
-	Retry int32 `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
-	// VsockPath is the host unix-domain socket path for the guest-agent vsock device.
-	// Empty unless allow_guest_agent is set on the spec. Use with the vsock-connect host helper.
-	VsockPath     string `protobuf:"bytes,7,opt,name=vsock_path,json=vsockPath,proto3" json:"vsock_path,omitempty"`
+	// ExecutionID is the identifier of the execution.
+	ExecutionId string `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Plan is the name of the plan that was executed.
+	Plan string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// StartedAt is the time the execution started.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// FinishedAt is the time the execution finished.
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Steps are the steps of the plan, in the order they were run.
+	Steps []*StepExecution `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Error is the error the execution failed with, if any.
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MicroVMStatus) Reset() {
-	*x = MicroVMStatus{}
-	mi := &file_types_microvm_proto_msgTypes[10]
+func (x *PlanExecution) Reset() {
+	*x = PlanExecution{}
+	mi := &file_types_microvm_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MicroVMStatus) String() string {
+func (x *PlanExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MicroVMStatus) ProtoMessage() {}
+func (*PlanExecution) ProtoMessage() {}
 
-func (x *MicroVMStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[10]
+func (x *PlanExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,83 +2483,83 @@ func (x *MicroVMStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MicroVMStatus.ProtoReflect.Descriptor instead.
-func (*MicroVMStatus) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PlanExecution.ProtoReflect.Descriptor instead.
+func (*PlanExecution) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MicroVMStatus) GetState() MicroVMStatus_MicroVMState {
+func (x *PlanExecution) GetExecutionId() string {
 	if x != nil {
-		return x.State
+		return x.ExecutionId
 	}
-	return MicroVMStatus_PENDING
+	return ""
 }
 
-func (x *MicroVMStatus) GetVolumes() map[string]*VolumeStatus {
+func (x *PlanExecution) GetPlan() string {
 	if x != nil {
-		return x.Volumes
+		return x.Plan
 	}
-	return nil
+	return ""
 }
 
-func (x *MicroVMStatus) GetKernelMount() *Mount {
+func (x *PlanExecution) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.KernelMount
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *MicroVMStatus) GetInitrdMount() *Mount {
+func (x *PlanExecution) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InitrdMount
+		return x.FinishedAt
 	}
 	return nil
 }
 
-func (x *MicroVMStatus) GetNetworkInterfaces() map[string]*NetworkInterfaceStatus {
+func (x *PlanExecution) GetSteps() []*StepExecution {
 	if x != nil {
-		return x.NetworkInterfaces
+		return x.Steps
 	}
 	return nil
 }
 
-func (x *MicroVMStatus) GetRetry() int32 {
-	if x != nil {
-		return x.Retry
-	}
-	return 0
-}
-
-func (x *MicroVMStatus) GetVsockPath() string {
+func (x *PlanExecution) GetError() string {
 	if x != nil {
-		return x.VsockPath
+		return x.Error
 	}
 	return ""
 }
 
-type VolumeStatus struct {
+// StepExecution is a record of a single step of a plan execution.
+type StepExecution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Mount represents a volume mount point.
-	Mount         *Mount `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	// Name is the name of the step.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Outcome is the outcome of the step.
+	Outcome StepExecution_Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=flintlock.types.StepExecution_Outcome" json:"outcome,omitempty"`
+	// Duration is how long the step took.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Error is the error the step failed with, if any.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeStatus) Reset() {
-	*x = VolumeStatus{}
-	mi := &file_types_microvm_proto_msgTypes[11]
+func (x *StepExecution) Reset() {
+	*x = StepExecution{}
+	mi := &file_types_microvm_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeStatus) String() string {
+func (x *StepExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeStatus) ProtoMessage() {}
+func (*StepExecution) ProtoMessage() {}
 
-func (x *VolumeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[11]
+func (x *StepExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,44 +2570,67 @@ func (x *VolumeStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeStatus.ProtoReflect.Descriptor instead.
-func (*VolumeStatus) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StepExecution.ProtoReflect.Descriptor instead.
+func (*StepExecution) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *VolumeStatus) GetMount() *Mount {
+func (x *StepExecution) GetName() string {
 	if x != nil {
-		return x.Mount
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepExecution) GetOutcome() StepExecution_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return StepExecution_SUCCEEDED
+}
+
+func (x *StepExecution) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-// Mount represents a volume mount point.
-type Mount struct {
+func (x *StepExecution) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// HostResources represents an amount of the compute resources of a host.
+type HostResources struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Type specifies the type of the mount (e.g. device or directory).
-	Type Mount_MountType `protobuf:"varint,1,opt,name=type,proto3,enum=flintlock.types.Mount_MountType" json:"type,omitempty"`
-	// Source is the location of the mounted volume.
-	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// VCPU is the number of vcpus.
+	Vcpu int64 `protobuf:"varint,1,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	// MemoryInMb is the amount of memory in megabytes.
+	MemoryInmb int64 `protobuf:"varint,2,opt,name=memory_inmb,json=memoryInmb,proto3" json:"memory_inmb,omitempty"`
+	// DiskInMb is the amount of disk space in megabytes.
+	DiskInmb      int64 `protobuf:"varint,3,opt,name=disk_inmb,json=diskInmb,proto3" json:"disk_inmb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Mount) Reset() {
-	*x = Mount{}
-	mi := &file_types_microvm_proto_msgTypes[12]
+func (x *HostResources) Reset() {
+	*x = HostResources{}
+	mi := &file_types_microvm_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Mount) String() string {
+func (x *HostResources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Mount) ProtoMessage() {}
+func (*HostResources) ProtoMessage() {}
 
-func (x *Mount) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[12]
+func (x *HostResources) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,53 +2641,65 @@ func (x *Mount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Mount.ProtoReflect.Descriptor instead.
-func (*Mount) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use HostResources.ProtoReflect.Descriptor instead.
+func (*HostResources) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Mount) GetType() Mount_MountType {
+func (x *HostResources) GetVcpu() int64 {
 	if x != nil {
-		return x.Type
+		return x.Vcpu
 	}
-	return Mount_DEV
+	return 0
 }
 
-func (x *Mount) GetSource() string {
+func (x *HostResources) GetMemoryInmb() int64 {
 	if x != nil {
-		return x.Source
+		return x.MemoryInmb
 	}
-	return ""
+	return 0
 }
 
-type NetworkInterfaceStatus struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// HostDeviceName is the name of the network interface used from the host. This will be
-	// a tuntap or macvtap interface.
-	HostDeviceName string `protobuf:"bytes,1,opt,name=host_device_name,json=hostDeviceName,proto3" json:"host_device_name,omitempty"`
-	// Index is the index of the network interface on the host.
-	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	// MACAddress is the MAC address of the host interface.
-	MacAddress    string `protobuf:"bytes,3,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HostResources) GetDiskInmb() int64 {
+	if x != nil {
+		return x.DiskInmb
+	}
+	return 0
 }
 
-func (x *NetworkInterfaceStatus) Reset() {
-	*x = NetworkInterfaceStatus{}
-	mi := &file_types_microvm_proto_msgTypes[13]
+// QuotaResources represents an amount of the resources of a namespace that can be
+// limited by a quota.
+type QuotaResources struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MicroVMs is the number of microvms.
+	Microvms int64 `protobuf:"varint,1,opt,name=microvms,proto3" json:"microvms,omitempty"`
+	// VCPU is the number of vcpus.
+	Vcpu int64 `protobuf:"varint,2,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	// MemoryInMb is the amount of memory in megabytes.
+	MemoryInmb int64 `protobuf:"varint,3,opt,name=memory_inmb,json=memoryInmb,proto3" json:"memory_inmb,omitempty"`
+	// DiskInMb is the total size of the volumes in megabytes.
+	DiskInmb int64 `protobuf:"varint,4,opt,name=disk_inmb,json=diskInmb,proto3" json:"disk_inmb,omitempty"`
+	// NetworkInterfaces is the number of network interfaces.
+	NetworkInterfaces int64 `protobuf:"varint,5,opt,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QuotaResources) Reset() {
+	*x = QuotaResources{}
+	mi := &file_types_microvm_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkInterfaceStatus) String() string {
+func (x *QuotaResources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInterfaceStatus) ProtoMessage() {}
+func (*QuotaResources) ProtoMessage() {}
 
-func (x *NetworkInterfaceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[13]
+func (x *QuotaResources) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,57 +2710,73 @@ func (x *NetworkInterfaceStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInterfaceStatus.ProtoReflect.Descriptor instead.
-func (*NetworkInterfaceStatus) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QuotaResources.ProtoReflect.Descriptor instead.
+func (*QuotaResources) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *NetworkInterfaceStatus) GetHostDeviceName() string {
+func (x *QuotaResources) GetMicrovms() int64 {
 	if x != nil {
-		return x.HostDeviceName
+		return x.Microvms
 	}
-	return ""
+	return 0
 }
 
-func (x *NetworkInterfaceStatus) GetIndex() int32 {
+func (x *QuotaResources) GetVcpu() int64 {
 	if x != nil {
-		return x.Index
+		return x.Vcpu
 	}
 	return 0
 }
 
-func (x *NetworkInterfaceStatus) GetMacAddress() string {
+func (x *QuotaResources) GetMemoryInmb() int64 {
 	if x != nil {
-		return x.MacAddress
+		return x.MemoryInmb
 	}
-	return ""
+	return 0
 }
 
-// NetworkOverrides represents override values for a network interface.
-type NetworkOverrides struct {
+func (x *QuotaResources) GetDiskInmb() int64 {
+	if x != nil {
+		return x.DiskInmb
+	}
+	return 0
+}
+
+func (x *QuotaResources) GetNetworkInterfaces() int64 {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return 0
+}
+
+// NamespaceQuota represents the limits on the resources the microvms in a namespace
+// can use between them. A limit of 0 means the resource isn't limited.
+type NamespaceQuota struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// BridgeName is the name of the Linux bridge to attach TAP devices to. This overrides
-	// any value set at the overall flintlock level.
-	BridgeName    *string `protobuf:"bytes,1,opt,name=bridge_name,json=bridgeName,proto3,oneof" json:"bridge_name,omitempty"`
+	// Namespace is the namespace the quota is for.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Limits is the most of each resource the microvms in the namespace can use.
+	Limits        *QuotaResources `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkOverrides) Reset() {
-	*x = NetworkOverrides{}
-	mi := &file_types_microvm_proto_msgTypes[14]
+func (x *NamespaceQuota) Reset() {
+	*x = NamespaceQuota{}
+	mi := &file_types_microvm_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkOverrides) String() string {
+func (x *NamespaceQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkOverrides) ProtoMessage() {}
+func (*NamespaceQuota) ProtoMessage() {}
 
-func (x *NetworkOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_types_microvm_proto_msgTypes[14]
+func (x *NamespaceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_types_microvm_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,24 +2787,33 @@ func (x *NetworkOverrides) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkOverrides.ProtoReflect.Descriptor instead.
-func (*NetworkOverrides) Descriptor() ([]byte, []int) {
-	return file_types_microvm_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use NamespaceQuota.ProtoReflect.Descriptor instead.
+func (*NamespaceQuota) Descriptor() ([]byte, []int) {
+	return file_types_microvm_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *NetworkOverrides) GetBridgeName() string {
-	if x != nil && x.BridgeName != nil {
-		return *x.BridgeName
+func (x *NamespaceQuota) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
 	}
 	return ""
 }
 
+func (x *NamespaceQuota) GetLimits() *QuotaResources {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
 var File_types_microvm_proto protoreflect.FileDescriptor
 
 var file_types_microvm_proto_rawDesc = string([]byte{
 	0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x01, 0x0a, 0x07, 0x4d, 0x69, 0x63, 0x72,
 	0x6f, 0x56, 0x4d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
@@ -1275,7 +2824,7 @@ var file_types_microvm_proto_rawDesc = string([]byte{
 	0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd4, 0x07, 0x0a, 0x0b, 0x4d, 0x69, 0x63, 0x72,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa5, 0x09, 0x0a, 0x0b, 0x4d, 0x69, 0x63, 0x72,
 	0x6f, 0x56, 0x4d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
@@ -1327,171 +2876,430 @@ var file_types_microvm_proto_rawDesc = string([]byte{
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c,
 	0x6c, 0x6f, 0x77, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
 	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x47, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x66, 0x6c,
+	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x37, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x13, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x69,
-	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xf6,
-	0x01, 0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x3e, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x6d, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x64,
-	0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3a,
-	0x0a, 0x0c, 0x43, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x06, 0x49, 0x6e, 0x69, 0x74, 0x72,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x67, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x67,
-	0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c,
-	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x01, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x01, 0x22, 0x32, 0x0a, 0x0a, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x02, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22,
+	0xf6, 0x01, 0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x6d, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61,
+	0x64, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x43, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x06, 0x49, 0x6e, 0x69, 0x74,
+	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xfc, 0x05, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x66, 0x61, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66,
+	0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x01, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x48, 0x02, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x3a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x48, 0x03, 0x52,
+	0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x76,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x06,
+	0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x74, 0x75,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x48, 0x08, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66,
 	0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x48,
-	0x02, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x88, 0x01, 0x01, 0x22,
-	0x21, 0x0a, 0x09, 0x49, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x4d, 0x41, 0x43, 0x56, 0x54, 0x41, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50,
-	0x10, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x0d, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x22, 0x92, 0x02, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
-	0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
-	0x24, 0x0a, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
-	0x6d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x73, 0x69, 0x7a, 0x65,
-	0x49, 0x6e, 0x4d, 0x62, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x62, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74,
-	0x69, 0x6f, 0x66, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x66, 0x73, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f,
-	0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x66, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
-	0x2a, 0x0a, 0x14, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x46, 0x53, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2d, 0x0a, 0x15, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xb8, 0x05, 0x0a, 0x0d, 0x4d,
-	0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x6c,
-	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69,
-	0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72,
-	0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x45, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
-	0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x5f, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x48, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01,
+	0x01, 0x22, 0x21, 0x0a, 0x09, 0x49, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x4d, 0x41, 0x43, 0x56, 0x54, 0x41, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54,
+	0x41, 0x50, 0x10, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d,
+	0x61, 0x63, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x74, 0x75, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x66, 0x66,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x02, 0x72,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x02, 0x72, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x48, 0x01, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x72, 0x78, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0x97, 0x01, 0x0a, 0x09,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x62, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x09, 0x62, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x03, 0x6f, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x01, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6f, 0x70, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x0e, 0x6f, 0x6e, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x72, 0x73,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x69, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f,
+	0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x22, 0x7d, 0x0a,
+	0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x12, 0x15, 0x0a, 0x03, 0x74, 0x73, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x03, 0x74, 0x73, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x03, 0x75, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x02, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x74, 0x73, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x66, 0x6f, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xae, 0x01, 0x0a,
+	0x08, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x69,
+	0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72,
+	0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x73, 0x70, 0x6f, 0x6f, 0x66, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x6e, 0x74, 0x69, 0x53, 0x70, 0x6f, 0x6f, 0x66, 0x69, 0x6e, 0x67, 0x22, 0xf4, 0x01,
+	0x0a, 0x0c, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x17,
+	0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x63, 0x69, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x69, 0x6e,
+	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65,
+	0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x17, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x72,
+	0x74, 0x88, 0x01, 0x01, 0x22, 0x2f, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49,
+	0x43, 0x4d, 0x50, 0x10, 0x03, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x76, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0xe6, 0x04, 0x0a,
+	0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x62, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x73, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x4d, 0x62, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2e,
+	0x49, 0x4f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x69, 0x6f, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x48, 0x03, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x22, 0x4a, 0x0a, 0x09, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x53, 0x41, 0x46,
+	0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x52, 0x49, 0x54, 0x45, 0x42, 0x41, 0x43, 0x4b,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x22, 0x36,
+	0x0a, 0x08, 0x49, 0x4f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x49, 0x4f, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41,
+	0x53, 0x59, 0x4e, 0x43, 0x10, 0x02, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x62, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f,
+	0x66, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x66, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76, 0x69,
+	0x72, 0x74, 0x69, 0x6f, 0x66, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2a, 0x0a,
+	0x14, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x46, 0x53, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x86, 0x09, 0x0a, 0x0d, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x6c, 0x69, 0x6e,
+	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56,
+	0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a,
+	0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x69,
+	0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0b, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x72, 0x64, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x12, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x73, 0x6f, 0x63,
+	0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x69, 0x6e,
+	0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0b,
+	0x73, 0x74, 0x65, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x40, 0x0a, 0x0e, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x67, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a,
+	0x59, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x16, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0f, 0x53, 0x74, 0x65,
+	0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x65, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0c, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x05, 0x22, 0x98, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x02, 0x22, 0x99, 0x01, 0x0a,
+	0x09, 0x53, 0x74, 0x65, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3c, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
 	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x0b, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x12,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f,
-	0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x73, 0x6f, 0x63,
-	0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x73,
-	0x6f, 0x63, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x59, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x05, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
 	0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x42, 0x0a, 0x0c, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0x3c, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x05, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x69,
-	0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x75,
-	0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x09, 0x4d, 0x6f,
-	0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x56, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x10, 0x01, 0x22, 0x79,
-	0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x6f, 0x73, 0x74,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x24, 0x0a,
-	0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x2d, 0x64, 0x65,
-	0x76, 0x2f, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x22, 0x0a,
+	0x09, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45,
+	0x56, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x50, 0x41, 0x54, 0x48, 0x10,
+	0x01, 0x22, 0x82, 0x02, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x50, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xf4, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x55,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x65, 0x70, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x6c,
+	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x65, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x07, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x55, 0x4c, 0x44, 0x5f, 0x44, 0x4f, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4f, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x61, 0x0a, 0x0d, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x63, 0x70,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x63, 0x70, 0x75, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x6d, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x6d, 0x62, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x69, 0x6e, 0x6d, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x6d, 0x62, 0x22, 0xad, 0x01, 0x0a, 0x0e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x76, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x63,
+	0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x63, 0x70, 0x75, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x6d, 0x62, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x6d, 0x62, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x69, 0x6e, 0x6d, 0x62, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x6d, 0x62, 0x12, 0x2d, 0x0a, 0x12,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x0e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c,
+	0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x06, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x2d, 0x64,
+	0x65, 0x76, 0x2f, 0x66, 0x6c, 0x69, 0x6e, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1506,66 +3314,118 @@ func file_types_microvm_proto_rawDescGZIP() []byte {
 	return file_types_microvm_proto_rawDescData
 }
 
-var file_types_microvm_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_types_microvm_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_types_microvm_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_types_microvm_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_types_microvm_proto_goTypes = []any{
-	(NetworkInterface_IfaceType)(0), // 0: flintlock.types.NetworkInterface.IfaceType
-	(MicroVMStatus_MicroVMState)(0), // 1: flintlock.types.MicroVMStatus.MicroVMState
-	(Mount_MountType)(0),            // 2: flintlock.types.Mount.MountType
-	(*MicroVM)(nil),                 // 3: flintlock.types.MicroVM
-	(*MicroVMSpec)(nil),             // 4: flintlock.types.MicroVMSpec
-	(*Kernel)(nil),                  // 5: flintlock.types.Kernel
-	(*Initrd)(nil),                  // 6: flintlock.types.Initrd
-	(*NetworkInterface)(nil),        // 7: flintlock.types.NetworkInterface
-	(*StaticAddress)(nil),           // 8: flintlock.types.StaticAddress
-	(*Volume)(nil),                  // 9: flintlock.types.Volume
-	(*VolumeSource)(nil),            // 10: flintlock.types.VolumeSource
-	(*VirtioFSVolumeSource)(nil),    // 11: flintlock.types.VirtioFSVolumeSource
-	(*ContainerVolumeSource)(nil),   // 12: flintlock.types.ContainerVolumeSource
-	(*MicroVMStatus)(nil),           // 13: flintlock.types.MicroVMStatus
-	(*VolumeStatus)(nil),            // 14: flintlock.types.VolumeStatus
-	(*Mount)(nil),                   // 15: flintlock.types.Mount
-	(*NetworkInterfaceStatus)(nil),  // 16: flintlock.types.NetworkInterfaceStatus
-	(*NetworkOverrides)(nil),        // 17: flintlock.types.NetworkOverrides
-	nil,                             // 18: flintlock.types.MicroVMSpec.LabelsEntry
-	nil,                             // 19: flintlock.types.MicroVMSpec.MetadataEntry
-	nil,                             // 20: flintlock.types.Kernel.CmdlineEntry
-	nil,                             // 21: flintlock.types.MicroVMStatus.VolumesEntry
-	nil,                             // 22: flintlock.types.MicroVMStatus.NetworkInterfacesEntry
-	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
+	(MicroVMSpec_PowerState)(0),     // 0: flintlock.types.MicroVMSpec.PowerState
+	(NetworkInterface_IfaceType)(0), // 1: flintlock.types.NetworkInterface.IfaceType
+	(FirewallRule_Protocol)(0),      // 2: flintlock.types.FirewallRule.Protocol
+	(Volume_CacheMode)(0),           // 3: flintlock.types.Volume.CacheMode
+	(Volume_IOEngine)(0),            // 4: flintlock.types.Volume.IOEngine
+	(MicroVMStatus_MicroVMState)(0), // 5: flintlock.types.MicroVMStatus.MicroVMState
+	(Condition_ConditionStatus)(0),  // 6: flintlock.types.Condition.ConditionStatus
+	(Mount_MountType)(0),            // 7: flintlock.types.Mount.MountType
+	(StepExecution_Outcome)(0),      // 8: flintlock.types.StepExecution.Outcome
+	(*MicroVM)(nil),                 // 9: flintlock.types.MicroVM
+	(*MicroVMSpec)(nil),             // 10: flintlock.types.MicroVMSpec
+	(*Kernel)(nil),                  // 11: flintlock.types.Kernel
+	(*Initrd)(nil),                  // 12: flintlock.types.Initrd
+	(*NetworkInterface)(nil),        // 13: flintlock.types.NetworkInterface
+	(*NetworkRateLimits)(nil),       // 14: flintlock.types.NetworkRateLimits
+	(*RateLimit)(nil),               // 15: flintlock.types.RateLimit
+	(*TokenBucket)(nil),             // 16: flintlock.types.TokenBucket
+	(*NetworkOffloads)(nil),         // 17: flintlock.types.NetworkOffloads
+	(*Firewall)(nil),                // 18: flintlock.types.Firewall
+	(*FirewallRule)(nil),            // 19: flintlock.types.FirewallRule
+	(*StaticAddress)(nil),           // 20: flintlock.types.StaticAddress
+	(*Volume)(nil),                  // 21: flintlock.types.Volume
+	(*VolumeSource)(nil),            // 22: flintlock.types.VolumeSource
+	(*VirtioFSVolumeSource)(nil),    // 23: flintlock.types.VirtioFSVolumeSource
+	(*ContainerVolumeSource)(nil),   // 24: flintlock.types.ContainerVolumeSource
+	(*MicroVMStatus)(nil),           // 25: flintlock.types.MicroVMStatus
+	(*Condition)(nil),               // 26: flintlock.types.Condition
+	(*StepError)(nil),               // 27: flintlock.types.StepError
+	(*VolumeStatus)(nil),            // 28: flintlock.types.VolumeStatus
+	(*Mount)(nil),                   // 29: flintlock.types.Mount
+	(*NetworkInterfaceStatus)(nil),  // 30: flintlock.types.NetworkInterfaceStatus
+	(*NetworkOverrides)(nil),        // 31: flintlock.types.NetworkOverrides
+	(*Snapshot)(nil),                // 32: flintlock.types.Snapshot
+	(*PlanExecution)(nil),           // 33: flintlock.types.PlanExecution
+	(*StepExecution)(nil),           // 34: flintlock.types.StepExecution
+	(*HostResources)(nil),           // 35: flintlock.types.HostResources
+	(*QuotaResources)(nil),          // 36: flintlock.types.QuotaResources
+	(*NamespaceQuota)(nil),          // 37: flintlock.types.NamespaceQuota
+	nil,                             // 38: flintlock.types.MicroVMSpec.LabelsEntry
+	nil,                             // 39: flintlock.types.MicroVMSpec.MetadataEntry
+	nil,                             // 40: flintlock.types.Kernel.CmdlineEntry
+	nil,                             // 41: flintlock.types.MicroVMStatus.VolumesEntry
+	nil,                             // 42: flintlock.types.MicroVMStatus.NetworkInterfacesEntry
+	nil,                             // 43: flintlock.types.MicroVMStatus.StepErrorsEntry
+	(*timestamppb.Timestamp)(nil),   // 44: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),     // 45: google.protobuf.Duration
 }
 var file_types_microvm_proto_depIdxs = []int32{
-	4,  // 0: flintlock.types.MicroVM.spec:type_name -> flintlock.types.MicroVMSpec
-	13, // 1: flintlock.types.MicroVM.status:type_name -> flintlock.types.MicroVMStatus
-	18, // 2: flintlock.types.MicroVMSpec.labels:type_name -> flintlock.types.MicroVMSpec.LabelsEntry
-	5,  // 3: flintlock.types.MicroVMSpec.kernel:type_name -> flintlock.types.Kernel
-	6,  // 4: flintlock.types.MicroVMSpec.initrd:type_name -> flintlock.types.Initrd
-	9,  // 5: flintlock.types.MicroVMSpec.root_volume:type_name -> flintlock.types.Volume
-	9,  // 6: flintlock.types.MicroVMSpec.additional_volumes:type_name -> flintlock.types.Volume
-	7,  // 7: flintlock.types.MicroVMSpec.interfaces:type_name -> flintlock.types.NetworkInterface
-	19, // 8: flintlock.types.MicroVMSpec.metadata:type_name -> flintlock.types.MicroVMSpec.MetadataEntry
-	23, // 9: flintlock.types.MicroVMSpec.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: flintlock.types.MicroVMSpec.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 11: flintlock.types.MicroVMSpec.deleted_at:type_name -> google.protobuf.Timestamp
-	20, // 12: flintlock.types.Kernel.cmdline:type_name -> flintlock.types.Kernel.CmdlineEntry
-	0,  // 13: flintlock.types.NetworkInterface.type:type_name -> flintlock.types.NetworkInterface.IfaceType
-	8,  // 14: flintlock.types.NetworkInterface.address:type_name -> flintlock.types.StaticAddress
-	17, // 15: flintlock.types.NetworkInterface.overrides:type_name -> flintlock.types.NetworkOverrides
-	10, // 16: flintlock.types.Volume.source:type_name -> flintlock.types.VolumeSource
-	1,  // 17: flintlock.types.MicroVMStatus.state:type_name -> flintlock.types.MicroVMStatus.MicroVMState
-	21, // 18: flintlock.types.MicroVMStatus.volumes:type_name -> flintlock.types.MicroVMStatus.VolumesEntry
-	15, // 19: flintlock.types.MicroVMStatus.kernel_mount:type_name -> flintlock.types.Mount
-	15, // 20: flintlock.types.MicroVMStatus.initrd_mount:type_name -> flintlock.types.Mount
-	22, // 21: flintlock.types.MicroVMStatus.network_interfaces:type_name -> flintlock.types.MicroVMStatus.NetworkInterfacesEntry
-	15, // 22: flintlock.types.VolumeStatus.mount:type_name -> flintlock.types.Mount
-	2,  // 23: flintlock.types.Mount.type:type_name -> flintlock.types.Mount.MountType
-	14, // 24: flintlock.types.MicroVMStatus.VolumesEntry.value:type_name -> flintlock.types.VolumeStatus
-	16, // 25: flintlock.types.MicroVMStatus.NetworkInterfacesEntry.value:type_name -> flintlock.types.NetworkInterfaceStatus
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	10, // 0: flintlock.types.MicroVM.spec:type_name -> flintlock.types.MicroVMSpec
+	25, // 1: flintlock.types.MicroVM.status:type_name -> flintlock.types.MicroVMStatus
+	38, // 2: flintlock.types.MicroVMSpec.labels:type_name -> flintlock.types.MicroVMSpec.LabelsEntry
+	11, // 3: flintlock.types.MicroVMSpec.kernel:type_name -> flintlock.types.Kernel
+	12, // 4: flintlock.types.MicroVMSpec.initrd:type_name -> flintlock.types.Initrd
+	21, // 5: flintlock.types.MicroVMSpec.root_volume:type_name -> flintlock.types.Volume
+	21, // 6: flintlock.types.MicroVMSpec.additional_volumes:type_name -> flintlock.types.Volume
+	13, // 7: flintlock.types.MicroVMSpec.interfaces:type_name -> flintlock.types.NetworkInterface
+	39, // 8: flintlock.types.MicroVMSpec.metadata:type_name -> flintlock.types.MicroVMSpec.MetadataEntry
+	44, // 9: flintlock.types.MicroVMSpec.created_at:type_name -> google.protobuf.Timestamp
+	44, // 10: flintlock.types.MicroVMSpec.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 11: flintlock.types.MicroVMSpec.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: flintlock.types.MicroVMSpec.power_state:type_name -> flintlock.types.MicroVMSpec.PowerState
+	40, // 13: flintlock.types.Kernel.cmdline:type_name -> flintlock.types.Kernel.CmdlineEntry
+	1,  // 14: flintlock.types.NetworkInterface.type:type_name -> flintlock.types.NetworkInterface.IfaceType
+	20, // 15: flintlock.types.NetworkInterface.address:type_name -> flintlock.types.StaticAddress
+	31, // 16: flintlock.types.NetworkInterface.overrides:type_name -> flintlock.types.NetworkOverrides
+	18, // 17: flintlock.types.NetworkInterface.firewall:type_name -> flintlock.types.Firewall
+	17, // 18: flintlock.types.NetworkInterface.offloads:type_name -> flintlock.types.NetworkOffloads
+	14, // 19: flintlock.types.NetworkInterface.rate_limits:type_name -> flintlock.types.NetworkRateLimits
+	15, // 20: flintlock.types.NetworkRateLimits.rx:type_name -> flintlock.types.RateLimit
+	15, // 21: flintlock.types.NetworkRateLimits.tx:type_name -> flintlock.types.RateLimit
+	16, // 22: flintlock.types.RateLimit.bandwidth:type_name -> flintlock.types.TokenBucket
+	16, // 23: flintlock.types.RateLimit.ops:type_name -> flintlock.types.TokenBucket
+	19, // 24: flintlock.types.Firewall.ingress:type_name -> flintlock.types.FirewallRule
+	19, // 25: flintlock.types.Firewall.egress:type_name -> flintlock.types.FirewallRule
+	2,  // 26: flintlock.types.FirewallRule.protocol:type_name -> flintlock.types.FirewallRule.Protocol
+	22, // 27: flintlock.types.Volume.source:type_name -> flintlock.types.VolumeSource
+	3,  // 28: flintlock.types.Volume.cache_mode:type_name -> flintlock.types.Volume.CacheMode
+	4,  // 29: flintlock.types.Volume.io_engine:type_name -> flintlock.types.Volume.IOEngine
+	15, // 30: flintlock.types.Volume.rate_limit:type_name -> flintlock.types.RateLimit
+	5,  // 31: flintlock.types.MicroVMStatus.state:type_name -> flintlock.types.MicroVMStatus.MicroVMState
+	41, // 32: flintlock.types.MicroVMStatus.volumes:type_name -> flintlock.types.MicroVMStatus.VolumesEntry
+	29, // 33: flintlock.types.MicroVMStatus.kernel_mount:type_name -> flintlock.types.Mount
+	29, // 34: flintlock.types.MicroVMStatus.initrd_mount:type_name -> flintlock.types.Mount
+	42, // 35: flintlock.types.MicroVMStatus.network_interfaces:type_name -> flintlock.types.MicroVMStatus.NetworkInterfacesEntry
+	26, // 36: flintlock.types.MicroVMStatus.conditions:type_name -> flintlock.types.Condition
+	27, // 37: flintlock.types.MicroVMStatus.last_error:type_name -> flintlock.types.StepError
+	43, // 38: flintlock.types.MicroVMStatus.step_errors:type_name -> flintlock.types.MicroVMStatus.StepErrorsEntry
+	44, // 39: flintlock.types.MicroVMStatus.guest_ready_at:type_name -> google.protobuf.Timestamp
+	6,  // 40: flintlock.types.Condition.status:type_name -> flintlock.types.Condition.ConditionStatus
+	44, // 41: flintlock.types.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
+	44, // 42: flintlock.types.StepError.occurred_at:type_name -> google.protobuf.Timestamp
+	29, // 43: flintlock.types.VolumeStatus.mount:type_name -> flintlock.types.Mount
+	7,  // 44: flintlock.types.Mount.type:type_name -> flintlock.types.Mount.MountType
+	20, // 45: flintlock.types.NetworkInterfaceStatus.allocated_address:type_name -> flintlock.types.StaticAddress
+	44, // 46: flintlock.types.Snapshot.created_at:type_name -> google.protobuf.Timestamp
+	44, // 47: flintlock.types.PlanExecution.started_at:type_name -> google.protobuf.Timestamp
+	44, // 48: flintlock.types.PlanExecution.finished_at:type_name -> google.protobuf.Timestamp
+	34, // 49: flintlock.types.PlanExecution.steps:type_name -> flintlock.types.StepExecution
+	8,  // 50: flintlock.types.StepExecution.outcome:type_name -> flintlock.types.StepExecution.Outcome
+	45, // 51: flintlock.types.StepExecution.duration:type_name -> google.protobuf.Duration
+	36, // 52: flintlock.types.NamespaceQuota.limits:type_name -> flintlock.types.QuotaResources
+	28, // 53: flintlock.types.MicroVMStatus.VolumesEntry.value:type_name -> flintlock.types.VolumeStatus
+	30, // 54: flintlock.types.MicroVMStatus.NetworkInterfacesEntry.value:type_name -> flintlock.types.NetworkInterfaceStatus
+	27, // 55: flintlock.types.MicroVMStatus.StepErrorsEntry.value:type_name -> flintlock.types.StepError
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_types_microvm_proto_init() }
@@ -1580,14 +3440,20 @@ func file_types_microvm_proto_init() {
 	file_types_microvm_proto_msgTypes[5].OneofWrappers = []any{}
 	file_types_microvm_proto_msgTypes[6].OneofWrappers = []any{}
 	file_types_microvm_proto_msgTypes[7].OneofWrappers = []any{}
-	file_types_microvm_proto_msgTypes[14].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[8].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[10].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[11].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[12].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[13].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[21].OneofWrappers = []any{}
+	file_types_microvm_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_microvm_proto_rawDesc), len(file_types_microvm_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   20,
+			NumEnums:      9,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
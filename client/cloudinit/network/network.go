@@ -18,6 +18,7 @@ type Ethernet struct {
 	DHCP6          *bool       `yaml:"dhcp6,omitempty"`
 	DHCPIdentifier *string     `yaml:"dhcp-identifier,omitempty"`
 	Nameservers    Nameservers `yaml:"nameservers,omitempty"`
+	MTU            int         `yaml:"mtu,omitempty"`
 }
 
 type Match struct {
@@ -1,6 +1,10 @@
 package application
 
 import (
+	"sync"
+	"time"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 )
 
@@ -22,10 +26,38 @@ func New(cfg *Config, ports *ports.Collection) App {
 type app struct {
 	cfg   *Config
 	ports *ports.Collection
+
+	// admissionMu serializes the capacity and quota checks and save of microvm changes so
+	// concurrent requests can't both be admitted into the same free capacity or quota.
+	admissionMu sync.Mutex
+
+	// microvmLocks holds a mutex for each microvm, by uid. It stops a snapshot being taken
+	// of a microvm while it's being reconciled.
+	microvmLocks sync.Map
+}
+
+// lockMicroVM locks the microvm with the uid until the returned function is called.
+func (a *app) lockMicroVM(uid string) func() {
+	value, _ := a.microvmLocks.LoadOrStore(uid, &sync.Mutex{})
+	mu, _ := value.(*sync.Mutex)
+	mu.Lock()
+
+	return mu.Unlock
 }
 
 type Config struct {
-	RootStateDir    string
-	MaximumRetry    int
-	DefaultProvider string
+	RootStateDir      string
+	MaximumRetry      int
+	DefaultProvider   string
+	GuestBootDeadline time.Duration
+	// NetworkNamespaces indicates if new microvms are isolated in a network namespace of their own.
+	NetworkNamespaces bool
+	// ReservedResources are the host resources kept back for the host and not allocated to microvms.
+	ReservedResources models.HostResources
+	// CPUOvercommitRatio is how many vcpus can be allocated per host cpu. Defaults to 1.
+	CPUOvercommitRatio float64
+	// MemoryOvercommitRatio is how much memory can be allocated per megabyte of host memory. Defaults to 1.
+	MemoryOvercommitRatio float64
+	// DiskOvercommitRatio is how much disk can be allocated per megabyte of host disk. Defaults to 1.
+	DiskOvercommitRatio float64
 }
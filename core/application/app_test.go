@@ -190,21 +190,889 @@ func TestApp_CreateMicroVM(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:         "network queues but provider lacks network queues capability, should fail",
+			specToCreate: createTestSpecWithNetworkQueues("id1234", "default", testUID),
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+				im.GenerateRandom().Return(testUID, nil).Times(1)
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						Name:      "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				).Return(nil, nil)
+			},
+		},
+		{
+			name:         "direct volume cache mode but provider lacks direct cache capability, should fail",
+			specToCreate: createTestSpecWithCacheMode("id1234", "default", testUID, models.VolumeCacheModeDirect),
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				pm.Capabilities().Return(models.Capabilities{models.MacvtapCapability, models.VolumeCacheUnsafeCapability}).AnyTimes()
+				im.GenerateRandom().Return(testUID, nil).Times(1)
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						Name:      "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				).Return(nil, nil)
+			},
+		},
 		{
 			name:         "allow guest agent but provider lacks vsock capability, should fail",
 			specToCreate: createTestSpecWithGuestAgent("id1234", "default", testUID),
 			expectError:  true,
-			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
-				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
-				im.GenerateRandom().Return(testUID, nil).Times(1)
-				rm.Get(
-					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(ports.RepositoryGetOptions{
-						Name:      "id1234",
-						Namespace: "default",
-						UID:       testUID,
-					}),
-				).Return(nil, nil)
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+				im.GenerateRandom().Return(testUID, nil).Times(1)
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						Name:      "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			ns := mock.NewMockNetworkService(mockCtrl)
+			is := mock.NewMockImageService(mockCtrl)
+			hs := mock.NewMockHostService(mockCtrl)
+			qr := mock.NewMockQuotaRepository(mockCtrl)
+			fs := afero.NewMemMapFs()
+			ports := &ports.Collection{
+				Repo: rm,
+				MicrovmProviders: map[string]ports.MicroVMService{
+					"mock": pm,
+				},
+				EventService:      em,
+				IdentifierService: im,
+				NetworkService:    ns,
+				ImageService:      is,
+				FileSystem:        fs,
+				Clock:             frozenTime,
+				HostService:       hs,
+				QuotaRepo:         qr,
+			}
+
+			tc.expect(rm.EXPECT(), em.EXPECT(), im.EXPECT(), pm.EXPECT())
+			expectEmptyHost(hs.EXPECT(), rm.EXPECT())
+			expectNoQuota(qr.EXPECT())
+
+			ctx := context.Background()
+			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
+			_, err := app.CreateMicroVM(ctx, tc.specToCreate)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestApp_CreateMicroVM_networkNamespace(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	em := mock.NewMockEventService(mockCtrl)
+	im := mock.NewMockIDService(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	hs := mock.NewMockHostService(mockCtrl)
+	qr := mock.NewMockQuotaRepository(mockCtrl)
+	ports := &ports.Collection{
+		Repo: rm,
+		MicrovmProviders: map[string]ports.MicroVMService{
+			"mock": pm,
+		},
+		EventService:      em,
+		IdentifierService: im,
+		FileSystem:        afero.NewMemMapFs(),
+		Clock:             time.Now,
+		HostService:       hs,
+		QuotaRepo:         qr,
+	}
+
+	pm.EXPECT().Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+	im.EXPECT().GenerateRandom().Return(testUID, nil).Times(1)
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(nil, nil)
+	expectEmptyHost(hs.EXPECT(), rm.EXPECT())
+	expectNoQuota(qr.EXPECT())
+
+	var saved *models.MicroVM
+
+	rm.EXPECT().
+		Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+		DoAndReturn(func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+			saved = mvm
+
+			return mvm, nil
+		})
+	em.EXPECT().Publish(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(defaults.TopicMicroVMEvents), gomock.Any())
+
+	app := application.New(&application.Config{DefaultProvider: "mock", NetworkNamespaces: true}, ports)
+	_, err := app.CreateMicroVM(context.Background(), createTestSpec("id1234", "default", testUID))
+
+	Expect(err).NotTo(HaveOccurred())
+	Expect(saved).NotTo(BeNil())
+	Expect(saved.Status.NetworkNamespace).To(Equal(defaults.NetworkNamespacePrefix + testUID))
+}
+
+func TestApp_DeleteMicroVM(t *testing.T) {
+	frozenTime := time.Now
+
+	testCases := []struct {
+		name        string
+		toDeleteUID string
+		expectError bool
+		expect      func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder)
+	}{
+		{
+			name:        "empty id, should fail",
+			expectError: true,
+			toDeleteUID: "",
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+			},
+		},
+		{
+			name:        "spec exists, should delete",
+			expectError: false,
+			toDeleteUID: testUID,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(
+					createTestSpec("id1234", "default", testUID),
+					nil,
+				)
+
+				expectedUpdatedSpec := createTestSpec("id1234", "default", testUID)
+				expectedUpdatedSpec.Spec.DeletedAt = frozenTime().Unix()
+				expectedUpdatedSpec.Status.State = models.DeletingState
+
+				rm.Save(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(expectedUpdatedSpec),
+				).Return(
+					createTestSpec("id1234", "default", testUID),
+					nil,
+				)
+
+				em.Publish(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(defaults.TopicMicroVMEvents),
+					gomock.Eq(&events.MicroVMSpecUpdated{
+						ID:        "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				)
+			},
+		},
+		{
+			name:        "spec doesn't exist, should not delete",
+			expectError: true,
+			toDeleteUID: testUID,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(
+					nil,
+					nil,
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			ns := mock.NewMockNetworkService(mockCtrl)
+			is := mock.NewMockImageService(mockCtrl)
+			fs := afero.NewMemMapFs()
+			ports := &ports.Collection{
+				Repo: rm,
+				MicrovmProviders: map[string]ports.MicroVMService{
+					"mock": pm,
+				},
+				EventService:      em,
+				IdentifierService: im,
+				NetworkService:    ns,
+				ImageService:      is,
+				FileSystem:        fs,
+				Clock:             frozenTime,
+			}
+
+			tc.expect(rm.EXPECT(), em.EXPECT(), im.EXPECT(), pm.EXPECT())
+
+			ctx := context.Background()
+			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
+			err := app.DeleteMicroVM(ctx, tc.toDeleteUID)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestApp_UpdateMicroVM(t *testing.T) {
+	frozenTime := time.Now
+
+	existingSpec := func() *models.MicroVM {
+		spec := createTestSpecWithMetadata("id1234", "default", testUID, createInstanceMetadatadata(t, testUID))
+		spec.Spec.Provider = "mock"
+		spec.Status.State = models.CreatedState
+
+		return spec
+	}
+
+	additionalVolume := models.Volume{
+		ID: "data",
+		Source: models.VolumeSource{
+			Container: &models.ContainerVolumeSource{
+				Image: "docker.io/library/data:latest",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		toUpdateUID  string
+		specToUpdate func() *models.MicroVM
+		expectError  bool
+		expect       func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder)
+	}{
+		{
+			name:         "empty uid, should fail",
+			toUpdateUID:  "",
+			specToUpdate: func() *models.MicroVM { return createTestSpec("id1234", "default", testUID) },
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+			},
+		},
+		{
+			name:         "nil spec, should fail",
+			toUpdateUID:  testUID,
+			specToUpdate: func() *models.MicroVM { return nil },
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+			},
+		},
+		{
+			name:         "spec doesn't exist, should fail",
+			toUpdateUID:  testUID,
+			specToUpdate: func() *models.MicroVM { return createTestSpec("id1234", "default", testUID) },
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(nil, nil)
+			},
+		},
+		{
+			name:        "spec is being deleted, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				return createTestSpec("id1234", "default", testUID)
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				deleting := existingSpec()
+				deleting.Spec.DeletedAt = frozenTime().Unix()
+
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(deleting, nil)
+			},
+		},
+		{
+			name:        "kernel changed, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.Kernel.Image = "docker.io/linuxkit/kernel:5.10.0"
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+		{
+			name:        "existing network interface changed, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces[1].GuestMAC = "AA:FF:00:00:00:02"
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+		{
+			name:        "network interface rate limits changed and provider can't update them live, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces[1].RateLimits = testRateLimits()
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+		{
+			name:        "network interface rate limits changed and provider can update them live, should update rate limits",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces[1].RateLimits = testRateLimits()
+
+				return spec
+			},
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{
+					models.MetadataServiceCapability,
+					models.MacvtapCapability,
+					models.LiveNetworkRateLimitUpdateCapability,
+				}).AnyTimes()
+
+				expectedUpdatedSpec := existingSpec()
+				expectedUpdatedSpec.Spec.NetworkInterfaces[1].RateLimits = testRateLimits()
+				expectedUpdatedSpec.Spec.UpdatedAt = frozenTime().Unix()
+				expectedUpdatedSpec.Status.RateLimitUpdatePending = true
+
+				rm.Save(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(expectedUpdatedSpec),
+				).Return(expectedUpdatedSpec, nil)
+
+				em.Publish(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(defaults.TopicMicroVMEvents),
+					gomock.Eq(&events.MicroVMSpecUpdated{
+						ID:        "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				)
+			},
+		},
+		{
+			name:        "separate rx and tx rate limits but provider lacks capability, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces[1].RateLimits = testRateLimits()
+				spec.Spec.NetworkInterfaces[1].RateLimits.Tx = nil
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+		{
+			name:        "volume added and interface removed, should update",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces = spec.Spec.NetworkInterfaces[:1]
+				spec.Spec.AdditionalVolumes = models.Volumes{additionalVolume}
+
+				return spec
+			},
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{
+					models.MetadataServiceCapability,
+					models.MacvtapCapability,
+					models.HotplugCapability,
+				}).AnyTimes()
+
+				expectedUpdatedSpec := existingSpec()
+				expectedUpdatedSpec.Spec.NetworkInterfaces = expectedUpdatedSpec.Spec.NetworkInterfaces[:1]
+				expectedUpdatedSpec.Spec.AdditionalVolumes = models.Volumes{additionalVolume}
+				expectedUpdatedSpec.Spec.UpdatedAt = frozenTime().Unix()
+				expectedUpdatedSpec.Status.DeviceUpdatePending = true
+
+				rm.Save(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(expectedUpdatedSpec),
+				).Return(expectedUpdatedSpec, nil)
+
+				em.Publish(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(defaults.TopicMicroVMEvents),
+					gomock.Eq(&events.MicroVMSpecUpdated{
+						ID:        "id1234",
+						Namespace: "default",
+						UID:       testUID,
+					}),
+				)
+			},
+		},
+		{
+			name:        "volume added and interface removed but provider lacks hotplug capability, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.NetworkInterfaces = spec.Spec.NetworkInterfaces[:1]
+				spec.Spec.AdditionalVolumes = models.Volumes{additionalVolume}
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+		{
+			name:        "virtiofs volume added, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.AdditionalVolumes = models.Volumes{
+					{ID: "shared", Source: models.VolumeSource{VirtioFS: &models.VirtioFSVolumeSource{Path: "/shared"}}},
+				}
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{
+					models.MetadataServiceCapability,
+					models.MacvtapCapability,
+					models.VirtioFSCapability,
+					models.HotplugCapability,
+				}).AnyTimes()
+			},
+		},
+		{
+			name:        "labels changed, should update without recreating",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.Labels = map[string]string{"env": "test"}
+
+				return spec
+			},
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+
+				expectedUpdatedSpec := existingSpec()
+				expectedUpdatedSpec.Spec.Labels = map[string]string{"env": "test"}
+				expectedUpdatedSpec.Spec.UpdatedAt = frozenTime().Unix()
+
+				rm.Save(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(expectedUpdatedSpec),
+				).Return(expectedUpdatedSpec, nil)
+
+				em.Publish(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(defaults.TopicMicroVMEvents),
+					gomock.Any(),
+				)
+			},
+		},
+		{
+			name:        "metadata changed and provider can update it live, should update metadata",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.Metadata = map[string]string{"user-data": "dGVzdA=="}
+
+				return spec
+			},
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{
+					models.MetadataServiceCapability,
+					models.MacvtapCapability,
+					models.LiveMetadataUpdateCapability,
+				}).AnyTimes()
+
+				expectedUpdatedSpec := existingSpec()
+				expectedUpdatedSpec.Spec.Metadata["user-data"] = "dGVzdA=="
+				expectedUpdatedSpec.Spec.UpdatedAt = frozenTime().Unix()
+				expectedUpdatedSpec.Status.MetadataUpdatePending = true
+
+				rm.Save(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(expectedUpdatedSpec),
+				).Return(expectedUpdatedSpec, nil)
+
+				em.Publish(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(defaults.TopicMicroVMEvents),
+					gomock.Any(),
+				)
+			},
+		},
+		{
+			name:        "metadata changed and provider can't update it live, should fail",
+			toUpdateUID: testUID,
+			specToUpdate: func() *models.MicroVM {
+				spec := createTestSpec("id1234", "default", testUID)
+				spec.Spec.Metadata = map[string]string{"user-data": "dGVzdA=="}
+
+				return spec
+			},
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq(ports.RepositoryGetOptions{
+						UID: testUID,
+					}),
+				).Return(existingSpec(), nil)
+
+				pm.Capabilities().Return(models.Capabilities{models.MetadataServiceCapability, models.MacvtapCapability}).AnyTimes()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			hs := mock.NewMockHostService(mockCtrl)
+			qr := mock.NewMockQuotaRepository(mockCtrl)
+			ports := &ports.Collection{
+				Repo: rm,
+				MicrovmProviders: map[string]ports.MicroVMService{
+					"mock": pm,
+				},
+				EventService:      em,
+				IdentifierService: im,
+				FileSystem:        afero.NewMemMapFs(),
+				Clock:             frozenTime,
+				HostService:       hs,
+				QuotaRepo:         qr,
+			}
+
+			tc.expect(rm.EXPECT(), em.EXPECT(), im.EXPECT(), pm.EXPECT())
+			expectEmptyHost(hs.EXPECT(), rm.EXPECT())
+			expectNoQuota(qr.EXPECT())
+
+			ctx := context.Background()
+			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
+			_, err := app.UpdateMicroVM(ctx, tc.toUpdateUID, tc.specToUpdate())
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestApp_PowerStateMicroVM(t *testing.T) {
+	frozenTime := time.Now
+
+	existingSpec := func(powerState models.PowerState) *models.MicroVM {
+		spec := createTestSpec("id1234", "default", testUID)
+		spec.Spec.Provider = "mock"
+		spec.Spec.PowerState = powerState
+		spec.Status.State = models.CreatedState
+
+		return spec
+	}
+
+	expectGet := func(rm *mock.MockMicroVMRepositoryMockRecorder, found *models.MicroVM) {
+		rm.Get(
+			gomock.AssignableToTypeOf(context.Background()),
+			gomock.Eq(ports.RepositoryGetOptions{
+				UID: testUID,
+			}),
+		).Return(found, nil)
+	}
+
+	expectSaveAndPublish := func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, expected *models.MicroVM) {
+		rm.Save(
+			gomock.AssignableToTypeOf(context.Background()),
+			gomock.Eq(expected),
+		).Return(expected, nil)
+
+		em.Publish(
+			gomock.AssignableToTypeOf(context.Background()),
+			gomock.Eq(defaults.TopicMicroVMEvents),
+			gomock.Eq(&events.MicroVMSpecUpdated{
+				ID:        "id1234",
+				Namespace: "default",
+				UID:       testUID,
+			}),
+		)
+	}
+
+	testCases := []struct {
+		name         string
+		uid          string
+		action       func(app application.App, uid string) error
+		capabilities models.Capabilities
+		expectError  bool
+		expect       func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder)
+	}{
+		{
+			name:        "stop with empty uid, should fail",
+			uid:         "",
+			action:      func(app application.App, uid string) error { return app.StopMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect:      func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {},
+		},
+		{
+			name:        "stop when spec doesn't exist, should fail",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.StopMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, nil)
+			},
+		},
+		{
+			name:        "stop when spec is being deleted, should fail",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.StopMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				deleting := existingSpec("")
+				deleting.Spec.DeletedAt = frozenTime().Unix()
+				expectGet(rm, deleting)
+			},
+		},
+		{
+			name:        "stop running microvm, should set power state to stopped",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.StopMicroVM(context.Background(), uid) },
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(""))
+				expectSaveAndPublish(rm, em, existingSpec(models.PowerStateStopped))
+			},
+		},
+		{
+			name:        "start stopped microvm, should set power state to running",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.StartMicroVM(context.Background(), uid) },
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(models.PowerStateStopped))
+				expectSaveAndPublish(rm, em, existingSpec(models.PowerStateRunning))
+			},
+		},
+		{
+			name:        "restart running microvm, should mark restart as pending",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.RestartMicroVM(context.Background(), uid) },
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(""))
+
+				expected := existingSpec("")
+				expected.Status.RestartPending = true
+				expectSaveAndPublish(rm, em, expected)
+			},
+		},
+		{
+			name:        "restart stopped microvm, should fail",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.RestartMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(models.PowerStateStopped))
+			},
+		},
+		{
+			name:        "restart paused microvm, should fail",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.RestartMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(models.PowerStatePaused))
+			},
+		},
+		{
+			name:         "pause running microvm, should set power state to paused",
+			uid:          testUID,
+			action:       func(app application.App, uid string) error { return app.PauseMicroVM(context.Background(), uid) },
+			capabilities: models.Capabilities{models.PauseCapability},
+			expectError:  false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(""))
+				expectSaveAndPublish(rm, em, existingSpec(models.PowerStatePaused))
+			},
+		},
+		{
+			name:         "pause when provider doesn't support pause, should fail",
+			uid:          testUID,
+			action:       func(app application.App, uid string) error { return app.PauseMicroVM(context.Background(), uid) },
+			capabilities: models.Capabilities{},
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(""))
+			},
+		},
+		{
+			name:         "pause stopped microvm, should fail",
+			uid:          testUID,
+			action:       func(app application.App, uid string) error { return app.PauseMicroVM(context.Background(), uid) },
+			capabilities: models.Capabilities{models.PauseCapability},
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(models.PowerStateStopped))
+			},
+		},
+		{
+			name:        "resume paused microvm, should set power state to running",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.ResumeMicroVM(context.Background(), uid) },
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(models.PowerStatePaused))
+				expectSaveAndPublish(rm, em, existingSpec(models.PowerStateRunning))
+			},
+		},
+		{
+			name:        "resume microvm that isn't paused, should fail",
+			uid:         testUID,
+			action:      func(app application.App, uid string) error { return app.ResumeMicroVM(context.Background(), uid) },
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder) {
+				expectGet(rm, existingSpec(""))
 			},
 		},
 	}
@@ -218,108 +1086,334 @@ func TestApp_CreateMicroVM(t *testing.T) {
 
 			rm := mock.NewMockMicroVMRepository(mockCtrl)
 			em := mock.NewMockEventService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			ports := &ports.Collection{
+				Repo: rm,
+				MicrovmProviders: map[string]ports.MicroVMService{
+					"mock": pm,
+				},
+				EventService: em,
+				FileSystem:   afero.NewMemMapFs(),
+				Clock:        frozenTime,
+			}
+
+			tc.expect(rm.EXPECT(), em.EXPECT())
+			pm.EXPECT().Capabilities().Return(tc.capabilities).AnyTimes()
+
+			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
+			err := tc.action(app, tc.uid)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestApp_CreateSnapshot(t *testing.T) {
+	frozenTime := func() time.Time { return time.Unix(1700000000, 0) }
+	vmid := "default/id1234/" + testUID
+	snapshotPath := "/var/lib/flintlock/snapshots/snap1234"
+
+	existingSpec := func(state models.MicroVMState) *models.MicroVM {
+		spec := createTestSpec("id1234", "default", testUID)
+		spec.Spec.Provider = "mock"
+		spec.Status.State = state
+		spec.Status.Volumes = models.VolumeStatuses{
+			"root": {Mount: models.Mount{Type: models.MountTypeDev, Source: "/dev/mapper/root"}},
+		}
+
+		return spec
+	}
+
+	expectedSnapshot := func() *models.Snapshot {
+		return &models.Snapshot{
+			UID:        "snap1234",
+			Name:       "warm",
+			Namespace:  "default",
+			MicroVMUID: testUID,
+			Provider:   "mock",
+			Path:       snapshotPath,
+			Source:     existingSpec(models.CreatedState).Spec,
+			Volumes:    []string{"root"},
+			CreatedAt:  frozenTime().Unix(),
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		snapshotName     string
+		expectError      bool
+		expectDirRemoved bool
+		expect           func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder)
+	}{
+		{
+			name:         "empty name, should fail",
+			snapshotName: "",
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+			},
+		},
+		{
+			name:         "microvm not running, should fail",
+			snapshotName: "warm",
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(ports.RepositoryGetOptions{UID: testUID})).
+					Return(existingSpec(models.PendingState), nil)
+			},
+		},
+		{
+			name:         "provider doesn't support snapshots, should fail",
+			snapshotName: "warm",
+			expectError:  true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(ports.RepositoryGetOptions{UID: testUID})).
+					Return(existingSpec(models.CreatedState), nil)
+				pm.Capabilities().Return(models.Capabilities{})
+			},
+		},
+		{
+			name:             "provider fails to snapshot, should fail and remove the snapshot directory",
+			snapshotName:     "warm",
+			expectError:      true,
+			expectDirRemoved: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(ports.RepositoryGetOptions{UID: testUID})).
+					Return(existingSpec(models.CreatedState), nil)
+				pm.Capabilities().Return(models.Capabilities{models.SnapshotCapability})
+				im.GenerateRandom().Return("snap1234", nil)
+				pm.State(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).
+					Return(ports.MicroVMStateRunning, nil)
+				gomock.InOrder(
+					pm.Pause(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).Return(nil),
+					pm.Snapshot(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid), gomock.Eq(snapshotPath)).
+						Return(errors.New("snapshot failed")),
+					pm.Resume(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).Return(nil),
+				)
+			},
+		},
+		{
+			name:         "running microvm, should pause it while it's snapshotted and save",
+			snapshotName: "warm",
+			expectError:  false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(ports.RepositoryGetOptions{UID: testUID})).
+					Return(existingSpec(models.CreatedState), nil)
+				pm.Capabilities().Return(models.Capabilities{models.SnapshotCapability})
+				im.GenerateRandom().Return("snap1234", nil)
+				pm.State(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).
+					Return(ports.MicroVMStateRunning, nil)
+				gomock.InOrder(
+					pm.Pause(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).Return(nil),
+					pm.Snapshot(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid), gomock.Eq(snapshotPath)).
+						Return(nil),
+					pm.Resume(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).Return(nil),
+				)
+				sm.Save(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(expectedSnapshot())).
+					Return(expectedSnapshot(), nil)
+			},
+		},
+		{
+			name:         "paused microvm, should snapshot and save",
+			snapshotName: "warm",
+			expectError:  false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, sm *mock.MockSnapshotRepositoryMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(ports.RepositoryGetOptions{UID: testUID})).
+					Return(existingSpec(models.PausedState), nil)
+				pm.Capabilities().Return(models.Capabilities{models.SnapshotCapability})
+				im.GenerateRandom().Return("snap1234", nil)
+				pm.State(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid)).
+					Return(ports.MicroVMStatePaused, nil)
+				pm.Snapshot(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vmid), gomock.Eq(snapshotPath)).
+					Return(nil)
+				sm.Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(expectedSnapshot(), nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			sm := mock.NewMockSnapshotRepository(mockCtrl)
 			im := mock.NewMockIDService(mockCtrl)
 			pm := mock.NewMockMicroVMService(mockCtrl)
-			ns := mock.NewMockNetworkService(mockCtrl)
-			is := mock.NewMockImageService(mockCtrl)
 			fs := afero.NewMemMapFs()
 			ports := &ports.Collection{
-				Repo: rm,
+				Repo:         rm,
+				SnapshotRepo: sm,
 				MicrovmProviders: map[string]ports.MicroVMService{
 					"mock": pm,
 				},
-				EventService:      em,
 				IdentifierService: im,
-				NetworkService:    ns,
-				ImageService:      is,
 				FileSystem:        fs,
 				Clock:             frozenTime,
 			}
 
-			tc.expect(rm.EXPECT(), em.EXPECT(), im.EXPECT(), pm.EXPECT())
+			Expect(afero.WriteFile(fs, "/dev/mapper/root", []byte("root volume"), 0o600)).To(Succeed())
 
-			ctx := context.Background()
-			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
-			_, err := app.CreateMicroVM(ctx, tc.specToCreate)
+			tc.expect(rm.EXPECT(), sm.EXPECT(), im.EXPECT(), pm.EXPECT())
+
+			app := application.New(&application.Config{
+				DefaultProvider: "mock",
+				RootStateDir:    "/var/lib/flintlock",
+			}, ports)
+			snapshot, err := app.CreateSnapshot(context.Background(), testUID, tc.snapshotName)
 
 			if tc.expectError {
 				Expect(err).To(HaveOccurred())
 			} else {
 				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot).To(Equal(expectedSnapshot()))
+
+				volume, readErr := afero.ReadFile(fs, snapshotPath+"/volumes/root")
+				Expect(readErr).NotTo(HaveOccurred())
+				Expect(string(volume)).To(Equal("root volume"))
+			}
+
+			if tc.expectDirRemoved {
+				exists, existsErr := afero.DirExists(fs, snapshotPath)
+				Expect(existsErr).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
 			}
 		})
 	}
 }
 
-func TestApp_DeleteMicroVM(t *testing.T) {
+func TestApp_DeleteSnapshot(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	sm := mock.NewMockSnapshotRepository(mockCtrl)
+	fs := afero.NewMemMapFs()
+	ports := &ports.Collection{
+		Repo:         rm,
+		SnapshotRepo: sm,
+		FileSystem:   fs,
+	}
+
+	snapshot := &models.Snapshot{UID: "snap1234", Path: "/var/lib/flintlock/snapshots/snap1234"}
+	Expect(fs.MkdirAll(snapshot.Path, defaults.DataDirPerm)).To(Succeed())
+
+	clone := createTestSpec("clone", "default", testUID)
+	clone.Spec.RestoreFromSnapshot = "snap1234"
+	deletedClone := createTestSpec("deleted", "default", testUID)
+	deletedClone.Spec.RestoreFromSnapshot = "snap1234"
+	deletedClone.Spec.DeletedAt = 1
+
+	sm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("missing")).Return(nil, nil)
+	sm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("snap1234")).Return(snapshot, nil).Times(2)
+	gomock.InOrder(
+		rm.EXPECT().GetAll(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+			Return([]*models.MicroVM{clone, deletedClone}, nil),
+		rm.EXPECT().GetAll(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+			Return([]*models.MicroVM{deletedClone}, nil),
+	)
+	sm.EXPECT().Delete(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(snapshot)).Return(nil)
+
+	app := application.New(&application.Config{}, ports)
+
+	Expect(app.DeleteSnapshot(context.Background(), "")).NotTo(Succeed())
+	Expect(app.DeleteSnapshot(context.Background(), "missing")).NotTo(Succeed())
+	Expect(app.DeleteSnapshot(context.Background(), "snap1234")).To(MatchError(ContainSubstring("used by microvm")))
+
+	exists, err := afero.DirExists(fs, snapshot.Path)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(exists).To(BeTrue())
+
+	Expect(app.DeleteSnapshot(context.Background(), "snap1234")).To(Succeed())
+
+	exists, err = afero.DirExists(fs, snapshot.Path)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(exists).To(BeFalse())
+}
+
+func TestApp_CreateMicroVMFromSnapshot(t *testing.T) {
 	frozenTime := time.Now
 
+	source := createTestSpec("source", "default", testUID)
+	source.Spec.Provider = "mock"
+	source.Spec.VCPU = 4
+	source.Spec.MemoryInMb = 4096
+
+	snapshot := &models.Snapshot{
+		UID:        "snap1234",
+		Name:       "warm",
+		Namespace:  "default",
+		MicroVMUID: testUID,
+		Provider:   "mock",
+		Path:       "/var/lib/flintlock/snapshots/snap1234",
+		Source:     source.Spec,
+		Volumes:    []string{"root"},
+	}
+
+	withoutVolumes := *snapshot
+	withoutVolumes.Volumes = nil
+
+	// The source microvm is still running with the mac addresses in the snapshot.
+	sourceRunning := createTestSpec("source", "default", testUID)
+	sourceRunning.Spec.NetworkInterfaces[1].GuestMAC = "AA:BB:CC:DD:EE:FF"
+
+	withMAC := *snapshot
+	withMAC.Source.NetworkInterfaces = sourceRunning.Spec.NetworkInterfaces
+
+	sourceDeleted := createTestSpec("source", "default", testUID)
+	sourceDeleted.Spec.NetworkInterfaces = sourceRunning.Spec.NetworkInterfaces
+	sourceDeleted.Spec.DeletedAt = 1
+
 	testCases := []struct {
 		name        string
-		toDeleteUID string
+		namespace   string
+		provider    string
+		snapshot    *models.Snapshot
+		existing    []*models.MicroVM
 		expectError bool
-		expect      func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder)
 	}{
 		{
-			name:        "empty id, should fail",
+			name:        "spec restored from snapshot, should use the snapshotted microvm",
+			namespace:   "default",
+			expectError: false,
+		},
+		{
+			name:        "snapshot in another namespace, should fail",
+			namespace:   "other",
 			expectError: true,
-			toDeleteUID: "",
-			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
-			},
 		},
 		{
-			name:        "spec exists, should delete",
-			expectError: false,
-			toDeleteUID: testUID,
-			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
-				rm.Get(
-					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(ports.RepositoryGetOptions{
-						UID: testUID,
-					}),
-				).Return(
-					createTestSpec("id1234", "default", testUID),
-					nil,
-				)
-
-				expectedUpdatedSpec := createTestSpec("id1234", "default", testUID)
-				expectedUpdatedSpec.Spec.DeletedAt = frozenTime().Unix()
-				expectedUpdatedSpec.Status.State = models.DeletingState
-
-				rm.Save(
-					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(expectedUpdatedSpec),
-				).Return(
-					createTestSpec("id1234", "default", testUID),
-					nil,
-				)
-
-				em.Publish(
-					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(defaults.TopicMicroVMEvents),
-					gomock.Eq(&events.MicroVMSpecUpdated{
-						ID:        "id1234",
-						Namespace: "default",
-						UID:       testUID,
-					}),
-				)
-			},
+			name:        "different provider, should fail",
+			namespace:   "default",
+			provider:    "other",
+			expectError: true,
 		},
 		{
-			name:        "spec doesn't exist, should not delete",
+			name:        "volumes not in the snapshot, should fail",
+			namespace:   "default",
+			snapshot:    &withoutVolumes,
 			expectError: true,
-			toDeleteUID: testUID,
-			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
-				rm.Get(
-					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(ports.RepositoryGetOptions{
-						UID: testUID,
-					}),
-				).Return(
-					nil,
-					nil,
-				)
-			},
+		},
+		{
+			name:        "mac address used by another microvm, should fail",
+			namespace:   "default",
+			snapshot:    &withMAC,
+			existing:    []*models.MicroVM{sourceRunning},
+			expectError: true,
+		},
+		{
+			name:        "mac address used by a deleted microvm, should use the snapshotted microvm",
+			namespace:   "default",
+			snapshot:    &withMAC,
+			existing:    []*models.MicroVM{sourceDeleted},
+			expectError: false,
 		},
 	}
 
@@ -331,36 +1425,84 @@ func TestApp_DeleteMicroVM(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			sm := mock.NewMockSnapshotRepository(mockCtrl)
 			em := mock.NewMockEventService(mockCtrl)
 			im := mock.NewMockIDService(mockCtrl)
 			pm := mock.NewMockMicroVMService(mockCtrl)
-			ns := mock.NewMockNetworkService(mockCtrl)
-			is := mock.NewMockImageService(mockCtrl)
-			fs := afero.NewMemMapFs()
+			hs := mock.NewMockHostService(mockCtrl)
+			qr := mock.NewMockQuotaRepository(mockCtrl)
 			ports := &ports.Collection{
-				Repo: rm,
+				Repo:         rm,
+				SnapshotRepo: sm,
 				MicrovmProviders: map[string]ports.MicroVMService{
 					"mock": pm,
 				},
 				EventService:      em,
 				IdentifierService: im,
-				NetworkService:    ns,
-				ImageService:      is,
-				FileSystem:        fs,
+				FileSystem:        afero.NewMemMapFs(),
 				Clock:             frozenTime,
+				HostService:       hs,
+				QuotaRepo:         qr,
 			}
 
-			tc.expect(rm.EXPECT(), em.EXPECT(), im.EXPECT(), pm.EXPECT())
+			restoreFrom := snapshot
+			if tc.snapshot != nil {
+				restoreFrom = tc.snapshot
+			}
+
+			sm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("snap1234")).Return(restoreFrom, nil)
+			hs.EXPECT().Resources(gomock.Any()).Return(&models.HostResources{
+				VCPU:       16,
+				MemoryInMb: 32768,
+				DiskInMb:   500000,
+			}, nil).AnyTimes()
+			rm.EXPECT().GetAll(gomock.Any(), gomock.Eq(models.ListMicroVMQuery{})).Return(tc.existing, nil).AnyTimes()
+			expectNoQuota(qr.EXPECT())
+			pm.EXPECT().Capabilities().Return(models.Capabilities{
+				models.MetadataServiceCapability,
+				models.MacvtapCapability,
+			}).AnyTimes()
+			im.EXPECT().GenerateRandom().Return("newuid", nil).AnyTimes()
+			rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			if !tc.expectError {
+				rm.EXPECT().Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).DoAndReturn(
+					func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+						return mvm, nil
+					},
+				)
+				em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			vmid, _ := models.NewVMID("clone", tc.namespace, "")
+			spec := &models.MicroVM{
+				ID: *vmid,
+				Spec: models.MicroVMSpec{
+					Provider:            tc.provider,
+					VCPU:                2,
+					MemoryInMb:          2048,
+					Metadata:            map[string]string{},
+					RestoreFromSnapshot: "snap1234",
+				},
+			}
 
-			ctx := context.Background()
 			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
-			err := app.DeleteMicroVM(ctx, tc.toDeleteUID)
+			created, err := app.CreateMicroVM(context.Background(), spec)
 
 			if tc.expectError {
 				Expect(err).To(HaveOccurred())
-			} else {
-				Expect(err).NotTo(HaveOccurred())
+
+				return
 			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Spec.RestoreFromSnapshot).To(Equal("snap1234"))
+			Expect(created.Spec.VCPU).To(Equal(source.Spec.VCPU))
+			Expect(created.Spec.MemoryInMb).To(Equal(source.Spec.MemoryInMb))
+			Expect(created.Spec.Kernel).To(Equal(source.Spec.Kernel))
+			Expect(created.Spec.RootVolume).To(Equal(source.Spec.RootVolume))
+			Expect(created.Spec.NetworkInterfaces).To(Equal(restoreFrom.Source.NetworkInterfaces))
+			Expect(created.Status.State).To(Equal(models.MicroVMState(models.PendingState)))
 		})
 	}
 }
@@ -494,7 +1636,7 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
 				rm.GetAll(
 					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(models.ListMicroVMQuery{"namespace": ""}),
+					gomock.Eq(models.ListMicroVMQuery{Namespace: ""}),
 				).Return(
 					nil,
 					nil,
@@ -510,7 +1652,7 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
 				rm.GetAll(
 					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(models.ListMicroVMQuery{"namespace": "default"}),
+					gomock.Eq(models.ListMicroVMQuery{Namespace: "default"}),
 				).Return(
 					nil,
 					errors.New("a random error occurred"),
@@ -526,7 +1668,7 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
 				rm.GetAll(
 					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(models.ListMicroVMQuery{"namespace": "default"}),
+					gomock.Eq(models.ListMicroVMQuery{Namespace: "default"}),
 				).Return(
 					nil,
 					nil,
@@ -542,7 +1684,7 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, em *mock.MockEventServiceMockRecorder, im *mock.MockIDServiceMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
 				rm.GetAll(
 					gomock.AssignableToTypeOf(context.Background()),
-					gomock.Eq(models.ListMicroVMQuery{"namespace": "default"}),
+					gomock.Eq(models.ListMicroVMQuery{Namespace: "default"}),
 				).Return(
 					[]*models.MicroVM{
 						createTestSpec("id1234", "default", testUID),
@@ -562,8 +1704,8 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 				rm.GetAll(
 					gomock.AssignableToTypeOf(context.Background()),
 					gomock.Eq(models.ListMicroVMQuery{
-						"namespace": "default",
-						"name":      "id1234",
+						Namespace: "default",
+						Name:      "id1234",
 					}),
 				).Return(
 					[]*models.MicroVM{
@@ -607,10 +1749,10 @@ func TestApp_GetAllMicroVM(t *testing.T) {
 
 			ctx := context.Background()
 			app := application.New(&application.Config{DefaultProvider: "mock"}, ports)
-			query := models.ListMicroVMQuery{"namespace": tc.toGetNS}
+			query := models.ListMicroVMQuery{Namespace: tc.toGetNS}
 
 			if tc.toGetName != nil {
-				query["name"] = *tc.toGetName
+				query.Name = *tc.toGetName
 			}
 
 			mvms, err := app.GetAllMicroVM(ctx, query)
@@ -643,6 +1785,28 @@ func createTestSpecWithGuestAgent(name, ns, uid string) *models.MicroVM {
 	return spec
 }
 
+func testRateLimits() *models.NetworkRateLimits {
+	limit := &models.RateLimit{
+		Bandwidth: &models.TokenBucket{Size: 1048576, RefillTimeMs: 100},
+	}
+
+	return &models.NetworkRateLimits{Rx: limit, Tx: limit}
+}
+
+func createTestSpecWithNetworkQueues(name, ns, uid string) *models.MicroVM {
+	spec := createTestSpecWithMetadata(name, ns, uid, map[string]string{})
+	spec.Spec.NetworkInterfaces[0].NumQueues = 4
+
+	return spec
+}
+
+func createTestSpecWithCacheMode(name, ns, uid string, cacheMode models.VolumeCacheMode) *models.MicroVM {
+	spec := createTestSpecWithMetadata(name, ns, uid, map[string]string{})
+	spec.Spec.RootVolume.CacheMode = cacheMode
+
+	return spec
+}
+
 func createTestSpecWithMetadata(name, ns, uid string, metadata map[string]string) *models.MicroVM {
 	var vmid *models.VMID
 
@@ -696,6 +1860,21 @@ func createTestSpecWithMetadata(name, ns, uid string, metadata map[string]string
 	}
 }
 
+// expectEmptyHost sets up a host with enough capacity for the test specs and no
+// microvms allocated.
+func expectEmptyHost(hs *mock.MockHostServiceMockRecorder, rm *mock.MockMicroVMRepositoryMockRecorder) {
+	hs.Resources(gomock.Any()).Return(&models.HostResources{
+		VCPU:       16,
+		MemoryInMb: 32768,
+		DiskInMb:   500000,
+	}, nil).AnyTimes()
+	rm.GetAll(gomock.Any(), gomock.Eq(models.ListMicroVMQuery{})).Return(nil, nil).AnyTimes()
+}
+
+func expectNoQuota(qr *mock.MockQuotaRepositoryMockRecorder) {
+	qr.Get(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+}
+
 func createInstanceMetadatadata(t *testing.T, instanceID string) map[string]string {
 	RegisterTestingT(t)
 
@@ -0,0 +1,93 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+func (a *app) GetHostCapacity(ctx context.Context) (*models.HostCapacity, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Trace("querying host capacity")
+
+	capacity, _, err := a.hostCapacity(ctx)
+
+	return capacity, err
+}
+
+// hostCapacity returns the capacity of the host along with the host resources it's based on.
+func (a *app) hostCapacity(ctx context.Context) (*models.HostCapacity, *models.HostResources, error) {
+	hostResources, err := a.ports.HostService.Resources(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting host resources: %w", err)
+	}
+
+	available := hostResources.Sub(a.cfg.ReservedResources)
+	total := models.HostResources{
+		VCPU:       overcommit(available.VCPU, a.cfg.CPUOvercommitRatio),
+		MemoryInMb: overcommit(available.MemoryInMb, a.cfg.MemoryOvercommitRatio),
+		DiskInMb:   overcommit(available.DiskInMb, a.cfg.DiskOvercommitRatio),
+	}
+
+	// Every microvm holds on to its resources until it's deleted, even if it's
+	// stopped, so that it can always be started again.
+	mvms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing microvms: %w", err)
+	}
+
+	allocated := models.HostResources{}
+	for _, mvm := range mvms {
+		allocated = allocated.Add(mvm.Spec.Resources())
+	}
+
+	return &models.HostCapacity{
+		Total:     total,
+		Allocated: allocated,
+		Free:      total.Sub(allocated),
+	}, hostResources, nil
+}
+
+// checkCapacity returns an error if the host doesn't have enough free capacity for a
+// microvm to go from using the current resources to the required resources. Callers
+// must hold admissionMu until the change is saved.
+func (a *app) checkCapacity(ctx context.Context, current, required models.HostResources) error {
+	capacity, hostResources, err := a.hostCapacity(ctx)
+	if err != nil {
+		return fmt.Errorf("checking host capacity: %w", err)
+	}
+
+	increase := required.Sub(current)
+
+	if increase.VCPU > 0 && increase.VCPU > capacity.Free.VCPU {
+		return coreerrs.NewInsufficientCapacity("vcpu", increase.VCPU, capacity.Free.VCPU)
+	}
+
+	if increase.MemoryInMb > 0 && increase.MemoryInMb > capacity.Free.MemoryInMb {
+		return coreerrs.NewInsufficientCapacity("memory (MB)", increase.MemoryInMb, capacity.Free.MemoryInMb)
+	}
+
+	// The disk isn't checked if the host doesn't know its size.
+	if hostResources.DiskInMb > 0 && increase.DiskInMb > 0 && increase.DiskInMb > capacity.Free.DiskInMb {
+		return coreerrs.NewInsufficientCapacity("disk (MB)", increase.DiskInMb, capacity.Free.DiskInMb)
+	}
+
+	return nil
+}
+
+// overcommit applies an overcommit ratio to an amount of a resource. A ratio that
+// isn't positive means no overcommit.
+func overcommit(amount int64, ratio float64) int64 {
+	if amount <= 0 {
+		return 0
+	}
+
+	if ratio <= 0 {
+		return amount
+	}
+
+	return int64(float64(amount) * ratio)
+}
@@ -0,0 +1,218 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApp_GetHostCapacity(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	hs := mock.NewMockHostService(mockCtrl)
+
+	hs.EXPECT().Resources(gomock.Any()).Return(&models.HostResources{
+		VCPU:       8,
+		MemoryInMb: 16384,
+		DiskInMb:   100000,
+	}, nil)
+
+	withVolume := createTestSpec("vm2", "ns2", testUID)
+	withVolume.Spec.AdditionalVolumes = models.Volumes{{ID: "data", Size: 5000}, {ID: "nosize"}}
+
+	rm.EXPECT().GetAll(gomock.Any(), gomock.Eq(models.ListMicroVMQuery{})).Return([]*models.MicroVM{
+		createTestSpec("vm1", "ns1", testUID),
+		withVolume,
+	}, nil)
+
+	app := application.New(&application.Config{
+		ReservedResources: models.HostResources{
+			VCPU:       2,
+			MemoryInMb: 4096,
+			DiskInMb:   10000,
+		},
+		CPUOvercommitRatio:    2,
+		MemoryOvercommitRatio: 1.5,
+	}, &ports.Collection{
+		Repo:        rm,
+		HostService: hs,
+	})
+
+	capacity, err := app.GetHostCapacity(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(capacity.Total).To(Equal(models.HostResources{VCPU: 12, MemoryInMb: 18432, DiskInMb: 90000}))
+	Expect(capacity.Allocated).To(Equal(models.HostResources{VCPU: 4, MemoryInMb: 4096, DiskInMb: 45000}))
+	Expect(capacity.Free).To(Equal(models.HostResources{VCPU: 8, MemoryInMb: 14336, DiskInMb: 45000}))
+}
+
+func TestApp_CreateMicroVM_Capacity(t *testing.T) {
+	testCases := []struct {
+		name        string
+		host        models.HostResources
+		cfg         application.Config
+		expectError bool
+	}{
+		{
+			name: "enough capacity, should create",
+			host: models.HostResources{VCPU: 4, MemoryInMb: 8192, DiskInMb: 50000},
+		},
+		{
+			name:        "not enough vcpu, should fail",
+			host:        models.HostResources{VCPU: 3, MemoryInMb: 8192, DiskInMb: 50000},
+			expectError: true,
+		},
+		{
+			name:        "not enough memory, should fail",
+			host:        models.HostResources{VCPU: 4, MemoryInMb: 3072, DiskInMb: 50000},
+			expectError: true,
+		},
+		{
+			name:        "not enough disk, should fail",
+			host:        models.HostResources{VCPU: 4, MemoryInMb: 8192, DiskInMb: 30000},
+			expectError: true,
+		},
+		{
+			name: "disk size not known, should create",
+			host: models.HostResources{VCPU: 4, MemoryInMb: 8192},
+		},
+		{
+			name: "reserved resources leave no space, should fail",
+			host: models.HostResources{VCPU: 4, MemoryInMb: 8192, DiskInMb: 50000},
+			cfg: application.Config{
+				ReservedResources: models.HostResources{MemoryInMb: 6144},
+			},
+			expectError: true,
+		},
+		{
+			name: "overcommit makes space, should create",
+			host: models.HostResources{VCPU: 2, MemoryInMb: 8192, DiskInMb: 50000},
+			cfg: application.Config{
+				CPUOvercommitRatio: 2,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			hs := mock.NewMockHostService(mockCtrl)
+			qr := mock.NewMockQuotaRepository(mockCtrl)
+
+			pm.EXPECT().Capabilities().Return(models.Capabilities{models.MacvtapCapability}).AnyTimes()
+			im.EXPECT().GenerateRandom().Return(testUID, nil)
+			rm.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			hs.EXPECT().Resources(gomock.Any()).Return(&tc.host, nil)
+			expectNoQuota(qr.EXPECT())
+
+			// An existing microvm with 2 vcpu, 2048MB memory and 20000MB disk.
+			rm.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]*models.MicroVM{
+				createTestSpec("existing", "default", testUID),
+			}, nil)
+
+			if !tc.expectError {
+				rm.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+						return mvm, nil
+					},
+				)
+				em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			cfg := tc.cfg
+			cfg.DefaultProvider = "mock"
+
+			app := application.New(&cfg, &ports.Collection{
+				Repo:              rm,
+				MicrovmProviders:  map[string]ports.MicroVMService{"mock": pm},
+				EventService:      em,
+				IdentifierService: im,
+				HostService:       hs,
+				QuotaRepo:         qr,
+				FileSystem:        afero.NewMemMapFs(),
+				Clock:             time.Now,
+			})
+
+			_, err := app.CreateMicroVM(context.Background(), createTestSpec("id1234", "default", testUID))
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+				Expect(coreerrs.IsInsufficientCapacity(err)).To(BeTrue())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestApp_UpdateMicroVM_Capacity(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	hs := mock.NewMockHostService(mockCtrl)
+	qr := mock.NewMockQuotaRepository(mockCtrl)
+
+	existing := createTestSpec("id1234", "default", testUID)
+	existing.Spec.Provider = "mock"
+
+	pm.EXPECT().Capabilities().Return(models.Capabilities{models.MacvtapCapability}).AnyTimes()
+	rm.EXPECT().Get(gomock.Any(), gomock.Any()).Return(existing, nil)
+	rm.EXPECT().GetAll(gomock.Any(), gomock.Any()).Return([]*models.MicroVM{existing}, nil)
+	expectNoQuota(qr.EXPECT())
+	hs.EXPECT().Resources(gomock.Any()).Return(&models.HostResources{
+		VCPU:       4,
+		MemoryInMb: 8192,
+		DiskInMb:   25000,
+	}, nil)
+
+	app := application.New(&application.Config{DefaultProvider: "mock"}, &ports.Collection{
+		Repo:             rm,
+		MicrovmProviders: map[string]ports.MicroVMService{"mock": pm},
+		HostService:      hs,
+		QuotaRepo:        qr,
+		FileSystem:       afero.NewMemMapFs(),
+		Clock:            time.Now,
+	})
+
+	updated := createTestSpec("id1234", "default", testUID)
+	updated.Spec.AdditionalVolumes = models.Volumes{
+		{
+			ID:   "data",
+			Size: 10000,
+			Source: models.VolumeSource{
+				Container: &models.ContainerVolumeSource{Image: "docker.io/library/data:latest"},
+			},
+		},
+	}
+
+	_, err := app.UpdateMicroVM(context.Background(), testUID, updated)
+	Expect(err).To(HaveOccurred())
+	Expect(coreerrs.IsInsufficientCapacity(err)).To(BeTrue())
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"path/filepath"
+	"strings"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/sirupsen/logrus"
 	"sigs.k8s.io/yaml"
 
@@ -15,12 +19,17 @@ import (
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/filecopy"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
 	"github.com/liquidmetal-dev/flintlock/pkg/validation"
 )
 
 const (
 	MetadataInterfaceName = "eth0"
+	MetadataInterfaceMAC  = "AA:FF:00:00:00:01"
+
+	// snapshotVolumePerm is the permissions of the copies of volumes in snapshots.
+	snapshotVolumePerm = 0o600
 )
 
 func (a *app) CreateMicroVM(ctx context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
@@ -31,6 +40,12 @@ func (a *app) CreateMicroVM(ctx context.Context, mvm *models.MicroVM) (*models.M
 		return nil, coreerrs.ErrSpecRequired
 	}
 
+	if mvm.Spec.RestoreFromSnapshot != "" {
+		if err := a.applySnapshot(ctx, mvm); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Trace("validating model")
 	validator := validation.NewValidator()
 	if validErr := validator.ValidateStruct(mvm); validErr != nil {
@@ -99,11 +114,32 @@ func (a *app) CreateMicroVM(ctx context.Context, mvm *models.MicroVM) (*models.M
 		a.addMetadataInterface(mvm)
 	}
 
+	a.admissionMu.Lock()
+	defer a.admissionMu.Unlock()
+
+	if err := a.checkQuota(ctx, mvm.ID.Namespace(), models.QuotaResources{}, mvm.Spec.QuotaResources()); err != nil {
+		return nil, err
+	}
+
+	if err := a.checkCapacity(ctx, models.HostResources{}, mvm.Spec.Resources()); err != nil {
+		return nil, err
+	}
+
+	if mvm.Spec.RestoreFromSnapshot != "" {
+		if err := a.checkMACsNotInUse(ctx, mvm); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the timestamp when the VMspec was created.
 	mvm.Spec.CreatedAt = a.ports.Clock().Unix()
 	mvm.Status.State = models.PendingState
 	mvm.Status.Retry = 0
 
+	if a.cfg.NetworkNamespaces {
+		mvm.Status.NetworkNamespace = defaults.NetworkNamespacePrefix + mvm.ID.UID()
+	}
+
 	createdMVM, err := a.ports.Repo.Save(ctx, mvm)
 	if err != nil {
 		return nil, fmt.Errorf("saving microvm spec: %w", err)
@@ -120,6 +156,79 @@ func (a *app) CreateMicroVM(ctx context.Context, mvm *models.MicroVM) (*models.M
 	return createdMVM, nil
 }
 
+// applySnapshot replaces the parts of the spec that are restored from a snapshot
+// with the values from the snapshotted microvm.
+func (a *app) applySnapshot(ctx context.Context, mvm *models.MicroVM) error {
+	snapshot, err := a.getSnapshot(ctx, mvm.Spec.RestoreFromSnapshot)
+	if err != nil {
+		return err
+	}
+
+	namespace := mvm.ID.Namespace()
+	if mvm.ID.IsEmpty() {
+		namespace = defaults.MicroVMNamespace
+	}
+
+	if snapshot.Namespace != namespace {
+		return snapshotNotFoundError{uid: snapshot.UID}
+	}
+
+	if mvm.Spec.Provider != "" && mvm.Spec.Provider != snapshot.Provider {
+		return errSnapshotProviderMismatch
+	}
+
+	source := snapshot.Source
+
+	// The guest expects its disks to be as they were when the snapshot was taken.
+	for _, vol := range snapshotVolumes(&source) {
+		if !snapshot.HasVolume(vol.ID) {
+			return errSnapshotVolumesNotCaptured
+		}
+	}
+
+	mvm.Spec.Provider = snapshot.Provider
+	mvm.Spec.Kernel = source.Kernel
+	mvm.Spec.Initrd = source.Initrd
+	mvm.Spec.VCPU = source.VCPU
+	mvm.Spec.MemoryInMb = source.MemoryInMb
+	mvm.Spec.RootVolume = source.RootVolume
+	mvm.Spec.AdditionalVolumes = source.AdditionalVolumes
+	mvm.Spec.NetworkInterfaces = source.NetworkInterfaces
+	mvm.Spec.AllowGuestAgent = source.AllowGuestAgent
+
+	return nil
+}
+
+// checkMACsNotInUse rejects a microvm restored from a snapshot while another microvm has
+// the same mac addresses, which the guest keeps from the snapshot. The mac address of the
+// metadata interface is the same for every microvm and is only seen by the host.
+func (a *app) checkMACsNotInUse(ctx context.Context, mvm *models.MicroVM) error {
+	mvms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{})
+	if err != nil {
+		return fmt.Errorf("getting microvms to check mac addresses: %w", err)
+	}
+
+	for _, existing := range mvms {
+		if existing.Spec.DeletedAt != 0 {
+			continue
+		}
+
+		for _, netInt := range mvm.Spec.NetworkInterfaces {
+			if netInt.GuestMAC == "" || strings.EqualFold(netInt.GuestMAC, MetadataInterfaceMAC) {
+				continue
+			}
+
+			for _, existingInt := range existing.Spec.NetworkInterfaces {
+				if strings.EqualFold(netInt.GuestMAC, existingInt.GuestMAC) {
+					return macInUseError{mac: netInt.GuestMAC, vmid: existing.ID.String()}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // checkProviderCapabilities rejects a spec that requests features the selected
 // provider does not support.
 func checkProviderCapabilities(mvm *models.MicroVM, provider ports.MicroVMService) error {
@@ -133,6 +242,32 @@ func checkProviderCapabilities(mvm *models.MicroVM, provider ports.MicroVMServic
 		}
 	}
 
+	for _, netInt := range mvm.Spec.NetworkInterfaces {
+		if netInt.NumQueues > 1 && !caps.Has(models.NetworkQueuesCapability) {
+			return errNetworkQueuesNotSupported
+		}
+
+		if netInt.Offloads != nil && !caps.Has(models.NetworkOffloadsCapability) {
+			return errNetworkOffloadsNotSupported
+		}
+
+		if netInt.RateLimits != nil && !caps.Has(models.NetworkRxTxRateLimitsCapability) &&
+			!cmp.Equal(netInt.RateLimits.Rx, netInt.RateLimits.Tx) {
+			return errRxTxRateLimitsNotSupported
+		}
+	}
+
+	volumes := append(models.Volumes{mvm.Spec.RootVolume}, mvm.Spec.AdditionalVolumes...)
+	for _, volume := range volumes {
+		if volume.CacheMode == models.VolumeCacheModeUnsafe && !caps.Has(models.VolumeCacheUnsafeCapability) {
+			return errUnsafeCacheNotSupported
+		}
+
+		if volume.CacheMode == models.VolumeCacheModeDirect && !caps.Has(models.VolumeCacheDirectCapability) {
+			return errDirectCacheNotSupported
+		}
+	}
+
 	if !caps.Has(models.VirtioFSCapability) {
 		for _, volume := range mvm.Spec.AdditionalVolumes {
 			if volume.Source.VirtioFS != nil {
@@ -148,6 +283,400 @@ func checkProviderCapabilities(mvm *models.MicroVM, provider ports.MicroVMServic
 	return nil
 }
 
+func (a *app) UpdateMicroVM(ctx context.Context, uid string, mvm *models.MicroVM) (*models.MicroVM, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("updating microvm")
+
+	if mvm == nil {
+		return nil, coreerrs.ErrSpecRequired
+	}
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if mvm.Spec.Provider == "" {
+		mvm.Spec.Provider = foundMvm.Spec.Provider
+	}
+
+	logger.Trace("validating model")
+	validator := validation.NewValidator()
+	if validErr := validator.ValidateStruct(mvm); validErr != nil {
+		return nil, fmt.Errorf("an error occurred when attempting to validate microvm spec: %w", validErr)
+	}
+
+	if mvm.ID.Name() != foundMvm.ID.Name() {
+		return nil, immutableFieldError{field: "name"}
+	}
+
+	if mvm.ID.Namespace() != foundMvm.ID.Namespace() {
+		return nil, immutableFieldError{field: "namespace"}
+	}
+
+	provider, ok := a.ports.MicrovmProviders[foundMvm.Spec.Provider]
+	if !ok {
+		return nil, fmt.Errorf("microvm provider %s isn't available", foundMvm.Spec.Provider)
+	}
+
+	mvm.ID = foundMvm.ID
+	logger = logger.WithField("vmid", mvm.ID)
+
+	if err := checkProviderCapabilities(mvm, provider); err != nil {
+		return nil, err
+	}
+
+	if mvm.Spec.Metadata == nil {
+		mvm.Spec.Metadata = map[string]string{}
+	}
+
+	err = a.addInstanceData(mvm, logger)
+	if err != nil {
+		return nil, fmt.Errorf("adding instance data: %w", err)
+	}
+	keepMetadataInterface(foundMvm, mvm)
+
+	if err := checkImmutableFields(foundMvm, mvm); err != nil {
+		return nil, err
+	}
+
+	a.admissionMu.Lock()
+	defer a.admissionMu.Unlock()
+
+	currentUsage := foundMvm.Spec.QuotaResources()
+	if err := a.checkQuota(ctx, foundMvm.ID.Namespace(), currentUsage, mvm.Spec.QuotaResources()); err != nil {
+		return nil, err
+	}
+
+	if err := a.checkCapacity(ctx, foundMvm.Spec.Resources(), mvm.Spec.Resources()); err != nil {
+		return nil, err
+	}
+
+	// Label changes don't affect the running microvm. Devices, metadata and the rate limits of
+	// network interfaces are only changed if the provider can change them while it's running.
+	if err := checkLiveUpdate(foundMvm, mvm, provider); err != nil {
+		return nil, err
+	}
+
+	// Only the fields that can be changed on an existing microvm are copied over, the
+	// reconciler will take care of the rest.
+	foundMvm.Spec.NetworkInterfaces = mvm.Spec.NetworkInterfaces
+	foundMvm.Spec.AdditionalVolumes = mvm.Spec.AdditionalVolumes
+	foundMvm.Spec.Metadata = mvm.Spec.Metadata
+	foundMvm.Spec.Labels = mvm.Spec.Labels
+	foundMvm.Spec.UpdatedAt = a.ports.Clock().Unix()
+
+	return a.saveMicroVMChange(ctx, foundMvm)
+}
+
+func (a *app) StopMicroVM(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("stopping microvm")
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	foundMvm.Spec.PowerState = models.PowerStateStopped
+	foundMvm.Status.RestartPending = false
+
+	_, err = a.saveMicroVMChange(ctx, foundMvm)
+
+	return err
+}
+
+func (a *app) StartMicroVM(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("starting microvm")
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	foundMvm.Spec.PowerState = models.PowerStateRunning
+
+	_, err = a.saveMicroVMChange(ctx, foundMvm)
+
+	return err
+}
+
+func (a *app) RestartMicroVM(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("restarting microvm")
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if foundMvm.Spec.IsStopped() {
+		return errMicroVMStopped
+	}
+
+	if foundMvm.Spec.IsPaused() {
+		return errMicroVMPaused
+	}
+
+	foundMvm.Status.RestartPending = true
+
+	_, err = a.saveMicroVMChange(ctx, foundMvm)
+
+	return err
+}
+
+func (a *app) PauseMicroVM(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("pausing microvm")
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	provider, ok := a.ports.MicrovmProviders[foundMvm.Spec.Provider]
+	if !ok {
+		return fmt.Errorf("microvm provider %s isn't available", foundMvm.Spec.Provider)
+	}
+
+	if !provider.Capabilities().Has(models.PauseCapability) {
+		return errPauseNotSupported
+	}
+
+	if foundMvm.Spec.IsStopped() {
+		return errMicroVMStopped
+	}
+
+	foundMvm.Spec.PowerState = models.PowerStatePaused
+
+	_, err = a.saveMicroVMChange(ctx, foundMvm)
+
+	return err
+}
+
+func (a *app) ResumeMicroVM(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("resuming microvm")
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if !foundMvm.Spec.IsPaused() {
+		return errMicroVMNotPaused
+	}
+
+	foundMvm.Spec.PowerState = models.PowerStateRunning
+
+	_, err = a.saveMicroVMChange(ctx, foundMvm)
+
+	return err
+}
+
+// getMicroVMForChange gets an existing microvm that is going to be changed.
+func (a *app) getMicroVMForChange(ctx context.Context, uid string) (*models.MicroVM, error) {
+	if uid == "" {
+		return nil, errUIDRequired
+	}
+
+	foundMvm, err := a.ports.Repo.Get(ctx, ports.RepositoryGetOptions{
+		UID: uid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking to see if spec exists: %w", err)
+	}
+
+	if foundMvm == nil {
+		return nil, specNotFoundError{
+			uid: uid,
+		}
+	}
+
+	if foundMvm.Spec.DeletedAt != 0 {
+		return nil, errMicroVMDeleting
+	}
+
+	return foundMvm, nil
+}
+
+// saveMicroVMChange saves a changed microvm and lets the reconciler know about it.
+func (a *app) saveMicroVMChange(ctx context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+	mvm.Status.Retry = 0
+	mvm.Status.NotBefore = 0
+
+	savedMvm, err := a.ports.Repo.Save(ctx, mvm)
+	if err != nil {
+		return nil, fmt.Errorf("saving microvm spec: %w", err)
+	}
+
+	if err := a.ports.EventService.Publish(ctx, defaults.TopicMicroVMEvents, &events.MicroVMSpecUpdated{
+		ID:        mvm.ID.Name(),
+		Namespace: mvm.ID.Namespace(),
+		UID:       mvm.ID.UID(),
+	}); err != nil {
+		return nil, fmt.Errorf("publishing microvm updated event: %w", err)
+	}
+
+	return savedMvm, nil
+}
+
+// checkImmutableFields rejects an update that changes a part of the spec that
+// can't be changed without recreating the microvm. Only additional volumes,
+// network interfaces and metadata can be added or removed.
+func checkImmutableFields(existing, updated *models.MicroVM) error {
+	opts := cmpopts.EquateEmpty()
+
+	if updated.Spec.Provider != existing.Spec.Provider {
+		return immutableFieldError{field: "provider"}
+	}
+
+	if !cmp.Equal(existing.Spec.Kernel, updated.Spec.Kernel, opts) {
+		return immutableFieldError{field: "kernel"}
+	}
+
+	if !cmp.Equal(existing.Spec.Initrd, updated.Spec.Initrd, opts) {
+		return immutableFieldError{field: "initrd"}
+	}
+
+	if existing.Spec.VCPU != updated.Spec.VCPU {
+		return immutableFieldError{field: "vcpu"}
+	}
+
+	if existing.Spec.MemoryInMb != updated.Spec.MemoryInMb {
+		return immutableFieldError{field: "memory"}
+	}
+
+	if !cmp.Equal(existing.Spec.RootVolume, updated.Spec.RootVolume, opts) {
+		return immutableFieldError{field: "root volume"}
+	}
+
+	if existing.Spec.AllowGuestAgent != updated.Spec.AllowGuestAgent {
+		return immutableFieldError{field: "allow guest agent"}
+	}
+
+	// The rate limits of a network interface can be changed, the rest of the interface can't.
+	netIntOpts := cmp.Options{opts, cmpopts.IgnoreFields(models.NetworkInterface{}, "RateLimits")}
+
+	for _, netInt := range updated.Spec.NetworkInterfaces {
+		for _, existingNetInt := range existing.Spec.NetworkInterfaces {
+			if netInt.GuestDeviceName == existingNetInt.GuestDeviceName &&
+				!cmp.Equal(netInt, existingNetInt, netIntOpts) {
+				return immutableFieldError{field: "network interface " + netInt.GuestDeviceName}
+			}
+		}
+	}
+
+	for _, vol := range updated.Spec.AdditionalVolumes {
+		existingVol := existing.Spec.AdditionalVolumes.GetByID(vol.ID)
+		if existingVol != nil && !cmp.Equal(vol, *existingVol, opts) {
+			return immutableFieldError{field: "volume " + vol.ID}
+		}
+	}
+
+	return nil
+}
+
+// checkLiveUpdate checks the provider can apply the changes of an update to the running
+// microvm and marks the changes as pending.
+func checkLiveUpdate(existing, updated *models.MicroVM, provider ports.MicroVMService) error {
+	capabilities := provider.Capabilities()
+
+	if devicesChanged(existing, updated) {
+		if !capabilities.Has(models.HotplugCapability) {
+			return errHotplugNotSupported
+		}
+
+		if virtioFSChanged(existing, updated) {
+			return errVirtioFSHotplugNotSupported
+		}
+
+		existing.Status.DeviceUpdatePending = true
+	}
+
+	if !cmp.Equal(existing.Spec.Metadata, updated.Spec.Metadata, cmpopts.EquateEmpty()) {
+		if !capabilities.Has(models.LiveMetadataUpdateCapability) {
+			return errLiveMetadataNotSupported
+		}
+
+		existing.Status.MetadataUpdatePending = true
+	}
+
+	if rateLimitsChanged(existing, updated) {
+		if !capabilities.Has(models.LiveNetworkRateLimitUpdateCapability) {
+			return errLiveRateLimitsNotSupported
+		}
+
+		existing.Status.RateLimitUpdatePending = true
+	}
+
+	return nil
+}
+
+// devicesChanged returns true if network interfaces or volumes have been added or removed
+// by the update.
+func devicesChanged(existing, updated *models.MicroVM) bool {
+	if len(existing.Spec.NetworkInterfaces) != len(updated.Spec.NetworkInterfaces) ||
+		len(existing.Spec.AdditionalVolumes) != len(updated.Spec.AdditionalVolumes) {
+		return true
+	}
+
+	for _, netInt := range updated.Spec.NetworkInterfaces {
+		if findNetworkInterface(existing, netInt.GuestDeviceName) == nil {
+			return true
+		}
+	}
+
+	for _, vol := range updated.Spec.AdditionalVolumes {
+		if existing.Spec.AdditionalVolumes.GetByID(vol.ID) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// virtioFSChanged returns true if a virtiofs volume has been added or removed by the update.
+func virtioFSChanged(existing, updated *models.MicroVM) bool {
+	for _, vol := range updated.Spec.AdditionalVolumes {
+		if vol.Source.VirtioFS != nil && existing.Spec.AdditionalVolumes.GetByID(vol.ID) == nil {
+			return true
+		}
+	}
+
+	for _, vol := range existing.Spec.AdditionalVolumes {
+		if vol.Source.VirtioFS != nil && updated.Spec.AdditionalVolumes.GetByID(vol.ID) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rateLimitsChanged returns true if the rate limits of a network interface the microvm
+// already has have been changed by the update.
+func rateLimitsChanged(existing, updated *models.MicroVM) bool {
+	for _, netInt := range updated.Spec.NetworkInterfaces {
+		existingNetInt := findNetworkInterface(existing, netInt.GuestDeviceName)
+		if existingNetInt != nil && !cmp.Equal(existingNetInt.RateLimits, netInt.RateLimits, cmpopts.EquateEmpty()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findNetworkInterface(vm *models.MicroVM, name string) *models.NetworkInterface {
+	for i := range vm.Spec.NetworkInterfaces {
+		if vm.Spec.NetworkInterfaces[i].GuestDeviceName == name {
+			return &vm.Spec.NetworkInterfaces[i]
+		}
+	}
+
+	return nil
+}
+
 func (a *app) DeleteMicroVM(ctx context.Context, uid string) error {
 	logger := log.GetLogger(ctx).WithField("component", "app")
 	logger.Trace("deleting microvm")
@@ -228,6 +757,34 @@ func (a *app) addInstanceData(vm *models.MicroVM, logger *logrus.Entry) error {
 	return nil
 }
 
+// keepMetadataInterface carries the metadata interface that was added when the
+// microvm was created over to the updated spec.
+func keepMetadataInterface(existing, mvm *models.MicroVM) {
+	var metadataInt *models.NetworkInterface
+
+	for i := range existing.Spec.NetworkInterfaces {
+		if existing.Spec.NetworkInterfaces[i].AllowMetadataRequests {
+			metadataInt = &existing.Spec.NetworkInterfaces[i]
+
+			break
+		}
+	}
+
+	if metadataInt == nil {
+		return
+	}
+
+	interfaces := []models.NetworkInterface{*metadataInt}
+
+	for _, netInt := range mvm.Spec.NetworkInterfaces {
+		if netInt.GuestDeviceName != metadataInt.GuestDeviceName {
+			interfaces = append(interfaces, netInt)
+		}
+	}
+
+	mvm.Spec.NetworkInterfaces = interfaces
+}
+
 func (a *app) addMetadataInterface(mvm *models.MicroVM) {
 	for i := range mvm.Spec.NetworkInterfaces {
 		netInt := mvm.Spec.NetworkInterfaces[i]
@@ -241,7 +798,7 @@ func (a *app) addMetadataInterface(mvm *models.MicroVM) {
 			GuestDeviceName:       MetadataInterfaceName,
 			Type:                  models.IfaceTypeTap,
 			AllowMetadataRequests: true,
-			GuestMAC:              "AA:FF:00:00:00:01",
+			GuestMAC:              MetadataInterfaceMAC,
 			StaticAddress: &models.StaticAddress{
 				Address: "169.254.0.1/16",
 			},
@@ -250,3 +807,187 @@ func (a *app) addMetadataInterface(mvm *models.MicroVM) {
 	interfaces = append(interfaces, mvm.Spec.NetworkInterfaces...)
 	mvm.Spec.NetworkInterfaces = interfaces
 }
+
+func (a *app) CreateSnapshot(ctx context.Context, uid string, name string) (*models.Snapshot, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("creating snapshot")
+
+	if name == "" {
+		return nil, errSnapshotNameRequired
+	}
+
+	// The microvm isn't reconciled while it's snapshotted, so it isn't changed or resumed
+	// part way through.
+	unlock := a.lockMicroVM(uid)
+	defer unlock()
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundMvm.Status.State != models.CreatedState && foundMvm.Status.State != models.PausedState {
+		return nil, errMicroVMNotRunning
+	}
+
+	provider, ok := a.ports.MicrovmProviders[foundMvm.Spec.Provider]
+	if !ok {
+		return nil, fmt.Errorf("microvm provider %s isn't available", foundMvm.Spec.Provider)
+	}
+
+	if !provider.Capabilities().Has(models.SnapshotCapability) {
+		return nil, errSnapshotNotSupported
+	}
+
+	snapshotUID, err := a.ports.IdentifierService.GenerateRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generating random ID for snapshot: %w", err)
+	}
+
+	snapshot := &models.Snapshot{
+		UID:        snapshotUID,
+		Name:       name,
+		Namespace:  foundMvm.ID.Namespace(),
+		MicroVMUID: foundMvm.ID.UID(),
+		Provider:   foundMvm.Spec.Provider,
+		Path:       filepath.Join(a.cfg.RootStateDir, "snapshots", snapshotUID),
+		Source:     foundMvm.Spec,
+		CreatedAt:  a.ports.Clock().Unix(),
+	}
+
+	if err := a.ports.FileSystem.MkdirAll(snapshot.Path, defaults.DataDirPerm); err != nil {
+		return nil, fmt.Errorf("creating snapshot directory %s: %w", snapshot.Path, err)
+	}
+
+	if err := a.snapshot(ctx, logger, provider, foundMvm, snapshot); err != nil {
+		if removeErr := a.ports.FileSystem.RemoveAll(snapshot.Path); removeErr != nil {
+			logger.Errorf("removing snapshot directory %s: %s", snapshot.Path, removeErr)
+		}
+
+		return nil, err
+	}
+
+	savedSnapshot, err := a.ports.SnapshotRepo.Save(ctx, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("saving snapshot: %w", err)
+	}
+
+	return savedSnapshot, nil
+}
+
+// snapshot writes the memory and device state of the microvm and copies of its volumes to
+// the snapshot directory. A running microvm is paused until it's done so the volumes match
+// the memory of the guest.
+func (a *app) snapshot(
+	ctx context.Context,
+	logger *logrus.Entry,
+	provider ports.MicroVMService,
+	mvm *models.MicroVM,
+	snapshot *models.Snapshot,
+) error {
+	id := mvm.ID.String()
+
+	state, err := provider.State(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state == ports.MicroVMStateRunning {
+		if err := provider.Pause(ctx, id); err != nil {
+			return fmt.Errorf("pausing microvm for snapshot: %w", err)
+		}
+
+		defer func() {
+			if resumeErr := provider.Resume(ctx, id); resumeErr != nil {
+				logger.Errorf("resuming microvm after snapshot: %s", resumeErr)
+			}
+		}()
+	}
+
+	if err := provider.Snapshot(ctx, id, snapshot.Path); err != nil {
+		return fmt.Errorf("snapshotting microvm: %w", err)
+	}
+
+	if err := a.ports.FileSystem.MkdirAll(snapshot.VolumePath(""), defaults.DataDirPerm); err != nil {
+		return fmt.Errorf("creating snapshot volumes directory: %w", err)
+	}
+
+	for _, vol := range snapshotVolumes(&mvm.Spec) {
+		status, ok := mvm.Status.Volumes[vol.ID]
+		if !ok || status.Mount.Type != models.MountTypeDev {
+			return fmt.Errorf("volume %s isn't mounted on the host", vol.ID)
+		}
+
+		err := filecopy.Copy(a.ports.FileSystem, status.Mount.Source, snapshot.VolumePath(vol.ID), snapshotVolumePerm)
+		if err != nil {
+			return fmt.Errorf("copying volume %s to snapshot: %w", vol.ID, err)
+		}
+
+		snapshot.Volumes = append(snapshot.Volumes, vol.ID)
+	}
+
+	return nil
+}
+
+// snapshotVolumes returns the volumes whose contents are copied into snapshots. Virtiofs
+// volumes are directories shared from the host so they're left as they are.
+func snapshotVolumes(spec *models.MicroVMSpec) []models.Volume {
+	volumes := []models.Volume{}
+
+	for _, vol := range append(models.Volumes{spec.RootVolume}, spec.AdditionalVolumes...) {
+		if vol.Source.Container != nil {
+			volumes = append(volumes, vol)
+		}
+	}
+
+	return volumes
+}
+
+func (a *app) DeleteSnapshot(ctx context.Context, uid string) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debug("deleting snapshot")
+
+	snapshot, err := a.getSnapshot(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	// Microvms restored from the snapshot have to be deleted first.
+	mvms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{})
+	if err != nil {
+		return fmt.Errorf("getting microvms using snapshot: %w", err)
+	}
+
+	for _, mvm := range mvms {
+		if mvm.Spec.RestoreFromSnapshot == snapshot.UID && mvm.Spec.DeletedAt == 0 {
+			return snapshotInUseError{uid: snapshot.UID, vmid: mvm.ID.String()}
+		}
+	}
+
+	if err := a.ports.FileSystem.RemoveAll(snapshot.Path); err != nil {
+		return fmt.Errorf("removing snapshot directory %s: %w", snapshot.Path, err)
+	}
+
+	if err := a.ports.SnapshotRepo.Delete(ctx, snapshot); err != nil {
+		return fmt.Errorf("deleting snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (a *app) getSnapshot(ctx context.Context, uid string) (*models.Snapshot, error) {
+	if uid == "" {
+		return nil, errUIDRequired
+	}
+
+	snapshot, err := a.ports.SnapshotRepo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("getting snapshot: %w", err)
+	}
+
+	if snapshot == nil {
+		return nil, snapshotNotFoundError{uid: uid}
+	}
+
+	return snapshot, nil
+}
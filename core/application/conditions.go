@@ -0,0 +1,129 @@
+package application
+
+import (
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+// recordStepError stores a reconciliation error against the microvm status.
+func (a *app) recordStepError(
+	spec *models.MicroVM,
+	step string,
+	condition models.ConditionType,
+	executionID string,
+	err error,
+) {
+	stepErr := models.StepError{
+		Step:        step,
+		Condition:   condition,
+		Message:     err.Error(),
+		ExecutionID: executionID,
+		OccurredAt:  a.ports.Clock().Unix(),
+	}
+
+	if spec.Status.StepErrors == nil {
+		spec.Status.StepErrors = map[string]models.StepError{}
+	}
+
+	spec.Status.StepErrors[step] = stepErr
+	spec.Status.LastError = &stepErr
+}
+
+// updateConditions sets the conditions of the microvm from its status. If the last
+// reconciliation failed the condition for the failed step is set to false with the error.
+func (a *app) updateConditions(spec *models.MicroVM) {
+	now := a.ports.Clock().Unix()
+	failed := spec.Status.LastError
+
+	condition := func(condType models.ConditionType, ok bool, trueReason, falseReason string) {
+		cond := models.Condition{
+			Type:               condType,
+			Status:             models.ConditionStatusTrue,
+			Reason:             trueReason,
+			LastTransitionTime: now,
+		}
+
+		switch {
+		case failed != nil && failed.Condition == condType:
+			cond.Status = models.ConditionStatusFalse
+			cond.Reason = "StepFailed"
+			cond.Message = failed.Message
+		case !ok:
+			cond.Status = models.ConditionStatusFalse
+			cond.Reason = falseReason
+		}
+
+		spec.Status.Conditions = spec.Status.Conditions.Set(cond)
+	}
+
+	condition(models.ConditionImagesReady, imagesMounted(spec), "Mounted", "NotMounted")
+	condition(models.ConditionNetworkReady, interfacesCreated(spec), "Created", "NotCreated")
+
+	switch spec.Status.State {
+	case models.CreatedState:
+		condition(models.ConditionVMMRunning, true, "Running", "")
+	case models.PausedState:
+		condition(models.ConditionVMMRunning, true, "Paused", "")
+	case models.StoppedState:
+		condition(models.ConditionVMMRunning, false, "", "Stopped")
+	case models.FailedState:
+		condition(models.ConditionVMMRunning, false, "", "Failed")
+	default:
+		condition(models.ConditionVMMRunning, false, "", "NotStarted")
+	}
+
+	// Only the guest agent can tell if the guest is up, and only if we wait for it.
+	if spec.Spec.AllowGuestAgent && a.cfg.GuestBootDeadline > 0 {
+		running := spec.Status.State == models.CreatedState || spec.Status.State == models.PausedState
+		heartbeat := spec.Status.GuestReadyAt != 0 && !spec.Status.GuestUnresponsive
+
+		noHeartbeat := "NoHeartbeat"
+		if spec.Status.GuestUnresponsive {
+			noHeartbeat = "HeartbeatStale"
+		}
+
+		condition(models.ConditionGuestReady, running && heartbeat, "HeartbeatReceived", noHeartbeat)
+	} else if spec.Status.Conditions.Get(models.ConditionGuestReady) == nil {
+		spec.Status.Conditions = spec.Status.Conditions.Set(models.Condition{
+			Type:               models.ConditionGuestReady,
+			Status:             models.ConditionStatusUnknown,
+			Reason:             "NotReported",
+			LastTransitionTime: now,
+		})
+	}
+}
+
+func imagesMounted(spec *models.MicroVM) bool {
+	if spec.Spec.Kernel.Image != "" && spec.Status.KernelMount == nil {
+		return false
+	}
+
+	if spec.Spec.Initrd != nil && spec.Spec.Initrd.Image != "" && spec.Status.InitrdMount == nil {
+		return false
+	}
+
+	volumes := append(models.Volumes{spec.Spec.RootVolume}, spec.Spec.AdditionalVolumes...)
+
+	for _, vol := range volumes {
+		if vol.Source.Container == nil {
+			continue
+		}
+
+		status, ok := spec.Status.Volumes[vol.ID]
+		if !ok || status.Mount.Source == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func interfacesCreated(spec *models.MicroVM) bool {
+	for _, iface := range spec.Spec.NetworkInterfaces {
+		status, ok := spec.Status.NetworkInterfaces[iface.GuestDeviceName]
+		if !ok || status.HostDeviceName == "" {
+			return false
+		}
+	}
+
+	return true
+}
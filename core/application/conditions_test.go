@@ -0,0 +1,108 @@
+package application
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+func TestUpdateConditions_FailedStep(t *testing.T) {
+	testCases := []struct {
+		name      string
+		condition models.ConditionType
+	}{
+		{name: "images", condition: models.ConditionImagesReady},
+		{name: "network", condition: models.ConditionNetworkReady},
+		{name: "vmm", condition: models.ConditionVMMRunning},
+		{name: "guest", condition: models.ConditionGuestReady},
+		{name: "no condition"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			a := &app{
+				cfg:   &Config{GuestBootDeadline: time.Minute},
+				ports: &ports.Collection{Clock: time.Now},
+			}
+
+			spec := &models.MicroVM{}
+			spec.Spec.AllowGuestAgent = true
+			spec.Status.State = models.CreatedState
+
+			a.recordStepError(spec, "test_step", tc.condition, "exec1", errors.New("step failed"))
+			a.updateConditions(spec)
+
+			for _, cond := range spec.Status.Conditions {
+				if cond.Type == tc.condition {
+					Expect(cond.Status).To(Equal(models.ConditionStatusFalse))
+					Expect(cond.Reason).To(Equal("StepFailed"))
+					Expect(cond.Message).To(Equal("step failed"))
+
+					continue
+				}
+
+				Expect(cond.Reason).NotTo(Equal("StepFailed"), string(cond.Type))
+			}
+		})
+	}
+}
+
+func TestUpdateConditions_GuestHeartbeat(t *testing.T) {
+	testCases := []struct {
+		name         string
+		readyAt      int64
+		unresponsive bool
+		expectStatus models.ConditionStatus
+		expectReason string
+	}{
+		{
+			name:         "no heartbeat yet",
+			expectStatus: models.ConditionStatusFalse,
+			expectReason: "NoHeartbeat",
+		},
+		{
+			name:         "heartbeat received",
+			readyAt:      1700000000,
+			expectStatus: models.ConditionStatusTrue,
+			expectReason: "HeartbeatReceived",
+		},
+		{
+			name:         "heartbeat missed since",
+			readyAt:      1700000000,
+			unresponsive: true,
+			expectStatus: models.ConditionStatusFalse,
+			expectReason: "HeartbeatStale",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			a := &app{
+				cfg:   &Config{GuestBootDeadline: time.Minute},
+				ports: &ports.Collection{Clock: time.Now},
+			}
+
+			spec := &models.MicroVM{}
+			spec.Spec.AllowGuestAgent = true
+			spec.Status.State = models.CreatedState
+			spec.Status.GuestReadyAt = tc.readyAt
+			spec.Status.GuestUnresponsive = tc.unresponsive
+
+			a.updateConditions(spec)
+
+			cond := spec.Status.Conditions.Get(models.ConditionGuestReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(tc.expectStatus))
+			Expect(cond.Reason).To(Equal(tc.expectReason))
+		})
+	}
+}
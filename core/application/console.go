@@ -0,0 +1,109 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	consoleReadSize = 32 * 1024
+	// attachConsoleTailLines is the number of lines of existing output shown when attaching
+	// to a console, so that things like a login prompt are visible straight away.
+	attachConsoleTailLines = 10
+)
+
+func (a *app) GetConsoleLog(
+	ctx context.Context,
+	uid string,
+	opts ports.ConsoleOutputOptions,
+	send func([]byte) error,
+) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Tracef("getting console log of microvm: %s", uid)
+
+	foundMvm, err := a.GetMicroVM(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	provider, err := a.consoleProvider(foundMvm)
+	if err != nil {
+		return err
+	}
+
+	output, err := provider.ConsoleOutput(ctx, foundMvm.ID.String(), opts)
+	if err != nil {
+		return fmt.Errorf("opening console output: %w", err)
+	}
+	defer output.Close()
+
+	buf := make([]byte, consoleReadSize)
+
+	for {
+		read, err := output.Read(buf)
+		if read > 0 {
+			if sendErr := send(buf[:read]); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading console output: %w", err)
+		}
+	}
+}
+
+func (a *app) AttachConsole(ctx context.Context, uid string) (io.ReadCloser, io.WriteCloser, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debugf("attaching to console of microvm: %s", uid)
+
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if foundMvm.Status.State != models.CreatedState && foundMvm.Status.State != models.PausedState {
+		return nil, nil, errMicroVMNotRunning
+	}
+
+	provider, err := a.consoleProvider(foundMvm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, err := provider.ConsoleInput(ctx, foundMvm.ID.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening console input: %w", err)
+	}
+
+	output, err := provider.ConsoleOutput(ctx, foundMvm.ID.String(), ports.ConsoleOutputOptions{
+		TailLines: attachConsoleTailLines,
+		Follow:    true,
+	})
+	if err != nil {
+		input.Close()
+
+		return nil, nil, fmt.Errorf("opening console output: %w", err)
+	}
+
+	return output, input, nil
+}
+
+func (a *app) consoleProvider(mvm *models.MicroVM) (ports.MicroVMService, error) {
+	provider, ok := a.ports.MicrovmProviders[mvm.Spec.Provider]
+	if !ok {
+		return nil, fmt.Errorf("microvm provider %s isn't available", mvm.Spec.Provider)
+	}
+
+	return provider, nil
+}
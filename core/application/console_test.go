@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApp_GetConsoleLog(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	collection := &ports.Collection{
+		Repo:             rm,
+		MicrovmProviders: map[string]ports.MicroVMService{"mock": pm},
+	}
+
+	spec := createTestSpec("id1234", "default", testUID)
+	spec.Spec.Provider = "mock"
+
+	opts := ports.ConsoleOutputOptions{TailLines: 5}
+
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: "missing"}).Return(nil, nil)
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+		Return(spec, nil).Times(2)
+	pm.EXPECT().ConsoleOutput(gomock.AssignableToTypeOf(context.Background()), spec.ID.String(), opts).
+		Return(io.NopCloser(bytes.NewBufferString("login: ")), nil)
+	pm.EXPECT().ConsoleOutput(gomock.AssignableToTypeOf(context.Background()), spec.ID.String(), opts).
+		Return(nil, errors.New("no output"))
+
+	app := application.New(&application.Config{}, collection)
+	noSend := func([]byte) error { return nil }
+
+	Expect(app.GetConsoleLog(context.Background(), "", opts, noSend)).NotTo(Succeed())
+	Expect(app.GetConsoleLog(context.Background(), "missing", opts, noSend)).NotTo(Succeed())
+
+	received := &bytes.Buffer{}
+	err := app.GetConsoleLog(context.Background(), testUID, opts, func(data []byte) error {
+		received.Write(data)
+
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(received.String()).To(Equal("login: "))
+
+	Expect(app.GetConsoleLog(context.Background(), testUID, opts, noSend)).NotTo(Succeed())
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestApp_AttachConsole(t *testing.T) {
+	existingSpec := func(state models.MicroVMState) *models.MicroVM {
+		spec := createTestSpec("id1234", "default", testUID)
+		spec.Spec.Provider = "mock"
+		spec.Status.State = state
+
+		return spec
+	}
+
+	attachOpts := ports.ConsoleOutputOptions{TailLines: 10, Follow: true}
+
+	testCases := []struct {
+		name        string
+		uid         string
+		expectError bool
+		expect      func(rm *mock.MockMicroVMRepositoryMockRecorder, pm *mock.MockMicroVMServiceMockRecorder)
+	}{
+		{
+			name:        "empty uid, should fail",
+			uid:         "",
+			expectError: true,
+			expect:      func(rm *mock.MockMicroVMRepositoryMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {},
+		},
+		{
+			name:        "microvm not running, should fail",
+			uid:         testUID,
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(existingSpec(models.StoppedState), nil)
+			},
+		},
+		{
+			name:        "console input unavailable, should fail",
+			uid:         testUID,
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(existingSpec(models.CreatedState), nil)
+				pm.ConsoleInput(gomock.AssignableToTypeOf(context.Background()), existingSpec(models.CreatedState).ID.String()).
+					Return(nil, errors.New("no such file"))
+			},
+		},
+		{
+			name:        "running microvm, should attach",
+			uid:         testUID,
+			expectError: false,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, pm *mock.MockMicroVMServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(existingSpec(models.CreatedState), nil)
+				pm.ConsoleInput(gomock.AssignableToTypeOf(context.Background()), existingSpec(models.CreatedState).ID.String()).
+					Return(nopWriteCloser{&bytes.Buffer{}}, nil)
+				pm.ConsoleOutput(gomock.AssignableToTypeOf(context.Background()), existingSpec(models.CreatedState).ID.String(), attachOpts).
+					Return(io.NopCloser(&bytes.Buffer{}), nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+
+			tc.expect(rm.EXPECT(), pm.EXPECT())
+
+			app := application.New(&application.Config{}, &ports.Collection{
+				Repo:             rm,
+				MicrovmProviders: map[string]ports.MicroVMService{"mock": pm},
+			})
+
+			output, input, err := app.AttachConsole(context.Background(), tc.uid)
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).NotTo(BeNil())
+			Expect(input).NotTo(BeNil())
+		})
+	}
+}
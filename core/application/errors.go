@@ -8,10 +8,35 @@ import (
 )
 
 var (
-	errUIDRequired            = errors.New("uid is required")
-	errMacvtapNotSupported    = errors.New("macvtap network interfaces not supported by the microvm provider")
-	errVirtioFSNotSupported   = errors.New("virtiofs not supported by the microvm provider")
-	errGuestAgentNotSupported = errors.New("guest agent (vsock) not supported by the microvm provider")
+	errUIDRequired                 = errors.New("uid is required")
+	errMacvtapNotSupported         = errors.New("macvtap network interfaces not supported by the microvm provider")
+	errVirtioFSNotSupported        = errors.New("virtiofs not supported by the microvm provider")
+	errGuestAgentNotSupported      = errors.New("guest agent (vsock) not supported by the microvm provider")
+	errNetworkQueuesNotSupported   = errors.New("multiple network interface queues not supported by the microvm provider")
+	errNetworkOffloadsNotSupported = errors.New("network interface offloads not supported by the microvm provider")
+	errRxTxRateLimitsNotSupported  = errors.New("separate rx and tx rate limits not supported by the microvm provider")
+	errUnsafeCacheNotSupported     = errors.New("unsafe volume cache mode not supported by the microvm provider")
+	errDirectCacheNotSupported     = errors.New("direct volume cache mode not supported by the microvm provider")
+	errMicroVMDeleting             = errors.New("microvm is being deleted and can't be changed")
+	errMicroVMStopped              = errors.New("microvm is stopped, start it instead")
+	errMicroVMPaused               = errors.New("microvm is paused, resume it instead")
+	errMicroVMNotPaused            = errors.New("microvm isn't paused")
+	errPauseNotSupported           = errors.New("pausing not supported by the microvm provider")
+	errMicroVMNotRunning           = errors.New("microvm isn't running or paused")
+	errSnapshotNotSupported        = errors.New("snapshots not supported by the microvm provider")
+	errSnapshotNameRequired        = errors.New("snapshot name is required")
+	errSnapshotProviderMismatch    = errors.New("microvm provider must be the provider that created the snapshot")
+	errSnapshotVolumesNotCaptured  = errors.New("snapshot doesn't include the contents of the volumes of the microvm")
+	errGuestAgentNotEnabled        = errors.New("guest agent isn't enabled for the microvm")
+	errMicroVMNotStarted           = errors.New("microvm isn't running")
+	errCommandRequired             = errors.New("command is required")
+	errPathRequired                = errors.New("path is required")
+	errHotplugNotSupported         = errors.New(
+		"adding or removing network interfaces and volumes not supported by the microvm provider")
+	errVirtioFSHotplugNotSupported = errors.New("virtiofs volumes can't be added to or removed from a microvm")
+	errLiveMetadataNotSupported    = errors.New("changing the metadata of a microvm not supported by the microvm provider")
+	errLiveRateLimitsNotSupported  = errors.New(
+		"changing network interface rate limits of a microvm not supported by the microvm provider")
 )
 
 type specAlreadyExistsError struct {
@@ -40,6 +65,44 @@ func (e specNotFoundError) Error() string {
 	return fmt.Sprintf("microvm spec %s not found", e.uid)
 }
 
+type snapshotNotFoundError struct {
+	uid string
+}
+
+// Error returns the error message.
+func (e snapshotNotFoundError) Error() string {
+	return fmt.Sprintf("snapshot %s not found", e.uid)
+}
+
+type snapshotInUseError struct {
+	uid  string
+	vmid string
+}
+
+// Error returns the error message.
+func (e snapshotInUseError) Error() string {
+	return fmt.Sprintf("snapshot %s is used by microvm %s", e.uid, e.vmid)
+}
+
+type macInUseError struct {
+	mac  string
+	vmid string
+}
+
+// Error returns the error message.
+func (e macInUseError) Error() string {
+	return fmt.Sprintf("mac address %s is used by microvm %s", e.mac, e.vmid)
+}
+
+type immutableFieldError struct {
+	field string
+}
+
+// Error returns the error message.
+func (e immutableFieldError) Error() string {
+	return fmt.Sprintf("%s can't be changed on an existing microvm", e.field)
+}
+
 type reachedMaximumRetryError struct {
 	vmid    models.VMID
 	retries int
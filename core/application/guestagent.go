@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+func (a *app) ExecInMicroVM(ctx context.Context, uid string, input ports.GuestExecInput) (int, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debugf("running command in microvm: %s", uid)
+
+	if len(input.Command) == 0 {
+		return 0, errCommandRequired
+	}
+
+	vsockPath, err := a.guestAgentSocket(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+
+	exitCode, err := a.ports.GuestAgentService.Exec(ctx, vsockPath, input)
+	if err != nil {
+		return 0, fmt.Errorf("running command in microvm %s: %w", uid, err)
+	}
+
+	return exitCode, nil
+}
+
+func (a *app) CopyToMicroVM(ctx context.Context, uid string, input ports.GuestCopyToInput) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debugf("copying %s to microvm: %s", input.Path, uid)
+
+	if input.Path == "" {
+		return errPathRequired
+	}
+
+	vsockPath, err := a.guestAgentSocket(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := a.ports.GuestAgentService.CopyTo(ctx, vsockPath, input); err != nil {
+		return fmt.Errorf("copying %s to microvm %s: %w", input.Path, uid, err)
+	}
+
+	return nil
+}
+
+func (a *app) CopyFromMicroVM(ctx context.Context, uid string, path string, dest io.Writer) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debugf("copying %s from microvm: %s", path, uid)
+
+	if path == "" {
+		return errPathRequired
+	}
+
+	vsockPath, err := a.guestAgentSocket(ctx, uid)
+	if err != nil {
+		return err
+	}
+
+	if err := a.ports.GuestAgentService.CopyFrom(ctx, vsockPath, path, dest); err != nil {
+		return fmt.Errorf("copying %s from microvm %s: %w", path, uid, err)
+	}
+
+	return nil
+}
+
+// guestAgentSocket returns the vsock socket for talking to the guest agent of a running microvm.
+func (a *app) guestAgentSocket(ctx context.Context, uid string) (string, error) {
+	foundMvm, err := a.getMicroVMForChange(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+
+	if !foundMvm.Spec.AllowGuestAgent || foundMvm.Status.VSockPath == "" {
+		return "", errGuestAgentNotEnabled
+	}
+
+	if foundMvm.Status.State != models.CreatedState {
+		return "", errMicroVMNotStarted
+	}
+
+	return foundMvm.Status.VSockPath, nil
+}
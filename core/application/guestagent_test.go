@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+const testVSockPath = "/var/lib/flintlock/vm/default/id1234/guest-agent.vsock"
+
+func guestAgentSpec(allowGuestAgent bool, state models.MicroVMState) *models.MicroVM {
+	spec := createTestSpec("id1234", "default", testUID)
+	spec.Spec.AllowGuestAgent = allowGuestAgent
+	spec.Status.State = state
+
+	if allowGuestAgent {
+		spec.Status.VSockPath = testVSockPath
+	}
+
+	return spec
+}
+
+func TestApp_ExecInMicroVM(t *testing.T) {
+	command := []string{"uname", "-a"}
+
+	testCases := []struct {
+		name             string
+		command          []string
+		expectError      bool
+		expectedExitCode int
+		expect           func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder)
+	}{
+		{
+			name:        "no command, should fail",
+			command:     nil,
+			expectError: true,
+			expect:      func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder) {},
+		},
+		{
+			name:        "guest agent not enabled, should fail",
+			command:     command,
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(guestAgentSpec(false, models.CreatedState), nil)
+			},
+		},
+		{
+			name:        "microvm not running, should fail",
+			command:     command,
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(guestAgentSpec(true, models.StoppedState), nil)
+			},
+		},
+		{
+			name:        "guest agent fails, should fail",
+			command:     command,
+			expectError: true,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(guestAgentSpec(true, models.CreatedState), nil)
+				gm.Exec(gomock.AssignableToTypeOf(context.Background()), testVSockPath, gomock.Any()).
+					Return(0, errors.New("connection refused"))
+			},
+		},
+		{
+			name:             "running microvm, should return exit code",
+			command:          command,
+			expectError:      false,
+			expectedExitCode: 2,
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder, gm *mock.MockGuestAgentServiceMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+					Return(guestAgentSpec(true, models.CreatedState), nil)
+				gm.Exec(gomock.AssignableToTypeOf(context.Background()), testVSockPath, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, input ports.GuestExecInput) (int, error) {
+						Expect(input.Command).To(Equal(command))
+
+						return 2, nil
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			gm := mock.NewMockGuestAgentService(mockCtrl)
+
+			tc.expect(rm.EXPECT(), gm.EXPECT())
+
+			app := application.New(&application.Config{}, &ports.Collection{
+				Repo:              rm,
+				GuestAgentService: gm,
+			})
+
+			exitCode, err := app.ExecInMicroVM(context.Background(), testUID, ports.GuestExecInput{Command: tc.command})
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCode).To(Equal(tc.expectedExitCode))
+		})
+	}
+}
+
+func TestApp_CopyMicroVM(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	gm := mock.NewMockGuestAgentService(mockCtrl)
+
+	input := ports.GuestCopyToInput{
+		Path:    "/etc/motd",
+		Mode:    0o644,
+		Content: bytes.NewBufferString("hello"),
+	}
+	dest := &bytes.Buffer{}
+
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+		Return(guestAgentSpec(true, models.CreatedState), nil).Times(2)
+	gm.EXPECT().CopyTo(gomock.AssignableToTypeOf(context.Background()), testVSockPath, input).Return(nil)
+	gm.EXPECT().CopyFrom(gomock.AssignableToTypeOf(context.Background()), testVSockPath, "/etc/motd", dest).Return(nil)
+
+	app := application.New(&application.Config{}, &ports.Collection{
+		Repo:              rm,
+		GuestAgentService: gm,
+	})
+
+	Expect(app.CopyToMicroVM(context.Background(), testUID, ports.GuestCopyToInput{})).NotTo(Succeed())
+	Expect(app.CopyFromMicroVM(context.Background(), testUID, "", dest)).NotTo(Succeed())
+
+	Expect(app.CopyToMicroVM(context.Background(), testUID, input)).To(Succeed())
+	Expect(app.CopyFromMicroVM(context.Background(), testUID, "/etc/motd", dest)).To(Succeed())
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+func (a *app) GetHostInfo(ctx context.Context) (*models.HostInfo, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Trace("querying host info")
+
+	info, err := a.ports.HostService.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting host info: %w", err)
+	}
+
+	info.DefaultProvider = a.cfg.DefaultProvider
+	info.Providers = []models.ProviderInfo{}
+
+	for name, provider := range a.ports.MicrovmProviders {
+		// A broken vmm binary shouldn't stop clients finding out about the rest of the host.
+		vmmVersion, err := provider.Version(ctx)
+		if err != nil {
+			logger.Warnf("failed to get version of provider %s: %s", name, err)
+		}
+
+		info.Providers = append(info.Providers, models.ProviderInfo{
+			Name:         name,
+			Capabilities: provider.Capabilities(),
+			VMMVersion:   vmmVersion,
+		})
+	}
+
+	sort.Slice(info.Providers, func(i, j int) bool {
+		return info.Providers[i].Name < info.Providers[j].Name
+	})
+
+	return info, nil
+}
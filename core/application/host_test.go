@@ -0,0 +1,56 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApp_GetHostInfo(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	hs := mock.NewMockHostService(mockCtrl)
+	fc := mock.NewMockMicroVMService(mockCtrl)
+	ch := mock.NewMockMicroVMService(mockCtrl)
+
+	hs.EXPECT().Info(gomock.Any()).Return(&models.HostInfo{KernelVersion: "6.1.0"}, nil)
+	fc.EXPECT().Version(gomock.Any()).Return("Firecracker v1.4.1", nil)
+	fc.EXPECT().Capabilities().Return(models.Capabilities{models.PauseCapability})
+	ch.EXPECT().Version(gomock.Any()).Return("", errors.New("binary not found"))
+	ch.EXPECT().Capabilities().Return(models.Capabilities{models.MacvtapCapability})
+
+	app := application.New(&application.Config{DefaultProvider: "firecracker"}, &ports.Collection{
+		HostService: hs,
+		MicrovmProviders: map[string]ports.MicroVMService{
+			"firecracker":     fc,
+			"cloudhypervisor": ch,
+		},
+	})
+
+	info, err := app.GetHostInfo(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(info.KernelVersion).To(Equal("6.1.0"))
+	Expect(info.DefaultProvider).To(Equal("firecracker"))
+	Expect(info.Providers).To(Equal([]models.ProviderInfo{
+		{
+			Name:         "cloudhypervisor",
+			Capabilities: models.Capabilities{models.MacvtapCapability},
+		},
+		{
+			Name:         "firecracker",
+			Capabilities: models.Capabilities{models.PauseCapability},
+			VMMVersion:   "Firecracker v1.4.1",
+		},
+	}))
+}
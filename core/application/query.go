@@ -35,6 +35,22 @@ func (a *app) GetMicroVM(ctx context.Context, uid string) (*models.MicroVM, erro
 	return foundMvm, nil
 }
 
+func (a *app) GetMicroVMHistory(ctx context.Context, uid string) ([]*models.PlanExecution, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Tracef("querying microvm history: %s", uid)
+
+	if _, err := a.GetMicroVM(ctx, uid); err != nil {
+		return nil, err
+	}
+
+	executions, err := a.ports.HistoryRepo.GetAll(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("error attempting to get history of microvm with uid: %s: %w", uid, err)
+	}
+
+	return executions, nil
+}
+
 func (a *app) GetAllMicroVM(ctx context.Context, query models.ListMicroVMQuery) ([]*models.MicroVM, error) {
 	logger := log.GetLogger(ctx).WithField("component", "app")
 	logger.Tracef("querying all microvms: %v", query)
@@ -52,3 +68,19 @@ func (a *app) GetAllMicroVM(ctx context.Context, query models.ListMicroVMQuery)
 
 	return foundMvms, nil
 }
+
+func (a *app) GetAllSnapshots(ctx context.Context, query models.ListSnapshotQuery) ([]*models.Snapshot, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Tracef("querying all snapshots: %v", query)
+
+	foundSnapshots, err := a.ports.SnapshotRepo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error attempting to list snapshots: %v: %w", query, err)
+	}
+
+	if foundSnapshots == nil {
+		return []*models.Snapshot{}, nil
+	}
+
+	return foundSnapshots, nil
+}
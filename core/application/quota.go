@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/validation"
+)
+
+func (a *app) SetNamespaceQuota(ctx context.Context, quota *models.NamespaceQuota) (*models.NamespaceQuota, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Debugf("setting quota for namespace %s", quota.Namespace)
+
+	validator := validation.NewValidator()
+	if validErr := validator.ValidateStruct(quota); validErr != nil {
+		return nil, fmt.Errorf("an error occurred when attempting to validate quota: %w", validErr)
+	}
+
+	// The quota can be set below what the namespace already uses, it only stops the
+	// namespace from using more.
+	a.admissionMu.Lock()
+	defer a.admissionMu.Unlock()
+
+	saved, err := a.ports.QuotaRepo.Save(ctx, quota)
+	if err != nil {
+		return nil, fmt.Errorf("saving quota for namespace %s: %w", quota.Namespace, err)
+	}
+
+	return saved, nil
+}
+
+func (a *app) GetNamespaceQuota(ctx context.Context, namespace string) (*models.NamespaceQuotaStatus, error) {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Trace("querying namespace quota")
+
+	if namespace == "" {
+		return nil, coreerrs.ErrNamespaceRequired
+	}
+
+	quota, err := a.ports.QuotaRepo.Get(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("getting quota for namespace %s: %w", namespace, err)
+	}
+
+	if quota == nil {
+		quota = &models.NamespaceQuota{Namespace: namespace}
+	}
+
+	used, err := a.namespaceUsage(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NamespaceQuotaStatus{
+		Quota: *quota,
+		Used:  used,
+	}, nil
+}
+
+// checkQuota returns an error if the quota of a namespace doesn't allow a microvm in it to go
+// from using the current resources to the required resources. Callers must hold admissionMu
+// until the change is saved.
+func (a *app) checkQuota(ctx context.Context, namespace string, current, required models.QuotaResources) error {
+	quota, err := a.ports.QuotaRepo.Get(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("getting quota for namespace %s: %w", namespace, err)
+	}
+
+	if quota == nil {
+		return nil
+	}
+
+	used, err := a.namespaceUsage(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	increase := required.Sub(current)
+	checks := []struct {
+		resource string
+		increase int64
+		used     int64
+		limit    int64
+	}{
+		{"microvms", increase.MicroVMs, used.MicroVMs, quota.Limits.MicroVMs},
+		{"vcpu", increase.VCPU, used.VCPU, quota.Limits.VCPU},
+		{"memory (MB)", increase.MemoryInMb, used.MemoryInMb, quota.Limits.MemoryInMb},
+		{"disk (MB)", increase.DiskInMb, used.DiskInMb, quota.Limits.DiskInMb},
+		{"network interfaces", increase.NetworkInterfaces, used.NetworkInterfaces, quota.Limits.NetworkInterfaces},
+	}
+
+	for _, check := range checks {
+		if check.limit > 0 && check.increase > 0 && check.used+check.increase > check.limit {
+			return coreerrs.NewQuotaExceeded(namespace, check.resource, check.increase, check.used, check.limit)
+		}
+	}
+
+	return nil
+}
+
+// namespaceUsage returns the resources used by the microvms in a namespace. As with the
+// host capacity, stopped microvms still count.
+func (a *app) namespaceUsage(ctx context.Context, namespace string) (models.QuotaResources, error) {
+	mvms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{Namespace: namespace})
+	if err != nil {
+		return models.QuotaResources{}, fmt.Errorf("listing microvms in namespace %s: %w", namespace, err)
+	}
+
+	used := models.QuotaResources{}
+	for _, mvm := range mvms {
+		used = used.Add(mvm.Spec.QuotaResources())
+	}
+
+	return used, nil
+}
@@ -0,0 +1,223 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApp_CreateMicroVM_Quota(t *testing.T) {
+	testCases := []struct {
+		name        string
+		limits      models.QuotaResources
+		expectError bool
+	}{
+		{
+			name:   "within quota",
+			limits: models.QuotaResources{MicroVMs: 2, VCPU: 4, MemoryInMb: 4096, DiskInMb: 40000, NetworkInterfaces: 2},
+		},
+		{
+			name:        "too many microvms",
+			limits:      models.QuotaResources{MicroVMs: 1},
+			expectError: true,
+		},
+		{
+			name:        "not enough vcpu",
+			limits:      models.QuotaResources{VCPU: 3},
+			expectError: true,
+		},
+		{
+			name:        "not enough memory",
+			limits:      models.QuotaResources{MemoryInMb: 4095},
+			expectError: true,
+		},
+		{
+			name:        "not enough disk",
+			limits:      models.QuotaResources{DiskInMb: 30000},
+			expectError: true,
+		},
+		{
+			// The metadata interfaces aren't counted, so each microvm has 1 interface.
+			name:        "too many network interfaces",
+			limits:      models.QuotaResources{NetworkInterfaces: 1},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			hs := mock.NewMockHostService(mockCtrl)
+			qr := mock.NewMockQuotaRepository(mockCtrl)
+
+			pm.EXPECT().Capabilities().Return(models.Capabilities{models.MacvtapCapability}).AnyTimes()
+			im.EXPECT().GenerateRandom().Return(testUID, nil)
+			rm.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+			expectEmptyHost(hs.EXPECT(), rm.EXPECT())
+
+			qr.EXPECT().Get(gomock.Any(), "team-a").Return(&models.NamespaceQuota{
+				Namespace: "team-a",
+				Limits:    tc.limits,
+			}, nil)
+
+			// An existing microvm in the namespace with 2 vcpu, 2048MB memory, 20000MB disk
+			// and 1 network interface.
+			rm.EXPECT().GetAll(gomock.Any(), models.ListMicroVMQuery{Namespace: "team-a"}).Return([]*models.MicroVM{
+				createTestSpec("existing", "team-a", testUID),
+			}, nil)
+
+			if !tc.expectError {
+				rm.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+						return mvm, nil
+					},
+				)
+				em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			app := application.New(&application.Config{DefaultProvider: "mock"}, &ports.Collection{
+				Repo:              rm,
+				MicrovmProviders:  map[string]ports.MicroVMService{"mock": pm},
+				EventService:      em,
+				IdentifierService: im,
+				HostService:       hs,
+				QuotaRepo:         qr,
+				FileSystem:        afero.NewMemMapFs(),
+				Clock:             time.Now,
+			})
+
+			_, err := app.CreateMicroVM(context.Background(), createTestSpec("id1234", "team-a", testUID))
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+				Expect(coreerrs.IsQuotaExceeded(err)).To(BeTrue())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestApp_UpdateMicroVM_Quota(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	qr := mock.NewMockQuotaRepository(mockCtrl)
+
+	existing := createTestSpec("id1234", "team-a", testUID)
+	existing.Spec.Provider = "mock"
+
+	pm.EXPECT().Capabilities().Return(models.Capabilities{models.MacvtapCapability}).AnyTimes()
+	rm.EXPECT().Get(gomock.Any(), gomock.Any()).Return(existing, nil)
+	rm.EXPECT().GetAll(gomock.Any(), models.ListMicroVMQuery{Namespace: "team-a"}).Return([]*models.MicroVM{existing}, nil)
+	qr.EXPECT().Get(gomock.Any(), "team-a").Return(&models.NamespaceQuota{
+		Namespace: "team-a",
+		Limits:    models.QuotaResources{DiskInMb: 25000},
+	}, nil)
+
+	app := application.New(&application.Config{DefaultProvider: "mock"}, &ports.Collection{
+		Repo:             rm,
+		MicrovmProviders: map[string]ports.MicroVMService{"mock": pm},
+		QuotaRepo:        qr,
+		FileSystem:       afero.NewMemMapFs(),
+		Clock:            time.Now,
+	})
+
+	updated := createTestSpec("id1234", "team-a", testUID)
+	updated.Spec.AdditionalVolumes = models.Volumes{
+		{
+			ID:   "data",
+			Size: 10000,
+			Source: models.VolumeSource{
+				Container: &models.ContainerVolumeSource{Image: "docker.io/library/data:latest"},
+			},
+		},
+	}
+
+	_, err := app.UpdateMicroVM(context.Background(), testUID, updated)
+	Expect(err).To(HaveOccurred())
+	Expect(coreerrs.IsQuotaExceeded(err)).To(BeTrue())
+}
+
+func TestApp_SetNamespaceQuota(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	qr := mock.NewMockQuotaRepository(mockCtrl)
+
+	quota := &models.NamespaceQuota{
+		Namespace: "team-a",
+		Limits:    models.QuotaResources{MicroVMs: 5, VCPU: 10},
+	}
+	qr.EXPECT().Save(gomock.Any(), quota).Return(quota, nil)
+
+	app := application.New(&application.Config{}, &ports.Collection{QuotaRepo: qr})
+
+	saved, err := app.SetNamespaceQuota(context.Background(), quota)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(saved).To(Equal(quota))
+
+	_, err = app.SetNamespaceQuota(context.Background(), &models.NamespaceQuota{
+		Namespace: "team-a",
+		Limits:    models.QuotaResources{VCPU: -1},
+	})
+	Expect(err).To(HaveOccurred())
+
+	_, err = app.SetNamespaceQuota(context.Background(), &models.NamespaceQuota{})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestApp_GetNamespaceQuota(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	qr := mock.NewMockQuotaRepository(mockCtrl)
+
+	qr.EXPECT().Get(gomock.Any(), "team-a").Return(nil, nil)
+	rm.EXPECT().GetAll(gomock.Any(), models.ListMicroVMQuery{Namespace: "team-a"}).Return([]*models.MicroVM{
+		createTestSpec("vm1", "team-a", testUID),
+		createTestSpec("vm2", "team-a", testUID),
+	}, nil)
+
+	app := application.New(&application.Config{}, &ports.Collection{Repo: rm, QuotaRepo: qr})
+
+	quotaStatus, err := app.GetNamespaceQuota(context.Background(), "team-a")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(quotaStatus.Quota).To(Equal(models.NamespaceQuota{Namespace: "team-a"}))
+	Expect(quotaStatus.Used).To(Equal(models.QuotaResources{
+		MicroVMs:          2,
+		VCPU:              4,
+		MemoryInMb:        4096,
+		DiskInMb:          40000,
+		NetworkInterfaces: 2,
+	}))
+}
@@ -2,12 +2,14 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/liquidmetal-dev/flintlock/api/events"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/plans"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
@@ -22,6 +24,9 @@ const backoffBaseInSeconds = 20
 func (a *app) ReconcileMicroVM(ctx context.Context, vmid models.VMID) error {
 	logger := log.GetLogger(ctx).WithField("action", "reconcile")
 
+	unlock := a.lockMicroVM(vmid.UID())
+	defer unlock()
+
 	logger.Debugf("Getting spec for %s", vmid.String())
 
 	spec, err := a.ports.Repo.Get(ctx, ports.RepositoryGetOptions{
@@ -36,6 +41,48 @@ func (a *app) ReconcileMicroVM(ctx context.Context, vmid models.VMID) error {
 	return a.reconcile(ctx, spec, logger)
 }
 
+func (a *app) RestoreDHCPLeases(ctx context.Context) error {
+	logger := log.GetLogger(ctx).WithField("action", "restore_dhcp_leases")
+
+	vms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{})
+	if err != nil {
+		return fmt.Errorf("getting microvms to restore dhcp leases: %w", err)
+	}
+
+	for _, vm := range vms {
+		if vm.Spec.DeletedAt != 0 {
+			continue
+		}
+
+		for i := range vm.Spec.NetworkInterfaces {
+			iface := &vm.Spec.NetworkInterfaces[i]
+
+			// Only interfaces the reconciler added a lease for are restored, so the lease is
+			// for the same MAC address.
+			status, ok := vm.Status.NetworkInterfaces[iface.GuestDeviceName]
+			if !ok || status.LeasedMACAddress == "" {
+				continue
+			}
+
+			address := iface.GuestAddress(status)
+			if address == nil {
+				continue
+			}
+
+			err := a.ports.DHCPService.AddLease(ctx, ports.DHCPLease{
+				MACAddress: status.LeasedMACAddress,
+				Address:    *address,
+				Hostname:   vm.ID.Name(),
+			})
+			if err != nil {
+				logger.Errorf("failed to restore the dhcp lease of %s on %s: %s", iface.GuestDeviceName, vm.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (a *app) plan(spec *models.MicroVM, logger *logrus.Entry) planner.Plan {
 	l := logger.WithField("stage", "plan")
 	l.Info("Generate plan")
@@ -50,9 +97,17 @@ func (a *app) plan(spec *models.MicroVM, logger *logrus.Entry) planner.Plan {
 		return plans.MicroVMDeletePlan(input)
 	}
 
+	// Keep the microvm stopped if that's what's been asked for.
+	if spec.Spec.IsStopped() {
+		return plans.MicroVMStopPlan(&plans.StopPlanInput{
+			VM: spec,
+		})
+	}
+
 	input := &plans.CreateOrUpdatePlanInput{
-		StateDirectory: a.cfg.RootStateDir,
-		VM:             spec,
+		StateDirectory:    a.cfg.RootStateDir,
+		VM:                spec,
+		GuestBootDeadline: a.cfg.GuestBootDeadline,
 	}
 
 	return plans.MicroVMCreateOrUpdatePlan(input)
@@ -74,6 +129,34 @@ func (a *app) reschedule(ctx context.Context, logger *logrus.Entry, spec *models
 		return fmt.Errorf("saving spec failed: %w", err)
 	}
 
+	a.publishStatusUpdated(ctx, logger, spec)
+	a.publishUpdateAfter(logger, spec, waitTime)
+
+	return nil
+}
+
+// requeue saves the progress of a reconciliation that's waiting for the guest to boot and
+// schedules another one after the wait. It isn't counted as a failed attempt.
+func (a *app) requeue(ctx context.Context, logger *logrus.Entry, spec *models.MicroVM, wait time.Duration) error {
+	spec.Status.NotBefore = time.Now().Add(wait).Unix()
+
+	logger.Debugf("guest hasn't booted yet, rescheduled for %s", time.Unix(spec.Status.NotBefore, 0))
+
+	a.updateConditions(spec)
+
+	if _, err := a.ports.Repo.Save(ctx, spec); err != nil {
+		return fmt.Errorf("saving spec failed: %w", err)
+	}
+
+	a.publishStatusUpdated(ctx, logger, spec)
+	a.publishUpdateAfter(logger, spec, wait)
+
+	return nil
+}
+
+// publishUpdateAfter publishes an update event for the microvm after the wait so it's
+// reconciled again.
+func (a *app) publishUpdateAfter(logger *logrus.Entry, spec *models.MicroVM, wait time.Duration) {
 	go func(uid string, sleepTime time.Duration) {
 		time.Sleep(sleepTime)
 
@@ -89,9 +172,7 @@ func (a *app) reschedule(ctx context.Context, logger *logrus.Entry, spec *models
 		if err != nil {
 			logger.Errorf("failed to publish an update event for %s", uid)
 		}
-	}(spec.ID.UID(), waitTime)
-
-	return nil
+	}(spec.ID.UID(), wait)
 }
 
 func (a *app) reconcile(ctx context.Context, spec *models.MicroVM, logger *logrus.Entry) error {
@@ -121,10 +202,49 @@ func (a *app) reconcile(ctx context.Context, spec *models.MicroVM, logger *logru
 		return fmt.Errorf("generating plan execution id: %w", err)
 	}
 
+	spec.Status.ExecutionID = executionID
+
 	actuator := planner.NewActuator()
 
-	stepCount, err := actuator.Execute(execCtx, plan, executionID)
+	execution, err := actuator.Execute(execCtx, plan, executionID)
+
+	// While the guest boots it's pinged every few seconds. Pings where the guest still hasn't
+	// responded change nothing, so they're neither saved nor added to the history.
+	if errors.Is(err, coreerrs.ErrGuestBooting) && execution.StepsExecuted() == 1 {
+		localLogger.Debug("guest hasn't booted yet, checking again later")
+		a.publishUpdateAfter(localLogger, spec, defaults.GuestPingInterval)
+
+		return nil
+	}
+
+	if plan.Name() != plans.MicroVMDeletePlanName || err != nil {
+		a.recordExecution(ctx, localLogger, spec, execution)
+	}
+
+	if errors.Is(err, coreerrs.ErrGuestBooting) {
+		return a.requeue(ctx, localLogger, spec, defaults.GuestPingInterval)
+	}
+
 	if err != nil {
+		failedStep := plan.Name()
+
+		var condition models.ConditionType
+
+		stepErr := &planner.StepError{}
+		if errors.As(err, &stepErr) {
+			failedStep = stepErr.Step
+			condition = stepErr.Condition
+		}
+
+		if errors.Is(err, coreerrs.ErrGuestNotReady) {
+			// The guest is stuck, so boot it again on the next attempt.
+			spec.Status.State = models.FailedState
+			spec.Status.RestartPending = true
+		}
+
+		a.recordStepError(spec, failedStep, condition, executionID, err)
+		a.updateConditions(spec)
+
 		if scheduleErr := a.reschedule(ctx, localLogger, spec); scheduleErr != nil {
 			return fmt.Errorf("rescheduling failed: %w", scheduleErr)
 		}
@@ -133,11 +253,24 @@ func (a *app) reconcile(ctx context.Context, spec *models.MicroVM, logger *logru
 	}
 
 	if plan.Name() == plans.MicroVMDeletePlanName {
+		if err := a.ports.HistoryRepo.Delete(ctx, spec.ID.UID()); err != nil {
+			return fmt.Errorf("deleting microvm history: %w", err)
+		}
+
+		a.microvmLocks.Delete(spec.ID.UID())
+
 		return nil
 	}
 
-	if stepCount == 0 {
-		return nil
+	if execution.StepsExecuted() == 0 {
+		// A plan can still move the microvm to another state without running any
+		// steps, like stopping a microvm that was never started.
+		previousState := spec.Status.State
+		plan.Finalise(models.CreatedState)
+
+		if spec.Status.State == previousState {
+			return nil
+		}
 	}
 
 	spec.Status.Retry = 0
@@ -149,9 +282,47 @@ func (a *app) reconcile(ctx context.Context, spec *models.MicroVM, logger *logru
 func (a *app) saveState(ctx context.Context, spec *models.MicroVM, plan planner.Plan, state models.MicroVMState) error {
 	plan.Finalise(state)
 
+	if state != models.FailedState {
+		spec.Status.LastError = nil
+	}
+
+	a.updateConditions(spec)
+
 	if _, err := a.ports.Repo.Save(ctx, spec); err != nil {
 		return fmt.Errorf("saving spec after plan execution: %w", err)
 	}
 
+	a.publishStatusUpdated(ctx, log.GetLogger(ctx), spec)
+
 	return nil
 }
+
+// recordExecution adds the execution to the history of the microvm. Executions that didn't
+// run any steps are left out so the periodic resync doesn't push out the useful history.
+func (a *app) recordExecution(
+	ctx context.Context,
+	logger *logrus.Entry,
+	spec *models.MicroVM,
+	execution *models.PlanExecution,
+) {
+	if execution.StepsExecuted() == 0 && execution.Error == "" {
+		return
+	}
+
+	if err := a.ports.HistoryRepo.Append(ctx, spec.ID, execution); err != nil {
+		logger.Errorf("failed to save execution %s to the history: %s", execution.ID, err)
+	}
+}
+
+// publishStatusUpdated lets watchers know a new version of the microvm was saved. It
+// isn't used to trigger reconciliation so a failure is only logged.
+func (a *app) publishStatusUpdated(ctx context.Context, logger *logrus.Entry, spec *models.MicroVM) {
+	err := a.ports.EventService.Publish(ctx, defaults.TopicMicroVMEvents, &events.MicroVMStatusUpdated{
+		ID:        spec.ID.Name(),
+		Namespace: spec.ID.Namespace(),
+		UID:       spec.ID.UID(),
+	})
+	if err != nil {
+		logger.Errorf("failed to publish a status update event for %s: %s", spec.ID, err)
+	}
+}
@@ -0,0 +1,351 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApp_ReconcileMicroVM_Status(t *testing.T) {
+	frozenTime := func() time.Time { return time.Unix(1700000000, 0) }
+
+	testCases := []struct {
+		name            string
+		stopErr         error
+		previousError   *models.StepError
+		expectError     bool
+		expectState     models.MicroVMState
+		expectLastError *models.StepError
+		expectVMM       models.Condition
+		expectOutcome   models.StepOutcome
+	}{
+		{
+			name:        "failed step is recorded in the status",
+			stopErr:     errors.New("vmm didn't respond"),
+			expectError: true,
+			expectState: models.CreatedState,
+			expectLastError: &models.StepError{
+				Step:        "microvm_stop",
+				Condition:   models.ConditionVMMRunning,
+				Message:     "executing plan steps: executing steps: executing step microvm_stop: stopping microvm: vmm didn't respond",
+				ExecutionID: "exec1",
+				OccurredAt:  frozenTime().Unix(),
+			},
+			expectOutcome: models.StepOutcomeDoFailed,
+			expectVMM: models.Condition{
+				Type:               models.ConditionVMMRunning,
+				Status:             models.ConditionStatusFalse,
+				Reason:             "StepFailed",
+				Message:            "executing plan steps: executing steps: executing step microvm_stop: stopping microvm: vmm didn't respond",
+				LastTransitionTime: frozenTime().Unix(),
+			},
+		},
+		{
+			name:          "successful reconcile clears the last error",
+			previousError: &models.StepError{Step: "microvm_stop", Message: "vmm didn't respond"},
+			expectError:   false,
+			expectState:   models.StoppedState,
+			expectOutcome: models.StepOutcomeSucceeded,
+			expectVMM: models.Condition{
+				Type:               models.ConditionVMMRunning,
+				Status:             models.ConditionStatusFalse,
+				Reason:             "Stopped",
+				LastTransitionTime: frozenTime().Unix(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			im := mock.NewMockIDService(mockCtrl)
+			pm := mock.NewMockMicroVMService(mockCtrl)
+			hm := mock.NewMockHistoryRepository(mockCtrl)
+			collection := &ports.Collection{
+				Repo:        rm,
+				HistoryRepo: hm,
+				MicrovmProviders: map[string]ports.MicroVMService{
+					"mock": pm,
+				},
+				EventService:      em,
+				IdentifierService: im,
+				Clock:             frozenTime,
+			}
+
+			vm := createTestSpec("id1234", "default", testUID)
+			vm.Spec.Provider = "mock"
+			vm.Spec.PowerState = models.PowerStateStopped
+			vm.Status.State = models.CreatedState
+			vm.Status.LastError = tc.previousError
+
+			rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(vm, nil)
+			im.EXPECT().GenerateRandom().Return("exec1", nil)
+			em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			var (
+				saved    models.MicroVM
+				recorded *models.PlanExecution
+			)
+
+			hm.EXPECT().Append(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(vm.ID), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ models.VMID, execution *models.PlanExecution) error {
+					recorded = execution
+
+					return nil
+				},
+			)
+
+			rm.EXPECT().Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).DoAndReturn(
+				func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+					saved = *mvm
+
+					return mvm, nil
+				},
+			)
+
+			running := pm.EXPECT().State(gomock.Any(), gomock.Eq(vm.ID.String())).Return(ports.MicroVMStateRunning, nil).Times(2)
+			stop := pm.EXPECT().Stop(gomock.Any(), gomock.Eq(vm.ID.String())).Return(tc.stopErr).After(running)
+
+			if tc.stopErr == nil {
+				pm.EXPECT().State(gomock.Any(), gomock.Eq(vm.ID.String())).Return(ports.MicroVMStateConfigured, nil).After(stop).AnyTimes()
+			}
+
+			app := application.New(&application.Config{MaximumRetry: 10}, collection)
+			err := app.ReconcileMicroVM(context.Background(), vm.ID)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(saved.Status.State).To(Equal(tc.expectState))
+			Expect(saved.Status.ExecutionID).To(Equal("exec1"))
+			Expect(saved.Status.LastError).To(Equal(tc.expectLastError))
+			Expect(saved.Status.Conditions.Get(models.ConditionVMMRunning)).To(Equal(&tc.expectVMM))
+			Expect(saved.Status.Conditions.Get(models.ConditionGuestReady).Status).To(Equal(models.ConditionStatusUnknown))
+
+			Expect(recorded).NotTo(BeNil())
+			Expect(recorded.ID).To(Equal("exec1"))
+			Expect(recorded.Plan).To(Equal("microvm_stop"))
+			Expect(recorded.Steps[0].Name).To(Equal("microvm_stop"))
+			Expect(recorded.Steps[0].Outcome).To(Equal(tc.expectOutcome))
+
+			if tc.expectLastError != nil {
+				Expect(saved.Status.StepErrors).To(HaveKeyWithValue("microvm_stop", *tc.expectLastError))
+			}
+		})
+	}
+}
+
+func TestApp_ReconcileMicroVM_StopPending(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	em := mock.NewMockEventService(mockCtrl)
+	im := mock.NewMockIDService(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	hm := mock.NewMockHistoryRepository(mockCtrl)
+	collection := &ports.Collection{
+		Repo:        rm,
+		HistoryRepo: hm,
+		MicrovmProviders: map[string]ports.MicroVMService{
+			"mock": pm,
+		},
+		EventService:      em,
+		IdentifierService: im,
+		Clock:             time.Now,
+	}
+
+	vm := createTestSpec("id1234", "default", testUID)
+	vm.Spec.Provider = "mock"
+	vm.Spec.PowerState = models.PowerStateStopped
+	vm.Status.State = models.PendingState
+
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(vm, nil)
+	im.EXPECT().GenerateRandom().Return("exec1", nil)
+	em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	pm.EXPECT().State(gomock.Any(), gomock.Eq(vm.ID.String())).Return(ports.MicroVMStatePending, nil)
+
+	var saved models.MicroVM
+
+	rm.EXPECT().Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).DoAndReturn(
+		func(_ context.Context, mvm *models.MicroVM) (*models.MicroVM, error) {
+			saved = *mvm
+
+			return mvm, nil
+		},
+	)
+
+	app := application.New(&application.Config{MaximumRetry: 10}, collection)
+	Expect(app.ReconcileMicroVM(context.Background(), vm.ID)).To(Succeed())
+
+	Expect(saved.Status.State).To(BeEquivalentTo(models.StoppedState))
+}
+
+func TestApp_CreateSnapshotWaitsForReconcile(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	em := mock.NewMockEventService(mockCtrl)
+	im := mock.NewMockIDService(mockCtrl)
+	pm := mock.NewMockMicroVMService(mockCtrl)
+	collection := &ports.Collection{
+		Repo: rm,
+		MicrovmProviders: map[string]ports.MicroVMService{
+			"mock": pm,
+		},
+		EventService:      em,
+		IdentifierService: im,
+		Clock:             time.Now,
+	}
+
+	vm := createTestSpec("id1234", "default", testUID)
+	vm.Spec.Provider = "mock"
+	vm.Spec.PowerState = models.PowerStateStopped
+	vm.Status.State = models.PendingState
+
+	reconciling := make(chan struct{})
+	release := make(chan struct{})
+
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(vm, nil).Times(2)
+	im.EXPECT().GenerateRandom().Return("exec1", nil)
+	em.EXPECT().Publish(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	rm.EXPECT().Save(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).Return(vm, nil)
+	pm.EXPECT().State(gomock.Any(), gomock.Eq(vm.ID.String())).DoAndReturn(
+		func(_ context.Context, _ string) (ports.MicroVMState, error) {
+			close(reconciling)
+			<-release
+
+			return ports.MicroVMStatePending, nil
+		},
+	)
+
+	app := application.New(&application.Config{MaximumRetry: 10}, collection)
+
+	reconciled := make(chan error, 1)
+
+	go func() {
+		reconciled <- app.ReconcileMicroVM(context.Background(), vm.ID)
+	}()
+
+	Eventually(reconciling).Should(BeClosed())
+
+	snapshotted := make(chan error, 1)
+
+	go func() {
+		_, err := app.CreateSnapshot(context.Background(), testUID, "warm")
+		snapshotted <- err
+	}()
+
+	Consistently(snapshotted, 100*time.Millisecond).ShouldNot(Receive())
+
+	close(release)
+
+	Eventually(reconciled).Should(Receive(BeNil()))
+	// The microvm was stopped by the reconcile, so it can't be snapshotted.
+	Eventually(snapshotted).Should(Receive(HaveOccurred()))
+}
+
+func TestApp_RestoreDHCPLeases(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	dm := mock.NewMockDHCPService(mockCtrl)
+	collection := &ports.Collection{
+		Repo:        rm,
+		DHCPService: dm,
+	}
+
+	allocated := &models.StaticAddress{Address: "192.168.10.4/24"}
+
+	leased := createTestSpec("id1234", "default", testUID)
+	leased.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{GuestDeviceName: "eth0", Type: models.IfaceTypeTap, AllowMetadataRequests: true},
+		{GuestDeviceName: "eth1", Type: models.IfaceTypeTap},
+		{
+			GuestDeviceName: "eth2",
+			Type:            models.IfaceTypeTap,
+			StaticAddress:   &models.StaticAddress{Address: "10.0.0.2/24"},
+		},
+	}
+	leased.Status.NetworkInterfaces = models.NetworkInterfaceStatuses{
+		"eth0": {},
+		"eth1": {AllocatedAddress: allocated, LeasedMACAddress: "AA:BB:CC:DD:EE:01"},
+	}
+
+	deleted := createTestSpec("id5678", "default", "deleted-uid")
+	deleted.Spec.DeletedAt = 1
+	deleted.Spec.NetworkInterfaces = []models.NetworkInterface{{GuestDeviceName: "eth1", Type: models.IfaceTypeTap}}
+	deleted.Status.NetworkInterfaces = models.NetworkInterfaceStatuses{
+		"eth1": {AllocatedAddress: allocated, LeasedMACAddress: "AA:BB:CC:DD:EE:02"},
+	}
+
+	rm.EXPECT().GetAll(gomock.AssignableToTypeOf(context.Background()), models.ListMicroVMQuery{}).
+		Return([]*models.MicroVM{leased, deleted}, nil)
+	dm.EXPECT().AddLease(gomock.AssignableToTypeOf(context.Background()), ports.DHCPLease{
+		MACAddress: "AA:BB:CC:DD:EE:01",
+		Address:    *allocated,
+		Hostname:   "id1234",
+	}).Return(nil)
+
+	app := application.New(&application.Config{}, collection)
+	Expect(app.RestoreDHCPLeases(context.Background())).To(Succeed())
+}
+
+func TestApp_GetMicroVMHistory(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rm := mock.NewMockMicroVMRepository(mockCtrl)
+	hm := mock.NewMockHistoryRepository(mockCtrl)
+	collection := &ports.Collection{
+		Repo:        rm,
+		HistoryRepo: hm,
+	}
+
+	executions := []*models.PlanExecution{{ID: "exec2"}, {ID: "exec1"}}
+
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: "missing"}).Return(nil, nil)
+	rm.EXPECT().Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: testUID}).
+		Return(createTestSpec("id1234", "default", testUID), nil)
+	hm.EXPECT().GetAll(gomock.AssignableToTypeOf(context.Background()), testUID).Return(executions, nil)
+
+	app := application.New(&application.Config{}, collection)
+
+	_, err := app.GetMicroVMHistory(context.Background(), "")
+	Expect(err).To(HaveOccurred())
+
+	_, err = app.GetMicroVMHistory(context.Background(), "missing")
+	Expect(err).To(HaveOccurred())
+
+	history, err := app.GetMicroVMHistory(context.Background(), testUID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(history).To(Equal(executions))
+}
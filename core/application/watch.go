@@ -0,0 +1,210 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liquidmetal-dev/flintlock/api/events"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+func (a *app) WatchMicroVMs(
+	ctx context.Context,
+	query models.WatchMicroVMQuery,
+	send func(*models.MicroVMEvent) error,
+) error {
+	logger := log.GetLogger(ctx).WithField("component", "app")
+	logger.Tracef("watching microvms: %v", query)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribe before listing so that nothing saved in between is missed. The
+	// versions sent are tracked so anything seen twice is only sent once.
+	evtCh, errCh := a.ports.EventService.SubscribeTopic(watchCtx, defaults.TopicMicroVMEvents)
+
+	watch := newMicroVMWatch(query, send)
+
+	if err := a.sendExistingMicroVMs(ctx, watch); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-evtCh:
+			if !ok {
+				return nil
+			}
+
+			if err := a.handleWatchEvent(ctx, watch, evt); err != nil {
+				return err
+			}
+		case err := <-errCh:
+			if err != nil {
+				return fmt.Errorf("receiving microvm events: %w", err)
+			}
+		}
+	}
+}
+
+func (a *app) sendExistingMicroVMs(ctx context.Context, watch *microvmWatch) error {
+	foundMvms, err := a.ports.Repo.GetAll(ctx, models.ListMicroVMQuery{Namespace: watch.query.Namespace})
+	if err != nil {
+		return fmt.Errorf("getting microvms to watch: %w", err)
+	}
+
+	found := map[string]bool{}
+
+	for _, mvm := range foundMvms {
+		found[mvm.ID.UID()] = true
+
+		if err := watch.saved(mvm); err != nil {
+			return err
+		}
+	}
+
+	for uid := range watch.query.ResumeFromVersions {
+		if found[uid] {
+			continue
+		}
+
+		if err := watch.deleted(uid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *app) handleWatchEvent(ctx context.Context, watch *microvmWatch, envelope *ports.EventEnvelope) error {
+	if envelope == nil {
+		return nil
+	}
+
+	var namespace, uid string
+
+	deleted := false
+
+	switch evt := envelope.Event.(type) {
+	case *events.MicroVMSpecCreated:
+		namespace, uid = evt.Namespace, evt.UID
+	case *events.MicroVMSpecUpdated:
+		namespace, uid = evt.Namespace, evt.UID
+	case *events.MicroVMStatusUpdated:
+		namespace, uid = evt.Namespace, evt.UID
+	case *events.MicroVMSpecDeleted:
+		uid = evt.UID
+		deleted = true
+	default:
+		return nil
+	}
+
+	// Only microvms that have been sent on the watch are deleted, so deleted events are
+	// matched by uid rather than namespace.
+	if deleted {
+		return watch.deleted(uid)
+	}
+
+	if watch.query.Namespace != "" && namespace != watch.query.Namespace {
+		return nil
+	}
+
+	// If it's been deleted since the event was published, the deleted event will follow.
+	foundMvm, err := a.ports.Repo.Get(ctx, ports.RepositoryGetOptions{UID: uid})
+	if err != nil {
+		if coreerrs.IsSpecNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("getting microvm %s to watch: %w", uid, err)
+	}
+
+	if foundMvm == nil {
+		return nil
+	}
+
+	return watch.saved(foundMvm)
+}
+
+func newMicroVMWatch(query models.WatchMicroVMQuery, send func(*models.MicroVMEvent) error) *microvmWatch {
+	watch := &microvmWatch{
+		query: query,
+		send:  send,
+		sent:  map[string]*models.MicroVM{},
+	}
+
+	for uid, version := range query.ResumeFromVersions {
+		watch.sent[uid] = &models.MicroVM{
+			ID:      *models.NewVMIDForce("", query.Namespace, uid),
+			Version: version,
+		}
+	}
+
+	return watch
+}
+
+// microvmWatch keeps track of the last version of each microvm sent to a watcher.
+type microvmWatch struct {
+	query models.WatchMicroVMQuery
+	send  func(*models.MicroVMEvent) error
+	sent  map[string]*models.MicroVM
+}
+
+func (w *microvmWatch) saved(mvm *models.MicroVM) error {
+	uid := mvm.ID.UID()
+	last, seen := w.sent[uid]
+
+	if !matchesLabels(mvm.Spec.Labels, w.query.Labels) {
+		return w.deleted(uid)
+	}
+
+	if seen && mvm.Version <= last.Version {
+		if mvm.Version == last.Version {
+			w.sent[uid] = mvm
+		}
+
+		return nil
+	}
+
+	eventType := models.MicroVMEventAdded
+	if seen {
+		eventType = models.MicroVMEventModified
+	}
+
+	w.sent[uid] = mvm
+
+	return w.send(&models.MicroVMEvent{
+		Type:    eventType,
+		MicroVM: mvm,
+	})
+}
+
+func (w *microvmWatch) deleted(uid string) error {
+	last, seen := w.sent[uid]
+	if !seen {
+		return nil
+	}
+
+	delete(w.sent, uid)
+
+	return w.send(&models.MicroVMEvent{
+		Type:    models.MicroVMEventDeleted,
+		MicroVM: last,
+	})
+}
+
+func matchesLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,194 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/api/events"
+	"github.com/liquidmetal-dev/flintlock/core/application"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+)
+
+func TestApp_WatchMicroVMs(t *testing.T) {
+	const (
+		uid1 = "uid1"
+		uid2 = "uid2"
+		uid3 = "uid3"
+	)
+
+	watchedVM := func(uid string, version int, labels map[string]string) *models.MicroVM {
+		vm := createTestSpec("vm-"+uid, "default", uid)
+		vm.ID = *models.NewVMIDForce("vm-"+uid, "default", uid)
+		vm.Version = version
+		vm.Spec.Labels = labels
+
+		return vm
+	}
+
+	updated := func(uid string) *ports.EventEnvelope {
+		return &ports.EventEnvelope{Event: &events.MicroVMStatusUpdated{ID: "vm-" + uid, Namespace: "default", UID: uid}}
+	}
+
+	deleted := func(uid string) *ports.EventEnvelope {
+		return &ports.EventEnvelope{Event: &events.MicroVMSpecDeleted{ID: "vm-" + uid, Namespace: "default", UID: uid}}
+	}
+
+	type sentEvent struct {
+		Type    models.MicroVMEventType
+		UID     string
+		Version int
+	}
+
+	testCases := []struct {
+		name     string
+		query    models.WatchMicroVMQuery
+		existing []*models.MicroVM
+		events   []*ports.EventEnvelope
+		expect   func(rm *mock.MockMicroVMRepositoryMockRecorder)
+		sent     []sentEvent
+	}{
+		{
+			name:     "existing microvms are added and later versions are modified",
+			query:    models.WatchMicroVMQuery{Namespace: "default"},
+			existing: []*models.MicroVM{watchedVM(uid1, 2, nil)},
+			events:   []*ports.EventEnvelope{updated(uid1), updated(uid1), deleted(uid1)},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {
+				first := rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: uid1}).
+					Return(watchedVM(uid1, 3, nil), nil)
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: uid1}).
+					Return(watchedVM(uid1, 3, nil), nil).After(first)
+			},
+			sent: []sentEvent{
+				{Type: models.MicroVMEventAdded, UID: uid1, Version: 2},
+				{Type: models.MicroVMEventModified, UID: uid1, Version: 3},
+				{Type: models.MicroVMEventDeleted, UID: uid1, Version: 3},
+			},
+		},
+		{
+			name:     "deleted events are matched by uid",
+			query:    models.WatchMicroVMQuery{Namespace: "default"},
+			existing: []*models.MicroVM{watchedVM(uid1, 2, nil)},
+			events: []*ports.EventEnvelope{
+				{Event: &events.MicroVMSpecDeleted{UID: uid2}},
+				{Event: &events.MicroVMSpecDeleted{UID: uid1}},
+			},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {},
+			sent: []sentEvent{
+				{Type: models.MicroVMEventAdded, UID: uid1, Version: 2},
+				{Type: models.MicroVMEventDeleted, UID: uid1, Version: 2},
+			},
+		},
+		{
+			name:     "updates of microvms deleted since are skipped",
+			query:    models.WatchMicroVMQuery{Namespace: "default"},
+			existing: []*models.MicroVM{watchedVM(uid1, 2, nil)},
+			events:   []*ports.EventEnvelope{updated(uid1), deleted(uid1)},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: uid1}).
+					Return(nil, coreerrs.NewSpecNotFound("vm-"+uid1, "default", "", uid1))
+			},
+			sent: []sentEvent{
+				{Type: models.MicroVMEventAdded, UID: uid1, Version: 2},
+				{Type: models.MicroVMEventDeleted, UID: uid1, Version: 2},
+			},
+		},
+		{
+			name:  "events from other namespaces are ignored",
+			query: models.WatchMicroVMQuery{Namespace: "other"},
+			events: []*ports.EventEnvelope{
+				updated(uid1),
+			},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {},
+			sent:   []sentEvent{},
+		},
+		{
+			name: "microvms that stop matching the labels are deleted",
+			query: models.WatchMicroVMQuery{
+				Namespace: "default",
+				Labels:    map[string]string{"role": "worker"},
+			},
+			existing: []*models.MicroVM{
+				watchedVM(uid1, 2, map[string]string{"role": "worker"}),
+				watchedVM(uid2, 2, map[string]string{"role": "control-plane"}),
+			},
+			events: []*ports.EventEnvelope{updated(uid1)},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {
+				rm.Get(gomock.AssignableToTypeOf(context.Background()), ports.RepositoryGetOptions{UID: uid1}).
+					Return(watchedVM(uid1, 3, map[string]string{"role": "control-plane"}), nil)
+			},
+			sent: []sentEvent{
+				{Type: models.MicroVMEventAdded, UID: uid1, Version: 2},
+				{Type: models.MicroVMEventDeleted, UID: uid1, Version: 2},
+			},
+		},
+		{
+			name: "resuming only sends what changed since the versions given",
+			query: models.WatchMicroVMQuery{
+				Namespace:          "default",
+				ResumeFromVersions: map[string]int{uid1: 2, uid2: 2, uid3: 4},
+			},
+			existing: []*models.MicroVM{
+				watchedVM(uid1, 2, nil),
+				watchedVM(uid2, 5, nil),
+			},
+			expect: func(rm *mock.MockMicroVMRepositoryMockRecorder) {},
+			sent: []sentEvent{
+				{Type: models.MicroVMEventModified, UID: uid2, Version: 5},
+				{Type: models.MicroVMEventDeleted, UID: uid3, Version: 4},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			evtCh := make(chan *ports.EventEnvelope, len(tc.events))
+			for _, evt := range tc.events {
+				evtCh <- evt
+			}
+			close(evtCh)
+
+			rm := mock.NewMockMicroVMRepository(mockCtrl)
+			em := mock.NewMockEventService(mockCtrl)
+			ports := &ports.Collection{
+				Repo:         rm,
+				EventService: em,
+			}
+
+			em.EXPECT().
+				SubscribeTopic(gomock.Any(), gomock.Eq(defaults.TopicMicroVMEvents)).
+				Return(evtCh, make(chan error))
+			rm.EXPECT().
+				GetAll(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(models.ListMicroVMQuery{Namespace: tc.query.Namespace})).
+				Return(tc.existing, nil)
+			tc.expect(rm.EXPECT())
+
+			app := application.New(&application.Config{}, ports)
+
+			sent := []sentEvent{}
+			err := app.WatchMicroVMs(context.Background(), tc.query, func(evt *models.MicroVMEvent) error {
+				sent = append(sent, sentEvent{
+					Type:    evt.Type,
+					UID:     evt.MicroVM.ID.UID(),
+					Version: evt.MicroVM.Version,
+				})
+
+				return nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sent).To(ConsistOf(tc.sent))
+		})
+	}
+}
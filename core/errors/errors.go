@@ -23,6 +23,11 @@ var (
 	ErrIfaceNotFound                      = errors.New("network interface not found")
 	ErrMissingStatusInfo                  = errors.New("status is not defined")
 	ErrUnableToBoot                       = errors.New("microvm is unable to boot")
+	ErrUnableToStop                       = errors.New("microvm is unable to stop")
+	ErrUnableToPause                      = errors.New("microvm is unable to pause")
+	ErrGuestNotReady                      = errors.New("guest didn't become ready before the boot deadline")
+	ErrGuestBooting                       = errors.New("guest agent hasn't responded yet")
+	ErrInvalidPageToken                   = errors.New("invalid page token")
 )
 
 // TopicNotFoundError is an error created when a topic with a specific name isn't found.
@@ -145,3 +150,60 @@ func IsNotSupported(err error) bool {
 
 	return errors.As(err, e)
 }
+
+func NewInsufficientCapacity(resource string, requested, free int64) error {
+	return insufficientCapacityError{
+		resource:  resource,
+		requested: requested,
+		free:      free,
+	}
+}
+
+type insufficientCapacityError struct {
+	resource  string
+	requested int64
+	free      int64
+}
+
+// Error returns the error message.
+func (e insufficientCapacityError) Error() string {
+	return fmt.Sprintf("insufficient host capacity for %s: requested %d, free %d", e.resource, e.requested, e.free)
+}
+
+// IsInsufficientCapacity tests an error to see if its an insufficient capacity error.
+func IsInsufficientCapacity(err error) bool {
+	e := &insufficientCapacityError{}
+
+	return errors.As(err, e)
+}
+
+func NewQuotaExceeded(namespace, resource string, requested, used, limit int64) error {
+	return quotaExceededError{
+		namespace: namespace,
+		resource:  resource,
+		requested: requested,
+		used:      used,
+		limit:     limit,
+	}
+}
+
+type quotaExceededError struct {
+	namespace string
+	resource  string
+	requested int64
+	used      int64
+	limit     int64
+}
+
+// Error returns the error message.
+func (e quotaExceededError) Error() string {
+	return fmt.Sprintf("quota of namespace %s exceeded for %s: requested %d, used %d of %d",
+		e.namespace, e.resource, e.requested, e.used, e.limit)
+}
+
+// IsQuotaExceeded tests an error to see if its a quota exceeded error.
+func IsQuotaExceeded(err error) bool {
+	e := &quotaExceededError{}
+
+	return errors.As(err, e)
+}
@@ -22,6 +22,46 @@ const (
 	// VSockCapability indicates the microvm provider supports attaching a vsock device
 	// (used by the guest-agent).
 	VSockCapability Capability = "vsock"
+
+	// PauseCapability indicates the microvm provider supports pausing and resuming
+	// a running microvm.
+	PauseCapability Capability = "pause"
+
+	// SnapshotCapability indicates the microvm provider supports snapshotting a microvm
+	// and restoring a microvm from a snapshot.
+	SnapshotCapability Capability = "snapshot"
+
+	// NetworkQueuesCapability indicates the microvm provider supports network interfaces
+	// with multiple queues.
+	NetworkQueuesCapability Capability = "network-queues"
+
+	// NetworkOffloadsCapability indicates the microvm provider supports setting the
+	// offloads of network interfaces.
+	NetworkOffloadsCapability Capability = "network-offloads"
+
+	// NetworkRxTxRateLimitsCapability indicates the microvm provider supports different
+	// rate limits for the traffic received and transmitted by a network interface.
+	NetworkRxTxRateLimitsCapability Capability = "network-rx-tx-rate-limits"
+
+	// VolumeCacheUnsafeCapability indicates the microvm provider supports volumes that
+	// ignore flushes from the guest.
+	VolumeCacheUnsafeCapability Capability = "volume-cache-unsafe"
+
+	// VolumeCacheDirectCapability indicates the microvm provider supports volumes that
+	// bypass the page cache of the host.
+	VolumeCacheDirectCapability Capability = "volume-cache-direct"
+
+	// LiveMetadataUpdateCapability indicates the microvm provider supports replacing the
+	// metadata of a running microvm.
+	LiveMetadataUpdateCapability Capability = "live-metadata-update"
+
+	// LiveNetworkRateLimitUpdateCapability indicates the microvm provider supports changing the
+	// rate limits of the network interfaces of a running microvm.
+	LiveNetworkRateLimitUpdateCapability Capability = "live-network-rate-limit-update"
+
+	// HotplugCapability indicates the microvm provider supports adding and removing the
+	// network interfaces and volumes of a running microvm.
+	HotplugCapability Capability = "hotplug"
 )
 
 // Capabilities represents a list of capabilities.
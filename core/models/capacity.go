@@ -0,0 +1,62 @@
+package models
+
+// HostResources represents an amount of the compute resources of a host.
+type HostResources struct {
+	// VCPU is the number of vcpus.
+	VCPU int64 `json:"vcpu"`
+	// MemoryInMb is the amount of memory in megabytes.
+	MemoryInMb int64 `json:"memory_inmb"`
+	// DiskInMb is the amount of disk space in megabytes.
+	DiskInMb int64 `json:"disk_inmb"`
+}
+
+// Add returns the sum of the resources.
+func (r HostResources) Add(other HostResources) HostResources {
+	return HostResources{
+		VCPU:       r.VCPU + other.VCPU,
+		MemoryInMb: r.MemoryInMb + other.MemoryInMb,
+		DiskInMb:   r.DiskInMb + other.DiskInMb,
+	}
+}
+
+// Sub returns the resources minus other. The result can be negative if the
+// host is overcommitted.
+func (r HostResources) Sub(other HostResources) HostResources {
+	return HostResources{
+		VCPU:       r.VCPU - other.VCPU,
+		MemoryInMb: r.MemoryInMb - other.MemoryInMb,
+		DiskInMb:   r.DiskInMb - other.DiskInMb,
+	}
+}
+
+// HostCapacity represents the capacity of a host for running microvms.
+type HostCapacity struct {
+	// Total is the resources that can be allocated to microvms, after the reserved
+	// resources are removed and the overcommit ratios are applied.
+	Total HostResources `json:"total"`
+	// Allocated is the resources requested by the existing microvms.
+	Allocated HostResources `json:"allocated"`
+	// Free is the resources that are still available for new microvms.
+	Free HostResources `json:"free"`
+}
+
+// Resources returns the resources that the microvm spec requests from the host. The
+// disk size only includes volumes with an explicit size.
+func (s *MicroVMSpec) Resources() HostResources {
+	resources := HostResources{
+		VCPU:       s.VCPU,
+		MemoryInMb: s.MemoryInMb,
+	}
+
+	if s.RootVolume.Size > 0 {
+		resources.DiskInMb += int64(s.RootVolume.Size)
+	}
+
+	for _, vol := range s.AdditionalVolumes {
+		if vol.Size > 0 {
+			resources.DiskInMb += int64(vol.Size)
+		}
+	}
+
+	return resources
+}
@@ -0,0 +1,82 @@
+package models
+
+// ConditionType is the type of a microvm condition.
+type ConditionType string
+
+const (
+	// ConditionImagesReady indicates the kernel, initrd and volume images are mounted.
+	ConditionImagesReady ConditionType = "ImagesReady"
+	// ConditionNetworkReady indicates the host network interfaces have been created.
+	ConditionNetworkReady ConditionType = "NetworkReady"
+	// ConditionVMMRunning indicates the vmm process for the microvm is running.
+	ConditionVMMRunning ConditionType = "VMMRunning"
+	// ConditionGuestReady indicates the guest has reported it's ready.
+	ConditionGuestReady ConditionType = "GuestReady"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	ConditionStatusTrue    ConditionStatus = "True"
+	ConditionStatusFalse   ConditionStatus = "False"
+	ConditionStatusUnknown ConditionStatus = "Unknown"
+)
+
+// Condition describes the state of one aspect of a microvm.
+type Condition struct {
+	// Type is the type of the condition.
+	Type ConditionType `json:"type"`
+	// Status is the status of the condition.
+	Status ConditionStatus `json:"status"`
+	// Reason is a short, machine readable reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable message with details about the transition.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the time the condition last changed status.
+	LastTransitionTime int64 `json:"last_transition_time"`
+}
+
+// Conditions represents a collection of conditions.
+type Conditions []Condition
+
+// Get will get a condition by type. It returns nil if the condition isn't set.
+func (c Conditions) Get(condType ConditionType) *Condition {
+	for i := range c {
+		if c[i].Type == condType {
+			return &c[i]
+		}
+	}
+
+	return nil
+}
+
+// Set will add or update a condition. The transition time is only changed when the status changes.
+func (c Conditions) Set(condition Condition) Conditions {
+	existing := c.Get(condition.Type)
+	if existing == nil {
+		return append(c, condition)
+	}
+
+	if existing.Status == condition.Status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+
+	*existing = condition
+
+	return c
+}
+
+// StepError is an error from a step of a reconciliation plan.
+type StepError struct {
+	// Step is the name of the step that failed.
+	Step string `json:"step"`
+	// Condition is the condition the failure is reported against, if the step reports one.
+	Condition ConditionType `json:"condition,omitempty"`
+	// Message is the error message.
+	Message string `json:"message"`
+	// ExecutionID is the identifier of the plan execution the step was part of.
+	ExecutionID string `json:"execution_id"`
+	// OccurredAt is the time the error occurred.
+	OccurredAt int64 `json:"occurred_at"`
+}
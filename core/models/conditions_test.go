@@ -0,0 +1,41 @@
+package models_test
+
+import (
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+func TestConditions_Set(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	conditions := models.Conditions{}
+	conditions = conditions.Set(models.Condition{
+		Type:               models.ConditionVMMRunning,
+		Status:             models.ConditionStatusFalse,
+		Reason:             "NotStarted",
+		LastTransitionTime: 1,
+	})
+	g.Expect(conditions).To(g.HaveLen(1))
+
+	conditions = conditions.Set(models.Condition{
+		Type:               models.ConditionVMMRunning,
+		Status:             models.ConditionStatusFalse,
+		Reason:             "Stopped",
+		LastTransitionTime: 2,
+	})
+	g.Expect(conditions).To(g.HaveLen(1))
+	g.Expect(conditions.Get(models.ConditionVMMRunning).Reason).To(g.Equal("Stopped"))
+	g.Expect(conditions.Get(models.ConditionVMMRunning).LastTransitionTime).To(g.Equal(int64(1)))
+
+	conditions = conditions.Set(models.Condition{
+		Type:               models.ConditionVMMRunning,
+		Status:             models.ConditionStatusTrue,
+		Reason:             "Running",
+		LastTransitionTime: 3,
+	})
+	g.Expect(conditions.Get(models.ConditionVMMRunning).LastTransitionTime).To(g.Equal(int64(3)))
+	g.Expect(conditions.Get(models.ConditionGuestReady)).To(g.BeNil())
+}
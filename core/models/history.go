@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// StepOutcome is the outcome of a step when a plan was executed.
+type StepOutcome string
+
+const (
+	// StepOutcomeSucceeded means the step was run and verified.
+	StepOutcomeSucceeded StepOutcome = "succeeded"
+	// StepOutcomeSkipped means ShouldDo reported there was nothing for the step to do.
+	StepOutcomeSkipped StepOutcome = "skipped"
+	// StepOutcomeShouldDoFailed means checking if the step should be run failed.
+	StepOutcomeShouldDoFailed StepOutcome = "should_do_failed"
+	// StepOutcomeDoFailed means running the step failed.
+	StepOutcomeDoFailed StepOutcome = "do_failed"
+	// StepOutcomeVerifyFailed means the step ran but verifying it failed.
+	StepOutcomeVerifyFailed StepOutcome = "verify_failed"
+)
+
+// PlanExecution is a record of a plan being executed against a microvm.
+type PlanExecution struct {
+	// ID is the identifier of the execution.
+	ID string `json:"id"`
+	// Plan is the name of the plan that was executed.
+	Plan string `json:"plan"`
+	// StartedAt is the time the execution started.
+	StartedAt int64 `json:"started_at"`
+	// FinishedAt is the time the execution finished.
+	FinishedAt int64 `json:"finished_at"`
+	// Steps are the steps that were run, in the order they were run.
+	Steps []StepExecution `json:"steps"`
+	// Error is the error the execution failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// StepsExecuted returns the number of steps that were run, i.e. not skipped.
+func (p *PlanExecution) StepsExecuted() int {
+	count := 0
+
+	for _, step := range p.Steps {
+		if step.Outcome != StepOutcomeSkipped && step.Outcome != StepOutcomeShouldDoFailed {
+			count++
+		}
+	}
+
+	return count
+}
+
+// StepExecution is a record of a single step of a plan execution.
+type StepExecution struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+	// Outcome is the outcome of the step.
+	Outcome StepOutcome `json:"outcome"`
+	// Duration is how long the step took.
+	Duration time.Duration `json:"duration"`
+	// Error is the error the step failed with, if any.
+	Error string `json:"error,omitempty"`
+}
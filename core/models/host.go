@@ -0,0 +1,66 @@
+package models
+
+// HostInfo represents the details of a flintlock host.
+type HostInfo struct {
+	// Version is the version of flintlock running on the host.
+	Version FlintlockVersion `json:"version"`
+	// Providers are the microvm providers the host offers.
+	Providers []ProviderInfo `json:"providers"`
+	// DefaultProvider is the name of the provider used when a microvm doesn't specify one.
+	DefaultProvider string `json:"default_provider"`
+	// KernelVersion is the version of the host kernel.
+	KernelVersion string `json:"kernel_version"`
+	// CPU is the details of the host cpu.
+	CPU CPUInfo `json:"cpu"`
+	// Network is the network configuration of the host.
+	Network HostNetworkInfo `json:"network"`
+	// Containerd is the containerd configuration of the host.
+	Containerd ContainerdInfo `json:"containerd"`
+}
+
+// FlintlockVersion represents the version of flintlock.
+type FlintlockVersion struct {
+	Version    string `json:"version"`
+	CommitHash string `json:"commit_hash"`
+	BuildDate  string `json:"build_date"`
+}
+
+// ProviderInfo represents a microvm provider available on a host.
+type ProviderInfo struct {
+	// Name is the name of the provider.
+	Name string `json:"name"`
+	// Capabilities are the capabilities the provider supports.
+	Capabilities Capabilities `json:"capabilities"`
+	// VMMVersion is the version reported by the provider's VMM binary.
+	VMMVersion string `json:"vmm_version"`
+}
+
+// CPUInfo represents the details of the host cpu.
+type CPUInfo struct {
+	// Model is the model name of the cpu.
+	Model string `json:"model"`
+	// Flags are the feature flags of the cpu.
+	Flags []string `json:"flags"`
+	// KVMAvailable indicates if /dev/kvm is present on the host.
+	KVMAvailable bool `json:"kvm_available"`
+	// NestedVirtualization indicates if kvm has nested virtualization enabled.
+	NestedVirtualization bool `json:"nested_virtualization"`
+}
+
+// HostNetworkInfo represents the network configuration of the host.
+type HostNetworkInfo struct {
+	// BridgeName is the name of the default bridge tap interfaces are attached to.
+	BridgeName string `json:"bridge_name"`
+	// ParentInterface is the name of the default parent interface for macvtap interfaces.
+	ParentInterface string `json:"parent_interface"`
+}
+
+// ContainerdInfo represents the containerd configuration of the host.
+type ContainerdInfo struct {
+	// Namespace is the containerd namespace flintlock uses.
+	Namespace string `json:"namespace"`
+	// KernelSnapshotter is the snapshotter used for kernel images.
+	KernelSnapshotter string `json:"kernel_snapshotter"`
+	// VolumeSnapshotter is the snapshotter used for volume images.
+	VolumeSnapshotter string `json:"volume_snapshotter"`
+}
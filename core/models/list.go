@@ -0,0 +1,128 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+)
+
+// ListMicroVMOrder is the field microvms are sorted by when listed.
+type ListMicroVMOrder string
+
+const (
+	// OrderByName sorts microvms by their namespace and then their name.
+	OrderByName ListMicroVMOrder = "name"
+	// OrderByCreatedAt sorts microvms by the time they were created.
+	OrderByCreatedAt ListMicroVMOrder = "created_at"
+)
+
+// ListMicroVMQuery is the query used to list microvms.
+type ListMicroVMQuery struct {
+	// Namespace is the namespace of the microvms. If empty microvms from all namespaces are listed.
+	Namespace string
+	// Name optionally restricts the microvms to ones with this name.
+	Name string
+	// Selector restricts the microvms to ones whose labels match.
+	Selector LabelSelector
+	// States restricts the microvms to ones in any of these states. If empty microvms in
+	// any state are listed.
+	States []MicroVMState
+	// OrderBy is the field to sort the microvms by, defaults to the name.
+	OrderBy ListMicroVMOrder
+	// Descending reverses the sort order.
+	Descending bool
+	// PageSize is the maximum number of microvms to list. Zero means no limit.
+	PageSize int
+	// PageToken is the token from the previous page to carry on listing from.
+	PageToken string
+}
+
+// MatchesState returns true if the state is one of the states of the query.
+func (q ListMicroVMQuery) MatchesState(state MicroVMState) bool {
+	if len(q.States) == 0 {
+		return true
+	}
+
+	for _, s := range q.States {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SortKey returns the key of a microvm that the query sorts on. Microvms with the same
+// key are then sorted by their uid.
+func (q ListMicroVMQuery) SortKey(namespace, name string, createdAt int64) string {
+	if q.OrderBy == OrderByCreatedAt {
+		// Zero padded so the keys sort in time order as strings.
+		return fmt.Sprintf("%020d", createdAt)
+	}
+
+	return namespace + "/" + name
+}
+
+// NextPageToken returns the page token for the page after the supplied microvms, or an
+// empty string if there's no more to list.
+func (q ListMicroVMQuery) NextPageToken(mvms []*MicroVM) string {
+	if q.PageSize <= 0 || len(mvms) < q.PageSize {
+		return ""
+	}
+
+	last := mvms[len(mvms)-1]
+
+	return EncodePageToken(PageCursor{
+		Key: q.SortKey(last.ID.Namespace(), last.ID.Name(), last.Spec.CreatedAt),
+		UID: last.ID.UID(),
+	})
+}
+
+// PageCursor is the position in a sorted list of microvms that a page token refers to.
+type PageCursor struct {
+	// Key is the sort key of the last microvm on the previous page.
+	Key string `json:"k"`
+	// UID is the uid of the last microvm on the previous page.
+	UID string `json:"u"`
+}
+
+// After returns true if a microvm with the sort key and uid comes after the cursor.
+func (c PageCursor) After(key, uid string, descending bool) bool {
+	if key == c.Key {
+		if descending {
+			return uid < c.UID
+		}
+
+		return uid > c.UID
+	}
+
+	if descending {
+		return key < c.Key
+	}
+
+	return key > c.Key
+}
+
+// EncodePageToken encodes a cursor as an opaque page token.
+func EncodePageToken(cursor PageCursor) string {
+	data, _ := json.Marshal(cursor) //nolint:errchkjson // marshalling strings can't fail
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken decodes a page token created by EncodePageToken.
+func DecodePageToken(token string) (*PageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, coreerrs.ErrInvalidPageToken
+	}
+
+	cursor := &PageCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, coreerrs.ErrInvalidPageToken
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,63 @@
+package models_test
+
+import (
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+func TestListMicroVMQuery_MatchesState(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	g.Expect(models.ListMicroVMQuery{}.MatchesState(models.FailedState)).To(g.BeTrue())
+
+	query := models.ListMicroVMQuery{States: []models.MicroVMState{models.CreatedState, models.StoppedState}}
+	g.Expect(query.MatchesState(models.StoppedState)).To(g.BeTrue())
+	g.Expect(query.MatchesState(models.FailedState)).To(g.BeFalse())
+}
+
+func TestListMicroVMQuery_NextPageToken(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	vmid1, _ := models.NewVMID("mvm1", "default", "uid1")
+	vmid2, _ := models.NewVMID("mvm2", "default", "uid2")
+	mvms := []*models.MicroVM{{ID: *vmid1}, {ID: *vmid2}}
+
+	g.Expect(models.ListMicroVMQuery{}.NextPageToken(mvms)).To(g.BeEmpty())
+	g.Expect(models.ListMicroVMQuery{PageSize: 3}.NextPageToken(mvms)).To(g.BeEmpty())
+
+	token := models.ListMicroVMQuery{PageSize: 2}.NextPageToken(mvms)
+	g.Expect(token).NotTo(g.BeEmpty())
+
+	cursor, err := models.DecodePageToken(token)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(cursor).To(g.Equal(&models.PageCursor{Key: "default/mvm2", UID: "uid2"}))
+}
+
+func TestPageCursor_After(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	cursor := models.PageCursor{Key: "default/mvm2", UID: "uid2"}
+
+	g.Expect(cursor.After("default/mvm3", "uid1", false)).To(g.BeTrue())
+	g.Expect(cursor.After("default/mvm2", "uid3", false)).To(g.BeTrue())
+	g.Expect(cursor.After("default/mvm2", "uid2", false)).To(g.BeFalse())
+	g.Expect(cursor.After("default/mvm1", "uid3", false)).To(g.BeFalse())
+
+	g.Expect(cursor.After("default/mvm1", "uid3", true)).To(g.BeTrue())
+	g.Expect(cursor.After("default/mvm2", "uid1", true)).To(g.BeTrue())
+	g.Expect(cursor.After("default/mvm3", "uid1", true)).To(g.BeFalse())
+}
+
+func TestDecodePageToken_Invalid(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	_, err := models.DecodePageToken("not a token!")
+	g.Expect(err).To(g.MatchError(coreerrs.ErrInvalidPageToken))
+
+	_, err = models.DecodePageToken("bm90IGpzb24")
+	g.Expect(err).To(g.MatchError(coreerrs.ErrInvalidPageToken))
+}
@@ -9,6 +9,20 @@ const (
 	CreatedState  = "created"
 	FailedState   = "failed"
 	DeletingState = "deleting"
+	StoppedState  = "stopped"
+	PausedState   = "paused"
+)
+
+// PowerState is the desired power state of a microvm.
+type PowerState string
+
+const (
+	// PowerStateRunning indicates the microvm should be running.
+	PowerStateRunning PowerState = "running"
+	// PowerStateStopped indicates the microvm should be stopped but kept.
+	PowerStateStopped PowerState = "stopped"
+	// PowerStatePaused indicates the microvm should be paused.
+	PowerStatePaused PowerState = "paused"
 )
 
 // MicroVM represents a microvm machine that is created via a provider.
@@ -27,6 +41,8 @@ type MicroVM struct {
 type MicroVMSpec struct {
 	// Provider specifies the name of the microvm provider to use.
 	Provider string `json:"provider"`
+	// Labels allows you to include extra data for the microvm.
+	Labels map[string]string `json:"labels,omitempty"`
 	// Kernel specifies the kernel and its argments to use.
 	Kernel Kernel `json:"kernel" validate:"omitempty"`
 	// Initrd is an optional initial ramdisk to use.
@@ -40,13 +56,17 @@ type MicroVMSpec struct {
 	// RootVolume specified the root volume to be attached to the machine.
 	RootVolume Volume `json:"root_volume" validate:"required,novirtiofs"`
 	// AdditionalVolumes specifies the volumes to be attached to the machine.
-	AdditionalVolumes Volumes `json:"additional_volumes" validate:"onlyOneVirtioFS,multipleVolSources"`
+	AdditionalVolumes Volumes `json:"additional_volumes" validate:"onlyOneVirtioFS,multipleVolSources,dive"`
 	// Metadata allows you to specify data to be added to the metadata service. The key is the name
 	// of the metadata item and the value is the base64 encoded contents of the metadata.
 	Metadata map[string]string `json:"metadata"`
 	// AllowGuestAgent, when true, attaches a vsock device so the in-guest guest-agent
 	// can communicate with the host.
 	AllowGuestAgent bool `json:"allow_guest_agent"`
+	// PowerState is the desired power state of the microvm. An empty value means running.
+	PowerState PowerState `json:"power_state,omitempty" validate:"omitempty,oneof=running stopped paused"`
+	// RestoreFromSnapshot is the UID of a snapshot to restore the microvm from instead of booting it.
+	RestoreFromSnapshot string `json:"restore_from_snapshot,omitempty"`
 	// CreatedAt indicates the time the microvm was created at.
 	CreatedAt int64 `json:"created_at" validate:"omitempty,datetimeInPast"`
 	// UpdatedAt indicates the time the microvm was last updated.
@@ -74,6 +94,43 @@ type MicroVMStatus struct {
 	// VSockPath is the host unix-domain socket path for the guest-agent vsock device.
 	// Empty unless the spec has AllowGuestAgent set.
 	VSockPath string `json:"vsock_path"`
+	// NetworkNamespace is the name of the network namespace the network interfaces and vmm
+	// process of the microvm are isolated in. Empty if they're in the namespace of the host.
+	NetworkNamespace string `json:"network_namespace,omitempty"`
+	// DeviceUpdatePending is set when network interfaces or volumes have been added to or
+	// removed from the spec and they haven't yet been hotplugged into the running microvm.
+	DeviceUpdatePending bool `json:"device_update_pending,omitempty"`
+	// MetadataUpdatePending is set when only the metadata of the spec has been updated and
+	// it hasn't yet been applied to the running microvm.
+	MetadataUpdatePending bool `json:"metadata_update_pending,omitempty"`
+	// RateLimitUpdatePending is set when only the rate limits of the network interfaces have
+	// been updated and they haven't yet been applied to the running microvm.
+	RateLimitUpdatePending bool `json:"rate_limit_update_pending,omitempty"`
+	// RestartPending is set when a restart of the microvm has been requested and
+	// hasn't been done yet.
+	RestartPending bool `json:"restart_pending"`
+	// Conditions describe the readiness of the different parts of the microvm.
+	Conditions Conditions `json:"conditions,omitempty"`
+	// LastError is the error from the last failed reconciliation. It's cleared when
+	// a reconciliation succeeds.
+	LastError *StepError `json:"last_error,omitempty"`
+	// StepErrors holds the last error from each step that has failed, keyed by step name.
+	StepErrors map[string]StepError `json:"step_errors,omitempty"`
+	// ExecutionID is the identifier of the last plan execution.
+	ExecutionID string `json:"execution_id,omitempty"`
+	// Restored is set once the microvm has been restored from the snapshot in its spec. It's
+	// created from its spec after that, so it's cold booted if it's stopped and started.
+	Restored bool `json:"restored,omitempty"`
+	// GuestReadyAt is when the guest agent first responded after the microvm was last booted,
+	// as a unix timestamp. It's zero until the guest is up.
+	GuestReadyAt int64 `json:"guest_ready_at,omitempty"`
+	// GuestBootedAt is when the microvm was last booted, as a unix timestamp. The guest boot
+	// deadline is counted from it.
+	GuestBootedAt int64 `json:"guest_booted_at,omitempty"`
+	// GuestUnresponsive is set when the guest agent stopped responding after the guest booted.
+	// It's cleared when the guest agent responds again. Only these changes are saved, not every
+	// heartbeat, so checking the guest doesn't write a new version of the spec.
+	GuestUnresponsive bool `json:"guest_unresponsive,omitempty"`
 }
 
 type Initrd struct {
@@ -83,8 +140,15 @@ type Initrd struct {
 	Filename string
 }
 
+// IsStopped returns true if the microvm should be stopped.
+func (s *MicroVMSpec) IsStopped() bool {
+	return s.PowerState == PowerStateStopped
+}
+
+// IsPaused returns true if the microvm should be paused.
+func (s *MicroVMSpec) IsPaused() bool {
+	return s.PowerState == PowerStatePaused
+}
+
 // ContainerImage represents the address of a OCI image.
 type ContainerImage string
-
-// ListMicroVMQuery is a key-value map to query microvms.
-type ListMicroVMQuery map[string]string
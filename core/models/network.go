@@ -25,8 +25,115 @@ type NetworkInterface struct {
 	StaticAddress *StaticAddress `json:"staticAddrss,omitempty"`
 	// BridgeName is the name of the Linux bridge to attach the TAP device to.
 	BridgeName string `json:"branch_name,omitempty"`
+	// NetworkName is the name of a network defined in the flintlockd config to attach the
+	// interface to. The network sets the bridge or parent device, VLAN and MTU of the interface.
+	NetworkName string `json:"network_name,omitempty"`
+	// VLANID is the VLAN to put the interface on, overriding the VLAN of its network. A TAP is
+	// attached to its VLAN filtering bridge with the VLAN as its PVID, and a MACVTAP is created
+	// on a VLAN sub-interface of its parent device.
+	VLANID uint16 `json:"vlan_id,omitempty" validate:"omitempty,max=4094"`
+	// MTU is the MTU of the interface in the guest and of its host device, overriding the MTU
+	// of its network.
+	MTU int `json:"mtu,omitempty" validate:"omitempty,min=68,max=65535"`
+	// NumQueues is the number of queue pairs of the interface. Multiple queues let the guest
+	// spread the traffic of the interface over its vCPUs.
+	NumQueues int `json:"num_queues,omitempty" validate:"omitempty,min=1,max=128"`
+	// Offloads are the offloads of the interface. If not supplied the defaults of the provider
+	// are used.
+	Offloads *NetworkOffloads `json:"offloads,omitempty"`
+	// RateLimits are the rate limits of the traffic of the interface. They can be changed on
+	// an existing microvm.
+	RateLimits *NetworkRateLimits `json:"rate_limits,omitempty" validate:"omitempty"`
+	// Firewall is an optional firewall for the traffic to and from the interface. It's only
+	// supported on TAP interfaces with a GuestMAC.
+	Firewall *Firewall `json:"firewall,omitempty" validate:"omitempty"`
 }
 
+// GuestMACAddress returns the MAC address of the interface in the guest. It's the GuestMAC if
+// one was supplied, otherwise the MAC address of the macvtap device or the one generated for
+// the tap device, which are in the status.
+func (n *NetworkInterface) GuestMACAddress(status *NetworkInterfaceStatus) string {
+	if n.GuestMAC != "" || status == nil {
+		return n.GuestMAC
+	}
+
+	if n.Type == IfaceTypeMacvtap {
+		return status.MACAddress
+	}
+
+	return status.GuestMACAddress
+}
+
+// GuestAddress returns the address of the interface in the guest. It's the StaticAddress if
+// one was supplied, otherwise the address allocated from the pool of its bridge, if any.
+func (n *NetworkInterface) GuestAddress(status *NetworkInterfaceStatus) *StaticAddress {
+	if n.StaticAddress != nil || status == nil {
+		return n.StaticAddress
+	}
+
+	return status.AllocatedAddress
+}
+
+// NetworkOffloads are the offloads of a network interface. Offloads that aren't set use the
+// defaults of the provider.
+type NetworkOffloads struct {
+	// TSO enables TCP segmentation offload.
+	TSO *bool `json:"tso,omitempty"`
+	// UFO enables UDP fragmentation offload.
+	UFO *bool `json:"ufo,omitempty"`
+	// Checksum enables checksum offload.
+	Checksum *bool `json:"checksum,omitempty"`
+}
+
+// NetworkRateLimits are the rate limits of a network interface.
+type NetworkRateLimits struct {
+	// Rx limits the traffic received by the guest.
+	Rx *RateLimit `json:"rx,omitempty" validate:"omitempty"`
+	// Tx limits the traffic transmitted by the guest.
+	Tx *RateLimit `json:"tx,omitempty" validate:"omitempty"`
+}
+
+// Firewall is the firewall of a network interface.
+type Firewall struct {
+	// Ingress are the rules allowing traffic to the guest. If there are any, traffic to the
+	// guest that doesn't match one, or isn't a reply, is dropped.
+	Ingress []FirewallRule `json:"ingress,omitempty" validate:"omitempty,dive"`
+	// Egress are the rules allowing traffic from the guest. If there are any, traffic from the
+	// guest that doesn't match one, or isn't a reply, is dropped.
+	Egress []FirewallRule `json:"egress,omitempty" validate:"omitempty,dive"`
+	// DisableAntiSpoofing turns off dropping traffic from the guest with a source MAC or IP
+	// address other than the ones assigned to the interface.
+	DisableAntiSpoofing bool `json:"disable_anti_spoofing,omitempty"`
+}
+
+// FirewallRule allows traffic to or from a guest.
+type FirewallRule struct {
+	// CIDR is the address range of the other end of the traffic. If not supplied any address
+	// matches.
+	CIDR string `json:"cidr,omitempty" validate:"omitempty,cidr"`
+	// Protocol is the protocol of the traffic. If not supplied any protocol matches.
+	Protocol FirewallProtocol `json:"protocol,omitempty" validate:"omitempty,oneof=tcp udp icmp"`
+	// Port is the destination port of the traffic, or the first port of a range if EndPort
+	// is set. It can only be used with tcp or udp.
+	Port uint16 `json:"port,omitempty"`
+	// EndPort is the last destination port of a range starting at Port.
+	EndPort uint16 `json:"end_port,omitempty" validate:"omitempty,gtefield=Port"`
+}
+
+// FirewallProtocol is the protocol a firewall rule matches.
+type FirewallProtocol string
+
+const (
+	// FirewallProtocolAny matches any protocol.
+	FirewallProtocolAny FirewallProtocol = ""
+	// FirewallProtocolTCP matches TCP.
+	FirewallProtocolTCP FirewallProtocol = "tcp"
+	// FirewallProtocolUDP matches UDP.
+	FirewallProtocolUDP FirewallProtocol = "udp"
+	// FirewallProtocolICMP matches ICMP, or ICMPv6 for IPv6 traffic.
+	FirewallProtocolICMP FirewallProtocol = "icmp"
+)
+
 // StaticAddress specifies a static IP address configuration.
 type StaticAddress struct {
 	// Address is the static IP address (IPv4 or IPv6) to assign to this interface.
@@ -75,6 +182,22 @@ type NetworkInterfaceStatus struct {
 	Index int `json:"index"`
 	// MACAddress is the MAC address of the host interface.
 	MACAddress string `json:"mac_address"`
+	// GuestMACAddress is the MAC address generated for the guest end of a tap interface that
+	// doesn't have a GuestMAC.
+	GuestMACAddress string `json:"guest_mac_address,omitempty"`
+	// BridgeName is the name of the bridge the tap device is attached to, if any.
+	BridgeName string `json:"bridge_name,omitempty"`
+	// AllocatedAddress is the address allocated to the interface from the pool of its bridge,
+	// if it doesn't have a static address.
+	AllocatedAddress *StaticAddress `json:"allocated_address,omitempty"`
+	// AddressPool is the name of the pool the address was allocated from.
+	AddressPool string `json:"address_pool,omitempty"`
+	// LeasedMACAddress is the guest MAC address the embedded DHCP server has a lease for.
+	LeasedMACAddress string `json:"leased_mac_address,omitempty"`
+	// FirewallDevice is the name of the host device the firewall rules were applied to.
+	FirewallDevice string `json:"firewall_device,omitempty"`
+	// MTU is the MTU of the host interface, which is set by the interface or its network.
+	MTU int `json:"mtu,omitempty"`
 }
 
 // NetworkInterfaceStatuses is a collection of network interfaces.
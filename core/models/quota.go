@@ -0,0 +1,74 @@
+package models
+
+// QuotaResources represents an amount of the resources of a namespace that can be limited by a quota.
+type QuotaResources struct {
+	// MicroVMs is the number of microvms.
+	MicroVMs int64 `json:"microvms" validate:"gte=0"`
+	// VCPU is the number of vcpus.
+	VCPU int64 `json:"vcpu" validate:"gte=0"`
+	// MemoryInMb is the amount of memory in megabytes.
+	MemoryInMb int64 `json:"memory_inmb" validate:"gte=0"`
+	// DiskInMb is the total size of the volumes in megabytes.
+	DiskInMb int64 `json:"disk_inmb" validate:"gte=0"`
+	// NetworkInterfaces is the number of network interfaces.
+	NetworkInterfaces int64 `json:"network_interfaces" validate:"gte=0"`
+}
+
+// Add returns the sum of the resources.
+func (r QuotaResources) Add(other QuotaResources) QuotaResources {
+	return QuotaResources{
+		MicroVMs:          r.MicroVMs + other.MicroVMs,
+		VCPU:              r.VCPU + other.VCPU,
+		MemoryInMb:        r.MemoryInMb + other.MemoryInMb,
+		DiskInMb:          r.DiskInMb + other.DiskInMb,
+		NetworkInterfaces: r.NetworkInterfaces + other.NetworkInterfaces,
+	}
+}
+
+// Sub returns the resources minus other.
+func (r QuotaResources) Sub(other QuotaResources) QuotaResources {
+	return QuotaResources{
+		MicroVMs:          r.MicroVMs - other.MicroVMs,
+		VCPU:              r.VCPU - other.VCPU,
+		MemoryInMb:        r.MemoryInMb - other.MemoryInMb,
+		DiskInMb:          r.DiskInMb - other.DiskInMb,
+		NetworkInterfaces: r.NetworkInterfaces - other.NetworkInterfaces,
+	}
+}
+
+// NamespaceQuota represents the limits on the resources the microvms in a namespace can use
+// between them. A limit of 0 means the resource isn't limited.
+type NamespaceQuota struct {
+	// Namespace is the namespace the quota is for.
+	Namespace string `json:"namespace" validate:"required"`
+	// Limits is the most of each resource the microvms in the namespace can use.
+	Limits QuotaResources `json:"limits"`
+}
+
+// NamespaceQuotaStatus represents the quota of a namespace and the resources the namespace uses.
+type NamespaceQuotaStatus struct {
+	// Quota is the quota of the namespace.
+	Quota NamespaceQuota `json:"quota"`
+	// Used is the resources used by the microvms in the namespace.
+	Used QuotaResources `json:"used"`
+}
+
+// QuotaResources returns the resources of a namespace that the microvm spec uses. Interfaces
+// that only allow metadata requests aren't counted as they don't connect to a network.
+func (s *MicroVMSpec) QuotaResources() QuotaResources {
+	resources := s.Resources()
+	quotaResources := QuotaResources{
+		MicroVMs:   1,
+		VCPU:       resources.VCPU,
+		MemoryInMb: resources.MemoryInMb,
+		DiskInMb:   resources.DiskInMb,
+	}
+
+	for _, iface := range s.NetworkInterfaces {
+		if !iface.AllowMetadataRequests {
+			quotaResources.NetworkInterfaces++
+		}
+	}
+
+	return quotaResources
+}
@@ -0,0 +1,21 @@
+package models
+
+// RateLimit limits the bandwidth and operations of a device with token buckets. A limit
+// that isn't supplied isn't enforced.
+type RateLimit struct {
+	// Bandwidth limits the bytes per refill time.
+	Bandwidth *TokenBucket `json:"bandwidth,omitempty" validate:"omitempty"`
+	// Ops limits the operations per refill time.
+	Ops *TokenBucket `json:"ops,omitempty" validate:"omitempty"`
+}
+
+// TokenBucket is a bucket of tokens, bytes or operations, that's refilled at a constant
+// rate. Once the bucket is empty, consumption is limited to the refill rate.
+type TokenBucket struct {
+	// Size is the number of tokens the bucket holds.
+	Size int64 `json:"size" validate:"required,gte=1"`
+	// OneTimeBurst is the number of extra tokens available once at the start.
+	OneTimeBurst int64 `json:"one_time_burst,omitempty" validate:"omitempty,gte=0"`
+	// RefillTimeMs is the time in milliseconds it takes to refill the bucket.
+	RefillTimeMs int64 `json:"refill_time_ms" validate:"required,gte=1"`
+}
@@ -0,0 +1,216 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SelectorOperator is the operator of a label selector requirement.
+type SelectorOperator string
+
+const (
+	// SelectorOpEquals matches labels with the key set to the value.
+	SelectorOpEquals SelectorOperator = "="
+	// SelectorOpNotEquals matches labels without the key set to the value, including
+	// when the key isn't set.
+	SelectorOpNotEquals SelectorOperator = "!="
+	// SelectorOpIn matches labels with the key set to one of the values.
+	SelectorOpIn SelectorOperator = "in"
+	// SelectorOpNotIn matches labels without the key set to one of the values, including
+	// when the key isn't set.
+	SelectorOpNotIn SelectorOperator = "notin"
+	// SelectorOpExists matches labels with the key set.
+	SelectorOpExists SelectorOperator = "exists"
+	// SelectorOpDoesNotExist matches labels without the key set.
+	SelectorOpDoesNotExist SelectorOperator = "!"
+)
+
+var (
+	errSelectorKeyRequired = errors.New("label selector requirement has no key")
+	errSelectorBadSet      = errors.New("label selector set must be in parentheses")
+	errSelectorUnbalanced  = errors.New("label selector has unbalanced parentheses")
+)
+
+// LabelSelector selects microvms by their labels. All the requirements must match.
+type LabelSelector []LabelRequirement
+
+// LabelRequirement is a single requirement of a label selector.
+type LabelRequirement struct {
+	// Key is the label key.
+	Key string
+	// Operator is how the label value is compared to the values.
+	Operator SelectorOperator
+	// Values are the values to compare to. There's a single value for the equality
+	// operators and none for the existence operators.
+	Values []string
+}
+
+// ParseLabelSelector parses a Kubernetes style label selector, for example
+// "env=prod,tier!=db,zone in (a,b),!legacy". An empty selector matches everything.
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	parts, err := splitSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := LabelSelector{}
+
+	for _, part := range parts {
+		requirement, err := parseRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("parsing label selector requirement %q: %w", part, err)
+		}
+
+		parsed = append(parsed, requirement)
+	}
+
+	return parsed, nil
+}
+
+// Matches returns true if the labels match all the requirements of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Matches returns true if the labels match the requirement.
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case SelectorOpEquals, SelectorOpIn:
+		return ok && r.hasValue(value)
+	case SelectorOpNotEquals, SelectorOpNotIn:
+		return !ok || !r.hasValue(value)
+	case SelectorOpExists:
+		return ok
+	case SelectorOpDoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (r LabelRequirement) hasValue(value string) bool {
+	for _, v := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitSelector splits a selector into its requirements on the commas that aren't
+// inside a set.
+func splitSelector(selector string) ([]string, error) {
+	parts := []string{}
+	depth := 0
+	start := 0
+
+	for i, char := range selector {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errSelectorUnbalanced
+			}
+		case ',':
+			if depth == 0 {
+				parts = appendNotEmpty(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, errSelectorUnbalanced
+	}
+
+	return appendNotEmpty(parts, selector[start:]), nil
+}
+
+func appendNotEmpty(parts []string, part string) []string {
+	part = strings.TrimSpace(part)
+	if part == "" {
+		return parts
+	}
+
+	return append(parts, part)
+}
+
+func parseRequirement(part string) (LabelRequirement, error) {
+	if key, ok := strings.CutPrefix(part, "!"); ok {
+		return newRequirement(key, SelectorOpDoesNotExist, nil)
+	}
+
+	for _, op := range []string{"!=", "==", "="} {
+		if key, value, ok := strings.Cut(part, op); ok {
+			operator := SelectorOpEquals
+			if op == "!=" {
+				operator = SelectorOpNotEquals
+			}
+
+			return newRequirement(key, operator, []string{strings.TrimSpace(value)})
+		}
+	}
+
+	fields := strings.Fields(part)
+	if len(fields) == 1 {
+		return newRequirement(fields[0], SelectorOpExists, nil)
+	}
+
+	key, rest, _ := strings.Cut(part, " ")
+	rest = strings.TrimSpace(rest)
+
+	for _, operator := range []SelectorOperator{SelectorOpNotIn, SelectorOpIn} {
+		set, ok := strings.CutPrefix(rest, string(operator))
+		if !ok {
+			continue
+		}
+
+		values, err := parseSet(set)
+		if err != nil {
+			return LabelRequirement{}, err
+		}
+
+		return newRequirement(key, operator, values)
+	}
+
+	return LabelRequirement{}, fmt.Errorf("unknown operator in %q", part)
+}
+
+func parseSet(set string) ([]string, error) {
+	set = strings.TrimSpace(set)
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return nil, errSelectorBadSet
+	}
+
+	values := []string{}
+	for _, value := range strings.Split(set[1:len(set)-1], ",") {
+		values = appendNotEmpty(values, value)
+	}
+
+	return values, nil
+}
+
+func newRequirement(key string, operator SelectorOperator, values []string) (LabelRequirement, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return LabelRequirement{}, errSelectorKeyRequired
+	}
+
+	return LabelRequirement{
+		Key:      key,
+		Operator: operator,
+		Values:   values,
+	}, nil
+}
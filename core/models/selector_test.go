@@ -0,0 +1,120 @@
+package models_test
+
+import (
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		name        string
+		selector    string
+		expected    models.LabelSelector
+		expectError bool
+	}{
+		{
+			name:     "empty selector",
+			selector: "",
+			expected: models.LabelSelector{},
+		},
+		{
+			name:     "equality requirements",
+			selector: "env=prod, tier==web,zone!=a",
+			expected: models.LabelSelector{
+				{Key: "env", Operator: models.SelectorOpEquals, Values: []string{"prod"}},
+				{Key: "tier", Operator: models.SelectorOpEquals, Values: []string{"web"}},
+				{Key: "zone", Operator: models.SelectorOpNotEquals, Values: []string{"a"}},
+			},
+		},
+		{
+			name:     "set requirements",
+			selector: "zone in (a, b),tier notin (db)",
+			expected: models.LabelSelector{
+				{Key: "zone", Operator: models.SelectorOpIn, Values: []string{"a", "b"}},
+				{Key: "tier", Operator: models.SelectorOpNotIn, Values: []string{"db"}},
+			},
+		},
+		{
+			name:     "existence requirements",
+			selector: "gpu,!legacy",
+			expected: models.LabelSelector{
+				{Key: "gpu", Operator: models.SelectorOpExists},
+				{Key: "legacy", Operator: models.SelectorOpDoesNotExist},
+			},
+		},
+		{
+			name:        "missing key",
+			selector:    "=prod",
+			expectError: true,
+		},
+		{
+			name:        "set without parentheses",
+			selector:    "zone in a",
+			expectError: true,
+		},
+		{
+			name:        "unbalanced parentheses",
+			selector:    "zone in (a,b",
+			expectError: true,
+		},
+		{
+			name:        "unknown operator",
+			selector:    "zone like a",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g.RegisterTestingT(t)
+
+			selector, err := models.ParseLabelSelector(tc.selector)
+			if tc.expectError {
+				g.Expect(err).To(g.HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(selector).To(g.Equal(tc.expected))
+		})
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	labels := map[string]string{
+		"env":  "prod",
+		"zone": "b",
+	}
+
+	testCases := []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "", expected: true},
+		{selector: "env=prod", expected: true},
+		{selector: "env=dev", expected: false},
+		{selector: "env!=dev", expected: true},
+		{selector: "tier!=db", expected: true},
+		{selector: "zone in (a,b)", expected: true},
+		{selector: "zone notin (a,b)", expected: false},
+		{selector: "tier notin (db)", expected: true},
+		{selector: "env", expected: true},
+		{selector: "tier", expected: false},
+		{selector: "!tier", expected: true},
+		{selector: "env=prod,!zone", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.selector, func(t *testing.T) {
+			g.RegisterTestingT(t)
+
+			selector, err := models.ParseLabelSelector(tc.selector)
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(selector.Matches(labels)).To(g.Equal(tc.expected))
+		})
+	}
+}
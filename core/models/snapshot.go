@@ -0,0 +1,48 @@
+package models
+
+import (
+	"path/filepath"
+	"slices"
+)
+
+// Snapshot represents a point in time copy of the memory and device state of a microvm.
+type Snapshot struct {
+	// UID is the globally unique identifier of the snapshot.
+	UID string `json:"uid" validate:"required"`
+	// Name is the name of the snapshot.
+	Name string `json:"name" validate:"required"`
+	// Namespace is the namespace of the microvm that was snapshotted.
+	Namespace string `json:"namespace" validate:"required"`
+	// MicroVMUID is the UID of the microvm that was snapshotted.
+	MicroVMUID string `json:"microvm_uid" validate:"required"`
+	// Provider is the name of the microvm provider that created the snapshot.
+	Provider string `json:"provider" validate:"required"`
+	// Path is the directory that holds the snapshot files. The layout of the
+	// files in the directory is specific to the provider.
+	Path string `json:"path" validate:"required"`
+	// Source is the spec of the microvm at the time it was snapshotted. It's used
+	// to recreate the microvm when restoring.
+	Source MicroVMSpec `json:"source"`
+	// Volumes are the IDs of the volumes whose contents were copied into the snapshot.
+	Volumes []string `json:"volumes,omitempty"`
+	// CreatedAt indicates the time the snapshot was created at.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// VolumePath returns the path of the copy of the contents of a volume in the snapshot.
+func (s *Snapshot) VolumePath(volumeID string) string {
+	return filepath.Join(s.Path, "volumes", volumeID)
+}
+
+// HasVolume returns true if the contents of the volume were copied into the snapshot.
+func (s *Snapshot) HasVolume(volumeID string) bool {
+	return slices.Contains(s.Volumes, volumeID)
+}
+
+// ListSnapshotQuery is the query used to list snapshots.
+type ListSnapshotQuery struct {
+	// Namespace is the namespace of the snapshots. If empty snapshots from all namespaces are listed.
+	Namespace string
+	// MicroVMUID optionally restricts the snapshots to ones taken of a single microvm.
+	MicroVMUID string
+}
@@ -15,8 +15,39 @@ type Volume struct {
 	// MountPoint allows you to optionally specify a mount point for the volume. This only
 	// applied to additional volumes and it will use cloud-init to mount the volumes.
 	MountPoint string `json:"mount_point,omitempty"`
+	// CacheMode is how the writes of the guest to the volume are cached on the host. If not
+	// supplied the default of the provider is used.
+	CacheMode VolumeCacheMode `json:"cache_mode,omitempty" validate:"omitempty,oneof=unsafe writeback direct"`
+	// IOEngine is the engine used for the I/O of the volume on the host. If not supplied the
+	// default of the provider is used.
+	IOEngine VolumeIOEngine `json:"io_engine,omitempty" validate:"omitempty,oneof=sync async"`
+	// RateLimit is an optional limit of the bandwidth and operations of the volume.
+	RateLimit *RateLimit `json:"rate_limit,omitempty" validate:"omitempty"`
 }
 
+// VolumeCacheMode is how the writes to a volume are cached on the host.
+type VolumeCacheMode string
+
+const (
+	// VolumeCacheModeUnsafe uses the page cache of the host and ignores flushes from the guest.
+	VolumeCacheModeUnsafe VolumeCacheMode = "unsafe"
+	// VolumeCacheModeWriteback uses the page cache of the host and syncs it on flushes from
+	// the guest.
+	VolumeCacheModeWriteback VolumeCacheMode = "writeback"
+	// VolumeCacheModeDirect bypasses the page cache of the host.
+	VolumeCacheModeDirect VolumeCacheMode = "direct"
+)
+
+// VolumeIOEngine is the engine used for the I/O of a volume on the host.
+type VolumeIOEngine string
+
+const (
+	// VolumeIOEngineSync uses blocking system calls.
+	VolumeIOEngineSync VolumeIOEngine = "sync"
+	// VolumeIOEngineAsync uses io_uring.
+	VolumeIOEngineAsync VolumeIOEngine = "async"
+)
+
 // Volumes represents a collection of volumes.
 type Volumes []Volume
 
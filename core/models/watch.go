@@ -0,0 +1,33 @@
+package models
+
+// MicroVMEventType is the type of change a watch event describes.
+type MicroVMEventType string
+
+const (
+	// MicroVMEventAdded is sent the first time a watcher sees a microvm.
+	MicroVMEventAdded MicroVMEventType = "added"
+	// MicroVMEventModified is sent when a new version of a microvm is saved.
+	MicroVMEventModified MicroVMEventType = "modified"
+	// MicroVMEventDeleted is sent when a microvm is deleted or no longer matches the watch.
+	MicroVMEventDeleted MicroVMEventType = "deleted"
+)
+
+// MicroVMEvent is a change to a microvm seen by a watch.
+type MicroVMEvent struct {
+	// Type is the type of change.
+	Type MicroVMEventType
+	// MicroVM is the microvm after the change. For deleted events it's the last version sent.
+	MicroVM *MicroVM
+}
+
+// WatchMicroVMQuery selects the microvms to watch.
+type WatchMicroVMQuery struct {
+	// Namespace restricts the watch to a single namespace. Empty means all namespaces.
+	Namespace string
+	// Labels restricts the watch to microvms that have all of these labels.
+	Labels map[string]string
+	// ResumeFromVersions is the last version the watcher saw for each microvm, keyed by
+	// uid. Microvms at these versions aren't sent again when the watch starts and any
+	// that have since been deleted are sent as deleted events.
+	ResumeFromVersions map[string]int
+}
@@ -3,6 +3,7 @@ package plans
 const (
 	MicroVMDeletePlanName         = "microvm_delete"
 	MicroVMCreateOrUpdatePlanName = "microvm_create_update"
+	MicroVMStopPlanName           = "microvm_stop"
 
 	microVMBootTime = 5
 )
@@ -20,6 +20,9 @@ type mockList struct {
 	IDService         *mock.MockIDService
 	MicroVMService    *mock.MockMicroVMService
 	NetworkService    *mock.MockNetworkService
+	IPAMService       *mock.MockIPAMService
+	DHCPService       *mock.MockDHCPService
+	FirewallService   *mock.MockFirewallService
 	ImageService      *mock.MockImageService
 }
 
@@ -30,9 +33,15 @@ func fakePorts(mockCtrl *gomock.Controller) (*mockList, *ports.Collection) {
 		IDService:         mock.NewMockIDService(mockCtrl),
 		MicroVMService:    mock.NewMockMicroVMService(mockCtrl),
 		NetworkService:    mock.NewMockNetworkService(mockCtrl),
+		IPAMService:       mock.NewMockIPAMService(mockCtrl),
+		DHCPService:       mock.NewMockDHCPService(mockCtrl),
+		FirewallService:   mock.NewMockFirewallService(mockCtrl),
 		ImageService:      mock.NewMockImageService(mockCtrl),
 	}
 
+	mList.IPAMService.EXPECT().HasPool(gomock.Any()).Return(false).AnyTimes()
+	mList.DHCPService.EXPECT().Serves(gomock.Any()).Return(false).AnyTimes()
+
 	return mList, &ports.Collection{
 		Repo:              mList.MicroVMRepository,
 		EventService:      mList.EventService,
@@ -40,10 +49,13 @@ func fakePorts(mockCtrl *gomock.Controller) (*mockList, *ports.Collection) {
 		MicrovmProviders: map[string]ports.MicroVMService{
 			"mock": mList.MicroVMService,
 		},
-		NetworkService: mList.NetworkService,
-		ImageService:   mList.ImageService,
-		FileSystem:     afero.NewMemMapFs(),
-		Clock:          time.Now,
+		NetworkService:  mList.NetworkService,
+		IPAMService:     mList.IPAMService,
+		DHCPService:     mList.DHCPService,
+		FirewallService: mList.FirewallService,
+		ImageService:    mList.ImageService,
+		FileSystem:      afero.NewMemMapFs(),
+		Clock:           time.Now,
 	}
 }
 
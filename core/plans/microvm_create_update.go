@@ -3,6 +3,7 @@ package plans
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/liquidmetal-dev/flintlock/core/steps/cloudinit"
 
@@ -20,19 +21,24 @@ import (
 type CreateOrUpdatePlanInput struct {
 	StateDirectory string
 	VM             *models.MicroVM
+	// GuestBootDeadline is how long to wait for the guest agent to respond after booting. Zero
+	// doesn't wait for the guest.
+	GuestBootDeadline time.Duration
 }
 
 func MicroVMCreateOrUpdatePlan(input *CreateOrUpdatePlanInput) planner.Plan {
 	return &microvmCreateOrUpdatePlan{
-		vm:       input.VM,
-		stateDir: input.StateDirectory,
-		steps:    []planner.Procedure{},
+		vm:                input.VM,
+		stateDir:          input.StateDirectory,
+		guestBootDeadline: input.GuestBootDeadline,
+		steps:             []planner.Procedure{},
 	}
 }
 
 type microvmCreateOrUpdatePlan struct {
-	vm       *models.MicroVM
-	stateDir string
+	vm                *models.MicroVM
+	stateDir          string
+	guestBootDeadline time.Duration
 
 	steps []planner.Procedure
 }
@@ -83,27 +89,119 @@ func (p *microvmCreateOrUpdatePlan) Create(ctx context.Context) ([]planner.Proce
 		}
 	}
 
+	// Network namespace
+	namespaceStep := network.NewCreateNamespace(&p.vm.ID, p.vm.Status.NetworkNamespace, ports.NetworkService)
+	if err := p.addStep(ctx, namespaceStep); err != nil {
+		return nil, fmt.Errorf("adding network namespace step: %w", err)
+	}
+
 	// Network interfaces
-	if err := p.addNetworkSteps(ctx, p.vm, ports.NetworkService); err != nil {
+	if err := p.addNetworkSteps(ctx, p.vm, ports.NetworkService, ports.IPAMService, ports.DHCPService,
+		ports.FirewallService); err != nil {
 		return nil, fmt.Errorf("adding network steps: %w", err)
 	}
 
-	// MicroVM provider create
-	if err := p.addStep(ctx, microvm.NewCreateStep(p.vm, provider)); err != nil {
+	// MicroVM resume requested
+	if err := p.addStep(ctx, microvm.NewResumeStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm resume step: %w", err)
+	}
+
+	// MicroVM network interfaces and volumes hotplug
+	if err := p.addStep(ctx, microvm.NewUpdateDevicesStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm update devices step: %w", err)
+	}
+
+	// Removed network interfaces and volumes, once the microvm doesn't have them
+	if !p.vm.Status.DeviceUpdatePending || p.hasStep("microvm_update_devices") {
+		if err := p.addNetworkRemovalSteps(ctx, p.vm, ports.NetworkService, ports.IPAMService,
+			ports.DHCPService, ports.FirewallService); err != nil {
+			return nil, fmt.Errorf("adding network removal steps: %w", err)
+		}
+
+		if err := p.addVolumeRemovalSteps(ctx, p.vm, ports.ImageService); err != nil {
+			return nil, fmt.Errorf("adding volume removal steps: %w", err)
+		}
+	}
+
+	// MicroVM metadata update, when it's the only change and can be applied live
+	if err := p.addStep(ctx, microvm.NewUpdateMetadataStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm metadata update step: %w", err)
+	}
+
+	// MicroVM network rate limits update, when they can be applied live
+	if err := p.addStep(ctx, microvm.NewUpdateNetworkRateLimitsStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm network rate limits update step: %w", err)
+	}
+
+	// MicroVM provider create, or restore when it's a new microvm from a snapshot
+	createStep, err := p.createStep(ctx, provider, ports)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.addStep(ctx, createStep); err != nil {
 		return nil, fmt.Errorf("adding microvm create step: %w", err)
 	}
 
-	// MicroVM provider doesn't auto-start
-	if !provider.Capabilities().Has(models.AutoStartCapability) {
+	// MicroVM provider doesn't auto-start, or the microvm was stopped and needs booting again
+	startRequired, err := p.startRequired(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if startRequired {
 		if err := p.addStep(ctx, microvm.NewStartStep(p.vm, provider, microVMBootTime)); err != nil {
 			return nil, fmt.Errorf("adding microvm start step: %w", err)
 		}
 	}
 
+	// MicroVM restart requested
+	if err := p.addStep(ctx, microvm.NewRestartStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm restart step: %w", err)
+	}
+
+	// Guest agent heartbeat, so the microvm is only created once the guest is up
+	waitStep := microvm.NewWaitForGuestStep(p.vm, ports.GuestAgentService, p.guestBootDeadline, p.booting())
+	if err := p.addStep(ctx, waitStep); err != nil {
+		return nil, fmt.Errorf("adding microvm wait for guest step: %w", err)
+	}
+
+	// MicroVM pause requested
+	if err := p.addStep(ctx, microvm.NewPauseStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm pause step: %w", err)
+	}
+
 	return p.steps, nil
 }
 
+// createStep returns the step that creates the microvm with the provider. A new
+// microvm that is restored from a snapshot is created from the snapshot files, it's
+// created from its spec once it's been restored.
+func (p *microvmCreateOrUpdatePlan) createStep(ctx context.Context,
+	provider ports.MicroVMService,
+	ports *ports.Collection,
+) (planner.Procedure, error) {
+	if p.vm.Spec.RestoreFromSnapshot == "" || p.vm.Status.Restored {
+		return microvm.NewCreateStep(p.vm, provider), nil
+	}
+
+	snapshot, err := ports.SnapshotRepo.Get(ctx, p.vm.Spec.RestoreFromSnapshot)
+	if err != nil {
+		return nil, fmt.Errorf("getting snapshot %s: %w", p.vm.Spec.RestoreFromSnapshot, err)
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %s not found", p.vm.Spec.RestoreFromSnapshot)
+	}
+
+	return microvm.NewRestoreStep(p.vm, provider, ports.FileSystem, snapshot), nil
+}
+
 func (p *microvmCreateOrUpdatePlan) Finalise(state models.MicroVMState) {
+	if state == models.CreatedState && p.vm.Spec.IsPaused() {
+		state = models.PausedState
+	}
+
 	p.vm.Status.State = state
 }
 
@@ -197,6 +295,9 @@ func (p *microvmCreateOrUpdatePlan) addImageSteps(ctx context.Context,
 func (p *microvmCreateOrUpdatePlan) addNetworkSteps(ctx context.Context,
 	vm *models.MicroVM,
 	networkSvc ports.NetworkService,
+	ipamSvc ports.IPAMService,
+	dhcpSvc ports.DHCPService,
+	firewallSvc ports.FirewallService,
 ) error {
 	for i := range vm.Spec.NetworkInterfaces {
 		iface := vm.Spec.NetworkInterfaces[i]
@@ -207,14 +308,151 @@ func (p *microvmCreateOrUpdatePlan) addNetworkSteps(ctx context.Context,
 			vm.Status.NetworkInterfaces[iface.GuestDeviceName] = status
 		}
 
-		if err := p.addStep(ctx, network.NewNetworkInterface(&vm.ID, &iface, status, networkSvc)); err != nil {
+		ifaceStep := network.NewNetworkInterface(&vm.ID, &iface, status, vm.Status.NetworkNamespace, networkSvc)
+		if err := p.addStep(ctx, ifaceStep); err != nil {
 			return fmt.Errorf("adding create network interface step: %w", err)
 		}
+
+		if err := p.addStep(ctx, network.NewAllocateAddress(&vm.ID, &iface, status, ipamSvc)); err != nil {
+			return fmt.Errorf("adding allocate network address step: %w", err)
+		}
+
+		// The firewall is removed if it's been removed from the spec of the interface.
+		firewallStep := network.NewApplyFirewall(&vm.ID, &iface, status, firewallSvc)
+		if iface.Firewall == nil {
+			firewallStep = network.NewRemoveFirewall(&vm.ID, iface.GuestDeviceName, status, firewallSvc)
+		}
+
+		if err := p.addStep(ctx, firewallStep); err != nil {
+			return fmt.Errorf("adding network firewall step: %w", err)
+		}
+
+		if err := p.addStep(ctx, network.NewAddLease(&vm.ID, &iface, status, dhcpSvc)); err != nil {
+			return fmt.Errorf("adding dhcp lease step: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// addNetworkRemovalSteps deletes the network interfaces that have been removed
+// from the spec, removes their firewalls and leases and releases their addresses. The status of an interface
+// is dropped once it's been deleted.
+func (p *microvmCreateOrUpdatePlan) addNetworkRemovalSteps(ctx context.Context,
+	vm *models.MicroVM,
+	networkSvc ports.NetworkService,
+	ipamSvc ports.IPAMService,
+	dhcpSvc ports.DHCPService,
+	firewallSvc ports.FirewallService,
+) error {
+	for name, status := range vm.Status.NetworkInterfaces {
+		if hasNetworkInterface(vm, name) {
+			continue
+		}
+
+		steps := []planner.Procedure{
+			network.DeleteNetworkInterface(&vm.ID, status, vm.Status.NetworkNamespace, networkSvc),
+			network.NewRemoveFirewall(&vm.ID, name, status, firewallSvc),
+			network.NewRemoveLease(&vm.ID, name, status, dhcpSvc),
+			network.NewReleaseAddress(&vm.ID, name, status, ipamSvc),
+		}
+		pending := false
+
+		for _, step := range steps {
+			shouldDo, err := step.ShouldDo(ctx)
+			if err != nil {
+				return fmt.Errorf("checking if step %s should be included in plan: %w", step.Name(), err)
+			}
+
+			if shouldDo {
+				p.steps = append(p.steps, step)
+				pending = true
+			}
+		}
+
+		if !pending {
+			delete(vm.Status.NetworkInterfaces, name)
+		}
+	}
+
+	return nil
+}
+
+// addVolumeRemovalSteps unmounts the volumes that have been removed from the spec. The status
+// of a volume is dropped once it's been unmounted.
+func (p *microvmCreateOrUpdatePlan) addVolumeRemovalSteps(ctx context.Context,
+	vm *models.MicroVM,
+	imageSvc ports.ImageService,
+) error {
+	for id, status := range vm.Status.Volumes {
+		if id == vm.Spec.RootVolume.ID || vm.Spec.AdditionalVolumes.GetByID(id) != nil {
+			continue
+		}
+
+		step := runtime.NewVolumeUnmount(&vm.ID, id, status, imageSvc)
+
+		shouldDo, err := step.ShouldDo(ctx)
+		if err != nil {
+			return fmt.Errorf("checking if step %s should be included in plan: %w", step.Name(), err)
+		}
+
+		if shouldDo {
+			p.steps = append(p.steps, step)
+		} else {
+			delete(vm.Status.Volumes, id)
+		}
+	}
+
+	return nil
+}
+
+func hasNetworkInterface(vm *models.MicroVM, name string) bool {
+	for i := range vm.Spec.NetworkInterfaces {
+		if vm.Spec.NetworkInterfaces[i].GuestDeviceName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *microvmCreateOrUpdatePlan) startRequired(ctx context.Context, provider ports.MicroVMService) (bool, error) {
+	if !provider.Capabilities().Has(models.AutoStartCapability) {
+		return true, nil
+	}
+
+	// A stopped microvm from a provider that auto-starts is left configured but not booted.
+	state, err := provider.State(ctx, p.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is configured: %w", err)
+	}
+
+	return state == ports.MicroVMStateConfigured, nil
+}
+
+// hasStep returns true if the plan has a step with the name.
+func (p *microvmCreateOrUpdatePlan) hasStep(name string) bool {
+	for _, step := range p.steps {
+		if step.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// booting returns true if the plan boots the microvm.
+func (p *microvmCreateOrUpdatePlan) booting() bool {
+	for _, step := range p.steps {
+		switch step.Name() {
+		case "microvm_create", "microvm_restore", "microvm_start", "microvm_restart":
+			return true
+		}
+	}
+
+	return false
+}
+
 func (p *microvmCreateOrUpdatePlan) ensureStatus() {
 	if p.vm.Status.Volumes == nil {
 		p.vm.Status.Volumes = models.VolumeStatuses{}
@@ -2,6 +2,7 @@ package plans_test
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -11,6 +12,8 @@ import (
 	"github.com/liquidmetal-dev/flintlock/core/plans"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	portsctx "github.com/liquidmetal-dev/flintlock/core/ports/context"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
 )
 
 func TestMicroVMCreateOrUpdatePlan(t *testing.T) {
@@ -35,7 +38,7 @@ func TestMicroVMCreateOrUpdatePlan(t *testing.T) {
 		DoAndReturn(func(_ context.Context, _ string) (ports.MicroVMState, error) {
 			return ports.MicroVMStatePending, nil
 		}).
-		Times(4)
+		Times(5)
 
 	mList.MicroVMService.
 		EXPECT().
@@ -50,7 +53,7 @@ func TestMicroVMCreateOrUpdatePlan(t *testing.T) {
 
 	mList.NetworkService.
 		EXPECT().
-		IfaceExists(gomock.Any(), &hostDeviceNameMatcher{}).
+		IfaceExists(gomock.Any(), gomock.Eq(""), &hostDeviceNameMatcher{}).
 		Return(false, nil).
 		Times(4)
 
@@ -111,6 +114,11 @@ func TestMicroVMCreateOrUpdatePlan(t *testing.T) {
 	Expect(testVM.Status.State).To(Equal(models.MicroVMState(models.PendingState)))
 
 	for _, step := range steps {
+		if step.Name() != "io_create_dir" {
+			_, reportsCondition := step.(planner.ConditionReporter)
+			Expect(reportsCondition).To(BeTrue(), step.Name())
+		}
+
 		should, err := step.ShouldDo(ctx)
 
 		Expect(err).NotTo(HaveOccurred())
@@ -125,6 +133,214 @@ func TestMicroVMCreateOrUpdatePlan(t *testing.T) {
 	}
 }
 
+func TestMicroVMCreateOrUpdatePlan_Update(t *testing.T) {
+	RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testVM := createTestSpec("vmid", "namespace")
+	testVM.Status.DeviceUpdatePending = true
+	testVM.Status.NetworkInterfaces["eth1"] = &models.NetworkInterfaceStatus{HostDeviceName: "fltap1234567"}
+	testVM.Status.NetworkInterfaces["eth2"] = &models.NetworkInterfaceStatus{HostDeviceName: "fltap7654321"}
+	testVM.Status.Volumes = models.VolumeStatuses{
+		"root": &models.VolumeStatus{Mount: models.Mount{Source: "/dev/mapper/root"}},
+		"data": &models.VolumeStatus{Mount: models.Mount{Source: "/dev/mapper/data"}},
+	}
+	testVM.Status.KernelMount = &models.Mount{Source: "/var/lib/kernel"}
+
+	mList, mockedPorts := fakePorts(mockCtrl)
+	ctx := portsctx.WithPorts(
+		context.Background(),
+		mockedPorts,
+	)
+	plan := plans.MicroVMCreateOrUpdatePlan(&plans.CreateOrUpdatePlanInput{
+		VM:             testVM,
+		StateDirectory: "/tmp/path/to/vm",
+	})
+
+	mList.MicroVMService.
+		EXPECT().
+		State(gomock.Any(), gomock.Eq("namespace/vmid/ae1ce196-6249-11ec-90d6-0242ac120003")).
+		Return(ports.MicroVMStateRunning, nil).
+		AnyTimes()
+
+	mList.MicroVMService.EXPECT().Capabilities().Return(models.Capabilities{}).Times(2)
+
+	mList.NetworkService.
+		EXPECT().
+		IfaceExists(gomock.Any(), gomock.Eq(""), gomock.Any()).
+		Return(true, nil).
+		AnyTimes()
+
+	mList.ImageService.
+		EXPECT().
+		IsMounted(gomock.Any(), gomock.Any()).
+		Return(true, nil).
+		AnyTimes()
+
+	steps, createErr := plan.Create(ctx)
+
+	Expect(createErr).NotTo(HaveOccurred())
+
+	stepNames := []string{}
+	for _, step := range steps {
+		stepNames = append(stepNames, step.Name())
+	}
+
+	Expect(stepNames).To(ContainElements("microvm_update_devices", "network_iface_delete", "runtime_volume_unmount"))
+	Expect(stepNames).NotTo(ContainElements("network_iface_create", "microvm_create", "microvm_start"))
+
+	// The removed devices are only deleted once they've been removed from the microvm.
+	Expect(slices.Index(stepNames, "microvm_update_devices")).To(BeNumerically("<",
+		slices.Index(stepNames, "network_iface_delete")))
+	Expect(slices.Index(stepNames, "microvm_update_devices")).To(BeNumerically("<",
+		slices.Index(stepNames, "runtime_volume_unmount")))
+	Expect(testVM.Status.Volumes).To(HaveKey("data"))
+	Expect(testVM.Status.Volumes).To(HaveKey("root"))
+}
+
+func TestMicroVMCreateOrUpdatePlan_UpdatePaused(t *testing.T) {
+	RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testVM := createTestSpec("vmid", "namespace")
+	testVM.Spec.PowerState = models.PowerStatePaused
+	testVM.Status.DeviceUpdatePending = true
+	testVM.Status.NetworkInterfaces["eth2"] = &models.NetworkInterfaceStatus{HostDeviceName: "fltap7654321"}
+	testVM.Status.Volumes = models.VolumeStatuses{
+		"root": &models.VolumeStatus{Mount: models.Mount{Source: "/dev/mapper/root"}},
+		"data": &models.VolumeStatus{Mount: models.Mount{Source: "/dev/mapper/data"}},
+	}
+	testVM.Status.KernelMount = &models.Mount{Source: "/var/lib/kernel"}
+
+	mList, mockedPorts := fakePorts(mockCtrl)
+	ctx := portsctx.WithPorts(
+		context.Background(),
+		mockedPorts,
+	)
+	plan := plans.MicroVMCreateOrUpdatePlan(&plans.CreateOrUpdatePlanInput{
+		VM:             testVM,
+		StateDirectory: "/tmp/path/to/vm",
+	})
+
+	mList.MicroVMService.
+		EXPECT().
+		State(gomock.Any(), gomock.Eq("namespace/vmid/ae1ce196-6249-11ec-90d6-0242ac120003")).
+		Return(ports.MicroVMStatePaused, nil).
+		AnyTimes()
+
+	mList.MicroVMService.EXPECT().Capabilities().Return(models.Capabilities{}).AnyTimes()
+
+	mList.NetworkService.
+		EXPECT().
+		IfaceExists(gomock.Any(), gomock.Eq(""), gomock.Any()).
+		Return(true, nil).
+		AnyTimes()
+
+	mList.ImageService.
+		EXPECT().
+		IsMounted(gomock.Any(), gomock.Any()).
+		Return(true, nil).
+		AnyTimes()
+
+	steps, createErr := plan.Create(ctx)
+
+	Expect(createErr).NotTo(HaveOccurred())
+
+	stepNames := []string{}
+	for _, step := range steps {
+		stepNames = append(stepNames, step.Name())
+	}
+
+	// The paused microvm still has the removed devices, so they're kept until it's resumed.
+	Expect(stepNames).NotTo(ContainElements("microvm_update_devices", "network_iface_delete",
+		"runtime_volume_unmount"))
+	Expect(testVM.Status.NetworkInterfaces).To(HaveKey("eth2"))
+	Expect(testVM.Status.Volumes).To(HaveKey("data"))
+}
+
+func TestMicroVMCreateOrUpdatePlan_RestoreFromSnapshot(t *testing.T) {
+	testCases := []struct {
+		name     string
+		restored bool
+		expected string
+	}{
+		{
+			name:     "new microvm, should restore from snapshot",
+			restored: false,
+			expected: "microvm_restore",
+		},
+		{
+			name:     "restored microvm, should create from spec",
+			restored: true,
+			expected: "microvm_create",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			testVM := createTestSpec("vmid", "namespace")
+			testVM.Spec.RestoreFromSnapshot = "snap1234"
+			testVM.Status.Restored = tc.restored
+
+			mList, mockedPorts := fakePorts(mockCtrl)
+			snapshotRepo := mock.NewMockSnapshotRepository(mockCtrl)
+			mockedPorts.SnapshotRepo = snapshotRepo
+			ctx := portsctx.WithPorts(
+				context.Background(),
+				mockedPorts,
+			)
+			plan := plans.MicroVMCreateOrUpdatePlan(&plans.CreateOrUpdatePlanInput{
+				VM:             testVM,
+				StateDirectory: "/tmp/path/to/vm",
+			})
+
+			if !tc.restored {
+				snapshotRepo.
+					EXPECT().
+					Get(gomock.Any(), gomock.Eq("snap1234")).
+					Return(&models.Snapshot{UID: "snap1234"}, nil)
+			}
+
+			mList.MicroVMService.
+				EXPECT().
+				State(gomock.Any(), gomock.Any()).
+				Return(ports.MicroVMStatePending, nil).
+				AnyTimes()
+
+			mList.MicroVMService.EXPECT().Capabilities().Return(models.Capabilities{}).AnyTimes()
+
+			mList.NetworkService.
+				EXPECT().
+				IfaceExists(gomock.Any(), gomock.Eq(""), gomock.Any()).
+				Return(true, nil).
+				AnyTimes()
+
+			mList.ImageService.
+				EXPECT().
+				IsMounted(gomock.Any(), gomock.Any()).
+				Return(true, nil).
+				AnyTimes()
+
+			steps, createErr := plan.Create(ctx)
+
+			Expect(createErr).NotTo(HaveOccurred())
+
+			stepNames := []string{}
+			for _, step := range steps {
+				stepNames = append(stepNames, step.Name())
+			}
+
+			Expect(stepNames).To(ContainElement(tc.expected))
+		})
+	}
+}
+
 func TestMicroVMPlanFinalise(t *testing.T) {
 	tt := []struct {
 		name  string
@@ -78,10 +78,17 @@ func (p *microvmDeletePlan) Create(ctx context.Context) ([]planner.Procedure, er
 	}
 
 	// Network interfaces
-	if err := p.addNetworkSteps(ctx, p.vm, ports.NetworkService); err != nil {
+	if err := p.addNetworkSteps(ctx, p.vm, ports.NetworkService, ports.IPAMService, ports.DHCPService,
+		ports.FirewallService); err != nil {
 		return nil, fmt.Errorf("adding network steps: %w", err)
 	}
 
+	// Network namespace, once the vmm process and interfaces in it are gone
+	namespaceStep := network.NewDeleteNamespace(&p.vm.ID, p.vm.Status.NetworkNamespace, ports.NetworkService)
+	if err := p.addStep(ctx, namespaceStep); err != nil {
+		return nil, fmt.Errorf("adding network namespace step: %w", err)
+	}
+
 	if err := p.addStep(ctx, runtime.NewDeleteDirectory(p.stateDir, ports.FileSystem)); err != nil {
 		return nil, fmt.Errorf("adding root dir step: %w", err)
 	}
@@ -90,7 +97,9 @@ func (p *microvmDeletePlan) Create(ctx context.Context) ([]planner.Procedure, er
 		publishStep := event.NewPublish(
 			defaults.TopicMicroVMEvents,
 			&events.MicroVMSpecDeleted{
-				UID: p.vm.ID.UID(),
+				ID:        p.vm.ID.Name(),
+				Namespace: p.vm.ID.Namespace(),
+				UID:       p.vm.ID.UID(),
 			},
 			ports.EventService,
 		)
@@ -130,16 +139,37 @@ func (p *microvmDeletePlan) addNetworkSteps(
 	ctx context.Context,
 	vm *models.MicroVM,
 	networkSvc ports.NetworkService,
+	ipamSvc ports.IPAMService,
+	dhcpSvc ports.DHCPService,
+	firewallSvc ports.FirewallService,
 ) error {
 	for i := range vm.Spec.NetworkInterfaces {
 		iface := vm.Spec.NetworkInterfaces[i]
 		ifaceStats := vm.Status.NetworkInterfaces[iface.GuestDeviceName]
 
-		step := network.DeleteNetworkInterface(&vm.ID, ifaceStats, networkSvc)
+		step := network.DeleteNetworkInterface(&vm.ID, ifaceStats, vm.Status.NetworkNamespace, networkSvc)
 
 		if err := p.addStep(ctx, step); err != nil {
 			return fmt.Errorf("adding delete network interface step: %w", err)
 		}
+
+		firewallStep := network.NewRemoveFirewall(&vm.ID, iface.GuestDeviceName, ifaceStats, firewallSvc)
+
+		if err := p.addStep(ctx, firewallStep); err != nil {
+			return fmt.Errorf("adding remove network firewall step: %w", err)
+		}
+
+		leaseStep := network.NewRemoveLease(&vm.ID, iface.GuestDeviceName, ifaceStats, dhcpSvc)
+
+		if err := p.addStep(ctx, leaseStep); err != nil {
+			return fmt.Errorf("adding remove dhcp lease step: %w", err)
+		}
+
+		releaseStep := network.NewReleaseAddress(&vm.ID, iface.GuestDeviceName, ifaceStats, ipamSvc)
+
+		if err := p.addStep(ctx, releaseStep); err != nil {
+			return fmt.Errorf("adding release network address step: %w", err)
+		}
 	}
 
 	return nil
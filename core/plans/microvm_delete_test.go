@@ -8,6 +8,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 
+	"github.com/liquidmetal-dev/flintlock/api/events"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/plans"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
@@ -59,7 +60,7 @@ func TestMicroVMDeletePlan(t *testing.T) {
 
 	mList.NetworkService.
 		EXPECT().
-		IfaceExists(gomock.Any(), &hostDeviceNameMatcher{}).
+		IfaceExists(gomock.Any(), gomock.Eq(""), &hostDeviceNameMatcher{}).
 		Return(true, nil).
 		AnyTimes()
 
@@ -76,7 +77,11 @@ func TestMicroVMDeletePlan(t *testing.T) {
 
 	mList.EventService.
 		EXPECT().
-		Publish(gomock.Any(), gomock.Eq(defaults.TopicMicroVMEvents), gomock.Any()).
+		Publish(gomock.Any(), gomock.Eq(defaults.TopicMicroVMEvents), gomock.Eq(&events.MicroVMSpecDeleted{
+			ID:        "vmid",
+			Namespace: "namespace",
+			UID:       testUID,
+		})).
 		Return(nil).
 		AnyTimes()
 
@@ -99,3 +104,64 @@ func TestMicroVMDeletePlan(t *testing.T) {
 		}
 	}
 }
+
+func TestMicroVMDeletePlan_networkNamespace(t *testing.T) {
+	RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mList, mockedPorts := fakePorts(mockCtrl)
+	ctx := portsctx.WithPorts(
+		context.Background(),
+		mockedPorts,
+	)
+	spec := createTestSpec("vmid", "namespace")
+	spec.Spec.DeletedAt = 1
+	spec.Status.NetworkNamespace = "flintlock-" + testUID
+	plan := plans.MicroVMDeletePlan(&plans.DeletePlanInput{
+		VM:             spec,
+		StateDirectory: "/tmp/path/to/vm",
+	})
+
+	mList.MicroVMService.
+		EXPECT().
+		State(gomock.Any(), gomock.Any()).
+		Return(ports.MicroVMStateRunning, nil).
+		AnyTimes()
+
+	mList.MicroVMService.EXPECT().Capabilities().Return(models.Capabilities{}).AnyTimes()
+
+	mList.MicroVMRepository.
+		EXPECT().
+		Exists(gomock.Any(), gomock.Any()).
+		Return(false, nil).
+		AnyTimes()
+
+	mList.NetworkService.
+		EXPECT().
+		IfaceExists(gomock.Any(), gomock.Eq("flintlock-"+testUID), &hostDeviceNameMatcher{}).
+		Return(true, nil).
+		AnyTimes()
+
+	mList.NetworkService.
+		EXPECT().
+		NamespaceExists(gomock.Any(), gomock.Eq("flintlock-"+testUID)).
+		Return(true, nil).
+		Times(1)
+
+	steps, createErr := plan.Create(ctx)
+
+	Expect(createErr).NotTo(HaveOccurred())
+
+	stepNames := []string{}
+	for _, step := range steps {
+		stepNames = append(stepNames, step.Name())
+	}
+
+	Expect(stepNames).To(Equal([]string{
+		"microvm_delete",
+		"network_iface_delete",
+		"network_namespace_delete",
+		"event_publish",
+	}))
+}
@@ -0,0 +1,90 @@
+package plans
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	portsctx "github.com/liquidmetal-dev/flintlock/core/ports/context"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+type StopPlanInput struct {
+	VM *models.MicroVM
+}
+
+// MicroVMStopPlan creates a plan that keeps a microvm stopped. Only the microvm
+// itself is stopped, its network interfaces, volumes and state directory are kept
+// so that it can be started again.
+func MicroVMStopPlan(input *StopPlanInput) planner.Plan {
+	return &microvmStopPlan{
+		vm:    input.VM,
+		steps: []planner.Procedure{},
+	}
+}
+
+type microvmStopPlan struct {
+	vm *models.MicroVM
+
+	steps []planner.Procedure
+}
+
+func (p *microvmStopPlan) Name() string {
+	return MicroVMStopPlanName
+}
+
+// Create will create a plan to stop a microvm.
+func (p *microvmStopPlan) Create(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithField("component", "plans").WithField("planType", "microvmStopPlan")
+	logger.Tracef("creating stop plan for microvm: %s", p.vm.ID)
+
+	ports, ok := portsctx.GetPorts(ctx)
+	if !ok {
+		return nil, portsctx.ErrPortsMissing
+	}
+
+	provider, ok := ports.MicrovmProviders[p.vm.Spec.Provider]
+	if !ok {
+		return nil, fmt.Errorf("microvm provider %s isn't available", p.vm.Spec.Provider)
+	}
+
+	if p.vm.Spec.DeletedAt != 0 || !p.vm.Spec.IsStopped() {
+		return []planner.Procedure{}, nil
+	}
+
+	p.clearPlanList()
+
+	if err := p.addStep(ctx, microvm.NewStopStep(p.vm, provider)); err != nil {
+		return nil, fmt.Errorf("adding microvm stop step: %w", err)
+	}
+
+	return p.steps, nil
+}
+
+// Finalise will set the state of the microvm. A successful plan leaves the microvm stopped.
+func (p *microvmStopPlan) Finalise(state models.MicroVMState) {
+	if state == models.CreatedState {
+		state = models.StoppedState
+	}
+
+	p.vm.Status.State = state
+}
+
+func (p *microvmStopPlan) clearPlanList() {
+	p.steps = []planner.Procedure{}
+}
+
+func (p *microvmStopPlan) addStep(ctx context.Context, step planner.Procedure) error {
+	shouldDo, err := step.ShouldDo(ctx)
+	if err != nil {
+		return fmt.Errorf("checking if step %s should be included in plan: %w", step.Name(), err)
+	}
+
+	if shouldDo {
+		p.steps = append(p.steps, step)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package plans_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/plans"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	portsctx "github.com/liquidmetal-dev/flintlock/core/ports/context"
+)
+
+func TestMicroVMStopPlan(t *testing.T) {
+	RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testVM := createTestSpec("vmid", "namespace")
+	testVM.Spec.PowerState = models.PowerStateStopped
+
+	mList, mockedPorts := fakePorts(mockCtrl)
+	ctx := portsctx.WithPorts(
+		context.Background(),
+		mockedPorts,
+	)
+	plan := plans.MicroVMStopPlan(&plans.StopPlanInput{
+		VM: testVM,
+	})
+
+	gomock.InOrder(
+		mList.MicroVMService.
+			EXPECT().
+			State(gomock.Any(), gomock.Eq("namespace/vmid/ae1ce196-6249-11ec-90d6-0242ac120003")).
+			Return(ports.MicroVMStateRunning, nil).
+			Times(2),
+		mList.MicroVMService.
+			EXPECT().
+			Stop(gomock.Any(), gomock.Eq("namespace/vmid/ae1ce196-6249-11ec-90d6-0242ac120003")).
+			Return(nil),
+	)
+
+	steps, createErr := plan.Create(ctx)
+
+	Expect(createErr).NotTo(HaveOccurred())
+	Expect(steps).To(HaveLen(1))
+
+	for _, step := range steps {
+		should, err := step.ShouldDo(ctx)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(should).To(BeTrue())
+
+		extraSteps, err := step.Do(ctx)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(extraSteps).To(BeNil())
+	}
+}
+
+func TestMicroVMStopPlan_NotStopped(t *testing.T) {
+	RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testVM := createTestSpec("vmid", "namespace")
+
+	_, mockedPorts := fakePorts(mockCtrl)
+	ctx := portsctx.WithPorts(
+		context.Background(),
+		mockedPorts,
+	)
+	plan := plans.MicroVMStopPlan(&plans.StopPlanInput{
+		VM: testVM,
+	})
+
+	steps, createErr := plan.Create(ctx)
+
+	Expect(createErr).NotTo(HaveOccurred())
+	Expect(steps).To(BeEmpty())
+}
+
+func TestMicroVMStopPlanFinalise(t *testing.T) {
+	tt := []struct {
+		name     string
+		state    models.MicroVMState
+		expected models.MicroVMState
+	}{
+		{
+			name:     "finalise with created updates mvm state to stopped",
+			state:    models.CreatedState,
+			expected: models.StoppedState,
+		},
+		{
+			name:     "finalise with failed updates mvm state to failed",
+			state:    models.FailedState,
+			expected: models.FailedState,
+		},
+	}
+	for _, tc := range tt {
+		RegisterTestingT(t)
+		vm := createTestSpec("vmid", "namespace")
+		plan := plans.MicroVMStopPlan(&plans.StopPlanInput{
+			VM: vm,
+		})
+
+		plan.Finalise(tc.state)
+
+		Expect(vm.Status.State).To(Equal(tc.expected))
+	}
+}
@@ -8,13 +8,21 @@ import (
 
 type Collection struct {
 	Repo              MicroVMRepository
+	SnapshotRepo      SnapshotRepository
+	HistoryRepo       HistoryRepository
+	QuotaRepo         QuotaRepository
 	MicrovmProviders  map[string]MicroVMService
 	EventService      EventService
 	IdentifierService IDService
 	NetworkService    NetworkService
+	IPAMService       IPAMService
+	DHCPService       DHCPService
+	FirewallService   FirewallService
 	ImageService      ImageService
 	DiskService       DiskService
 	FileSystem        afero.Fs
 	Clock             func() time.Time
 	VirtioFSService   VirtioFSService
+	GuestAgentService GuestAgentService
+	HostService       HostService
 }
@@ -29,3 +29,35 @@ type MicroVMRepository interface {
 	// ReleaseLease will release the supplied lease.
 	ReleaseLease(ctx context.Context, microvm *models.MicroVM) error
 }
+
+// SnapshotRepository is the port definition for a snapshot repository.
+type SnapshotRepository interface {
+	// Save will save the supplied snapshot.
+	Save(ctx context.Context, snapshot *models.Snapshot) (*models.Snapshot, error)
+	// Delete will delete the supplied snapshot.
+	Delete(ctx context.Context, snapshot *models.Snapshot) error
+	// Get will get the snapshot with the given uid.
+	Get(ctx context.Context, uid string) (*models.Snapshot, error)
+	// GetAll will get a list of snapshots that match the query.
+	GetAll(ctx context.Context, query models.ListSnapshotQuery) ([]*models.Snapshot, error)
+}
+
+// HistoryRepository is the port definition for a repository of microvm plan executions.
+type HistoryRepository interface {
+	// Append will add an execution to the history of a microvm. Only the most recent
+	// executions are kept.
+	Append(ctx context.Context, vmid models.VMID, execution *models.PlanExecution) error
+	// GetAll will get the history of the microvm with the given uid, most recent first.
+	GetAll(ctx context.Context, uid string) ([]*models.PlanExecution, error)
+	// Delete will delete the history of the microvm with the given uid.
+	Delete(ctx context.Context, uid string) error
+}
+
+// QuotaRepository is the port definition for a repository of namespace quotas.
+type QuotaRepository interface {
+	// Save will save the supplied quota, replacing any existing quota for the namespace.
+	Save(ctx context.Context, quota *models.NamespaceQuota) (*models.NamespaceQuota, error)
+	// Get will get the quota for the given namespace. If the namespace doesn't have a quota
+	// nil is returned.
+	Get(ctx context.Context, namespace string) (*models.NamespaceQuota, error)
+}
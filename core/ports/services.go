@@ -2,8 +2,10 @@ package ports
 
 import (
 	"context"
+	"io"
 	"time"
 
+	hostv1 "github.com/liquidmetal-dev/flintlock/api/services/host/v1alpha1"
 	mvmv1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 )
@@ -19,16 +21,51 @@ type MicroVMService interface {
 	Delete(ctx context.Context, id string) error
 	// Start will start a created microvm.
 	Start(ctx context.Context, vm *models.MicroVM) error
+	// Stop will stop a running microvm, its runtime state is kept so it can be started again.
+	Stop(ctx context.Context, id string) error
+	// Restart will restart a running microvm.
+	Restart(ctx context.Context, vm *models.MicroVM) error
+	// Pause will pause a running microvm.
+	Pause(ctx context.Context, id string) error
+	// Resume will resume a paused microvm.
+	Resume(ctx context.Context, id string) error
+	// Snapshot will save the memory and device state of a running or paused microvm
+	// to files in the supplied directory.
+	Snapshot(ctx context.Context, id string, path string) error
+	// Restore will create a new microvm from a snapshot and resume it.
+	Restore(ctx context.Context, vm *models.MicroVM, snapshot *models.Snapshot) error
+	// UpdateMetadata will replace the metadata of a running microvm.
+	UpdateMetadata(ctx context.Context, vm *models.MicroVM) error
+	// UpdateNetworkRateLimits will apply the rate limits of the network interfaces to a running microvm.
+	UpdateNetworkRateLimits(ctx context.Context, vm *models.MicroVM) error
+	// UpdateDevices will hotplug the network interfaces and volumes of a running microvm, adding
+	// the ones in its spec that it hasn't got and removing the ones that aren't in its spec.
+	UpdateDevices(ctx context.Context, vm *models.MicroVM) error
 	// State returns the state of a microvm.
 	State(ctx context.Context, id string) (MicroVMState, error)
 	// Metrics returns with the metrics of a microvm.
 	Metrics(ctx context.Context, id models.VMID) (MachineMetrics, error)
+	// ConsoleOutput returns a reader for the serial console output of a microvm.
+	ConsoleOutput(ctx context.Context, id string, opts ConsoleOutputOptions) (io.ReadCloser, error)
+	// ConsoleInput returns a writer that sends input to the serial console of a running microvm.
+	ConsoleInput(ctx context.Context, id string) (io.WriteCloser, error)
+	// Version returns the version of the VMM used by the provider.
+	Version(ctx context.Context) (string, error)
 }
 
 // This state represents the state of the Firecracker MVM process itself
 // The state for the entire Flintlock MVM is represented in models.MicroVMState.
 type MicroVMState string
 
+// ConsoleOutputOptions are the options used when reading the console output of a microvm.
+type ConsoleOutputOptions struct {
+	// TailLines is the number of lines from the end of the output to start reading from.
+	// Zero means read all of the output.
+	TailLines int
+	// Follow will wait for more output rather than stopping at the end of the current output.
+	Follow bool
+}
+
 // MachineMetrics is a metrics interface for providers.
 type MachineMetrics interface {
 	ToPrometheus() []byte
@@ -39,6 +76,7 @@ const (
 	MicroVMStatePending    MicroVMState = "pending"
 	MicroVMStateConfigured MicroVMState = "configured"
 	MicroVMStateRunning    MicroVMState = "running"
+	MicroVMStatePaused     MicroVMState = "paused"
 )
 
 // MicroVMGRPCService is a port for a microvm grpc service.
@@ -46,6 +84,11 @@ type MicroVMGRPCService interface {
 	mvmv1.MicroVMServer
 }
 
+// HostGRPCService is a port for a host grpc service.
+type HostGRPCService interface {
+	hostv1.HostServer
+}
+
 // IDService is a port for a service for working with identifiers.
 type IDService interface {
 	// GenerateRandom will create a random identifier.
@@ -82,6 +125,9 @@ type ImageService interface {
 	Exists(ctx context.Context, input *ImageSpec) (bool, error)
 	// IsMounted checks if the image is pulled and mounted.
 	IsMounted(ctx context.Context, input *ImageMountSpec) (bool, error)
+	// Unmount will remove the mount of an image for a specific owner. The image itself
+	// isn't removed.
+	Unmount(ctx context.Context, input *ImageMountSpec) error
 }
 
 type ImageSpec struct {
@@ -110,10 +156,17 @@ type NetworkService interface {
 	IfaceCreate(ctx context.Context, input IfaceCreateInput) (*IfaceDetails, error)
 	// IfaceDelete is used to delete a network interface
 	IfaceDelete(ctx context.Context, input DeleteIfaceInput) error
-	// IfaceExists will check if an interface with the given name exists
-	IfaceExists(ctx context.Context, name string) (bool, error)
-	// IfaceDetails will get the details of the supplied network interface.
-	IfaceDetails(ctx context.Context, name string) (*IfaceDetails, error)
+	// IfaceExists will check if an interface with the given name exists in the network
+	// namespace. An empty network namespace is the namespace of the host.
+	IfaceExists(ctx context.Context, networkNamespace, name string) (bool, error)
+	// IfaceDetails will get the details of the supplied network interface in the network namespace.
+	IfaceDetails(ctx context.Context, networkNamespace, name string) (*IfaceDetails, error)
+	// NamespaceCreate will create a named network namespace.
+	NamespaceCreate(ctx context.Context, name string) error
+	// NamespaceDelete will delete a named network namespace and the interfaces in it.
+	NamespaceDelete(ctx context.Context, name string) error
+	// NamespaceExists will check if a named network namespace exists.
+	NamespaceExists(ctx context.Context, name string) (bool, error)
 }
 
 type IfaceCreateInput struct {
@@ -128,6 +181,20 @@ type IfaceCreateInput struct {
 	Attach bool
 	// BridgeName is the name of the bridge to attach to. Only if this is a tap device and attach is true.
 	BridgeName string
+	// NetworkName is the name of the network to create the interface on. The bridge name and
+	// VLAN ID override the ones of the network.
+	NetworkName string
+	// VLANID is the VLAN to put the interface on.
+	VLANID uint16
+	// MTU is the MTU of the interface, overriding the MTU of its network.
+	MTU int
+	// NumQueues is the number of queue pairs of the interface. A tap device with more than
+	// one is created as a multi-queue device.
+	NumQueues int
+	// NetworkNamespace is the network namespace to create the interface in, if it's not
+	// created in the namespace of the host. An attached tap device is connected to its
+	// bridge with a veth pair, the host end of which has the name of the tap device.
+	NetworkNamespace string
 }
 
 type IfaceDetails struct {
@@ -139,11 +206,101 @@ type IfaceDetails struct {
 	MAC string
 	// Index is the network interface index on the host.
 	Index int
+	// BridgeName is the name of the bridge the interface is attached to, if any.
+	BridgeName string
+	// MTU is the MTU of the interface on the host.
+	MTU int
 }
 
 type DeleteIfaceInput struct {
 	// DeviceName is the name of the network interface to delete from the host.
 	DeviceName string
+	// NetworkNamespace is the network namespace the interface is in, if it's not in the
+	// namespace of the host.
+	NetworkNamespace string
+}
+
+// IPAMService is a port for a service that manages pools of IP addresses for the network
+// interfaces attached to bridges.
+type IPAMService interface {
+	// HasPool returns true if there's a pool of addresses for the bridge. An empty bridge
+	// name is the default bridge.
+	HasPool(bridgeName string) bool
+	// Allocate will allocate an address from the pool of the bridge to the owner. If the owner
+	// already has an address from the pool the same address is returned.
+	Allocate(ctx context.Context, input IPAllocateInput) (*IPAllocation, error)
+	// Release will return an address allocated to the owner to its pool.
+	Release(ctx context.Context, input IPReleaseInput) error
+}
+
+type IPAllocateInput struct {
+	// BridgeName is the name of the bridge the interface is attached to.
+	BridgeName string
+	// Owner is what the address is allocated to.
+	Owner string
+}
+
+type IPAllocation struct {
+	// Pool is the name of the pool the address was allocated from.
+	Pool string
+	// Address is the allocated address and the gateway and nameservers of the pool.
+	Address models.StaticAddress
+}
+
+type IPReleaseInput struct {
+	// Pool is the name of the pool the address was allocated from.
+	Pool string
+	// Address is the allocated address.
+	Address models.IPAddressCIDR
+	// Owner is what the address is allocated to.
+	Owner string
+}
+
+// DHCPService is a port for the embedded DHCP server that serves the addresses of the network
+// interfaces attached to a bridge.
+type DHCPService interface {
+	// Serves returns true if the DHCP server is enabled for the bridge. An empty bridge name
+	// is the default bridge.
+	Serves(bridgeName string) bool
+	// HasLease returns true if there's a lease of the address for the MAC address.
+	HasLease(macAddress string, address models.IPAddressCIDR) bool
+	// AddLease will add a lease, replacing any existing lease for the same MAC address.
+	AddLease(ctx context.Context, lease DHCPLease) error
+	// RemoveLease will remove the lease for the MAC address.
+	RemoveLease(ctx context.Context, macAddress string) error
+	// Start will start serving the leases. It serves until the context is cancelled.
+	Start(ctx context.Context) error
+}
+
+type DHCPLease struct {
+	// MACAddress is the MAC address of the guest network interface.
+	MACAddress string
+	// Address is the address to lease and the gateway and nameservers to use with it.
+	Address models.StaticAddress
+	// Hostname is the hostname to give the guest.
+	Hostname string
+}
+
+// FirewallService is a port for a service that filters the traffic to and from network interfaces.
+type FirewallService interface {
+	// Apply will create the rules for a network interface, replacing any existing rules.
+	Apply(ctx context.Context, input FirewallInput) error
+	// Verify returns an error if the rules of a network interface aren't the ones for the input.
+	Verify(ctx context.Context, input FirewallInput) error
+	// Remove will remove the rules for a network interface.
+	Remove(ctx context.Context, deviceName string) error
+}
+
+// FirewallInput is the input for applying the firewall of a network interface.
+type FirewallInput struct {
+	// DeviceName is the name of the host device of the interface.
+	DeviceName string
+	// MACAddress is the MAC address of the guest network interface.
+	MACAddress string
+	// Address is the address of the guest network interface, if it's known.
+	Address models.IPAddressCIDR
+	// Firewall is the firewall of the interface.
+	Firewall models.Firewall
 }
 
 // DiskService is a port for a service that creates disk images.
@@ -204,3 +361,49 @@ type VirtioFSService interface {
 	Delete(ctx context.Context, vmid *models.VMID) error
 	HasVirtioFSDProcess(ctx context.Context, vmid *models.VMID) (bool, error)
 }
+
+// GuestAgentService is a port for a service that talks to the guest agent running in a microvm.
+type GuestAgentService interface {
+	// Exec runs a command in the microvm and returns its exit code.
+	Exec(ctx context.Context, vsockPath string, input GuestExecInput) (int, error)
+	// CopyTo writes content to a file in the microvm.
+	CopyTo(ctx context.Context, vsockPath string, input GuestCopyToInput) error
+	// CopyFrom reads a file from the microvm into dest.
+	CopyFrom(ctx context.Context, vsockPath string, path string, dest io.Writer) error
+	// Ping checks the guest agent in the microvm is up and responding.
+	Ping(ctx context.Context, vsockPath string) error
+}
+
+// GuestExecInput is the input to run a command in a microvm.
+type GuestExecInput struct {
+	// Command is the command and its arguments.
+	Command []string
+	// Env is additional environment variables for the command.
+	Env map[string]string
+	// WorkingDir is the directory to run the command in, defaults to the guest agent's choice.
+	WorkingDir string
+	// Stdin is sent to the command, it's closed when Stdin returns io.EOF. Can be nil.
+	Stdin io.Reader
+	// Stdout receives the stdout of the command.
+	Stdout io.Writer
+	// Stderr receives the stderr of the command.
+	Stderr io.Writer
+}
+
+// GuestCopyToInput is the input to write a file in a microvm.
+type GuestCopyToInput struct {
+	// Path is the path of the file in the microvm.
+	Path string
+	// Mode is the permissions of the file if it's created.
+	Mode uint32
+	// Content is the content of the file.
+	Content io.Reader
+}
+
+// HostService is a port for a service that gets information about the host flintlock is running on.
+type HostService interface {
+	// Resources returns the physical resources of the host.
+	Resources(ctx context.Context) (*models.HostResources, error)
+	// Info returns the details of the host. The providers are left for the caller to fill in.
+	Info(ctx context.Context) (*models.HostInfo, error)
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"io"
 
 	"github.com/liquidmetal-dev/flintlock/core/models"
 )
@@ -10,20 +11,69 @@ import (
 type MicroVMCommandUseCases interface {
 	// CreateMicroVM is a use case for creating a microvm.
 	CreateMicroVM(ctx context.Context, mvm *models.MicroVM) (*models.MicroVM, error)
+	// UpdateMicroVM is a use case for changing the spec of an existing microvm.
+	UpdateMicroVM(ctx context.Context, uid string, mvm *models.MicroVM) (*models.MicroVM, error)
+	// StopMicroVM is a use case for stopping a microvm without deleting it.
+	StopMicroVM(ctx context.Context, uid string) error
+	// StartMicroVM is a use case for starting a stopped microvm.
+	StartMicroVM(ctx context.Context, uid string) error
+	// RestartMicroVM is a use case for restarting a running microvm.
+	RestartMicroVM(ctx context.Context, uid string) error
+	// PauseMicroVM is a use case for pausing a running microvm.
+	PauseMicroVM(ctx context.Context, uid string) error
+	// ResumeMicroVM is a use case for resuming a paused microvm.
+	ResumeMicroVM(ctx context.Context, uid string) error
 	// DeleteMicroVM is a use case for deleting a microvm.
 	DeleteMicroVM(ctx context.Context, vmid string) error
+	// CreateSnapshot is a use case for snapshotting a running or paused microvm.
+	CreateSnapshot(ctx context.Context, uid string, name string) (*models.Snapshot, error)
+	// DeleteSnapshot is a use case for deleting a snapshot and its files.
+	DeleteSnapshot(ctx context.Context, uid string) error
+	// SetNamespaceQuota is a use case for setting the limits on the resources of a namespace.
+	SetNamespaceQuota(ctx context.Context, quota *models.NamespaceQuota) (*models.NamespaceQuota, error)
+	// AttachConsole is a use case for attaching to the serial console of a running microvm. It
+	// returns the console output, which follows new output until the context is done, and the
+	// console input. Both must be closed by the caller.
+	AttachConsole(ctx context.Context, uid string) (io.ReadCloser, io.WriteCloser, error)
+	// ExecInMicroVM is a use case for running a command in a running microvm using the guest agent.
+	// It returns the exit code of the command.
+	ExecInMicroVM(ctx context.Context, uid string, input GuestExecInput) (int, error)
+	// CopyToMicroVM is a use case for writing a file in a running microvm using the guest agent.
+	CopyToMicroVM(ctx context.Context, uid string, input GuestCopyToInput) error
 }
 
 // MicroVMQueryUseCases is the interface for uses cases that are queries for microvms.
 type MicroVMQueryUseCases interface {
 	// GetMicroVM is a use case for getting details of a specific microvm.
 	GetMicroVM(ctx context.Context, vmid string) (*models.MicroVM, error)
+	// GetMicroVMHistory is a use case for getting the recent plan executions of a specific microvm.
+	GetMicroVMHistory(ctx context.Context, uid string) ([]*models.PlanExecution, error)
 	// GetAllMicroVM is a use case for getting details of all microvms in a given namespace.
 	GetAllMicroVM(ctx context.Context, query models.ListMicroVMQuery) ([]*models.MicroVM, error)
+	// WatchMicroVMs is a use case for being told about changes to the microvms that match a query. Each
+	// change is passed to send and it blocks until the context is done or send returns an error.
+	WatchMicroVMs(ctx context.Context, query models.WatchMicroVMQuery, send func(*models.MicroVMEvent) error) error
+	// GetAllSnapshots is a use case for getting details of the snapshots that match a query.
+	GetAllSnapshots(ctx context.Context, query models.ListSnapshotQuery) ([]*models.Snapshot, error)
+	// GetConsoleLog is a use case for reading the serial console output of a microvm. The output is
+	// passed to send in chunks, which are only valid until send returns. If following the output it
+	// blocks until the context is done or send returns an error.
+	GetConsoleLog(ctx context.Context, uid string, opts ConsoleOutputOptions, send func([]byte) error) error
+	// CopyFromMicroVM is a use case for reading a file from a running microvm using the guest agent.
+	CopyFromMicroVM(ctx context.Context, uid string, path string, dest io.Writer) error
+	// GetHostCapacity is a use case for getting the total, allocated and free resources of the host.
+	GetHostCapacity(ctx context.Context) (*models.HostCapacity, error)
+	// GetNamespaceQuota is a use case for getting the quota of a namespace and the resources it uses.
+	GetNamespaceQuota(ctx context.Context, namespace string) (*models.NamespaceQuotaStatus, error)
+	// GetHostInfo is a use case for getting the details of the host and the providers it offers.
+	GetHostInfo(ctx context.Context) (*models.HostInfo, error)
 }
 
 // ReconcileMicroVMsUseCase is the interface for use cases that are related to reconciling microvms.
 type ReconcileMicroVMsUseCase interface {
 	// ReconcileMicroVM is a use case for reconciling a specific microvm.
 	ReconcileMicroVM(ctx context.Context, vmid models.VMID) error
+	// RestoreDHCPLeases is a use case for adding the leases of the existing microvms back to
+	// the embedded DHCP server, which only keeps them in memory.
+	RestoreDHCPLeases(ctx context.Context) error
 }
@@ -30,6 +30,11 @@ func (s *diskMountStep) Name() string {
 	return "cloudinit_disk_mount"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *diskMountStep) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
 func (s *diskMountStep) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step": s.Name(),
@@ -30,6 +30,11 @@ func (s *createStep) Name() string {
 	return "microvm_create"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *createStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
 func (s *createStep) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step": s.Name(),
@@ -61,6 +66,13 @@ func (s *createStep) Do(ctx context.Context) ([]planner.Procedure, error) {
 		return nil, fmt.Errorf("creating microvm: %w", err)
 	}
 
+	// The microvm has been freshly created from the latest spec so there are no
+	// changes left to apply and no need to restart it.
+	s.vm.Status.DeviceUpdatePending = false
+	s.vm.Status.MetadataUpdatePending = false
+	s.vm.Status.RateLimitUpdatePending = false
+	s.vm.Status.RestartPending = false
+
 	return nil, nil
 }
 
@@ -30,6 +30,11 @@ func (s *deleteStep) Name() string {
 	return "microvm_delete"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *deleteStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
 func (s *deleteStep) ShouldDo(ctx context.Context) (bool, error) {
 	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
 	if err != nil {
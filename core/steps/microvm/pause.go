@@ -0,0 +1,88 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewPauseStep creates a step that pauses a running microvm that should be paused.
+func NewPauseStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &pauseStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type pauseStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *pauseStep) Name() string {
+	return "microvm_pause"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *pauseStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *pauseStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if !s.vm.Spec.IsPaused() {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *pauseStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("pausing microvm")
+
+	if err := s.vmSvc.Pause(ctx, s.vm.ID.String()); err != nil {
+		return nil, fmt.Errorf("pausing microvm: %w", err)
+	}
+
+	return nil, nil
+}
+
+func (s *pauseStep) Verify(ctx context.Context) error {
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state != ports.MicroVMStatePaused {
+		return errors.ErrUnableToPause
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	internalerr "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewPauseStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Spec.PowerState = models.PowerStatePaused
+
+	step := microvm.NewPauseStep(vm, microVMService)
+
+	running := microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	pause := microVMService.
+		EXPECT().
+		Pause(ctx, vm.ID.String()).
+		Return(nil).
+		After(running)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStatePaused, nil).
+		After(pause)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+}
+
+func TestNewPauseStep_StateCheck(t *testing.T) {
+	type stateCheck struct {
+		State       ports.MicroVMState
+		ExpectToRun bool
+	}
+
+	stateTestCases := []stateCheck{
+		{State: ports.MicroVMStatePending, ExpectToRun: false},
+		{State: ports.MicroVMStateConfigured, ExpectToRun: false},
+		{State: ports.MicroVMStateRunning, ExpectToRun: true},
+		{State: ports.MicroVMStatePaused, ExpectToRun: false},
+		{State: ports.MicroVMStateUnknown, ExpectToRun: false},
+	}
+
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Spec.PowerState = models.PowerStatePaused
+
+	step := microvm.NewPauseStep(vm, microVMService)
+
+	for _, testCase := range stateTestCases {
+		microVMService.
+			EXPECT().
+			State(ctx, vm.ID.String()).
+			Return(testCase.State, nil)
+
+		shouldDo, shouldErr := step.ShouldDo(ctx)
+
+		g.Expect(shouldDo).To(g.Equal(testCase.ExpectToRun))
+		g.Expect(shouldErr).To(g.BeNil())
+	}
+}
+
+func TestNewPauseStep_NotRequested(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewPauseStep(vm, microVMService)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewPauseStep_PauseError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Spec.PowerState = models.PowerStatePaused
+
+	step := microvm.NewPauseStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		Pause(ctx, vm.ID.String()).
+		Return(errors.New("i have a bad feeling about this"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).ToNot(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+}
+
+func TestNewPauseStep_VerifyStillRunning(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Spec.PowerState = models.PowerStatePaused
+
+	step := microvm.NewPauseStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	err := step.Verify(ctx)
+
+	g.Expect(err).To(g.MatchError(internalerr.ErrUnableToPause))
+}
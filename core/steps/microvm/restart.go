@@ -0,0 +1,80 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+func NewRestartStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &restartStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type restartStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *restartStep) Name() string {
+	return "microvm_restart"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *restartStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *restartStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if !s.vm.Status.RestartPending {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *restartStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("restarting microvm")
+
+	if err := s.vmSvc.Restart(ctx, s.vm); err != nil {
+		return nil, fmt.Errorf("restarting microvm: %w", err)
+	}
+
+	s.vm.Status.RestartPending = false
+
+	return nil, nil
+}
+
+func (s *restartStep) Verify(_ context.Context) error {
+	return nil
+}
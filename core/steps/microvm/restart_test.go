@@ -0,0 +1,89 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewRestartStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.RestartPending = true
+
+	step := microvm.NewRestartStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	microVMService.
+		EXPECT().
+		Restart(ctx, vm).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+	g.Expect(vm.Status.RestartPending).To(g.BeFalse())
+}
+
+func TestNewRestartStep_NotRequested(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewRestartStep(vm, microVMService)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewRestartStep_RestartError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.RestartPending = true
+
+	step := microvm.NewRestartStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		Restart(ctx, vm).
+		Return(errors.New("i have a bad feeling about this"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).ToNot(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(vm.Status.RestartPending).To(g.BeTrue())
+}
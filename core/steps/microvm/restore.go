@@ -0,0 +1,108 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/filecopy"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+const restoredVolumePerm = 0o600
+
+// NewRestoreStep creates a step that creates a microvm by restoring it from a snapshot. The
+// volumes of the microvm are replaced with the copies in the snapshot first.
+func NewRestoreStep(
+	vm *models.MicroVM,
+	vmSvc ports.MicroVMService,
+	fs afero.Fs,
+	snapshot *models.Snapshot,
+) planner.Procedure {
+	return &restoreStep{
+		vm:       vm,
+		vmSvc:    vmSvc,
+		fs:       fs,
+		snapshot: snapshot,
+	}
+}
+
+type restoreStep struct {
+	vm       *models.MicroVM
+	vmSvc    ports.MicroVMService
+	fs       afero.Fs
+	snapshot *models.Snapshot
+}
+
+// Name is the name of the procedure/operation.
+func (s *restoreStep) Name() string {
+	return "microvm_restore"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *restoreStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *restoreStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStatePending, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *restoreStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":     s.Name(),
+		"vmid":     s.vm.ID,
+		"snapshot": s.snapshot.UID,
+	})
+	logger.Debug("restoring microvm from snapshot")
+
+	for _, volumeID := range s.snapshot.Volumes {
+		status, ok := s.vm.Status.Volumes[volumeID]
+		if !ok {
+			return nil, fmt.Errorf("volume %s from snapshot %s isn't mounted", volumeID, s.snapshot.UID)
+		}
+
+		err := filecopy.Copy(s.fs, s.snapshot.VolumePath(volumeID), status.Mount.Source, restoredVolumePerm)
+		if err != nil {
+			return nil, fmt.Errorf("restoring volume %s from snapshot: %w", volumeID, err)
+		}
+	}
+
+	if err := s.vmSvc.Restore(ctx, s.vm, s.snapshot); err != nil {
+		return nil, fmt.Errorf("restoring microvm from snapshot %s: %w", s.snapshot.UID, err)
+	}
+
+	s.vm.Status.Restored = true
+	s.vm.Status.DeviceUpdatePending = false
+	s.vm.Status.MetadataUpdatePending = false
+	s.vm.Status.RateLimitUpdatePending = false
+	s.vm.Status.RestartPending = false
+
+	return nil, nil
+}
+
+func (s *restoreStep) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewRestoreStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	fs := afero.NewMemMapFs()
+	vm := testVMToCreate()
+	vm.Status.DeviceUpdatePending = true
+	vm.Status.Volumes = models.VolumeStatuses{
+		"root": {Mount: models.Mount{Type: models.MountTypeDev, Source: "/dev/mapper/root"}},
+	}
+	snapshot := &models.Snapshot{UID: "snap1234", Path: "/tmp/snapshots/snap1234", Volumes: []string{"root"}}
+
+	g.Expect(afero.WriteFile(fs, "/dev/mapper/root", []byte("new volume"), 0o600)).To(g.Succeed())
+	g.Expect(afero.WriteFile(fs, snapshot.VolumePath("root"), []byte("snapshotted"), 0o600)).To(g.Succeed())
+
+	step := microvm.NewRestoreStep(vm, microVMService, fs, snapshot)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStatePending, nil)
+
+	microVMService.
+		EXPECT().
+		Restore(ctx, vm, snapshot).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+	g.Expect(vm.Status.DeviceUpdatePending).To(g.BeFalse())
+	g.Expect(vm.Status.Restored).To(g.BeTrue())
+
+	volume, err := afero.ReadFile(fs, "/dev/mapper/root")
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(string(volume)).To(g.Equal("snapshotted"))
+}
+
+func TestNewRestoreStep_AlreadyRunning(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewRestoreStep(vm, microVMService, afero.NewMemMapFs(), &models.Snapshot{UID: "snap1234"})
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewRestoreStep_RestoreError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	snapshot := &models.Snapshot{UID: "snap1234"}
+
+	step := microvm.NewRestoreStep(vm, microVMService, afero.NewMemMapFs(), snapshot)
+
+	microVMService.
+		EXPECT().
+		Restore(ctx, vm, snapshot).
+		Return(errors.New("restore failed"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(err).To(g.HaveOccurred())
+}
+
+func TestNewRestoreStep_VolumeNotMounted(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	vm := testVMToCreate()
+	snapshot := &models.Snapshot{UID: "snap1234", Path: "/tmp/snapshots/snap1234", Volumes: []string{"root"}}
+
+	step := microvm.NewRestoreStep(vm, microVMService, afero.NewMemMapFs(), snapshot)
+
+	subSteps, err := step.Do(context.Background())
+
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(err).To(g.MatchError(g.ContainSubstring("isn't mounted")))
+}
@@ -0,0 +1,79 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewResumeStep creates a step that resumes a paused microvm that should be running.
+func NewResumeStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &resumeStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type resumeStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *resumeStep) Name() string {
+	return "microvm_resume"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *resumeStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *resumeStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if s.vm.Spec.IsPaused() {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	return state == ports.MicroVMStatePaused, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *resumeStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("resuming microvm")
+
+	if err := s.vmSvc.Resume(ctx, s.vm.ID.String()); err != nil {
+		return nil, fmt.Errorf("resuming microvm: %w", err)
+	}
+
+	return nil, nil
+}
+
+func (s *resumeStep) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewResumeStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.State = models.PausedState
+
+	step := microvm.NewResumeStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStatePaused, nil)
+
+	microVMService.
+		EXPECT().
+		Resume(ctx, vm.ID.String()).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+}
+
+func TestNewResumeStep_StillPaused(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Spec.PowerState = models.PowerStatePaused
+	vm.Status.State = models.PausedState
+
+	step := microvm.NewResumeStep(vm, microVMService)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewResumeStep_PausedOutsideFlintlock(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.State = models.CreatedState
+
+	step := microvm.NewResumeStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStatePaused, nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewResumeStep_ResumeError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewResumeStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		Resume(ctx, vm.ID.String()).
+		Return(errors.New("i have a bad feeling about this"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).ToNot(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+}
@@ -37,6 +37,11 @@ func (s *startStep) Name() string {
 	return "microvm_start"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *startStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
 func (s *startStep) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step": s.Name(),
@@ -49,7 +54,7 @@ func (s *startStep) ShouldDo(ctx context.Context) (bool, error) {
 		return false, fmt.Errorf("checking if microvm is running: %w", err)
 	}
 
-	return state != ports.MicroVMStateRunning, nil
+	return state != ports.MicroVMStateRunning && state != ports.MicroVMStatePaused, nil
 }
 
 // Do will perform the operation/procedure.
@@ -0,0 +1,83 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+func NewStopStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &stopStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type stopStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *stopStep) Name() string {
+	return "microvm_stop"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *stopStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *stopStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning || state == ports.MicroVMStatePaused, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *stopStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("stopping microvm")
+
+	if err := s.vmSvc.Stop(ctx, s.vm.ID.String()); err != nil {
+		return nil, fmt.Errorf("stopping microvm: %w", err)
+	}
+
+	return nil, nil
+}
+
+func (s *stopStep) Verify(ctx context.Context) error {
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	if state == ports.MicroVMStateRunning || state == ports.MicroVMStatePaused {
+		return errors.ErrUnableToStop
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	internalerr "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewStopStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewStopStep(vm, microVMService)
+
+	gomock.InOrder(
+		microVMService.
+			EXPECT().
+			State(ctx, vm.ID.String()).
+			Return(ports.MicroVMStateRunning, nil),
+		microVMService.
+			EXPECT().
+			Stop(ctx, vm.ID.String()).
+			Return(nil),
+		microVMService.
+			EXPECT().
+			State(ctx, vm.ID.String()).
+			Return(ports.MicroVMStatePending, nil),
+	)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+}
+
+func TestNewStopStep_StateCheck(t *testing.T) {
+	type stateCheck struct {
+		State       ports.MicroVMState
+		ExpectToRun bool
+	}
+
+	stateTestCases := []stateCheck{
+		{State: ports.MicroVMStatePending, ExpectToRun: false},
+		{State: ports.MicroVMStateConfigured, ExpectToRun: false},
+		{State: ports.MicroVMStateRunning, ExpectToRun: true},
+		{State: ports.MicroVMStatePaused, ExpectToRun: true},
+		{State: ports.MicroVMStateUnknown, ExpectToRun: false},
+	}
+
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewStopStep(vm, microVMService)
+
+	for _, testCase := range stateTestCases {
+		microVMService.
+			EXPECT().
+			State(ctx, vm.ID.String()).
+			Return(testCase.State, nil)
+
+		shouldDo, shouldErr := step.ShouldDo(ctx)
+
+		g.Expect(shouldDo).To(g.Equal(testCase.ExpectToRun))
+		g.Expect(shouldErr).To(g.BeNil())
+	}
+}
+
+func TestNewStopStep_StillRunning(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewStopStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(verifyErr).To(g.MatchError(internalerr.ErrUnableToStop))
+}
+
+func TestNewStopStep_StopError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewStopStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		Stop(ctx, vm.ID.String()).
+		Return(errors.New("i have a bad feeling about this"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).ToNot(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+}
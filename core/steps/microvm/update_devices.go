@@ -0,0 +1,83 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewUpdateDevicesStep creates a step that hotplugs the network interfaces and volumes that
+// have been added to or removed from the spec of an existing microvm. A paused microvm is
+// updated once it's resumed.
+func NewUpdateDevicesStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &updateDevicesStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type updateDevicesStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *updateDevicesStep) Name() string {
+	return "microvm_update_devices"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *updateDevicesStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *updateDevicesStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if !s.vm.Status.DeviceUpdatePending {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning || state == ports.MicroVMStateConfigured, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *updateDevicesStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("updating microvm devices")
+
+	if err := s.vmSvc.UpdateDevices(ctx, s.vm); err != nil {
+		return nil, fmt.Errorf("updating microvm devices: %w", err)
+	}
+
+	s.vm.Status.DeviceUpdatePending = false
+
+	return nil, nil
+}
+
+func (s *updateDevicesStep) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestNewUpdateDevicesStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.DeviceUpdatePending = true
+
+	step := microvm.NewUpdateDevicesStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		State(ctx, vm.ID.String()).
+		Return(ports.MicroVMStateRunning, nil)
+
+	microVMService.
+		EXPECT().
+		UpdateDevices(ctx, vm).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+	g.Expect(vm.Status.DeviceUpdatePending).To(g.BeFalse())
+}
+
+func TestNewUpdateDevicesStep_NoPendingUpdate(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+
+	step := microvm.NewUpdateDevicesStep(vm, microVMService)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestNewUpdateDevicesStep_StateCheck(t *testing.T) {
+	type stateCheck struct {
+		State       ports.MicroVMState
+		ExpectToRun bool
+	}
+
+	stateTestCases := []stateCheck{
+		{State: ports.MicroVMStatePending, ExpectToRun: false},
+		{State: ports.MicroVMStateConfigured, ExpectToRun: true},
+		{State: ports.MicroVMStateRunning, ExpectToRun: true},
+		{State: ports.MicroVMStatePaused, ExpectToRun: false},
+		{State: ports.MicroVMStateUnknown, ExpectToRun: false},
+	}
+
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.DeviceUpdatePending = true
+
+	step := microvm.NewUpdateDevicesStep(vm, microVMService)
+
+	for _, testCase := range stateTestCases {
+		microVMService.
+			EXPECT().
+			State(ctx, vm.ID.String()).
+			Return(testCase.State, nil)
+
+		shouldDo, shouldErr := step.ShouldDo(ctx)
+
+		g.Expect(shouldDo).To(g.Equal(testCase.ExpectToRun))
+		g.Expect(shouldErr).To(g.BeNil())
+	}
+}
+
+func TestNewUpdateDevicesStep_UpdateError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	microVMService := mock.NewMockMicroVMService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMToCreate()
+	vm.Status.DeviceUpdatePending = true
+
+	step := microvm.NewUpdateDevicesStep(vm, microVMService)
+
+	microVMService.
+		EXPECT().
+		UpdateDevices(ctx, vm).
+		Return(errors.New("i have a bad feeling about this"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).ToNot(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(vm.Status.DeviceUpdatePending).To(g.BeTrue())
+}
@@ -0,0 +1,84 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewUpdateMetadataStep creates a step that applies updated metadata to a running microvm
+// without recreating it.
+func NewUpdateMetadataStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &updateMetadataStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type updateMetadataStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *updateMetadataStep) Name() string {
+	return "microvm_metadata_update"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *updateMetadataStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *updateMetadataStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if !s.vm.Status.MetadataUpdatePending {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning ||
+		state == ports.MicroVMStateConfigured ||
+		state == ports.MicroVMStatePaused, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *updateMetadataStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("updating microvm metadata")
+
+	if err := s.vmSvc.UpdateMetadata(ctx, s.vm); err != nil {
+		return nil, fmt.Errorf("updating microvm metadata: %w", err)
+	}
+
+	s.vm.Status.MetadataUpdatePending = false
+
+	return nil, nil
+}
+
+func (s *updateMetadataStep) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewUpdateNetworkRateLimitsStep creates a step that applies updated rate limits of the network
+// interfaces to a running microvm without recreating it.
+func NewUpdateNetworkRateLimitsStep(vm *models.MicroVM, vmSvc ports.MicroVMService) planner.Procedure {
+	return &updateNetworkRateLimitsStep{
+		vm:    vm,
+		vmSvc: vmSvc,
+	}
+}
+
+type updateNetworkRateLimitsStep struct {
+	vm    *models.MicroVM
+	vmSvc ports.MicroVMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *updateNetworkRateLimitsStep) Name() string {
+	return "microvm_rate_limits_update"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *updateNetworkRateLimitsStep) Condition() models.ConditionType {
+	return models.ConditionVMMRunning
+}
+
+func (s *updateNetworkRateLimitsStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if !s.vm.Status.RateLimitUpdatePending {
+		return false, nil
+	}
+
+	state, err := s.vmSvc.State(ctx, s.vm.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("checking if microvm is running: %w", err)
+	}
+
+	return state == ports.MicroVMStateRunning ||
+		state == ports.MicroVMStateConfigured ||
+		state == ports.MicroVMStatePaused, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *updateNetworkRateLimitsStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("updating microvm network rate limits")
+
+	if err := s.vmSvc.UpdateNetworkRateLimits(ctx, s.vm); err != nil {
+		return nil, fmt.Errorf("updating microvm network rate limits: %w", err)
+	}
+
+	s.vm.Status.RateLimitUpdatePending = false
+
+	return nil, nil
+}
+
+func (s *updateNetworkRateLimitsStep) Verify(_ context.Context) error {
+	return nil
+}
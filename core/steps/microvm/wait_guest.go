@@ -0,0 +1,149 @@
+package microvm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+const guestPingTimeout = 2 * time.Second
+
+// NewWaitForGuestStep creates a step that pings the guest agent of a microvm to see if the guest
+// has booted. It doesn't wait for the guest: until the guest responds or the boot deadline passes
+// the step fails with ErrGuestBooting so the microvm is checked again later. Once the guest has
+// booted the heartbeat is checked on each reconcile, but the step only runs when the guest stops
+// or starts responding again, so a check where nothing changed isn't saved. If booting is true
+// the microvm has just been (re)booted and the guest has to become ready again. A zero
+// bootDeadline disables the step.
+func NewWaitForGuestStep(
+	vm *models.MicroVM,
+	guestSvc ports.GuestAgentService,
+	bootDeadline time.Duration,
+	booting bool,
+) planner.Procedure {
+	return &waitForGuestStep{
+		vm:           vm,
+		guestSvc:     guestSvc,
+		bootDeadline: bootDeadline,
+		booting:      booting,
+	}
+}
+
+type waitForGuestStep struct {
+	vm           *models.MicroVM
+	guestSvc     ports.GuestAgentService
+	bootDeadline time.Duration
+	booting      bool
+
+	pinged  bool
+	pingErr error
+}
+
+// Name is the name of the procedure/operation.
+func (s *waitForGuestStep) Name() string {
+	return "microvm_wait_guest"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *waitForGuestStep) Condition() models.ConditionType {
+	return models.ConditionGuestReady
+}
+
+func (s *waitForGuestStep) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if s.bootDeadline == 0 || !s.vm.Spec.AllowGuestAgent || s.vm.Status.VSockPath == "" || s.vm.Spec.IsPaused() {
+		return false, nil
+	}
+
+	if s.booting || s.vm.Status.GuestBootedAt == 0 || s.vm.Status.GuestReadyAt == 0 {
+		return true, nil
+	}
+
+	responding := s.ping(ctx) == nil
+
+	return responding == s.vm.Status.GuestUnresponsive, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *waitForGuestStep) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.vm == nil {
+		return nil, errors.ErrSpecRequired
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"vmid": s.vm.ID,
+	})
+	logger.Debug("pinging the guest agent")
+
+	if s.booting || s.vm.Status.GuestBootedAt == 0 {
+		s.vm.Status.GuestBootedAt = time.Now().Unix()
+		s.vm.Status.GuestReadyAt = 0
+		s.vm.Status.GuestUnresponsive = false
+		s.pinged = false
+	}
+
+	if err := s.ping(ctx); err != nil {
+		if s.vm.Status.GuestReadyAt != 0 {
+			// The guest has booted, so a missed heartbeat is reported rather than rebooting it.
+			logger.Warnf("guest agent stopped responding: %s", err)
+			s.vm.Status.GuestUnresponsive = true
+
+			return nil, nil
+		}
+
+		logger.Tracef("guest agent not responding yet: %s", err)
+
+		if time.Now().Before(time.Unix(s.vm.Status.GuestBootedAt, 0).Add(s.bootDeadline)) {
+			return nil, fmt.Errorf("pinging guest agent: %w", errors.ErrGuestBooting)
+		}
+
+		return nil, fmt.Errorf("waiting %s for guest agent: %w", s.bootDeadline, errors.ErrGuestNotReady)
+	}
+
+	if s.vm.Status.GuestReadyAt == 0 {
+		s.vm.Status.GuestReadyAt = time.Now().Unix()
+	}
+
+	s.vm.Status.GuestUnresponsive = false
+	logger.Debug("guest agent responded")
+
+	return nil, nil
+}
+
+func (s *waitForGuestStep) Verify(_ context.Context) error {
+	if s.vm.Status.GuestReadyAt == 0 {
+		return errors.ErrGuestNotReady
+	}
+
+	return nil
+}
+
+// ping pings the guest agent. The guest is only pinged once, so Do uses the result of the
+// ping from ShouldDo.
+func (s *waitForGuestStep) ping(ctx context.Context) error {
+	if s.pinged {
+		return s.pingErr
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, guestPingTimeout)
+	defer cancel()
+
+	s.pinged = true
+	s.pingErr = s.guestSvc.Ping(pingCtx, s.vm.Status.VSockPath)
+
+	return s.pingErr
+}
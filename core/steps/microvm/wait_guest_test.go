@@ -0,0 +1,284 @@
+package microvm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/steps/microvm"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+const testVSockPath = "/var/lib/flintlock/vm/ns/vm/uid/guest-agent.vsock"
+
+func testVMWithGuestAgent() *models.MicroVM {
+	vm := testVMToCreate()
+	vm.Spec.AllowGuestAgent = true
+	vm.Status.VSockPath = testVSockPath
+
+	return vm
+}
+
+func TestNewWaitForGuestStep(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithGuestAgent()
+
+	step := microvm.NewWaitForGuestStep(vm, guestSvc, time.Minute, true)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+	g.Expect(vm.Status.GuestReadyAt).NotTo(g.BeZero())
+}
+
+func TestNewWaitForGuestStep_DeadlineExceeded(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithGuestAgent()
+	vm.Status.GuestReadyAt = 1700000000
+
+	step := microvm.NewWaitForGuestStep(vm, guestSvc, time.Nanosecond, true)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(errors.New("connection refused"))
+
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.MatchError(coreerrs.ErrGuestNotReady))
+	g.Expect(verifyErr).To(g.MatchError(coreerrs.ErrGuestNotReady))
+	g.Expect(vm.Status.GuestReadyAt).To(g.BeZero())
+}
+
+func TestNewWaitForGuestStep_StillBooting(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithGuestAgent()
+
+	step := microvm.NewWaitForGuestStep(vm, guestSvc, time.Minute, true)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(errors.New("connection refused"))
+
+	subSteps, doErr := step.Do(ctx)
+
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.MatchError(coreerrs.ErrGuestBooting))
+	g.Expect(vm.Status.GuestBootedAt).NotTo(g.BeZero())
+	g.Expect(vm.Status.GuestReadyAt).To(g.BeZero())
+}
+
+func TestNewWaitForGuestStep_DeadlineFromEarlierBoot(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithGuestAgent()
+	vm.Status.GuestBootedAt = time.Now().Add(-time.Hour).Unix()
+
+	step := microvm.NewWaitForGuestStep(vm, guestSvc, time.Minute, false)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(errors.New("connection refused"))
+
+	_, doErr := step.Do(ctx)
+
+	g.Expect(doErr).To(g.MatchError(coreerrs.ErrGuestNotReady))
+}
+
+func TestNewWaitForGuestStep_HeartbeatMissed(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithGuestAgent()
+	vm.Status.GuestBootedAt = 1700000000
+	vm.Status.GuestReadyAt = 1700000010
+
+	step := microvm.NewWaitForGuestStep(vm, guestSvc, time.Minute, false)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(errors.New("connection refused")).
+		Times(1)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	_, doErr := step.Do(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(vm.Status.GuestUnresponsive).To(g.BeTrue())
+	g.Expect(vm.Status.GuestReadyAt).To(g.Equal(int64(1700000010)))
+
+	step = microvm.NewWaitForGuestStep(vm, guestSvc, time.Minute, false)
+
+	guestSvc.
+		EXPECT().
+		Ping(gomock.Any(), testVSockPath).
+		Return(nil)
+
+	shouldDo, shouldErr = step.ShouldDo(ctx)
+	_, doErr = step.Do(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(vm.Status.GuestUnresponsive).To(g.BeFalse())
+	g.Expect(vm.Status.GuestReadyAt).To(g.Equal(int64(1700000010)))
+}
+
+func TestNewWaitForGuestStep_ShouldDo(t *testing.T) {
+	notResponding := errors.New("connection refused")
+
+	testCases := []struct {
+		name         string
+		vm           func() *models.MicroVM
+		bootDeadline time.Duration
+		booting      bool
+		ping         *error
+		expectRun    bool
+	}{
+		{
+			name:         "no boot deadline",
+			vm:           testVMWithGuestAgent,
+			bootDeadline: 0,
+			booting:      true,
+			expectRun:    false,
+		},
+		{
+			name:         "guest agent not allowed",
+			vm:           testVMToCreate,
+			bootDeadline: time.Minute,
+			booting:      true,
+			expectRun:    false,
+		},
+		{
+			name: "paused",
+			vm: func() *models.MicroVM {
+				vm := testVMWithGuestAgent()
+				vm.Spec.PowerState = models.PowerStatePaused
+
+				return vm
+			},
+			bootDeadline: time.Minute,
+			booting:      true,
+			expectRun:    false,
+		},
+		{
+			name:         "booting",
+			vm:           testVMWithGuestAgent,
+			bootDeadline: time.Minute,
+			booting:      true,
+			expectRun:    true,
+		},
+		{
+			name:         "not booting, guest not ready yet",
+			vm:           testVMWithGuestAgent,
+			bootDeadline: time.Minute,
+			booting:      false,
+			expectRun:    true,
+		},
+		{
+			name:         "guest still responding",
+			vm:           testVMBooted(false),
+			bootDeadline: time.Minute,
+			ping:         new(error),
+			expectRun:    false,
+		},
+		{
+			name:         "guest stopped responding",
+			vm:           testVMBooted(false),
+			bootDeadline: time.Minute,
+			ping:         &notResponding,
+			expectRun:    true,
+		},
+		{
+			name:         "guest still not responding",
+			vm:           testVMBooted(true),
+			bootDeadline: time.Minute,
+			ping:         &notResponding,
+			expectRun:    false,
+		},
+		{
+			name:         "guest responding again",
+			vm:           testVMBooted(true),
+			bootDeadline: time.Minute,
+			ping:         new(error),
+			expectRun:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g.RegisterTestingT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			guestSvc := mock.NewMockGuestAgentService(mockCtrl)
+			step := microvm.NewWaitForGuestStep(tc.vm(), guestSvc, tc.bootDeadline, tc.booting)
+
+			if tc.ping != nil {
+				guestSvc.EXPECT().Ping(gomock.Any(), testVSockPath).Return(*tc.ping)
+			}
+
+			shouldDo, err := step.ShouldDo(context.Background())
+
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(shouldDo).To(g.Equal(tc.expectRun))
+		})
+	}
+}
+
+func testVMBooted(unresponsive bool) func() *models.MicroVM {
+	return func() *models.MicroVM {
+		vm := testVMWithGuestAgent()
+		vm.Status.GuestBootedAt = 1700000000
+		vm.Status.GuestReadyAt = 1700000010
+		vm.Status.GuestUnresponsive = unresponsive
+
+		return vm
+	}
+}
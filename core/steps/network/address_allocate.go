@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewAllocateAddress creates a step that allocates an address to a tap interface from the pool
+// of its bridge, if it doesn't have a static address.
+func NewAllocateAddress(vmid *models.VMID,
+	iface *models.NetworkInterface,
+	status *models.NetworkInterfaceStatus,
+	svc ports.IPAMService,
+) planner.Procedure {
+	return &allocateAddress{
+		vmid:   vmid,
+		iface:  iface,
+		status: status,
+		svc:    svc,
+	}
+}
+
+type allocateAddress struct {
+	vmid   *models.VMID
+	iface  *models.NetworkInterface
+	status *models.NetworkInterfaceStatus
+
+	svc ports.IPAMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *allocateAddress) Name() string {
+	return "network_address_allocate"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *allocateAddress) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *allocateAddress) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	// The metadata interface has a link local address and macvtap interfaces aren't on a bridge.
+	if s.iface.Type != models.IfaceTypeTap || s.iface.AllowMetadataRequests || s.iface.StaticAddress != nil {
+		return false, nil
+	}
+
+	if s.status != nil && s.status.AllocatedAddress != nil {
+		return false, nil
+	}
+
+	return s.svc.HasPool(attachedBridge(s.iface, s.status)), nil
+}
+
+// Do will perform the operation/procedure.
+func (s *allocateAddress) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("running step to allocate network interface address")
+
+	if s.status == nil {
+		return nil, errors.ErrMissingStatusInfo
+	}
+
+	allocation, err := s.svc.Allocate(ctx, ports.IPAllocateInput{
+		BridgeName: attachedBridge(s.iface, s.status),
+		Owner:      addressOwner(s.vmid, s.iface.GuestDeviceName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("allocating address for network interface %s: %w", s.iface.GuestDeviceName, err)
+	}
+
+	s.status.AllocatedAddress = &allocation.Address
+	s.status.AddressPool = allocation.Pool
+
+	return nil, nil
+}
+
+func (s *allocateAddress) Verify(_ context.Context) error {
+	return nil
+}
+
+// addressOwner is the owner of the address allocated to an interface of a microvm.
+func addressOwner(vmid *models.VMID, guestDeviceName string) string {
+	return vmid.UID() + "/" + guestDeviceName
+}
+
+// attachedBridge is the bridge an interface is attached to. It's the bridge of its network if the
+// interface doesn't set one, which is only known once the interface has been created.
+func attachedBridge(iface *models.NetworkInterface, status *models.NetworkInterfaceStatus) string {
+	if status != nil && status.BridgeName != "" {
+		return status.BridgeName
+	}
+
+	return iface.BridgeName
+}
@@ -0,0 +1,230 @@
+package network_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/network"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestAllocateAddress_shouldDo(t *testing.T) {
+	staticAddress := &models.StaticAddress{Address: "192.168.10.10/24"}
+
+	testCases := []struct {
+		name     string
+		iface    models.NetworkInterface
+		status   *models.NetworkInterfaceStatus
+		hasPool  bool
+		expected bool
+	}{
+		{
+			name:     "tap on bridge with pool",
+			iface:    models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap},
+			status:   &models.NetworkInterfaceStatus{},
+			hasPool:  true,
+			expected: true,
+		},
+		{
+			name:     "tap on bridge without pool",
+			iface:    models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap},
+			status:   &models.NetworkInterfaceStatus{},
+			hasPool:  false,
+			expected: false,
+		},
+		{
+			name:     "already allocated",
+			iface:    models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap},
+			status:   &models.NetworkInterfaceStatus{AllocatedAddress: staticAddress},
+			hasPool:  true,
+			expected: false,
+		},
+		{
+			name: "static address",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				Type:            models.IfaceTypeTap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			hasPool:  true,
+			expected: false,
+		},
+		{
+			name: "metadata interface",
+			iface: models.NetworkInterface{
+				GuestDeviceName:       "eth0",
+				Type:                  models.IfaceTypeTap,
+				AllowMetadataRequests: true,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			hasPool:  true,
+			expected: false,
+		},
+		{
+			name:     "macvtap",
+			iface:    models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeMacvtap},
+			status:   &models.NetworkInterfaceStatus{},
+			hasPool:  true,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			g.RegisterTestingT(t)
+
+			vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+			svc := mock.NewMockIPAMService(mockCtrl)
+			svc.EXPECT().HasPool(gomock.Any()).Return(tc.hasPool).AnyTimes()
+
+			step := network.NewAllocateAddress(vmid, &tc.iface, tc.status, svc)
+
+			shouldDo, err := step.ShouldDo(context.Background())
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(shouldDo).To(g.Equal(tc.expected))
+		})
+	}
+}
+
+func TestAllocateAddress_do(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap, BridgeName: "br1"}
+	status := &models.NetworkInterfaceStatus{}
+	svc := mock.NewMockIPAMService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().
+		Allocate(gomock.Eq(ctx), gomock.Eq(ports.IPAllocateInput{BridgeName: "br1", Owner: vmUID + "/eth1"})).
+		Return(&ports.IPAllocation{
+			Pool:    "br1",
+			Address: models.StaticAddress{Address: "192.168.10.4/24"},
+		}, nil)
+
+	step := network.NewAllocateAddress(vmid, iface, status, svc)
+
+	_, err := step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.AddressPool).To(g.Equal("br1"))
+	g.Expect(status.AllocatedAddress).NotTo(g.BeNil())
+	g.Expect(status.AllocatedAddress.Address).To(g.Equal(models.IPAddressCIDR("192.168.10.4/24")))
+}
+
+func TestAllocateAddress_attachedBridge(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap, NetworkName: "tenant-a"}
+	status := &models.NetworkInterfaceStatus{BridgeName: "br-tenants"}
+	svc := mock.NewMockIPAMService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().HasPool(gomock.Eq("br-tenants")).Return(true)
+	svc.EXPECT().
+		Allocate(gomock.Eq(ctx), gomock.Eq(ports.IPAllocateInput{BridgeName: "br-tenants", Owner: vmUID + "/eth1"})).
+		Return(&ports.IPAllocation{
+			Pool:    "br-tenants",
+			Address: models.StaticAddress{Address: "192.168.20.4/24"},
+		}, nil)
+
+	step := network.NewAllocateAddress(vmid, iface, status, svc)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.AddressPool).To(g.Equal("br-tenants"))
+}
+
+func TestAllocateAddress_doFails(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{GuestDeviceName: "eth1", Type: models.IfaceTypeTap}
+	status := &models.NetworkInterfaceStatus{}
+	svc := mock.NewMockIPAMService(mockCtrl)
+
+	svc.EXPECT().Allocate(gomock.Any(), gomock.Any()).Return(nil, errors.New("pool exhausted"))
+
+	step := network.NewAllocateAddress(vmid, iface, status, svc)
+
+	_, err := step.Do(context.Background())
+	g.Expect(err).To(g.HaveOccurred())
+	g.Expect(status.AllocatedAddress).To(g.BeNil())
+}
+
+func TestReleaseAddress(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	status := &models.NetworkInterfaceStatus{
+		AllocatedAddress: &models.StaticAddress{Address: "192.168.10.4/24"},
+		AddressPool:      "br0",
+	}
+	svc := mock.NewMockIPAMService(mockCtrl)
+	ctx := context.Background()
+
+	step := network.NewReleaseAddress(vmid, "eth1", status, svc)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	svc.EXPECT().
+		Release(gomock.Eq(ctx), gomock.Eq(ports.IPReleaseInput{
+			Pool:    "br0",
+			Address: "192.168.10.4/24",
+			Owner:   vmUID + "/eth1",
+		})).
+		Return(nil)
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.AllocatedAddress).To(g.BeNil())
+	g.Expect(status.AddressPool).To(g.BeEmpty())
+
+	shouldDo, err = step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
+
+func TestReleaseAddress_noStatus(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	svc := mock.NewMockIPAMService(mockCtrl)
+
+	step := network.NewReleaseAddress(vmid, "eth1", nil, svc)
+
+	shouldDo, err := step.ShouldDo(context.Background())
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
@@ -0,0 +1,84 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewReleaseAddress creates a step that returns the address allocated to an interface to its pool.
+func NewReleaseAddress(vmid *models.VMID,
+	guestDeviceName string,
+	status *models.NetworkInterfaceStatus,
+	svc ports.IPAMService,
+) planner.Procedure {
+	return &releaseAddress{
+		vmid:            vmid,
+		guestDeviceName: guestDeviceName,
+		status:          status,
+		svc:             svc,
+	}
+}
+
+type releaseAddress struct {
+	vmid            *models.VMID
+	guestDeviceName string
+	status          *models.NetworkInterfaceStatus
+
+	svc ports.IPAMService
+}
+
+// Name is the name of the procedure/operation.
+func (s *releaseAddress) Name() string {
+	return "network_address_release"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *releaseAddress) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *releaseAddress) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("checking if procedure should be run")
+
+	return s.status != nil && s.status.AllocatedAddress != nil, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *releaseAddress) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("running step to release network interface address")
+
+	err := s.svc.Release(ctx, ports.IPReleaseInput{
+		Pool:    s.status.AddressPool,
+		Address: s.status.AllocatedAddress.Address,
+		Owner:   addressOwner(s.vmid, s.guestDeviceName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("releasing address of network interface %s: %w", s.guestDeviceName, err)
+	}
+
+	s.status.AllocatedAddress = nil
+	s.status.AddressPool = ""
+
+	return nil, nil
+}
+
+func (s *releaseAddress) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewAddLease creates a step that adds a lease of the address of a tap interface to the
+// embedded DHCP server.
+func NewAddLease(vmid *models.VMID,
+	iface *models.NetworkInterface,
+	status *models.NetworkInterfaceStatus,
+	svc ports.DHCPService,
+) planner.Procedure {
+	return &addLease{
+		vmid:   vmid,
+		iface:  iface,
+		status: status,
+		svc:    svc,
+	}
+}
+
+type addLease struct {
+	vmid   *models.VMID
+	iface  *models.NetworkInterface
+	status *models.NetworkInterfaceStatus
+
+	svc ports.DHCPService
+}
+
+// Name is the name of the procedure/operation.
+func (s *addLease) Name() string {
+	return "network_dhcp_lease_add"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *addLease) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *addLease) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	// Only tap interfaces are attached to the bridge the server is on, and the lease is
+	// keyed by the guest MAC so it has to be known up front.
+	if s.iface.Type != models.IfaceTypeTap || s.iface.AllowMetadataRequests || s.guestMAC() == "" {
+		return false, nil
+	}
+
+	address := s.iface.GuestAddress(s.status)
+	if address == nil || !s.svc.Serves(attachedBridge(s.iface, s.status)) {
+		return false, nil
+	}
+
+	return !s.svc.HasLease(s.guestMAC(), address.Address), nil
+}
+
+// Do will perform the operation/procedure.
+func (s *addLease) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("running step to add dhcp lease for network interface")
+
+	if s.status == nil {
+		return nil, errors.ErrMissingStatusInfo
+	}
+
+	address := s.iface.GuestAddress(s.status)
+	if address == nil {
+		return nil, nil
+	}
+
+	err := s.svc.AddLease(ctx, ports.DHCPLease{
+		MACAddress: s.guestMAC(),
+		Address:    *address,
+		Hostname:   s.vmid.Name(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding dhcp lease for network interface %s: %w", s.iface.GuestDeviceName, err)
+	}
+
+	s.status.LeasedMACAddress = strings.ToUpper(s.guestMAC())
+
+	return nil, nil
+}
+
+func (s *addLease) Verify(_ context.Context) error {
+	return nil
+}
+
+// guestMAC is the MAC address of the interface in the guest, which is either the one in the
+// spec or the one generated when the tap device was created.
+func (s *addLease) guestMAC() string {
+	return s.iface.GuestMACAddress(s.status)
+}
@@ -0,0 +1,78 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewRemoveLease creates a step that removes the lease of an interface from the embedded DHCP server.
+func NewRemoveLease(vmid *models.VMID,
+	guestDeviceName string,
+	status *models.NetworkInterfaceStatus,
+	svc ports.DHCPService,
+) planner.Procedure {
+	return &removeLease{
+		vmid:            vmid,
+		guestDeviceName: guestDeviceName,
+		status:          status,
+		svc:             svc,
+	}
+}
+
+type removeLease struct {
+	vmid            *models.VMID
+	guestDeviceName string
+	status          *models.NetworkInterfaceStatus
+
+	svc ports.DHCPService
+}
+
+// Name is the name of the procedure/operation.
+func (s *removeLease) Name() string {
+	return "network_dhcp_lease_remove"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *removeLease) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *removeLease) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("checking if procedure should be run")
+
+	return s.status != nil && s.status.LeasedMACAddress != "", nil
+}
+
+// Do will perform the operation/procedure.
+func (s *removeLease) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("running step to remove dhcp lease of network interface")
+
+	if err := s.svc.RemoveLease(ctx, s.status.LeasedMACAddress); err != nil {
+		return nil, fmt.Errorf("removing dhcp lease of network interface %s: %w", s.guestDeviceName, err)
+	}
+
+	s.status.LeasedMACAddress = ""
+
+	return nil, nil
+}
+
+func (s *removeLease) Verify(_ context.Context) error {
+	return nil
+}
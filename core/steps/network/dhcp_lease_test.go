@@ -0,0 +1,262 @@
+package network_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/network"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestAddLease_shouldDo(t *testing.T) {
+	staticAddress := &models.StaticAddress{Address: "192.168.10.10/24"}
+	allocatedAddress := &models.StaticAddress{Address: "192.168.10.4/24"}
+
+	testCases := []struct {
+		name     string
+		iface    models.NetworkInterface
+		status   *models.NetworkInterfaceStatus
+		serves   bool
+		hasLease bool
+		expected bool
+	}{
+		{
+			name: "static address",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			serves:   true,
+			expected: true,
+		},
+		{
+			name: "allocated address",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+			},
+			status:   &models.NetworkInterfaceStatus{AllocatedAddress: allocatedAddress},
+			serves:   true,
+			expected: true,
+		},
+		{
+			name: "already leased",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+			},
+			status:   &models.NetworkInterfaceStatus{AllocatedAddress: allocatedAddress},
+			serves:   true,
+			hasLease: true,
+			expected: false,
+		},
+		{
+			name: "no address",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			serves:   true,
+			expected: false,
+		},
+		{
+			name: "bridge not served",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			serves:   false,
+			expected: false,
+		},
+		{
+			name: "no guest mac",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				Type:            models.IfaceTypeTap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			serves:   true,
+			expected: false,
+		},
+		{
+			name: "generated guest mac",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				Type:            models.IfaceTypeTap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{GuestMACAddress: defaultMACAddress},
+			serves:   true,
+			expected: true,
+		},
+		{
+			name: "macvtap",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeMacvtap,
+				StaticAddress:   staticAddress,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			serves:   true,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			g.RegisterTestingT(t)
+
+			vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+			svc := mock.NewMockDHCPService(mockCtrl)
+			svc.EXPECT().Serves(gomock.Any()).Return(tc.serves).AnyTimes()
+			svc.EXPECT().HasLease(gomock.Any(), gomock.Any()).Return(tc.hasLease).AnyTimes()
+
+			step := network.NewAddLease(vmid, &tc.iface, tc.status, svc)
+
+			shouldDo, err := step.ShouldDo(context.Background())
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(shouldDo).To(g.Equal(tc.expected))
+		})
+	}
+}
+
+func TestAddLease_do(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		GuestMAC:        "aa:bb:cc:dd:ee:ff",
+		Type:            models.IfaceTypeTap,
+	}
+	status := &models.NetworkInterfaceStatus{
+		AllocatedAddress: &models.StaticAddress{Address: "192.168.10.4/24"},
+	}
+	svc := mock.NewMockDHCPService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().
+		AddLease(gomock.Eq(ctx), gomock.Eq(ports.DHCPLease{
+			MACAddress: "aa:bb:cc:dd:ee:ff",
+			Address:    models.StaticAddress{Address: "192.168.10.4/24"},
+			Hostname:   vmName,
+		})).
+		Return(nil)
+
+	step := network.NewAddLease(vmid, iface, status, svc)
+
+	_, err := step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.LeasedMACAddress).To(g.Equal(defaultMACAddress))
+}
+
+func TestAddLease_doGeneratedMAC(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		Type:            models.IfaceTypeTap,
+		StaticAddress:   &models.StaticAddress{Address: "192.168.10.10/24"},
+	}
+	status := &models.NetworkInterfaceStatus{
+		MACAddress:      "aa:bb:cc:dd:ee:01",
+		GuestMACAddress: "aa:bb:cc:dd:ee:ff",
+	}
+	svc := mock.NewMockDHCPService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().
+		AddLease(gomock.Eq(ctx), gomock.Eq(ports.DHCPLease{
+			MACAddress: "aa:bb:cc:dd:ee:ff",
+			Address:    models.StaticAddress{Address: "192.168.10.10/24"},
+			Hostname:   vmName,
+		})).
+		Return(nil)
+
+	step := network.NewAddLease(vmid, iface, status, svc)
+
+	_, err := step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.LeasedMACAddress).To(g.Equal(defaultMACAddress))
+}
+
+func TestAddLease_doFails(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		GuestMAC:        defaultMACAddress,
+		Type:            models.IfaceTypeTap,
+		StaticAddress:   &models.StaticAddress{Address: "192.168.10.10/24"},
+	}
+	status := &models.NetworkInterfaceStatus{}
+	svc := mock.NewMockDHCPService(mockCtrl)
+
+	svc.EXPECT().AddLease(gomock.Any(), gomock.Any()).Return(errors.New("invalid mac"))
+
+	step := network.NewAddLease(vmid, iface, status, svc)
+
+	_, err := step.Do(context.Background())
+	g.Expect(err).To(g.HaveOccurred())
+	g.Expect(status.LeasedMACAddress).To(g.BeEmpty())
+}
+
+func TestRemoveLease(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	status := &models.NetworkInterfaceStatus{LeasedMACAddress: defaultMACAddress}
+	svc := mock.NewMockDHCPService(mockCtrl)
+	ctx := context.Background()
+
+	step := network.NewRemoveLease(vmid, "eth1", status, svc)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	svc.EXPECT().RemoveLease(gomock.Eq(ctx), gomock.Eq(defaultMACAddress)).Return(nil)
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.LeasedMACAddress).To(g.BeEmpty())
+
+	shouldDo, err = step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
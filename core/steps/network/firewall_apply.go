@@ -0,0 +1,122 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewApplyFirewall creates a step that applies the firewall of a tap interface to its host device.
+func NewApplyFirewall(vmid *models.VMID,
+	iface *models.NetworkInterface,
+	status *models.NetworkInterfaceStatus,
+	svc ports.FirewallService,
+) planner.Procedure {
+	return &applyFirewall{
+		vmid:   vmid,
+		iface:  iface,
+		status: status,
+		svc:    svc,
+	}
+}
+
+type applyFirewall struct {
+	vmid   *models.VMID
+	iface  *models.NetworkInterface
+	status *models.NetworkInterfaceStatus
+
+	svc ports.FirewallService
+}
+
+// Name is the name of the procedure/operation.
+func (s *applyFirewall) Name() string {
+	return "network_firewall_apply"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *applyFirewall) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *applyFirewall) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if s.iface.Firewall == nil || s.iface.Type != models.IfaceTypeTap {
+		return false, nil
+	}
+
+	if s.status == nil || s.status.HostDeviceName == "" {
+		return false, nil
+	}
+
+	// The rules are applied again if they've changed, which includes the address of the
+	// interface being allocated, or if they've gone missing from the host.
+	return s.svc.Verify(ctx, s.input()) != nil, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *applyFirewall) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.iface.GuestDeviceName,
+	})
+	logger.Debug("running step to apply firewall of network interface")
+
+	if s.status == nil {
+		return nil, errors.ErrMissingStatusInfo
+	}
+
+	if s.status.FirewallDevice != "" && s.status.FirewallDevice != s.status.HostDeviceName {
+		if err := s.svc.Remove(ctx, s.status.FirewallDevice); err != nil {
+			return nil, fmt.Errorf("removing firewall of previous device %s: %w", s.status.FirewallDevice, err)
+		}
+
+		s.status.FirewallDevice = ""
+	}
+
+	if err := s.svc.Apply(ctx, s.input()); err != nil {
+		return nil, fmt.Errorf("applying firewall of network interface %s: %w", s.iface.GuestDeviceName, err)
+	}
+
+	s.status.FirewallDevice = s.status.HostDeviceName
+
+	return nil, nil
+}
+
+func (s *applyFirewall) Verify(ctx context.Context) error {
+	if err := s.svc.Verify(ctx, s.input()); err != nil {
+		return fmt.Errorf("verifying firewall of network interface %s: %w", s.iface.GuestDeviceName, err)
+	}
+
+	return nil
+}
+
+// input is the input for the firewall. Anti-spoofing is bound to the same address given to the
+// guest in its network config, if there is one.
+func (s *applyFirewall) input() ports.FirewallInput {
+	input := ports.FirewallInput{
+		DeviceName: s.status.HostDeviceName,
+		MACAddress: s.iface.GuestMAC,
+		Firewall:   *s.iface.Firewall,
+	}
+
+	switch {
+	case s.iface.StaticAddress != nil:
+		input.Address = s.iface.StaticAddress.Address
+	case s.status.AllocatedAddress != nil:
+		input.Address = s.status.AllocatedAddress.Address
+	}
+
+	return input
+}
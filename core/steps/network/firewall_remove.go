@@ -0,0 +1,78 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewRemoveFirewall creates a step that removes the firewall of an interface from its host device.
+func NewRemoveFirewall(vmid *models.VMID,
+	guestDeviceName string,
+	status *models.NetworkInterfaceStatus,
+	svc ports.FirewallService,
+) planner.Procedure {
+	return &removeFirewall{
+		vmid:            vmid,
+		guestDeviceName: guestDeviceName,
+		status:          status,
+		svc:             svc,
+	}
+}
+
+type removeFirewall struct {
+	vmid            *models.VMID
+	guestDeviceName string
+	status          *models.NetworkInterfaceStatus
+
+	svc ports.FirewallService
+}
+
+// Name is the name of the procedure/operation.
+func (s *removeFirewall) Name() string {
+	return "network_firewall_remove"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *removeFirewall) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *removeFirewall) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("checking if procedure should be run")
+
+	return s.status != nil && s.status.FirewallDevice != "", nil
+}
+
+// Do will perform the operation/procedure.
+func (s *removeFirewall) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":  s.Name(),
+		"iface": s.guestDeviceName,
+		"vm":    s.vmid.String(),
+	})
+	logger.Debug("running step to remove firewall of network interface")
+
+	if err := s.svc.Remove(ctx, s.status.FirewallDevice); err != nil {
+		return nil, fmt.Errorf("removing firewall of network interface %s: %w", s.guestDeviceName, err)
+	}
+
+	s.status.FirewallDevice = ""
+
+	return nil, nil
+}
+
+func (s *removeFirewall) Verify(_ context.Context) error {
+	return nil
+}
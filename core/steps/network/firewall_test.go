@@ -0,0 +1,203 @@
+package network_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/network"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestApplyFirewall_shouldDo(t *testing.T) {
+	firewall := &models.Firewall{
+		Ingress: []models.FirewallRule{{Protocol: models.FirewallProtocolTCP, Port: 22}},
+	}
+
+	testCases := []struct {
+		name      string
+		iface     models.NetworkInterface
+		status    *models.NetworkInterfaceStatus
+		verifyErr error
+		expected  bool
+	}{
+		{
+			name: "rules missing",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+				Firewall:        firewall,
+			},
+			status:    &models.NetworkInterfaceStatus{HostDeviceName: expectedTapDeviceName},
+			verifyErr: errors.New("firewall rules don't match the interface"),
+			expected:  true,
+		},
+		{
+			name: "rules applied",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+				Firewall:        firewall,
+			},
+			status:   &models.NetworkInterfaceStatus{HostDeviceName: expectedTapDeviceName},
+			expected: false,
+		},
+		{
+			name: "no firewall",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+			},
+			status:   &models.NetworkInterfaceStatus{HostDeviceName: expectedTapDeviceName},
+			expected: false,
+		},
+		{
+			name: "interface not created yet",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeTap,
+				Firewall:        firewall,
+			},
+			status:   &models.NetworkInterfaceStatus{},
+			expected: false,
+		},
+		{
+			name: "macvtap",
+			iface: models.NetworkInterface{
+				GuestDeviceName: "eth1",
+				GuestMAC:        defaultMACAddress,
+				Type:            models.IfaceTypeMacvtap,
+				Firewall:        firewall,
+			},
+			status:   &models.NetworkInterfaceStatus{HostDeviceName: expectedMacvtapDeviceName},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			g.RegisterTestingT(t)
+
+			vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+			svc := mock.NewMockFirewallService(mockCtrl)
+			svc.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(tc.verifyErr).AnyTimes()
+
+			step := network.NewApplyFirewall(vmid, &tc.iface, tc.status, svc)
+
+			shouldDo, err := step.ShouldDo(context.Background())
+			g.Expect(err).NotTo(g.HaveOccurred())
+			g.Expect(shouldDo).To(g.Equal(tc.expected))
+		})
+	}
+}
+
+func TestApplyFirewall_do(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		GuestMAC:        defaultMACAddress,
+		Type:            models.IfaceTypeTap,
+		Firewall:        &models.Firewall{DisableAntiSpoofing: true},
+	}
+	status := &models.NetworkInterfaceStatus{
+		HostDeviceName:   expectedTapDeviceName,
+		AllocatedAddress: &models.StaticAddress{Address: "192.168.10.4/24"},
+		FirewallDevice:   "old_tap",
+	}
+	svc := mock.NewMockFirewallService(mockCtrl)
+	ctx := context.Background()
+
+	expectedInput := ports.FirewallInput{
+		DeviceName: expectedTapDeviceName,
+		MACAddress: defaultMACAddress,
+		Address:    "192.168.10.4/24",
+		Firewall:   models.Firewall{DisableAntiSpoofing: true},
+	}
+
+	gomock.InOrder(
+		svc.EXPECT().Remove(gomock.Eq(ctx), gomock.Eq("old_tap")).Return(nil),
+		svc.EXPECT().Apply(gomock.Eq(ctx), gomock.Eq(expectedInput)).Return(nil),
+		svc.EXPECT().Verify(gomock.Eq(ctx), gomock.Eq(expectedInput)).Return(nil),
+	)
+
+	step := network.NewApplyFirewall(vmid, iface, status, svc)
+
+	_, err := step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.FirewallDevice).To(g.Equal(expectedTapDeviceName))
+	g.Expect(step.Verify(ctx)).To(g.Succeed())
+}
+
+func TestApplyFirewall_doFails(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface := &models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		GuestMAC:        defaultMACAddress,
+		Type:            models.IfaceTypeTap,
+		StaticAddress:   &models.StaticAddress{Address: "192.168.10.10/24"},
+		Firewall:        &models.Firewall{},
+	}
+	status := &models.NetworkInterfaceStatus{HostDeviceName: expectedTapDeviceName}
+	svc := mock.NewMockFirewallService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(errors.New("operation not supported"))
+	svc.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(errors.New("firewall rules don't match the interface"))
+
+	step := network.NewApplyFirewall(vmid, iface, status, svc)
+
+	_, err := step.Do(ctx)
+	g.Expect(err).To(g.HaveOccurred())
+	g.Expect(status.FirewallDevice).To(g.BeEmpty())
+	g.Expect(step.Verify(ctx)).NotTo(g.Succeed())
+}
+
+func TestRemoveFirewall(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	status := &models.NetworkInterfaceStatus{FirewallDevice: expectedTapDeviceName}
+	svc := mock.NewMockFirewallService(mockCtrl)
+	ctx := context.Background()
+
+	step := network.NewRemoveFirewall(vmid, "eth1", status, svc)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	svc.EXPECT().Remove(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).Return(nil)
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.FirewallDevice).To(g.BeEmpty())
+
+	shouldDo, err = step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
@@ -14,23 +14,28 @@ import (
 	"github.com/liquidmetal-dev/flintlock/pkg/planner"
 )
 
+// NewNetworkInterface creates the network interface in the network namespace of the microvm,
+// or on the host if the microvm doesn't have one.
 func NewNetworkInterface(vmid *models.VMID,
 	iface *models.NetworkInterface,
 	status *models.NetworkInterfaceStatus,
+	networkNamespace string,
 	svc ports.NetworkService,
 ) planner.Procedure {
 	return &createInterface{
-		vmid:   vmid,
-		iface:  iface,
-		svc:    svc,
-		status: status,
+		vmid:             vmid,
+		iface:            iface,
+		svc:              svc,
+		status:           status,
+		networkNamespace: networkNamespace,
 	}
 }
 
 type createInterface struct {
-	vmid   *models.VMID
-	iface  *models.NetworkInterface
-	status *models.NetworkInterfaceStatus
+	vmid             *models.VMID
+	iface            *models.NetworkInterface
+	status           *models.NetworkInterfaceStatus
+	networkNamespace string
 
 	svc ports.NetworkService
 }
@@ -40,6 +45,11 @@ func (s *createInterface) Name() string {
 	return "network_iface_create"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *createInterface) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
 func (s *createInterface) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step":  s.Name(),
@@ -53,7 +63,7 @@ func (s *createInterface) ShouldDo(ctx context.Context) (bool, error) {
 
 	deviceName := s.status.HostDeviceName
 
-	exists, err := s.svc.IfaceExists(ctx, deviceName)
+	exists, err := s.svc.IfaceExists(ctx, s.networkNamespace, deviceName)
 	if err != nil {
 		return false, fmt.Errorf("checking if network interface %s exists: %w", deviceName, err)
 	}
@@ -88,13 +98,13 @@ func (s *createInterface) Do(ctx context.Context) ([]planner.Procedure, error) {
 
 	deviceName := s.status.HostDeviceName
 
-	exists, err := s.svc.IfaceExists(ctx, deviceName)
+	exists, err := s.svc.IfaceExists(ctx, s.networkNamespace, deviceName)
 	if err != nil {
 		return nil, fmt.Errorf("checking if networking interface exists: %w", err)
 	}
 
 	if exists {
-		details, detailsErr := s.svc.IfaceDetails(ctx, deviceName)
+		details, detailsErr := s.svc.IfaceDetails(ctx, s.networkNamespace, deviceName)
 		if detailsErr != nil {
 			return nil, fmt.Errorf("getting interface details: %w", detailsErr)
 		}
@@ -102,16 +112,34 @@ func (s *createInterface) Do(ctx context.Context) ([]planner.Procedure, error) {
 		s.status.HostDeviceName = deviceName
 		s.status.Index = details.Index
 		s.status.MACAddress = details.MAC
+		s.status.BridgeName = details.BridgeName
+		s.status.MTU = details.MTU
 
 		return nil, nil
 	}
 
+	// The hypervisor would pick a random MAC address for the guest end of a tap device, so pick
+	// one here instead so it's known to the DHCP server.
+	if s.iface.Type == models.IfaceTypeTap && s.iface.GuestMAC == "" && s.status.GuestMACAddress == "" {
+		guestMAC, err := network.NewGuestMAC()
+		if err != nil {
+			return nil, fmt.Errorf("creating guest mac address: %w", err)
+		}
+
+		s.status.GuestMACAddress = guestMAC
+	}
+
 	input := &ports.IfaceCreateInput{
-		DeviceName: deviceName,
-		Type:       s.iface.Type,
-		MAC:        s.iface.GuestMAC,
-		Attach:     true,
-		BridgeName: s.iface.BridgeName,
+		DeviceName:       deviceName,
+		Type:             s.iface.Type,
+		MAC:              s.iface.GuestMAC,
+		Attach:           true,
+		BridgeName:       s.iface.BridgeName,
+		NetworkName:      s.iface.NetworkName,
+		VLANID:           s.iface.VLANID,
+		MTU:              s.iface.MTU,
+		NumQueues:        s.iface.NumQueues,
+		NetworkNamespace: s.networkNamespace,
 	}
 
 	if s.iface.Type == models.IfaceTypeTap && s.iface.AllowMetadataRequests {
@@ -126,6 +154,8 @@ func (s *createInterface) Do(ctx context.Context) ([]planner.Procedure, error) {
 	s.status.HostDeviceName = deviceName
 	s.status.Index = output.Index
 	s.status.MACAddress = output.MAC
+	s.status.BridgeName = output.BridgeName
+	s.status.MTU = output.MTU
 
 	return nil, nil
 }
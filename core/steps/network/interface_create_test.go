@@ -27,10 +27,10 @@ func TestNewNetworkInterface_everythingIsEmpty(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Times(0)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
@@ -59,17 +59,17 @@ func TestNewNetworkInterface_doesNotExist(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Times(0)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 	shouldDo, err := step.ShouldDo(ctx)
 
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), &hostDeviceNameMatcher{}).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), &hostDeviceNameMatcher{}).
 		Return(false, nil).
 		Times(1)
 
@@ -85,6 +85,7 @@ func TestNewNetworkInterface_doesNotExist(t *testing.T) {
 
 	_, err = step.Do(ctx)
 	g.Expect(err).To(g.BeNil())
+	g.Expect(status.GuestMACAddress).To(g.MatchRegexp("^([0-9a-f]{2}:){5}[0-9a-f]{2}$"))
 
 	verifyErr := step.Verify(ctx)
 	g.Expect(verifyErr).To(g.BeNil())
@@ -103,17 +104,17 @@ func TestNewNetworkInterface_emptyStatus(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Any()).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Any()).
 		Times(0)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 	shouldDo, err := step.ShouldDo(ctx)
 
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Any()).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Any()).
 		Times(0)
 
 	svc.EXPECT().
@@ -149,23 +150,23 @@ func TestNewNetworkInterface_existingInterface(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 	shouldDo, err := step.ShouldDo(ctx)
 
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeFalse())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
 	svc.EXPECT().
-		IfaceDetails(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceDetails(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(&ports.IfaceDetails{
 			DeviceName: expectedTapDeviceName,
 			Type:       models.IfaceTypeTap,
@@ -193,18 +194,18 @@ func TestNewNetworkInterface_missingInterface(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, nil).
 		Times(1)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 	shouldDo, err := step.ShouldDo(ctx)
 
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, nil).
 		Times(1)
 
@@ -228,6 +229,50 @@ func TestNewNetworkInterface_missingInterface(t *testing.T) {
 	g.Expect(status.MACAddress).To(g.Equal(reverseMACAddress))
 }
 
+func TestNewNetworkInterface_network(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface, status := fullNetworkInterface()
+	iface.NetworkName = "tenant-a"
+	iface.VLANID = 100
+	svc := mock.NewMockNetworkService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
+		Return(false, nil).
+		Times(1)
+
+	svc.EXPECT().
+		IfaceCreate(gomock.Eq(ctx), gomock.Eq(ports.IfaceCreateInput{
+			DeviceName:  expectedTapDeviceName,
+			MAC:         defaultMACAddress,
+			Attach:      true,
+			NetworkName: "tenant-a",
+			VLANID:      100,
+		})).
+		Return(&ports.IfaceDetails{
+			DeviceName: expectedTapDeviceName,
+			Type:       models.IfaceTypeTap,
+			MAC:        defaultMACAddress,
+			BridgeName: "br-tenants",
+			MTU:        9000,
+		}, nil).
+		Times(1)
+
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
+
+	_, err := step.Do(ctx)
+
+	g.Expect(err).To(g.BeNil())
+	g.Expect(status.BridgeName).To(g.Equal("br-tenants"))
+	g.Expect(status.MTU).To(g.Equal(9000))
+}
+
 func TestNewNetworkInterface_svcError(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -240,11 +285,11 @@ func TestNewNetworkInterface_svcError(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, errors.ErrParentIfaceRequiredForAttachingTap).
 		Times(2)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 	shouldDo, err := step.ShouldDo(ctx)
 
 	g.Expect(err).ToNot(g.BeNil())
@@ -269,15 +314,15 @@ func TestNewNetworkInterface_fillChangedStatus(t *testing.T) {
 	svc := mock.NewMockNetworkService(mockCtrl)
 	ctx := context.Background()
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
 	svc.EXPECT().
-		IfaceDetails(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceDetails(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(&ports.IfaceDetails{
 			DeviceName: expectedTapDeviceName,
 			Type:       models.IfaceTypeMacvtap,
@@ -308,17 +353,17 @@ func TestNewNetworkInterface_createError(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Times(0)
 
-	step := network.NewNetworkInterface(vmid, iface, status, svc)
+	step := network.NewNetworkInterface(vmid, iface, status, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), &hostDeviceNameMatcher{}).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), &hostDeviceNameMatcher{}).
 		Return(false, nil).
 		Times(1)
 
@@ -333,3 +378,50 @@ func TestNewNetworkInterface_createError(t *testing.T) {
 	verifyErr := step.Verify(ctx)
 	g.Expect(verifyErr).To(g.BeNil())
 }
+
+func TestNewNetworkInterface_networkNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	iface, status := fullNetworkInterface()
+	iface.Type = models.IfaceTypeTap
+	iface.AllowMetadataRequests = false
+	svc := mock.NewMockNetworkService(mockCtrl)
+	ctx := context.Background()
+
+	svc.EXPECT().
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace), gomock.Eq(expectedTapDeviceName)).
+		Return(false, nil).
+		Times(2)
+
+	svc.EXPECT().
+		IfaceCreate(gomock.Eq(ctx), gomock.Eq(ports.IfaceCreateInput{
+			DeviceName:       expectedTapDeviceName,
+			Type:             models.IfaceTypeTap,
+			MAC:              defaultMACAddress,
+			Attach:           true,
+			NetworkNamespace: testNetworkNamespace,
+		})).
+		Return(&ports.IfaceDetails{
+			DeviceName: expectedTapDeviceName,
+			Type:       models.IfaceTypeTap,
+			MAC:        reverseMACAddress,
+			Index:      2,
+			BridgeName: "br0",
+		}, nil).
+		Times(1)
+
+	step := network.NewNetworkInterface(vmid, iface, status, testNetworkNamespace, svc)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(status.Index).To(g.Equal(2))
+	g.Expect(status.BridgeName).To(g.Equal("br0"))
+}
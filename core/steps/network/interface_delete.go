@@ -15,18 +15,21 @@ import (
 
 func DeleteNetworkInterface(vmid *models.VMID,
 	iface *models.NetworkInterfaceStatus,
+	networkNamespace string,
 	svc ports.NetworkService,
 ) planner.Procedure {
 	return deleteInterface{
-		vmid:  vmid,
-		iface: iface,
-		svc:   svc,
+		vmid:             vmid,
+		iface:            iface,
+		networkNamespace: networkNamespace,
+		svc:              svc,
 	}
 }
 
 type deleteInterface struct {
-	vmid  *models.VMID
-	iface *models.NetworkInterfaceStatus
+	vmid             *models.VMID
+	iface            *models.NetworkInterfaceStatus
+	networkNamespace string
 
 	svc ports.NetworkService
 }
@@ -54,7 +57,7 @@ func (s deleteInterface) Do(ctx context.Context) ([]planner.Procedure, error) {
 		return nil, errors.ErrMissingStatusInfo
 	}
 
-	exists, err := s.svc.IfaceExists(ctx, deviceName)
+	exists, err := s.svc.IfaceExists(ctx, s.networkNamespace, deviceName)
 	if err != nil {
 		return nil, fmt.Errorf("checking if networking interface exists: %w", err)
 	}
@@ -63,7 +66,10 @@ func (s deleteInterface) Do(ctx context.Context) ([]planner.Procedure, error) {
 		return nil, nil
 	}
 
-	deleteErr := s.svc.IfaceDelete(ctx, ports.DeleteIfaceInput{DeviceName: deviceName})
+	deleteErr := s.svc.IfaceDelete(ctx, ports.DeleteIfaceInput{
+		DeviceName:       deviceName,
+		NetworkNamespace: s.networkNamespace,
+	})
 	if deleteErr != nil {
 		return nil, fmt.Errorf("deleting networking interface: %w", err)
 	}
@@ -89,7 +95,7 @@ func (s deleteInterface) ShouldDo(ctx context.Context) (bool, error) {
 		return false, nil
 	}
 
-	exists, err := s.svc.IfaceExists(ctx, deviceName)
+	exists, err := s.svc.IfaceExists(ctx, s.networkNamespace, deviceName)
 	if err != nil {
 		return false, fmt.Errorf("checking if network interface %s exists: %w", deviceName, err)
 	}
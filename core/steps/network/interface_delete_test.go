@@ -27,18 +27,18 @@ func TestDeleteNetworkInterface_doesNotExist(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, nil).
 		Times(1)
 
-	step := network.DeleteNetworkInterface(vmid, iface, svc)
+	step := network.DeleteNetworkInterface(vmid, iface, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeFalse())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, nil).
 		Times(1)
 
@@ -60,7 +60,7 @@ func TestDeleteNetworkInterface_emptyStatus(t *testing.T) {
 	svc := mock.NewMockNetworkService(mockCtrl)
 	ctx := context.Background()
 
-	step := network.DeleteNetworkInterface(vmid, iface, svc)
+	step := network.DeleteNetworkInterface(vmid, iface, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
@@ -85,18 +85,18 @@ func TestDeleteNetworkInterface_exists(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
-	step := network.DeleteNetworkInterface(vmid, iface, svc)
+	step := network.DeleteNetworkInterface(vmid, iface, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
@@ -127,18 +127,18 @@ func TestDeleteNetworkInterface_exists_errorDeleting(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
-	step := network.DeleteNetworkInterface(vmid, iface, svc)
+	step := network.DeleteNetworkInterface(vmid, iface, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).To(g.BeNil())
 	g.Expect(shouldDo).To(g.BeTrue())
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(true, nil).
 		Times(1)
 
@@ -169,11 +169,11 @@ func TestDeleteNetworkInterface_IfaceExistsError(t *testing.T) {
 	ctx := context.Background()
 
 	svc.EXPECT().
-		IfaceExists(gomock.Eq(ctx), gomock.Eq(expectedTapDeviceName)).
+		IfaceExists(gomock.Eq(ctx), gomock.Eq(""), gomock.Eq(expectedTapDeviceName)).
 		Return(false, errors.ErrParentIfaceRequiredForAttachingTap).
 		Times(2)
 
-	step := network.DeleteNetworkInterface(vmid, iface, svc)
+	step := network.DeleteNetworkInterface(vmid, iface, "", svc)
 
 	shouldDo, err := step.ShouldDo(ctx)
 	g.Expect(err).ToNot(g.BeNil())
@@ -0,0 +1,80 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewCreateNamespace creates a step that creates the network namespace the network interfaces
+// and vmm process of a microvm are isolated in.
+func NewCreateNamespace(vmid *models.VMID, name string, svc ports.NetworkService) planner.Procedure {
+	return &createNamespace{
+		vmid: vmid,
+		name: name,
+		svc:  svc,
+	}
+}
+
+type createNamespace struct {
+	vmid *models.VMID
+	name string
+
+	svc ports.NetworkService
+}
+
+// Name is the name of the procedure/operation.
+func (s *createNamespace) Name() string {
+	return "network_namespace_create"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *createNamespace) Condition() models.ConditionType {
+	return models.ConditionNetworkReady
+}
+
+func (s *createNamespace) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":      s.Name(),
+		"namespace": s.name,
+		"vm":        s.vmid.String(),
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if s.name == "" {
+		return false, nil
+	}
+
+	exists, err := s.svc.NamespaceExists(ctx, s.name)
+	if err != nil {
+		return false, fmt.Errorf("checking if network namespace %s exists: %w", s.name, err)
+	}
+
+	return !exists, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *createNamespace) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":      s.Name(),
+		"namespace": s.name,
+		"vm":        s.vmid.String(),
+	})
+	logger.Debug("running step to create network namespace")
+
+	if err := s.svc.NamespaceCreate(ctx, s.name); err != nil {
+		return nil, fmt.Errorf("creating network namespace %s: %w", s.name, err)
+	}
+
+	return nil, nil
+}
+
+func (s *createNamespace) Verify(_ context.Context) error {
+	return nil
+}
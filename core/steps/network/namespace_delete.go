@@ -0,0 +1,75 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewDeleteNamespace creates a step that deletes the network namespace of a microvm, along with
+// any of its network interfaces that are left in it.
+func NewDeleteNamespace(vmid *models.VMID, name string, svc ports.NetworkService) planner.Procedure {
+	return &deleteNamespace{
+		vmid: vmid,
+		name: name,
+		svc:  svc,
+	}
+}
+
+type deleteNamespace struct {
+	vmid *models.VMID
+	name string
+
+	svc ports.NetworkService
+}
+
+// Name is the name of the procedure/operation.
+func (s *deleteNamespace) Name() string {
+	return "network_namespace_delete"
+}
+
+func (s *deleteNamespace) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":      s.Name(),
+		"namespace": s.name,
+		"vm":        s.vmid.String(),
+	})
+	logger.Debug("checking if procedure should be run")
+
+	if s.name == "" {
+		return false, nil
+	}
+
+	exists, err := s.svc.NamespaceExists(ctx, s.name)
+	if err != nil {
+		return false, fmt.Errorf("checking if network namespace %s exists: %w", s.name, err)
+	}
+
+	return exists, nil
+}
+
+// Do will perform the operation/procedure.
+func (s *deleteNamespace) Do(ctx context.Context) ([]planner.Procedure, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step":      s.Name(),
+		"namespace": s.name,
+		"vm":        s.vmid.String(),
+	})
+	logger.Debug("running step to delete network namespace")
+
+	if err := s.svc.NamespaceDelete(ctx, s.name); err != nil {
+		return nil, fmt.Errorf("deleting network namespace %s: %w", s.name, err)
+	}
+
+	return nil, nil
+}
+
+func (s *deleteNamespace) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,91 @@
+package network_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/steps/network"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+const testNetworkNamespace = "flintlock-testuid"
+
+func TestCreateNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	svc := mock.NewMockNetworkService(mockCtrl)
+	ctx := context.Background()
+
+	step := network.NewCreateNamespace(vmid, testNetworkNamespace, svc)
+
+	gomock.InOrder(
+		svc.EXPECT().NamespaceExists(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(false, nil),
+		svc.EXPECT().NamespaceCreate(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(nil),
+		svc.EXPECT().NamespaceExists(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(true, nil),
+	)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+
+	shouldDo, err = step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
+
+func TestCreateNamespace_noNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	svc := mock.NewMockNetworkService(mockCtrl)
+
+	step := network.NewCreateNamespace(vmid, "", svc)
+
+	shouldDo, err := step.ShouldDo(context.Background())
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
+
+func TestDeleteNamespace(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g.RegisterTestingT(t)
+
+	vmid, _ := models.NewVMID(vmName, nsName, vmUID)
+	svc := mock.NewMockNetworkService(mockCtrl)
+	ctx := context.Background()
+
+	step := network.NewDeleteNamespace(vmid, testNetworkNamespace, svc)
+
+	gomock.InOrder(
+		svc.EXPECT().NamespaceExists(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(true, nil),
+		svc.EXPECT().NamespaceDelete(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(nil),
+		svc.EXPECT().NamespaceExists(gomock.Eq(ctx), gomock.Eq(testNetworkNamespace)).Return(false, nil),
+	)
+
+	shouldDo, err := step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeTrue())
+
+	_, err = step.Do(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+
+	shouldDo, err = step.ShouldDo(ctx)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(shouldDo).To(g.BeFalse())
+}
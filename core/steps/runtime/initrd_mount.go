@@ -30,6 +30,11 @@ func (s *initrdMount) Name() string {
 	return "runtime_initrd_mount"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *initrdMount) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
 func (s *initrdMount) ShouldDo(ctx context.Context) (bool, error) {
 	if s.vm == nil {
 		return false, cerrs.ErrSpecRequired
@@ -30,6 +30,11 @@ func (s *kernelMount) Name() string {
 	return "runtime_kernel_mount"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *kernelMount) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
 func (s *kernelMount) ShouldDo(ctx context.Context) (bool, error) {
 	if s.vm == nil {
 		return false, cerrs.ErrSpecRequired
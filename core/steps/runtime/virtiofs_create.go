@@ -38,6 +38,11 @@ func (s *volumeVirtioFSMount) Name() string {
 	return "runtime_virtiofs_create"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *volumeVirtioFSMount) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
 func (s *volumeVirtioFSMount) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step": s.Name(),
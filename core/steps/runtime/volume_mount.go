@@ -38,6 +38,11 @@ func (s *volumeMount) Name() string {
 	return "runtime_volume_mount"
 }
 
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *volumeMount) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
 func (s *volumeMount) ShouldDo(ctx context.Context) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"step": s.Name(),
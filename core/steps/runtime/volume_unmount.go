@@ -0,0 +1,87 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	cerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/planner"
+)
+
+// NewVolumeUnmount creates a step that removes the mount of a volume that has been removed
+// from the spec of a microvm.
+func NewVolumeUnmount(vmid *models.VMID,
+	volumeID string,
+	status *models.VolumeStatus,
+	imageService ports.ImageService,
+) planner.Procedure {
+	return &volumeUnmount{
+		vmid:     vmid,
+		volumeID: volumeID,
+		status:   status,
+		imageSvc: imageService,
+	}
+}
+
+type volumeUnmount struct {
+	vmid     *models.VMID
+	volumeID string
+	status   *models.VolumeStatus
+	imageSvc ports.ImageService
+}
+
+// Name is the name of the procedure/operation.
+func (s *volumeUnmount) Name() string {
+	return "runtime_volume_unmount"
+}
+
+// Condition is the condition of the microvm that a failure of the step is reported against.
+func (s *volumeUnmount) Condition() models.ConditionType {
+	return models.ConditionImagesReady
+}
+
+func (s *volumeUnmount) ShouldDo(ctx context.Context) (bool, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"id":   s.volumeID,
+	})
+	logger.Debug("checking if procedure should be run")
+
+	return s.status != nil && s.status.Mount.Source != "", nil
+}
+
+// Do will perform the operation/procedure.
+func (s *volumeUnmount) Do(ctx context.Context) ([]planner.Procedure, error) {
+	if s.status == nil {
+		return nil, cerrs.ErrMissingStatusInfo
+	}
+
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"step": s.Name(),
+		"id":   s.volumeID,
+	})
+	logger.Debug("running step to unmount volume")
+
+	input := &ports.ImageMountSpec{
+		Owner:        s.vmid.String(),
+		OwnerUsageID: s.volumeID,
+		Use:          models.ImageUseVolume,
+	}
+
+	if err := s.imageSvc.Unmount(ctx, input); err != nil {
+		return nil, fmt.Errorf("unmounting volume %s: %w", s.volumeID, err)
+	}
+
+	s.status.Mount = models.Mount{}
+
+	return nil, nil
+}
+
+func (s *volumeUnmount) Verify(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/core/steps/runtime"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestVolumeUnmount(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	imageService := mock.NewMockImageService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithMount()
+	status := &models.VolumeStatus{Mount: testMount("/dev/mapper/removed")}
+
+	step := runtime.NewVolumeUnmount(&vm.ID, "removedVolume", status, imageService)
+
+	imageService.
+		EXPECT().
+		Unmount(gomock.Eq(ctx), gomock.Eq(&ports.ImageMountSpec{
+			Owner:        vm.ID.String(),
+			OwnerUsageID: "removedVolume",
+			Use:          models.ImageUseVolume,
+		})).
+		Return(nil)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+	subSteps, doErr := step.Do(ctx)
+	verifyErr := step.Verify(ctx)
+
+	g.Expect(shouldDo).To(g.BeTrue())
+	g.Expect(shouldErr).To(g.BeNil())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(doErr).To(g.BeNil())
+	g.Expect(verifyErr).To(g.BeNil())
+	g.Expect(status.Mount).To(g.BeZero())
+}
+
+func TestVolumeUnmount_NotMounted(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	imageService := mock.NewMockImageService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithMount()
+
+	step := runtime.NewVolumeUnmount(&vm.ID, "removedVolume", &models.VolumeStatus{}, imageService)
+
+	shouldDo, shouldErr := step.ShouldDo(ctx)
+
+	g.Expect(shouldDo).To(g.BeFalse())
+	g.Expect(shouldErr).To(g.BeNil())
+}
+
+func TestVolumeUnmount_UnmountError(t *testing.T) {
+	g.RegisterTestingT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	imageService := mock.NewMockImageService(mockCtrl)
+	ctx := context.Background()
+	vm := testVMWithMount()
+	status := &models.VolumeStatus{Mount: testMount("/dev/mapper/removed")}
+
+	step := runtime.NewVolumeUnmount(&vm.ID, "removedVolume", status, imageService)
+
+	imageService.
+		EXPECT().
+		Unmount(gomock.Any(), gomock.Any()).
+		Return(errors.New("snapshot is busy"))
+
+	subSteps, err := step.Do(ctx)
+
+	g.Expect(err).To(g.HaveOccurred())
+	g.Expect(subSteps).To(g.BeEmpty())
+	g.Expect(status.Mount.Source).To(g.Equal("/dev/mapper/removed"))
+}
@@ -14,4 +14,6 @@ type Config struct {
 	SocketPath string
 	// Namespace is the default containerd namespace to use
 	Namespace string
+	// HistorySize is the number of plan executions to keep for each microvm.
+	HistorySize int
 }
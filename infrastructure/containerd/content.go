@@ -10,18 +10,40 @@ import (
 const (
 	// MicroVMSpecType is the type name for a microvm spec.
 	MicroVMSpecType = "microvm"
+	// SnapshotType is the type name for the details of a microvm snapshot.
+	SnapshotType = "snapshot"
+	// HistoryType is the type name for a record of a plan execution against a microvm.
+	HistoryType = "history"
+	// QuotaType is the type name for the quota of a namespace.
+	QuotaType = "quota"
 
-	nameLabelFormat      = "%s/name"
-	namespaceLabelFormat = "%s/ns"
-	typeLabelFormat      = "%s/type"
-	versionLabelFormat   = "%s/version"
-	uidLabelFormat       = "%s/uid"
+	nameLabelFormat       = "%s/name"
+	namespaceLabelFormat  = "%s/ns"
+	typeLabelFormat       = "%s/type"
+	versionLabelFormat    = "%s/version"
+	uidLabelFormat        = "%s/uid"
+	microvmUIDLabelFormat = "%s/microvm-uid"
+	stateLabelFormat      = "%s/state"
+	createdAtLabelFormat  = "%s/created-at"
+	specLabelFormat       = "%s/label/%s"
 )
 
 func contentRefName(microvm *models.MicroVM) string {
 	return fmt.Sprintf("%s/microvm/%s", defaults.Domain, microvm.ID.String())
 }
 
+func snapshotContentRefName(snapshot *models.Snapshot) string {
+	return fmt.Sprintf("%s/snapshot/%s", defaults.Domain, snapshot.UID)
+}
+
+func historyContentRefName(vmid models.VMID, executionID string) string {
+	return fmt.Sprintf("%s/history/%s/%s", defaults.Domain, vmid.UID(), executionID)
+}
+
+func quotaContentRefName(namespace string) string {
+	return fmt.Sprintf("%s/quota/%s", defaults.Domain, namespace)
+}
+
 func labelFilter(name, value string) string {
 	return fmt.Sprintf("labels.\"%s\"==\"%s\"", name, value)
 }
@@ -50,3 +72,24 @@ func VersionLabel() string {
 func UIDLabel() string {
 	return fmt.Sprintf(uidLabelFormat, defaults.Domain)
 }
+
+// MicroVMUIDLabel is the name of the containerd content store label to hold the UID of the microvm
+// that a snapshot was taken of.
+func MicroVMUIDLabel() string {
+	return fmt.Sprintf(microvmUIDLabelFormat, defaults.Domain)
+}
+
+// StateLabel is the name of the containerd content store label to hold the state of a microvm.
+func StateLabel() string {
+	return fmt.Sprintf(stateLabelFormat, defaults.Domain)
+}
+
+// CreatedAtLabel is the name of the containerd content store label to hold the time a microvm was created.
+func CreatedAtLabel() string {
+	return fmt.Sprintf(createdAtLabelFormat, defaults.Domain)
+}
+
+// SpecLabel is the name of the containerd content store label to hold a label from the microvm spec.
+func SpecLabel(key string) string {
+	return fmt.Sprintf(specLabelFormat, defaults.Domain, key)
+}
@@ -0,0 +1,195 @@
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// NewHistoryRepo will create a new containerd backed microvm history repository with the supplied
+// containerd configuration.
+func NewHistoryRepo(cfg *Config) (ports.HistoryRepository, error) {
+	client, err := containerd.New(cfg.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating containerd client: %w", err)
+	}
+
+	return NewHistoryRepoWithClient(cfg, client), nil
+}
+
+// NewHistoryRepoWithClient will create a new containerd backed microvm history repository with the
+// supplied containerd client.
+func NewHistoryRepoWithClient(cfg *Config, client *containerd.Client) ports.HistoryRepository {
+	size := cfg.HistorySize
+	if size <= 0 {
+		size = defaults.MicroVMHistorySize
+	}
+
+	return &historyRepo{
+		client: client,
+		config: cfg,
+		size:   size,
+	}
+}
+
+type historyRepo struct {
+	client *containerd.Client
+	config *Config
+	size   int
+}
+
+// Append will save the execution to the containerd content store and then remove the oldest
+// executions of the microvm so that only the configured number are kept.
+func (r *historyRepo) Append(ctx context.Context, vmid models.VMID, execution *models.PlanExecution) error {
+	logger := log.GetLogger(ctx).WithField("repo", "containerd_history")
+	logger.Debugf("saving execution %s for microvm %s", execution.ID, vmid)
+
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	leaseCtx, err := withOwnerLease(namespaceCtx, historyLeaseOwner(vmid.UID()), r.client)
+	if err != nil {
+		return fmt.Errorf("getting lease for owner: %w", err)
+	}
+
+	store := r.client.ContentStore()
+
+	writer, err := store.Writer(leaseCtx, content.WithRef(historyContentRefName(vmid, execution.ID)))
+	if err != nil {
+		return fmt.Errorf("getting containerd writer: %w", err)
+	}
+
+	data, err := json.Marshal(execution)
+	if err != nil {
+		return fmt.Errorf("marshalling execution to json: %w", err)
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return fmt.Errorf("writing data to contentd store: %w", err)
+	}
+
+	labels := map[string]string{
+		NameLabel():       vmid.Name(),
+		NamespaceLabel():  vmid.Namespace(),
+		TypeLabel():       HistoryType,
+		UIDLabel():        execution.ID,
+		MicroVMUIDLabel(): vmid.UID(),
+	}
+
+	err = writer.Commit(namespaceCtx, 0, "", content.WithLabels(labels))
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return fmt.Errorf("committing content to store: %w", err)
+	}
+
+	infos, err := r.findInfos(namespaceCtx, vmid.UID())
+	if err != nil {
+		return fmt.Errorf("finding history for microvm %s: %w", vmid, err)
+	}
+
+	for i := r.size; i < len(infos); i++ {
+		if err := store.Delete(namespaceCtx, infos[i].Digest); err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("deleting content %s from content store: %w", infos[i].Digest, err)
+		}
+	}
+
+	return nil
+}
+
+// GetAll will get the executions of the microvm with the given uid, most recent first.
+func (r *historyRepo) GetAll(ctx context.Context, uid string) ([]*models.PlanExecution, error) {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	infos, err := r.findInfos(namespaceCtx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("finding history for microvm %s: %w", uid, err)
+	}
+
+	items := []*models.PlanExecution{}
+
+	for _, info := range infos {
+		readData, err := content.ReadBlob(namespaceCtx, r.client.ContentStore(), v1.Descriptor{
+			Digest: info.Digest,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading content %s: %w", info.Digest, ErrReadingContent)
+		}
+
+		execution := &models.PlanExecution{}
+		if err := json.Unmarshal(readData, execution); err != nil {
+			return nil, fmt.Errorf("unmarshalling json content to execution: %w", err)
+		}
+
+		items = append(items, execution)
+	}
+
+	return items, nil
+}
+
+// Delete will delete the history of the microvm with the given uid from the containerd content store.
+func (r *historyRepo) Delete(ctx context.Context, uid string) error {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+	store := r.client.ContentStore()
+
+	infos, err := r.findInfos(namespaceCtx, uid)
+	if err != nil {
+		return fmt.Errorf("finding history for microvm %s: %w", uid, err)
+	}
+
+	for _, info := range infos {
+		if err := store.Delete(namespaceCtx, info.Digest); err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("deleting content %s from content store: %w", info.Digest, err)
+		}
+	}
+
+	if err := deleteLease(namespaceCtx, historyLeaseOwner(uid), r.client); err != nil &&
+		!errdefs.IsNotFound(err) {
+		return fmt.Errorf("deleting history lease: %w", err)
+	}
+
+	return nil
+}
+
+// findInfos returns the content info of the executions for a microvm, most recent first.
+func (r *historyRepo) findInfos(ctx context.Context, uid string) ([]content.Info, error) {
+	store := r.client.ContentStore()
+	filters := strings.Join([]string{
+		labelFilter(TypeLabel(), HistoryType),
+		labelFilter(MicroVMUIDLabel(), uid),
+	}, ",")
+	infos := []content.Info{}
+
+	err := store.Walk(
+		ctx,
+		func(info content.Info) error {
+			infos = append(infos, info)
+
+			return nil
+		},
+		filters,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("walking content store: %w", err)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].CreatedAt.After(infos[j].CreatedAt)
+	})
+
+	return infos, nil
+}
+
+func historyLeaseOwner(uid string) string {
+	return "history/" + uid
+}
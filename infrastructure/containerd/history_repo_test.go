@@ -0,0 +1,58 @@
+package containerd_test
+
+import (
+	"context"
+	"testing"
+
+	ctr "github.com/containerd/containerd"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/containerd"
+)
+
+func TestHistoryRepo_Integration(t *testing.T) {
+	if !runContainerDTests() {
+		t.Skip("skipping containerd history repo integration test")
+	}
+
+	var (
+		repo ports.HistoryRepository
+		ctx  context.Context
+	)
+
+	vmid := *models.NewVMIDForce(testOwnerName, testOwnerNamespace, testOwnerUID)
+
+	t.Cleanup(func() {
+		_ = repo.Delete(ctx, vmid.UID())
+	})
+
+	RegisterTestingT(t)
+
+	var client *ctr.Client
+	client, ctx = testCreateClient(t)
+
+	repo = containerd.NewHistoryRepoWithClient(&containerd.Config{
+		SnapshotterKernel: testSnapshotter,
+		SnapshotterVolume: testSnapshotter,
+		Namespace:         ctrdRepoNS,
+		HistorySize:       2,
+	}, client)
+
+	for _, id := range []string{"exec1", "exec2", "exec3"} {
+		Expect(repo.Append(ctx, vmid, &models.PlanExecution{ID: id, Plan: "microvm_create_update"})).To(Succeed())
+	}
+
+	history, err := repo.GetAll(ctx, vmid.UID())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(history).To(HaveLen(2))
+	Expect(history[0].ID).To(Equal("exec3"))
+	Expect(history[1].ID).To(Equal("exec2"))
+
+	Expect(repo.Delete(ctx, vmid.UID())).To(Succeed())
+
+	history, err = repo.GetAll(ctx, vmid.UID())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(history).To(BeEmpty())
+}
@@ -122,6 +122,32 @@ func (im *imageService) IsMounted(ctx context.Context, input *ports.ImageMountSp
 	return snapshotExists, nil
 }
 
+// Unmount will remove the snapshot an image was mounted from for an owner.
+func (im *imageService) Unmount(ctx context.Context, input *ports.ImageMountSpec) error {
+	logger := log.GetLogger(ctx).WithField("service", "containerd_image")
+	logger.Debugf("unmounting image for owner %s and usage %s", input.Owner, input.OwnerUsageID)
+
+	nsCtx := namespaces.WithNamespace(ctx, im.config.Namespace)
+
+	snapshotKey := snapshotKey(input.Owner, input.OwnerUsageID)
+	ss := im.client.SnapshotService(im.getSnapshotter(input.Use))
+
+	snapshotExists, err := snapshotExists(nsCtx, snapshotKey, ss)
+	if err != nil {
+		return fmt.Errorf("checking for existence of snapshot %s: %w", snapshotKey, err)
+	}
+
+	if !snapshotExists {
+		return nil
+	}
+
+	if err := ss.Remove(nsCtx, snapshotKey); err != nil && !errdefs.IsNotFound(err) {
+		return fmt.Errorf("removing snapshot %s: %w", snapshotKey, err)
+	}
+
+	return nil
+}
+
 func (im *imageService) imageExists(ctx context.Context, imageName, owner string) (bool, error) {
 	leaseCtx, err := withOwnerLease(ctx, owner, im.client)
 	if err != nil {
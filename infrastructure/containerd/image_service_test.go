@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/containerd/containerd/snapshots"
 	"github.com/golang/mock/gomock"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
@@ -628,3 +629,70 @@ func TestImageService_Exists_failedCheck(t *testing.T) {
 	g.Expect(err).To(g.HaveOccurred())
 	g.Expect(exists).To(g.BeFalse())
 }
+
+// TestImageService_Unmount tests a successful Unmount.
+func TestImageService_Unmount(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	containerdClient := mock.NewMockClient(mockCtrl)
+	snapshotManager := mock.NewMockSnapshotter(mockCtrl)
+	svcConfig := containerd.Config{
+		SnapshotterKernel: "native",
+		SnapshotterVolume: "devmapper",
+		SocketPath:        "/something",
+		Namespace:         "unit_test_ns",
+	}
+	ctx := context.Background()
+	client := containerd.NewImageServiceWithClient(&svcConfig, containerdClient)
+	snapshotKey := fmt.Sprintf("flintlock/%s/%s", testOwner, testOwnerID)
+
+	containerdClient.EXPECT().
+		SnapshotService("devmapper").
+		Return(snapshotManager)
+	snapshotManager.EXPECT().
+		Walk(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn snapshots.WalkFunc, _ ...string) error {
+			return fn(ctx, snapshots.Info{Name: snapshotKey})
+		})
+	snapshotManager.EXPECT().
+		Remove(gomock.Any(), snapshotKey)
+
+	err := client.Unmount(ctx, &ports.ImageMountSpec{
+		Owner:        testOwner,
+		Use:          models.ImageUseVolume,
+		OwnerUsageID: testOwnerID,
+	})
+	g.Expect(err).NotTo(g.HaveOccurred())
+}
+
+// TestImageService_Unmount_notMounted tests that nothing is removed if the image
+// isn't mounted.
+func TestImageService_Unmount_notMounted(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	containerdClient := mock.NewMockClient(mockCtrl)
+	snapshotManager := mock.NewMockSnapshotter(mockCtrl)
+	svcConfig := containerd.Config{
+		SnapshotterKernel: "native",
+		SnapshotterVolume: "devmapper",
+		SocketPath:        "/something",
+		Namespace:         "unit_test_ns",
+	}
+	ctx := context.Background()
+	client := containerd.NewImageServiceWithClient(&svcConfig, containerdClient)
+
+	containerdClient.EXPECT().
+		SnapshotService("devmapper").
+		Return(snapshotManager)
+	snapshotManager.EXPECT().
+		Walk(gomock.Any(), gomock.Any())
+
+	err := client.Unmount(ctx, &ports.ImageMountSpec{
+		Owner:        testOwner,
+		Use:          models.ImageUseVolume,
+		OwnerUsageID: testOwnerID,
+	})
+	g.Expect(err).NotTo(g.HaveOccurred())
+}
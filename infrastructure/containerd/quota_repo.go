@@ -0,0 +1,167 @@
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// NewQuotaRepo will create a new containerd backed namespace quota repository with the supplied
+// containerd configuration.
+func NewQuotaRepo(cfg *Config) (ports.QuotaRepository, error) {
+	client, err := containerd.New(cfg.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating containerd client: %w", err)
+	}
+
+	return NewQuotaRepoWithClient(cfg, client), nil
+}
+
+// NewQuotaRepoWithClient will create a new containerd backed namespace quota repository with the
+// supplied containerd client.
+func NewQuotaRepoWithClient(cfg *Config, client *containerd.Client) ports.QuotaRepository {
+	return &quotaRepo{
+		client: client,
+		config: cfg,
+	}
+}
+
+type quotaRepo struct {
+	client *containerd.Client
+	config *Config
+}
+
+// Save will save the quota to the containerd content store and then remove the previous quota
+// of the namespace.
+func (r *quotaRepo) Save(ctx context.Context, quota *models.NamespaceQuota) (*models.NamespaceQuota, error) {
+	logger := log.GetLogger(ctx).WithField("repo", "containerd_quota")
+	logger.Debugf("saving quota for namespace %s", quota.Namespace)
+
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	leaseCtx, err := withOwnerLease(namespaceCtx, quotaLeaseOwner(quota.Namespace), r.client)
+	if err != nil {
+		return nil, fmt.Errorf("getting lease for owner: %w", err)
+	}
+
+	store := r.client.ContentStore()
+
+	writer, err := store.Writer(leaseCtx, content.WithRef(quotaContentRefName(quota.Namespace)))
+	if err != nil {
+		return nil, fmt.Errorf("getting containerd writer: %w", err)
+	}
+
+	data, err := json.Marshal(quota)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling quota to json: %w", err)
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return nil, fmt.Errorf("writing data to contentd store: %w", err)
+	}
+
+	labels := map[string]string{
+		NamespaceLabel(): quota.Namespace,
+		TypeLabel():      QuotaType,
+	}
+
+	err = writer.Commit(namespaceCtx, 0, "", content.WithLabels(labels))
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("committing content to store: %w", err)
+	}
+
+	saved := digest.FromBytes(data)
+
+	infos, err := r.findInfos(namespaceCtx, quota.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("finding quota for namespace %s: %w", quota.Namespace, err)
+	}
+
+	for _, info := range infos {
+		if info.Digest == saved {
+			continue
+		}
+
+		if err := store.Delete(namespaceCtx, info.Digest); err != nil && !errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("deleting content %s from content store: %w", info.Digest, err)
+		}
+	}
+
+	return quota, nil
+}
+
+// Get will get the quota of the namespace from the containerd content store. If the namespace
+// doesn't have a quota nil is returned.
+func (r *quotaRepo) Get(ctx context.Context, namespace string) (*models.NamespaceQuota, error) {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	infos, err := r.findInfos(namespaceCtx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("finding quota for namespace %s: %w", namespace, err)
+	}
+
+	if len(infos) == 0 {
+		return nil, nil
+	}
+
+	readData, err := content.ReadBlob(namespaceCtx, r.client.ContentStore(), v1.Descriptor{
+		Digest: infos[0].Digest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading content %s: %w", infos[0].Digest, ErrReadingContent)
+	}
+
+	quota := &models.NamespaceQuota{}
+	if err := json.Unmarshal(readData, quota); err != nil {
+		return nil, fmt.Errorf("unmarshalling json content to quota: %w", err)
+	}
+
+	return quota, nil
+}
+
+// findInfos returns the content info of the quotas of a namespace, most recent first. There's
+// only more than one if a save didn't finish removing the previous quota.
+func (r *quotaRepo) findInfos(ctx context.Context, namespace string) ([]content.Info, error) {
+	store := r.client.ContentStore()
+	filters := strings.Join([]string{
+		labelFilter(TypeLabel(), QuotaType),
+		labelFilter(NamespaceLabel(), namespace),
+	}, ",")
+	infos := []content.Info{}
+
+	err := store.Walk(
+		ctx,
+		func(info content.Info) error {
+			infos = append(infos, info)
+
+			return nil
+		},
+		filters,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("walking content store: %w", err)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].CreatedAt.After(infos[j].CreatedAt)
+	})
+
+	return infos, nil
+}
+
+func quotaLeaseOwner(namespace string) string {
+	return "quota/" + namespace
+}
@@ -0,0 +1,61 @@
+package containerd_test
+
+import (
+	"context"
+	"testing"
+
+	ctr "github.com/containerd/containerd"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/containerd"
+)
+
+func TestQuotaRepo_Integration(t *testing.T) {
+	if !runContainerDTests() {
+		t.Skip("skipping containerd quota repo integration test")
+	}
+
+	RegisterTestingT(t)
+
+	var (
+		repo   ports.QuotaRepository
+		ctx    context.Context
+		client *ctr.Client
+	)
+
+	client, ctx = testCreateClient(t)
+
+	repo = containerd.NewQuotaRepoWithClient(&containerd.Config{
+		SnapshotterKernel: testSnapshotter,
+		SnapshotterVolume: testSnapshotter,
+		Namespace:         ctrdRepoNS,
+	}, client)
+
+	quota, err := repo.Get(ctx, testOwnerNamespace)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(quota).To(BeNil())
+
+	_, err = repo.Save(ctx, &models.NamespaceQuota{
+		Namespace: testOwnerNamespace,
+		Limits:    models.QuotaResources{MicroVMs: 2},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = repo.Save(ctx, &models.NamespaceQuota{
+		Namespace: testOwnerNamespace,
+		Limits:    models.QuotaResources{MicroVMs: 5, VCPU: 10},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	quota, err = repo.Get(ctx, testOwnerNamespace)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(quota).NotTo(BeNil())
+	Expect(quota.Limits.MicroVMs).To(Equal(int64(5)))
+	Expect(quota.Limits.VCPU).To(Equal(int64(10)))
+
+	quota, err = repo.Get(ctx, "other")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(quota).To(BeNil())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -138,13 +139,15 @@ func (r *containerdRepo) Get(ctx context.Context, options ports.RepositoryGetOpt
 	return spec, nil
 }
 
-// GetAll will get a list of microvm details from the containerd content store.
+// GetAll will get a list of microvm details from the containerd content store. Only the
+// labels of the content are used to filter, sort and page the microvms, so only the specs
+// of the microvms that are returned are read from the store.
 func (r *containerdRepo) GetAll(ctx context.Context, query models.ListMicroVMQuery) ([]*models.MicroVM, error) {
 	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
 	store := r.client.ContentStore()
 	filters := []string{labelFilter(TypeLabel(), MicroVMSpecType)}
 	versions := map[string]int{}
-	digests := map[string]*digest.Digest{}
+	latest := map[string]content.Info{}
 
 	filters = append(filters, convertQueryToFilter(query)...)
 
@@ -164,7 +167,7 @@ func (r *containerdRepo) GetAll(ctx context.Context, query models.ListMicroVMQue
 
 		if version > high {
 			versions[key] = version
-			digests[key] = &info.Digest
+			latest[key] = info
 		}
 
 		return nil
@@ -173,20 +176,120 @@ func (r *containerdRepo) GetAll(ctx context.Context, query models.ListMicroVMQue
 		return nil, fmt.Errorf("walking content store: %w", err)
 	}
 
+	var cursor *models.PageCursor
+	if query.PageToken != "" {
+		if cursor, err = models.DecodePageToken(query.PageToken); err != nil {
+			return nil, err //nolint:wrapcheck // it's one of our errors
+		}
+	}
+
+	candidates := []*listCandidate{}
+
+	for _, info := range latest {
+		candidate, err := r.newListCandidate(namespaceCtx, info, query)
+		if err != nil {
+			return nil, err
+		}
+
+		if !candidate.matches(query) {
+			continue
+		}
+
+		if cursor != nil && !cursor.After(candidate.sortKey, candidate.uid, query.Descending) {
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.sortKey == b.sortKey {
+			return (a.uid < b.uid) != query.Descending
+		}
+
+		return (a.sortKey < b.sortKey) != query.Descending
+	})
+
+	if query.PageSize > 0 && len(candidates) > query.PageSize {
+		candidates = candidates[:query.PageSize]
+	}
+
 	items := []*models.MicroVM{}
 
-	for _, d := range digests {
-		vm, getErr := r.getWithDigest(namespaceCtx, d)
-		if getErr != nil {
-			return nil, fmt.Errorf("getting microvm spec: %w", getErr)
+	for _, candidate := range candidates {
+		if candidate.microvm == nil {
+			candidate.microvm, err = r.getWithDigest(namespaceCtx, &candidate.digest)
+			if err != nil {
+				return nil, fmt.Errorf("getting microvm spec: %w", err)
+			}
 		}
 
-		items = append(items, vm)
+		items = append(items, candidate.microvm)
 	}
 
 	return items, nil
 }
 
+// listCandidate is the latest version of a microvm spec being considered for a list.
+type listCandidate struct {
+	uid     string
+	digest  digest.Digest
+	labels  map[string]string
+	sortKey string
+	microvm *models.MicroVM
+}
+
+func (r *containerdRepo) newListCandidate(
+	ctx context.Context,
+	info content.Info,
+	query models.ListMicroVMQuery,
+) (*listCandidate, error) {
+	candidate := &listCandidate{
+		uid:    info.Labels[UIDLabel()],
+		digest: info.Digest,
+		labels: info.Labels,
+	}
+
+	// Specs saved before the state was added to the content labels have to be read
+	// to find out their state and labels.
+	if _, ok := info.Labels[StateLabel()]; !ok {
+		microvm, err := r.getWithDigest(ctx, &info.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("getting microvm spec: %w", err)
+		}
+
+		candidate.microvm = microvm
+		candidate.labels = getVMLabels(microvm)
+	}
+
+	createdAt, _ := strconv.ParseInt(candidate.labels[CreatedAtLabel()], 10, 64)
+	candidate.sortKey = query.SortKey(candidate.labels[NamespaceLabel()], candidate.labels[NameLabel()], createdAt)
+
+	return candidate, nil
+}
+
+func (c *listCandidate) matches(query models.ListMicroVMQuery) bool {
+	if !query.MatchesState(models.MicroVMState(c.labels[StateLabel()])) {
+		return false
+	}
+
+	if len(query.Selector) == 0 {
+		return true
+	}
+
+	specLabels := map[string]string{}
+	prefix := SpecLabel("")
+
+	for key, value := range c.labels {
+		if name, ok := strings.CutPrefix(key, prefix); ok {
+			specLabels[name] = value
+		}
+	}
+
+	return query.Selector.Matches(specLabels)
+}
+
 // ReleaseLease will release the supplied lease.
 func (r *containerdRepo) ReleaseLease(ctx context.Context, microvm *models.MicroVM) error {
 	mu := r.getMutex(microvm.ID.String())
@@ -289,7 +392,7 @@ func (r *containerdRepo) findDigestForSpec(ctx context.Context,
 ) (*digest.Digest, error) {
 	var digest *digest.Digest
 
-	combinedFilters := []string{}
+	combinedFilters := []string{labelFilter(TypeLabel(), MicroVMSpecType)}
 
 	if options.Name != "" {
 		combinedFilters = append(combinedFilters, labelFilter(NameLabel(), options.Name))
@@ -337,9 +440,10 @@ func (r *containerdRepo) findDigestForSpec(ctx context.Context,
 
 func (r *containerdRepo) findAllDigestForSpec(ctx context.Context, name, namespace string) ([]*digest.Digest, error) {
 	store := r.client.ContentStore()
+	typeLabelFilter := labelFilter(TypeLabel(), MicroVMSpecType)
 	idLabelFilter := labelFilter(NameLabel(), name)
 	nsLabelFilter := labelFilter(NamespaceLabel(), namespace)
-	combinedFilters := []string{idLabelFilter, nsLabelFilter}
+	combinedFilters := []string{typeLabelFilter, idLabelFilter, nsLabelFilter}
 	allFilters := strings.Join(combinedFilters, ",")
 	digests := []*digest.Digest{}
 
@@ -381,32 +485,29 @@ func getVMLabels(microvm *models.MicroVM) map[string]string {
 		TypeLabel():      MicroVMSpecType,
 		VersionLabel():   strconv.Itoa(microvm.Version),
 		UIDLabel():       microvm.ID.UID(),
+		StateLabel():     string(microvm.Status.State),
+		CreatedAtLabel(): strconv.FormatInt(microvm.Spec.CreatedAt, 10),
+	}
+
+	for key, value := range microvm.Spec.Labels {
+		labels[SpecLabel(key)] = value
 	}
 
 	return labels
 }
 
+// convertQueryToFilter converts the parts of a query that can be filtered by containerd. Only the
+// labels that are the same on every version of a spec can be used, otherwise an old version could
+// match when the latest doesn't.
 func convertQueryToFilter(query models.ListMicroVMQuery) []string {
 	filters := []string{}
 
-	for key, value := range query {
-		if value == "" {
-			continue
-		}
-
-		if key == "namespace" {
-			filters = append(filters, labelFilter(NamespaceLabel(), value))
-
-			continue
-		}
-
-		if key == "name" {
-			filters = append(filters, labelFilter(NameLabel(), value))
-
-			continue
-		}
+	if query.Namespace != "" {
+		filters = append(filters, labelFilter(NamespaceLabel(), query.Namespace))
+	}
 
-		filters = append(filters, labelFilter(key, value))
+	if query.Name != "" {
+		filters = append(filters, labelFilter(NameLabel(), query.Name))
 	}
 
 	return filters
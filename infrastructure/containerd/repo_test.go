@@ -90,7 +90,7 @@ func TestMicroVMRepo_Integration(t *testing.T) {
 	Expect(olderVM).NotTo(BeNil())
 	Expect(olderVM.Version).To(Equal(2))
 
-	all, err := repo.GetAll(ctx, models.ListMicroVMQuery{"namespace": testOwnerNamespace})
+	all, err := repo.GetAll(ctx, models.ListMicroVMQuery{Namespace: testOwnerNamespace})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(all)).To(Equal(1))
 
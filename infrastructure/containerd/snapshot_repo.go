@@ -0,0 +1,203 @@
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// NewSnapshotRepo will create a new containerd backed snapshot repository with the supplied containerd configuration.
+func NewSnapshotRepo(cfg *Config) (ports.SnapshotRepository, error) {
+	client, err := containerd.New(cfg.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating containerd client: %w", err)
+	}
+
+	return NewSnapshotRepoWithClient(cfg, client), nil
+}
+
+// NewSnapshotRepoWithClient will create a new containerd backed snapshot repository with the supplied containerd client.
+func NewSnapshotRepoWithClient(cfg *Config, client *containerd.Client) ports.SnapshotRepository {
+	return &snapshotRepo{
+		client: client,
+		config: cfg,
+	}
+}
+
+type snapshotRepo struct {
+	client *containerd.Client
+	config *Config
+}
+
+// Save will save the supplied snapshot details to the containerd content store.
+func (r *snapshotRepo) Save(ctx context.Context, snapshot *models.Snapshot) (*models.Snapshot, error) {
+	logger := log.GetLogger(ctx).WithField("repo", "containerd_snapshot")
+	logger.Debugf("saving snapshot %s", snapshot.UID)
+
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	leaseCtx, err := withOwnerLease(namespaceCtx, snapshotLeaseOwner(snapshot.UID), r.client)
+	if err != nil {
+		return nil, fmt.Errorf("getting lease for owner: %w", err)
+	}
+
+	store := r.client.ContentStore()
+
+	writer, err := store.Writer(leaseCtx, content.WithRef(snapshotContentRefName(snapshot)))
+	if err != nil {
+		return nil, fmt.Errorf("getting containerd writer: %w", err)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling snapshot to json: %w", err)
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return nil, fmt.Errorf("writing data to contentd store: %w", err)
+	}
+
+	err = writer.Commit(namespaceCtx, 0, "", content.WithLabels(getSnapshotLabels(snapshot)))
+	if err != nil && !errdefs.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("committing content to store: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// Get will get the snapshot with the given uid from the containerd content store. If the
+// snapshot doesn't exist nil is returned.
+func (r *snapshotRepo) Get(ctx context.Context, uid string) (*models.Snapshot, error) {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	digests, err := r.findDigests(namespaceCtx, labelFilter(UIDLabel(), uid))
+	if err != nil {
+		return nil, fmt.Errorf("finding content for snapshot %s: %w", uid, err)
+	}
+
+	if len(digests) == 0 {
+		return nil, nil
+	}
+
+	return r.getWithDigest(namespaceCtx, digests[0])
+}
+
+// GetAll will get a list of snapshot details from the containerd content store.
+func (r *snapshotRepo) GetAll(ctx context.Context, query models.ListSnapshotQuery) ([]*models.Snapshot, error) {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+	filters := []string{}
+
+	if query.Namespace != "" {
+		filters = append(filters, labelFilter(NamespaceLabel(), query.Namespace))
+	}
+
+	if query.MicroVMUID != "" {
+		filters = append(filters, labelFilter(MicroVMUIDLabel(), query.MicroVMUID))
+	}
+
+	digests, err := r.findDigests(namespaceCtx, filters...)
+	if err != nil {
+		return nil, fmt.Errorf("finding snapshot content: %w", err)
+	}
+
+	items := []*models.Snapshot{}
+
+	for _, d := range digests {
+		snapshot, getErr := r.getWithDigest(namespaceCtx, d)
+		if getErr != nil {
+			return nil, fmt.Errorf("getting snapshot: %w", getErr)
+		}
+
+		items = append(items, snapshot)
+	}
+
+	return items, nil
+}
+
+// Delete will delete the supplied snapshot details from the containerd content store.
+func (r *snapshotRepo) Delete(ctx context.Context, snapshot *models.Snapshot) error {
+	namespaceCtx := namespaces.WithNamespace(ctx, r.config.Namespace)
+	store := r.client.ContentStore()
+
+	digests, err := r.findDigests(namespaceCtx, labelFilter(UIDLabel(), snapshot.UID))
+	if err != nil {
+		return fmt.Errorf("finding content for snapshot %s: %w", snapshot.UID, err)
+	}
+
+	for _, d := range digests {
+		if err := store.Delete(namespaceCtx, *d); err != nil {
+			return fmt.Errorf("deleting content %s from content store: %w", d.String(), err)
+		}
+	}
+
+	if err := deleteLease(namespaceCtx, snapshotLeaseOwner(snapshot.UID), r.client); err != nil &&
+		!errdefs.IsNotFound(err) {
+		return fmt.Errorf("deleting snapshot lease: %w", err)
+	}
+
+	return nil
+}
+
+func (r *snapshotRepo) findDigests(ctx context.Context, filters ...string) ([]*digest.Digest, error) {
+	store := r.client.ContentStore()
+	allFilters := strings.Join(append([]string{labelFilter(TypeLabel(), SnapshotType)}, filters...), ",")
+	digests := []*digest.Digest{}
+
+	err := store.Walk(
+		ctx,
+		func(info content.Info) error {
+			digests = append(digests, &info.Digest)
+
+			return nil
+		},
+		allFilters,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("walking content store: %w", err)
+	}
+
+	return digests, nil
+}
+
+func (r *snapshotRepo) getWithDigest(ctx context.Context, metadigest *digest.Digest) (*models.Snapshot, error) {
+	readData, err := content.ReadBlob(ctx, r.client.ContentStore(), v1.Descriptor{
+		Digest: *metadigest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading content %s: %w", metadigest, ErrReadingContent)
+	}
+
+	snapshot := &models.Snapshot{}
+
+	if err := json.Unmarshal(readData, snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshalling json content to snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func getSnapshotLabels(snapshot *models.Snapshot) map[string]string {
+	return map[string]string{
+		NameLabel():       snapshot.Name,
+		NamespaceLabel():  snapshot.Namespace,
+		TypeLabel():       SnapshotType,
+		UIDLabel():        snapshot.UID,
+		MicroVMUIDLabel(): snapshot.MicroVMUID,
+	}
+}
+
+func snapshotLeaseOwner(uid string) string {
+	return "snapshot/" + uid
+}
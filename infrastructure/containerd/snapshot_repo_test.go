@@ -0,0 +1,77 @@
+package containerd_test
+
+import (
+	"context"
+	"testing"
+
+	ctr "github.com/containerd/containerd"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/containerd"
+)
+
+func TestSnapshotRepo_Integration(t *testing.T) {
+	if !runContainerDTests() {
+		t.Skip("skipping containerd snapshot repo integration test")
+	}
+
+	var (
+		repo     ports.SnapshotRepository
+		ctx      context.Context
+		snapshot *models.Snapshot
+	)
+
+	t.Cleanup(func() {
+		if snapshot != nil {
+			_ = repo.Delete(ctx, snapshot)
+		}
+	})
+
+	RegisterTestingT(t)
+
+	var client *ctr.Client
+	client, ctx = testCreateClient(t)
+
+	repo = containerd.NewSnapshotRepoWithClient(&containerd.Config{
+		SnapshotterKernel: testSnapshotter,
+		SnapshotterVolume: testSnapshotter,
+		Namespace:         ctrdRepoNS,
+	}, client)
+
+	missing, err := repo.Get(ctx, "snapuid")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(missing).To(BeNil())
+
+	snapshot = &models.Snapshot{
+		UID:        "snapuid",
+		Name:       "warm",
+		Namespace:  testOwnerNamespace,
+		MicroVMUID: testOwnerUID,
+		Provider:   "firecracker",
+		Path:       "/var/lib/flintlock/snapshots/snapuid",
+		Source:     makeSpec(testOwnerName, testOwnerNamespace, testOwnerUID).Spec,
+	}
+	_, err = repo.Save(ctx, snapshot)
+	Expect(err).NotTo(HaveOccurred())
+
+	got, err := repo.Get(ctx, "snapuid")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(got).NotTo(BeNil())
+	Expect(got.Name).To(Equal("warm"))
+
+	all, err := repo.GetAll(ctx, models.ListSnapshotQuery{Namespace: testOwnerNamespace, MicroVMUID: testOwnerUID})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(all).To(HaveLen(1))
+
+	none, err := repo.GetAll(ctx, models.ListSnapshotQuery{Namespace: "other"})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(none).To(BeEmpty())
+
+	Expect(repo.Delete(ctx, snapshot)).To(Succeed())
+
+	missing, err = repo.Get(ctx, "snapuid")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(missing).To(BeNil())
+}
@@ -190,9 +190,7 @@ func (r *MicroVMController) handleEvent(envelope *ports.EventEnvelope, logger *l
 func (r *MicroVMController) resyncSpecs(ctx context.Context, logger *logrus.Entry) error {
 	logger.Info("resyncing microvm specs")
 
-	specs, err := r.queryUC.GetAllMicroVM(ctx, models.ListMicroVMQuery{
-		"Namespace": "",
-	})
+	specs, err := r.queryUC.GetAllMicroVM(ctx, models.ListMicroVMQuery{})
 	if err != nil {
 		return fmt.Errorf("getting all microvm specs for resync: %w", err)
 	}
@@ -0,0 +1,13 @@
+package dhcp
+
+import "time"
+
+// Config is the configuration for the embedded DHCP server.
+type Config struct {
+	// Enabled indicates if the DHCP server should be run.
+	Enabled bool
+	// BridgeName is the name of the bridge to serve leases on.
+	BridgeName string
+	// LeaseTime is how long a lease is valid for before the guest has to renew it.
+	LeaseTime time.Duration
+}
@@ -0,0 +1,186 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/server4"
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	dhcpv4ServerPort = 67
+	dhcpv4ClientPort = 68
+
+	ipv4Bits = 32
+)
+
+// serveV4 answers the DHCPv4 requests of guests with a lease until the connection is closed.
+func (s *server) serveV4(ctx context.Context, conn net.PacketConn, serverIP netip.Addr) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":  "dhcp",
+		"protocol": "dhcpv4",
+	})
+
+	handler := func(conn net.PacketConn, _ net.Addr, req *dhcpv4.DHCPv4) {
+		reply, err := s.replyV4(req, serverIP)
+		if err != nil {
+			logger.Warnf("creating dhcpv4 reply to %s: %v", req.ClientHWAddr, err)
+
+			return
+		}
+
+		if reply == nil {
+			return
+		}
+
+		logger.Debugf("sending dhcpv4 %s for %s to %s", reply.MessageType(), reply.YourIPAddr, req.ClientHWAddr)
+
+		if _, err := conn.WriteTo(reply.ToBytes(), replyV4Destination(req, reply)); err != nil {
+			logger.Warnf("sending dhcpv4 reply to %s: %v", req.ClientHWAddr, err)
+		}
+	}
+
+	srv, err := server4.NewServer("", nil, handler, server4.WithConn(conn))
+	if err != nil {
+		logger.Errorf("creating dhcpv4 server: %v", err)
+
+		return
+	}
+
+	if err := srv.Serve(); err != nil && !errors.Is(err, net.ErrClosed) {
+		logger.Errorf("serving dhcpv4: %v", err)
+	}
+}
+
+// replyV4 returns the reply to a request, or nil if the server shouldn't reply. Requests
+// from guests without a lease are ignored so another DHCP server can answer them.
+func (s *server) replyV4(req *dhcpv4.DHCPv4, serverIP netip.Addr) (*dhcpv4.DHCPv4, error) {
+	if req.OpCode != dhcpv4.OpcodeBootRequest {
+		return nil, nil
+	}
+
+	found, ok := s.lease(req.ClientHWAddr)
+	if !ok || !found.prefix.Addr().Is4() {
+		return nil, nil
+	}
+
+	serverID := req.ServerIdentifier()
+	if serverID != nil && !serverID.Equal(serverIP.AsSlice()) {
+		// The guest has chosen another server.
+		return nil, nil
+	}
+
+	leased := found.prefix.Addr().AsSlice()
+
+	switch req.MessageType() {
+	case dhcpv4.MessageTypeDiscover:
+		return s.leaseReplyV4(req, dhcpv4.MessageTypeOffer, found, serverIP)
+	case dhcpv4.MessageTypeRequest:
+		requested := req.RequestedIPAddress()
+		if requested == nil {
+			requested = req.ClientIPAddr
+		}
+
+		if !requested.Equal(leased) {
+			return newReplyV4(req, dhcpv4.MessageTypeNak, serverIP)
+		}
+
+		return s.leaseReplyV4(req, dhcpv4.MessageTypeAck, found, serverIP)
+	case dhcpv4.MessageTypeInform:
+		reply, err := s.leaseReplyV4(req, dhcpv4.MessageTypeAck, found, serverIP)
+		if err != nil {
+			return nil, err
+		}
+
+		reply.YourIPAddr = net.IPv4zero
+		reply.Options.Del(dhcpv4.OptionIPAddressLeaseTime)
+		reply.Options.Del(dhcpv4.OptionRenewTimeValue)
+		reply.Options.Del(dhcpv4.OptionRebindingTimeValue)
+
+		return reply, nil
+	case dhcpv4.MessageTypeDecline, dhcpv4.MessageTypeRelease:
+		// Leases last as long as the microvm, so there's nothing to do.
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (s *server) leaseReplyV4(req *dhcpv4.DHCPv4,
+	msgType dhcpv4.MessageType,
+	found lease,
+	serverIP netip.Addr,
+) (*dhcpv4.DHCPv4, error) {
+	reply, err := newReplyV4(req, msgType, serverIP)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseTime, renewalTime, rebindingTime := s.leaseTimes()
+
+	reply.YourIPAddr = found.prefix.Addr().AsSlice()
+	reply.UpdateOption(dhcpv4.OptIPAddressLeaseTime(time.Duration(leaseTime) * time.Second))
+	reply.UpdateOption(dhcpv4.OptRenewTimeValue(time.Duration(renewalTime) * time.Second))
+	reply.UpdateOption(dhcpv4.OptRebindingTimeValue(time.Duration(rebindingTime) * time.Second))
+	reply.UpdateOption(dhcpv4.OptSubnetMask(net.CIDRMask(found.prefix.Bits(), ipv4Bits)))
+
+	if found.Address.Gateway != nil {
+		if gateway, err := netip.ParsePrefix(string(*found.Address.Gateway)); err == nil && gateway.Addr().Is4() {
+			reply.UpdateOption(dhcpv4.OptRouter(gateway.Addr().AsSlice()))
+		}
+	}
+
+	nameservers := []net.IP{}
+
+	for _, nameserver := range found.Address.Nameservers {
+		if addr, err := netip.ParseAddr(nameserver); err == nil && addr.Is4() {
+			nameservers = append(nameservers, addr.AsSlice())
+		}
+	}
+
+	if len(nameservers) > 0 {
+		reply.UpdateOption(dhcpv4.OptDNS(nameservers...))
+	}
+
+	if found.Hostname != "" {
+		reply.UpdateOption(dhcpv4.OptHostName(found.Hostname))
+	}
+
+	return reply, nil
+}
+
+func newReplyV4(req *dhcpv4.DHCPv4, msgType dhcpv4.MessageType, serverIP netip.Addr) (*dhcpv4.DHCPv4, error) {
+	modifiers := []dhcpv4.Modifier{
+		dhcpv4.WithMessageType(msgType),
+		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverIP.AsSlice())),
+	}
+
+	if msgType != dhcpv4.MessageTypeNak {
+		modifiers = append(modifiers, dhcpv4.WithClientIP(req.ClientIPAddr))
+	}
+
+	reply, err := dhcpv4.NewReplyFromRequest(req, modifiers...)
+	if err != nil {
+		return nil, fmt.Errorf("creating dhcpv4 %s: %w", msgType, err)
+	}
+
+	return reply, nil
+}
+
+// replyV4Destination is where to send a reply. Guests that already have an address are
+// answered directly, everything else is broadcast as the guest can't receive unicast yet.
+func replyV4Destination(req, reply *dhcpv4.DHCPv4) *net.UDPAddr {
+	if req.ClientIPAddr != nil && !req.ClientIPAddr.IsUnspecified() && reply.MessageType() != dhcpv4.MessageTypeNak {
+		return &net.UDPAddr{IP: req.ClientIPAddr, Port: dhcpv4ClientPort}
+	}
+
+	return &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4ClientPort}
+}
@@ -0,0 +1,236 @@
+package dhcp
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+const (
+	testGuestMAC = "aa:bb:cc:dd:ee:01"
+	testOtherMAC = "aa:bb:cc:dd:ee:02"
+)
+
+var testServerIP = netip.MustParseAddr("192.168.100.1")
+
+func TestDHCPv4_Reply(t *testing.T) {
+	srv := newTestServer(t, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address: models.StaticAddress{
+			Address:     "192.168.100.10/24",
+			Gateway:     gatewayAddress("192.168.100.1/24"),
+			Nameservers: []string{"1.1.1.1", "2606:4700:4700::1111"},
+		},
+		Hostname: "mvm1",
+	})
+
+	testCases := []struct {
+		name         string
+		request      *dhcpv4.DHCPv4
+		expectedType dhcpv4.MessageType
+		expectedAddr string
+	}{
+		{
+			name:         "discover",
+			request:      newTestRequestV4(dhcpv4.MessageTypeDiscover, testGuestMAC),
+			expectedType: dhcpv4.MessageTypeOffer,
+			expectedAddr: "192.168.100.10",
+		},
+		{
+			name: "request for leased address",
+			request: newTestRequestV4(dhcpv4.MessageTypeRequest, testGuestMAC,
+				dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(net.ParseIP("192.168.100.10"))),
+				dhcpv4.WithOption(dhcpv4.OptServerIdentifier(testServerIP.AsSlice())),
+			),
+			expectedType: dhcpv4.MessageTypeAck,
+			expectedAddr: "192.168.100.10",
+		},
+		{
+			name: "renewal",
+			request: newTestRequestV4(dhcpv4.MessageTypeRequest, testGuestMAC,
+				dhcpv4.WithClientIP(net.ParseIP("192.168.100.10")),
+			),
+			expectedType: dhcpv4.MessageTypeAck,
+			expectedAddr: "192.168.100.10",
+		},
+		{
+			name: "request for another address",
+			request: newTestRequestV4(dhcpv4.MessageTypeRequest, testGuestMAC,
+				dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(net.ParseIP("192.168.100.20"))),
+			),
+			expectedType: dhcpv4.MessageTypeNak,
+		},
+		{
+			name: "request to another server",
+			request: newTestRequestV4(dhcpv4.MessageTypeRequest, testGuestMAC,
+				dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(net.ParseIP("192.168.100.10"))),
+				dhcpv4.WithOption(dhcpv4.OptServerIdentifier(net.ParseIP("192.168.100.2"))),
+			),
+		},
+		{
+			name:    "discover without lease",
+			request: newTestRequestV4(dhcpv4.MessageTypeDiscover, testOtherMAC),
+		},
+		{
+			name:    "release",
+			request: newTestRequestV4(dhcpv4.MessageTypeRelease, testGuestMAC),
+		},
+		{
+			name: "reply",
+			request: newTestRequestV4(dhcpv4.MessageTypeOffer, testGuestMAC, func(d *dhcpv4.DHCPv4) {
+				d.OpCode = dhcpv4.OpcodeBootReply
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			reply, err := srv.replyV4(tc.request, testServerIP)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tc.expectedType == dhcpv4.MessageTypeNone {
+				g.Expect(reply).To(BeNil())
+
+				return
+			}
+
+			g.Expect(reply).NotTo(BeNil())
+			g.Expect(reply.MessageType()).To(Equal(tc.expectedType))
+			g.Expect(reply.TransactionID).To(Equal(tc.request.TransactionID))
+			g.Expect(reply.ServerIdentifier().String()).To(Equal(testServerIP.String()))
+
+			if tc.expectedType == dhcpv4.MessageTypeNak {
+				return
+			}
+
+			g.Expect(reply.YourIPAddr.String()).To(Equal(tc.expectedAddr))
+			g.Expect(reply.SubnetMask()).To(Equal(net.IPv4Mask(255, 255, 255, 0)))
+			g.Expect(reply.Router()).To(HaveLen(1))
+			g.Expect(reply.Router()[0].String()).To(Equal("192.168.100.1"))
+			g.Expect(reply.DNS()).To(HaveLen(1))
+			g.Expect(reply.DNS()[0].String()).To(Equal("1.1.1.1"))
+			g.Expect(reply.HostName()).To(Equal("mvm1"))
+			g.Expect(reply.IPAddressLeaseTime(0)).To(Equal(time.Hour))
+			g.Expect(reply.IPAddressRenewalTime(0)).To(Equal(30 * time.Minute))
+			g.Expect(reply.IPAddressRebindingTime(0)).To(Equal(3150 * time.Second))
+		})
+	}
+}
+
+func TestDHCPv4_ReplyDestination(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := newTestServer(t, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address:    models.StaticAddress{Address: "192.168.100.10/24"},
+	})
+
+	discover := newTestRequestV4(dhcpv4.MessageTypeDiscover, testGuestMAC)
+	offer, err := srv.replyV4(discover, testServerIP)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(replyV4Destination(discover, offer).IP.Equal(net.IPv4bcast)).To(BeTrue())
+
+	renew := newTestRequestV4(dhcpv4.MessageTypeRequest, testGuestMAC, dhcpv4.WithClientIP(net.ParseIP("192.168.100.10")))
+	ack, err := srv.replyV4(renew, testServerIP)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(replyV4Destination(renew, ack).IP.String()).To(Equal("192.168.100.10"))
+
+	inform := newTestRequestV4(dhcpv4.MessageTypeInform, testGuestMAC, dhcpv4.WithClientIP(net.ParseIP("192.168.100.10")))
+	informAck, err := srv.replyV4(inform, testServerIP)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(informAck.MessageType()).To(Equal(dhcpv4.MessageTypeAck))
+	g.Expect(informAck.YourIPAddr.IsUnspecified()).To(BeTrue())
+	g.Expect(informAck.Options.Has(dhcpv4.OptionIPAddressLeaseTime)).To(BeFalse())
+
+	g.Expect(srv.RemoveLease(context.Background(), testGuestMAC)).To(Succeed())
+
+	offer, err = srv.replyV4(discover, testServerIP)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(offer).To(BeNil())
+}
+
+func TestServer_Leases(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := newTestServer(t)
+	ctx := context.Background()
+
+	g.Expect(srv.Serves("")).To(BeTrue())
+	g.Expect(srv.Serves("br0")).To(BeTrue())
+	g.Expect(srv.Serves("br1")).To(BeFalse())
+
+	g.Expect(srv.HasLease(testGuestMAC, "192.168.100.10/24")).To(BeFalse())
+
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: "AA:BB:CC:DD:EE:01",
+		Address:    models.StaticAddress{Address: "192.168.100.10/24"},
+	})).To(Succeed())
+	g.Expect(srv.HasLease(testGuestMAC, "192.168.100.10/24")).To(BeTrue())
+	g.Expect(srv.HasLease(testGuestMAC, "192.168.100.11/24")).To(BeFalse())
+
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address:    models.StaticAddress{Address: "192.168.100.11/24"},
+	})).To(Succeed())
+	g.Expect(srv.HasLease(testGuestMAC, "192.168.100.11/24")).To(BeTrue())
+
+	g.Expect(srv.RemoveLease(ctx, "AA:BB:CC:DD:EE:01")).To(Succeed())
+	g.Expect(srv.HasLease(testGuestMAC, "192.168.100.11/24")).To(BeFalse())
+
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: "not-a-mac",
+		Address:    models.StaticAddress{Address: "192.168.100.10/24"},
+	})).NotTo(Succeed())
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address:    models.StaticAddress{Address: "192.168.100.10"},
+	})).NotTo(Succeed())
+
+	disabled := New(&Config{BridgeName: "br0"})
+	g.Expect(disabled.Serves("")).To(BeFalse())
+}
+
+func newTestServer(t *testing.T, leases ...ports.DHCPLease) *server {
+	t.Helper()
+
+	srv, _ := New(&Config{
+		Enabled:    true,
+		BridgeName: "br0",
+		LeaseTime:  time.Hour,
+	}).(*server)
+
+	for _, lease := range leases {
+		if err := srv.AddLease(context.Background(), lease); err != nil {
+			t.Fatalf("adding lease: %v", err)
+		}
+	}
+
+	return srv
+}
+
+func newTestRequestV4(msgType dhcpv4.MessageType, mac string, modifiers ...dhcpv4.Modifier) *dhcpv4.DHCPv4 {
+	hwAddr, _ := net.ParseMAC(mac)
+
+	msg, _ := dhcpv4.New(append([]dhcpv4.Modifier{
+		dhcpv4.WithHwAddr(hwAddr),
+		dhcpv4.WithMessageType(msgType),
+	}, modifiers...)...)
+
+	return msg
+}
+
+func gatewayAddress(address string) *models.IPAddressCIDR {
+	gateway := models.IPAddressCIDR(address)
+
+	return &gateway
+}
@@ -0,0 +1,222 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/dhcpv6/server6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/ipv6"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// listenDHCPv6 listens for the DHCPv6 messages guests send to all DHCP servers on the bridge.
+func listenDHCPv6(ctx context.Context, bridge *net.Interface) (net.PacketConn, error) {
+	conn, err := listenOnDevice(ctx, "udp6", fmt.Sprintf("[::]:%d", dhcpv6.DefaultServerPort), bridge.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &net.UDPAddr{IP: dhcpv6.AllDHCPRelayAgentsAndServers}
+	if err := ipv6.NewPacketConn(conn).JoinGroup(bridge, group); err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("joining dhcp servers multicast group: %w", err)
+	}
+
+	return conn, nil
+}
+
+// serveV6 answers the DHCPv6 requests of guests with a lease until the connection is closed.
+func (s *server) serveV6(ctx context.Context, conn net.PacketConn, bridge *net.Interface) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":  "dhcp",
+		"protocol": "dhcpv6",
+	})
+
+	serverDUID := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: bridge.HardwareAddr}
+
+	handler := func(conn net.PacketConn, peer net.Addr, req dhcpv6.DHCPv6) {
+		srcAddr, ok := peer.(*net.UDPAddr)
+		if !ok {
+			return
+		}
+
+		msg, ok := req.(*dhcpv6.Message)
+		if !ok {
+			// Guests are on the bridge, so there are no relays.
+			return
+		}
+
+		srcIP, _ := netip.AddrFromSlice(srcAddr.IP)
+
+		reply := s.replyV6(msg, srcIP, serverDUID)
+		if reply == nil {
+			return
+		}
+
+		logger.Debugf("sending dhcpv6 %s to %s", reply.MessageType, srcAddr)
+
+		dst := &net.UDPAddr{IP: srcAddr.IP, Port: dhcpv6.DefaultClientPort, Zone: bridge.Name}
+		if _, err := conn.WriteTo(reply.ToBytes(), dst); err != nil {
+			logger.Warnf("sending dhcpv6 reply to %s: %v", srcAddr, err)
+		}
+	}
+
+	srv, err := server6.NewServer("", nil, handler, server6.WithConn(conn))
+	if err != nil {
+		logger.Errorf("creating dhcpv6 server: %v", err)
+
+		return
+	}
+
+	if err := srv.Serve(); err != nil && !errors.Is(err, net.ErrClosed) {
+		logger.Errorf("serving dhcpv6: %v", err)
+	}
+}
+
+// replyV6 returns the reply to a message from a guest, or nil if the server shouldn't reply.
+// Messages from guests without an IPv6 lease are ignored so another DHCP server can answer them.
+func (s *server) replyV6(req *dhcpv6.Message, src netip.Addr, serverDUID dhcpv6.DUID) *dhcpv6.Message {
+	clientDUID := req.Options.ClientID()
+	if clientDUID == nil {
+		return nil
+	}
+
+	mac := clientMAC(clientDUID, src)
+	if mac == nil {
+		return nil
+	}
+
+	found, ok := s.lease(mac)
+	if !ok || !found.prefix.Addr().Is6() {
+		return nil
+	}
+
+	switch req.MessageType {
+	case dhcpv6.MessageTypeRequest, dhcpv6.MessageTypeRenew, dhcpv6.MessageTypeRelease, dhcpv6.MessageTypeDecline:
+		if !serverDUID.Equal(req.Options.ServerID()) {
+			return nil
+		}
+	case dhcpv6.MessageTypeSolicit, dhcpv6.MessageTypeRebind, dhcpv6.MessageTypeConfirm,
+		dhcpv6.MessageTypeInformationRequest:
+	default:
+		return nil
+	}
+
+	reply := &dhcpv6.Message{
+		MessageType:   dhcpv6.MessageTypeReply,
+		TransactionID: req.TransactionID,
+	}
+
+	reply.AddOption(dhcpv6.OptClientID(clientDUID))
+	reply.AddOption(dhcpv6.OptServerID(serverDUID))
+
+	switch req.MessageType {
+	case dhcpv6.MessageTypeSolicit:
+		if req.GetOneOption(dhcpv6.OptionRapidCommit) != nil {
+			dhcpv6.WithRapidCommit(reply)
+		} else {
+			reply.MessageType = dhcpv6.MessageTypeAdvertise
+		}
+
+		fallthrough
+	case dhcpv6.MessageTypeRequest, dhcpv6.MessageTypeRenew, dhcpv6.MessageTypeRebind:
+		iaNA := req.Options.OneIANA()
+		if iaNA == nil {
+			return nil
+		}
+
+		reply.AddOption(s.iaNA(iaNA.IaId, found))
+	case dhcpv6.MessageTypeConfirm:
+		reply.AddOption(&dhcpv6.OptStatusCode{StatusCode: confirmStatus(req, found)})
+
+		return reply
+	case dhcpv6.MessageTypeRelease, dhcpv6.MessageTypeDecline:
+		// Leases last as long as the microvm, so there's nothing to do.
+		reply.AddOption(&dhcpv6.OptStatusCode{StatusCode: iana.StatusSuccess})
+
+		return reply
+	}
+
+	nameservers := []net.IP{}
+
+	for _, nameserver := range found.Address.Nameservers {
+		if addr, err := netip.ParseAddr(nameserver); err == nil && addr.Is6() && !addr.Is4In6() {
+			nameservers = append(nameservers, addr.AsSlice())
+		}
+	}
+
+	if len(nameservers) > 0 {
+		reply.AddOption(dhcpv6.OptDNS(nameservers...))
+	}
+
+	return reply
+}
+
+// iaNA returns an IA_NA option for the identity association with the leased address.
+func (s *server) iaNA(iaid [4]byte, found lease) *dhcpv6.OptIANA {
+	leaseTime, renewalTime, rebindingTime := s.leaseTimes()
+
+	iaNA := &dhcpv6.OptIANA{
+		IaId: iaid,
+		T1:   time.Duration(renewalTime) * time.Second,
+		T2:   time.Duration(rebindingTime) * time.Second,
+	}
+
+	iaNA.Options.Add(&dhcpv6.OptIAAddress{
+		IPv6Addr:          found.prefix.Addr().AsSlice(),
+		PreferredLifetime: time.Duration(leaseTime) * time.Second,
+		ValidLifetime:     time.Duration(leaseTime) * time.Second,
+	})
+
+	return iaNA
+}
+
+// confirmStatus returns the status of a confirm message, which is whether the addresses the
+// guest has are still right for the link.
+func confirmStatus(req *dhcpv6.Message, found lease) iana.StatusCode {
+	for _, iaNA := range req.Options.IANA() {
+		for _, address := range iaNA.Options.Addresses() {
+			addr, ok := netip.AddrFromSlice(address.IPv6Addr)
+			if ok && addr != found.prefix.Addr() {
+				return iana.StatusNotOnLink
+			}
+		}
+	}
+
+	return iana.StatusSuccess
+}
+
+// clientMAC returns the MAC address of a guest from its DUID if it's based on the link layer
+// address, otherwise from its modified EUI-64 link local address.
+func clientMAC(duid dhcpv6.DUID, src netip.Addr) net.HardwareAddr {
+	switch d := duid.(type) {
+	case *dhcpv6.DUIDLL:
+		if d.HWType == iana.HWTypeEthernet && len(d.LinkLayerAddr) == ethernetAddressLen {
+			return d.LinkLayerAddr
+		}
+	case *dhcpv6.DUIDLLT:
+		if d.HWType == iana.HWTypeEthernet && len(d.LinkLayerAddr) == ethernetAddressLen {
+			return d.LinkLayerAddr
+		}
+	}
+
+	if !src.Is6() || !src.IsLinkLocalUnicast() {
+		return nil
+	}
+
+	addr := src.As16()
+	if addr[11] != 0xff || addr[12] != 0xfe {
+		return nil
+	}
+
+	return net.HardwareAddr{addr[8] ^ 0x02, addr[9], addr[10], addr[13], addr[14], addr[15]}
+}
@@ -0,0 +1,258 @@
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/mdlayher/ndp"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+var (
+	testServerDUID  = &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01}}
+	testGuestDUIDEN = &dhcpv6.DUIDEN{EnterpriseNumber: 0xabcd, EnterpriseIdentifier: []byte{1, 2, 3, 4}}
+	// testGuestLinkLocal is the modified EUI-64 link local address of testGuestMAC.
+	testGuestLinkLocal = netip.MustParseAddr("fe80::a8bb:ccff:fedd:ee01")
+)
+
+func TestDHCPv6_ClientMAC(t *testing.T) {
+	g := NewWithT(t)
+
+	mac, _ := net.ParseMAC(testGuestMAC)
+
+	duidLL := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: mac}
+	duidLLT := &dhcpv6.DUIDLLT{HWType: iana.HWTypeEthernet, Time: 1234, LinkLayerAddr: mac}
+
+	g.Expect(clientMAC(duidLL, netip.Addr{})).To(Equal(mac))
+	g.Expect(clientMAC(duidLLT, netip.Addr{})).To(Equal(mac))
+	g.Expect(clientMAC(testGuestDUIDEN, testGuestLinkLocal)).To(Equal(mac))
+	g.Expect(clientMAC(testGuestDUIDEN, netip.MustParseAddr("fe80::1234:5678:9abc:def0"))).To(BeNil())
+	g.Expect(clientMAC(testGuestDUIDEN, netip.MustParseAddr("2001:db8::a8bb:ccff:fedd:ee01"))).To(BeNil())
+}
+
+func TestDHCPv6_Reply(t *testing.T) {
+	srv := newTestServer(t, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address: models.StaticAddress{
+			Address:     "2001:db8::10/64",
+			Nameservers: []string{"1.1.1.1", "2606:4700:4700::1111"},
+		},
+	})
+
+	leased := netip.MustParseAddr("2001:db8::10")
+	otherServerDUID := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}}
+
+	testCases := []struct {
+		name         string
+		request      *dhcpv6.Message
+		src          netip.Addr
+		expectedType dhcpv6.MessageType
+		expectIA     bool
+		expectStatus int
+	}{
+		{
+			name:         "solicit",
+			request:      newTestRequestV6(dhcpv6.MessageTypeSolicit, withIANA()),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeAdvertise,
+			expectIA:     true,
+			expectStatus: -1,
+		},
+		{
+			name:         "solicit with rapid commit",
+			request:      newTestRequestV6(dhcpv6.MessageTypeSolicit, withIANA(), dhcpv6.WithRapidCommit),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectIA:     true,
+			expectStatus: -1,
+		},
+		{
+			name:         "request",
+			request:      newTestRequestV6(dhcpv6.MessageTypeRequest, withIANA(), dhcpv6.WithServerID(testServerDUID)),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectIA:     true,
+			expectStatus: -1,
+		},
+		{
+			name:    "request to another server",
+			request: newTestRequestV6(dhcpv6.MessageTypeRequest, withIANA(), dhcpv6.WithServerID(otherServerDUID)),
+			src:     testGuestLinkLocal,
+		},
+		{
+			name:         "rebind",
+			request:      newTestRequestV6(dhcpv6.MessageTypeRebind, withIANA()),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectIA:     true,
+			expectStatus: -1,
+		},
+		{
+			name:         "confirm leased address",
+			request:      newTestRequestV6(dhcpv6.MessageTypeConfirm, withIANA(leased)),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectStatus: int(iana.StatusSuccess),
+		},
+		{
+			name:         "confirm another address",
+			request:      newTestRequestV6(dhcpv6.MessageTypeConfirm, withIANA(netip.MustParseAddr("2001:db8::20"))),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectStatus: int(iana.StatusNotOnLink),
+		},
+		{
+			name:         "release",
+			request:      newTestRequestV6(dhcpv6.MessageTypeRelease, withIANA(leased), dhcpv6.WithServerID(testServerDUID)),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectStatus: int(iana.StatusSuccess),
+		},
+		{
+			name:         "decline",
+			request:      newTestRequestV6(dhcpv6.MessageTypeDecline, withIANA(leased), dhcpv6.WithServerID(testServerDUID)),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectStatus: int(iana.StatusSuccess),
+		},
+		{
+			name:         "information request",
+			request:      newTestRequestV6(dhcpv6.MessageTypeInformationRequest),
+			src:          testGuestLinkLocal,
+			expectedType: dhcpv6.MessageTypeReply,
+			expectStatus: -1,
+		},
+		{
+			name:    "solicit without lease",
+			request: newTestRequestV6(dhcpv6.MessageTypeSolicit, withIANA()),
+			src:     netip.MustParseAddr("fe80::a8bb:ccff:fedd:ee02"),
+		},
+		{
+			name:    "solicit without identity association",
+			request: newTestRequestV6(dhcpv6.MessageTypeSolicit),
+			src:     testGuestLinkLocal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			reply := srv.replyV6(tc.request, tc.src, testServerDUID)
+			if tc.expectedType == 0 {
+				g.Expect(reply).To(BeNil())
+
+				return
+			}
+
+			g.Expect(reply).NotTo(BeNil())
+
+			// Check the reply as the guest receives it.
+			reply, err := dhcpv6.MessageFromBytes(reply.ToBytes())
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(reply.MessageType).To(Equal(tc.expectedType))
+			g.Expect(reply.TransactionID).To(Equal(tc.request.TransactionID))
+			g.Expect(testGuestDUIDEN.Equal(reply.Options.ClientID())).To(BeTrue())
+			g.Expect(testServerDUID.Equal(reply.Options.ServerID())).To(BeTrue())
+
+			if tc.expectStatus >= 0 {
+				g.Expect(reply.Options.Status()).NotTo(BeNil())
+				g.Expect(int(reply.Options.Status().StatusCode)).To(Equal(tc.expectStatus))
+			} else {
+				g.Expect(reply.Options.DNS()).To(HaveLen(1))
+				g.Expect(reply.Options.DNS()[0].String()).To(Equal("2606:4700:4700::1111"))
+			}
+
+			iaNA := reply.Options.OneIANA()
+			if !tc.expectIA {
+				g.Expect(iaNA).To(BeNil())
+
+				return
+			}
+
+			g.Expect(iaNA).NotTo(BeNil())
+			g.Expect(iaNA.IaId).To(Equal([4]byte{0, 0, 0, 1}))
+			g.Expect(iaNA.T1).To(Equal(30 * time.Minute))
+			g.Expect(iaNA.T2).To(Equal(3150 * time.Second))
+
+			address := iaNA.Options.OneAddress()
+			g.Expect(address).NotTo(BeNil())
+			g.Expect(address.IPv6Addr.String()).To(Equal(leased.String()))
+			g.Expect(address.PreferredLifetime).To(Equal(time.Hour))
+			g.Expect(address.ValidLifetime).To(Equal(time.Hour))
+		})
+	}
+}
+
+func TestRouterAdvertisement(t *testing.T) {
+	g := NewWithT(t)
+
+	mac := net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01}
+
+	srv := newTestServer(t, ports.DHCPLease{
+		MACAddress: testGuestMAC,
+		Address:    models.StaticAddress{Address: "192.168.100.10/24"},
+	})
+	g.Expect(srv.routerAdvertisement(mac)).To(BeNil(), "no ipv6 leases to advertise")
+
+	srv = newTestServer(t,
+		ports.DHCPLease{MACAddress: testGuestMAC, Address: models.StaticAddress{Address: "2001:db8::10/64"}},
+		ports.DHCPLease{MACAddress: testOtherMAC, Address: models.StaticAddress{Address: "2001:db8::11/64"}},
+	)
+
+	data, err := ndp.MarshalMessage(srv.routerAdvertisement(mac))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	parsed, err := ndp.ParseMessage(data)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	msg, ok := parsed.(*ndp.RouterAdvertisement)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(msg.ManagedConfiguration).To(BeTrue())
+	g.Expect(msg.OtherConfiguration).To(BeTrue())
+	g.Expect(msg.RouterLifetime).To(BeZero())
+	g.Expect(msg.Options).To(HaveLen(2))
+
+	g.Expect(msg.Options[0]).To(Equal(&ndp.LinkLayerAddress{Direction: ndp.Source, Addr: mac}))
+
+	prefixInfo, ok := msg.Options[1].(*ndp.PrefixInformation)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(prefixInfo.PrefixLength).To(Equal(uint8(64)))
+	g.Expect(prefixInfo.OnLink).To(BeTrue())
+	g.Expect(prefixInfo.ValidLifetime).To(Equal(time.Hour))
+	g.Expect(prefixInfo.PreferredLifetime).To(Equal(time.Hour))
+	g.Expect(prefixInfo.Prefix.String()).To(Equal("2001:db8::"))
+}
+
+func newTestRequestV6(msgType dhcpv6.MessageType, modifiers ...dhcpv6.Modifier) *dhcpv6.Message {
+	msg := &dhcpv6.Message{
+		MessageType:   msgType,
+		TransactionID: dhcpv6.TransactionID{1, 2, 3},
+	}
+	msg.AddOption(dhcpv6.OptClientID(testGuestDUIDEN))
+
+	for _, modifier := range modifiers {
+		modifier(msg)
+	}
+
+	return msg
+}
+
+// withIANA adds an identity association with the addresses to the message.
+func withIANA(addrs ...netip.Addr) dhcpv6.Modifier {
+	iaNA := &dhcpv6.OptIANA{IaId: [4]byte{0, 0, 0, 1}}
+
+	for _, addr := range addrs {
+		iaNA.Options.Add(&dhcpv6.OptIAAddress{IPv6Addr: addr.AsSlice()})
+	}
+
+	return dhcpv6.WithOption(iaNA)
+}
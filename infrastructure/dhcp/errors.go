@@ -0,0 +1,8 @@
+package dhcp
+
+import "errors"
+
+var (
+	errBridgeRequired  = errors.New("a bridge name is required to run the dhcp server")
+	errNoBridgeAddress = errors.New("bridge has no ipv4 address or ipv6 link local address to serve from")
+)
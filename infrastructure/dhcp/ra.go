@@ -0,0 +1,141 @@
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/mdlayher/ndp"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/ipv6"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	// routerAdvertInterval is how often unsolicited router advertisements are sent.
+	routerAdvertInterval = time.Minute
+
+	raCurHopLimit      = 64
+	ethernetAddressLen = 6
+)
+
+// listenRouterSolicitations opens an NDP connection on the bridge to receive the router
+// solicitations of guests and send router advertisements.
+func listenRouterSolicitations(bridge *net.Interface) (*ndp.Conn, error) {
+	conn, _, err := ndp.Dial(bridge, ndp.LinkLocal)
+	if err != nil {
+		return nil, fmt.Errorf("opening ndp connection: %w", err)
+	}
+
+	filter := &ipv6.ICMPFilter{}
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+
+	if err := conn.SetICMPFilter(filter); err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("setting icmpv6 filter: %w", err)
+	}
+
+	if err := conn.JoinGroup(net.IPv6linklocalallrouters); err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("joining all routers multicast group: %w", err)
+	}
+
+	return conn, nil
+}
+
+// advertise sends router advertisements telling guests to get their address with DHCPv6
+// periodically and when a guest solicits one, until the connection is closed.
+func (s *server) advertise(ctx context.Context, conn *ndp.Conn, bridge *net.Interface) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":  "dhcp",
+		"protocol": "ndp",
+	})
+
+	solicited := make(chan struct{}, 1)
+
+	go func() {
+		for {
+			msg, _, _, err := conn.ReadFrom()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					close(solicited)
+
+					return
+				}
+
+				continue
+			}
+
+			if _, ok := msg.(*ndp.RouterSolicitation); ok {
+				select {
+				case solicited <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(routerAdvertInterval)
+	defer ticker.Stop()
+
+	for {
+		msg := s.routerAdvertisement(bridge.HardwareAddr)
+		if msg != nil {
+			err := conn.WriteTo(msg, nil, net.IPv6linklocalallnodes)
+			if err != nil && !errors.Is(err, net.ErrClosed) {
+				logger.Warnf("sending router advertisement: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-solicited:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// routerAdvertisement returns a router advertisement with the managed and other config flags
+// set and the prefixes of the IPv6 leases as on link. It isn't a default router, and there's
+// nothing to advertise if there are no IPv6 leases.
+func (s *server) routerAdvertisement(mac net.HardwareAddr) *ndp.RouterAdvertisement {
+	prefixes := s.ipv6Prefixes()
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	leaseTime, _, _ := s.leaseTimes()
+	lifetime := time.Duration(leaseTime) * time.Second
+
+	msg := &ndp.RouterAdvertisement{
+		CurrentHopLimit:      raCurHopLimit,
+		ManagedConfiguration: true,
+		OtherConfiguration:   true,
+	}
+
+	if len(mac) == ethernetAddressLen {
+		msg.Options = append(msg.Options, &ndp.LinkLayerAddress{Direction: ndp.Source, Addr: mac})
+	}
+
+	for _, prefix := range prefixes {
+		msg.Options = append(msg.Options, &ndp.PrefixInformation{
+			PrefixLength:      uint8(prefix.Bits()),
+			OnLink:            true,
+			ValidLifetime:     lifetime,
+			PreferredLifetime: lifetime,
+			Prefix:            prefix.Addr().AsSlice(),
+		})
+	}
+
+	return msg
+}
@@ -0,0 +1,293 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	renewalDivisor      = 2
+	rebindingDivisor    = 8
+	rebindingMultiplier = 7
+)
+
+// New creates a new embedded DHCP server. The server only starts serving when Start is called.
+func New(cfg *Config) ports.DHCPService {
+	return &server{
+		cfg:    cfg,
+		leases: map[string]lease{},
+	}
+}
+
+type server struct {
+	cfg *Config
+
+	mu     sync.RWMutex
+	leases map[string]lease
+}
+
+// lease is a lease with its address parsed.
+type lease struct {
+	ports.DHCPLease
+
+	prefix netip.Prefix
+}
+
+// Serves returns true if the DHCP server is enabled for the bridge.
+func (s *server) Serves(bridgeName string) bool {
+	if !s.cfg.Enabled {
+		return false
+	}
+
+	return bridgeName == "" || bridgeName == s.cfg.BridgeName
+}
+
+// HasLease returns true if there's a lease of the address for the MAC address.
+func (s *server) HasLease(macAddress string, address models.IPAddressCIDR) bool {
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	existing, ok := s.leases[mac.String()]
+
+	return ok && existing.Address.Address == address
+}
+
+// AddLease will add a lease, replacing any existing lease for the same MAC address.
+func (s *server) AddLease(ctx context.Context, input ports.DHCPLease) error {
+	mac, err := net.ParseMAC(input.MACAddress)
+	if err != nil {
+		return fmt.Errorf("parsing mac address %s: %w", input.MACAddress, err)
+	}
+
+	prefix, err := netip.ParsePrefix(string(input.Address.Address))
+	if err != nil {
+		return fmt.Errorf("parsing address %s: %w", input.Address.Address, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.leases[mac.String()] = lease{DHCPLease: input, prefix: prefix}
+
+	log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "dhcp",
+		"mac":     mac.String(),
+	}).Infof("added lease of %s", prefix.Addr())
+
+	return nil
+}
+
+// RemoveLease will remove the lease for the MAC address.
+func (s *server) RemoveLease(ctx context.Context, macAddress string) error {
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return fmt.Errorf("parsing mac address %s: %w", macAddress, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.leases, mac.String())
+
+	log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "dhcp",
+		"mac":     mac.String(),
+	}).Info("removed lease")
+
+	return nil
+}
+
+// Start will start serving DHCPv4 if the bridge has an IPv4 address, and DHCPv6 with router
+// advertisements if it has an IPv6 link local address. It serves until the context is cancelled.
+func (s *server) Start(ctx context.Context) error {
+	logger := log.GetLogger(ctx).WithField("service", "dhcp")
+
+	if s.cfg.BridgeName == "" {
+		return errBridgeRequired
+	}
+
+	bridge, err := net.InterfaceByName(s.cfg.BridgeName)
+	if err != nil {
+		return fmt.Errorf("getting bridge %s: %w", s.cfg.BridgeName, err)
+	}
+
+	serverIPv4, linkLocal, err := bridgeAddresses(bridge)
+	if err != nil {
+		return err
+	}
+
+	if !serverIPv4.IsValid() && !linkLocal.IsValid() {
+		return fmt.Errorf("%w: %s", errNoBridgeAddress, s.cfg.BridgeName)
+	}
+
+	if serverIPv4.IsValid() {
+		conn, err := listenOnDevice(ctx, "udp4", fmt.Sprintf(":%d", dhcpv4ServerPort), bridge.Name)
+		if err != nil {
+			return fmt.Errorf("listening for dhcpv4 on %s: %w", bridge.Name, err)
+		}
+
+		logger.Infof("serving dhcpv4 on %s from %s", bridge.Name, serverIPv4)
+
+		go closeOnDone(ctx, conn)
+		go s.serveV4(ctx, conn, serverIPv4)
+	} else {
+		logger.Warnf("not serving dhcpv4, bridge %s has no ipv4 address", bridge.Name)
+	}
+
+	if linkLocal.IsValid() {
+		conn, err := listenDHCPv6(ctx, bridge)
+		if err != nil {
+			return fmt.Errorf("listening for dhcpv6 on %s: %w", bridge.Name, err)
+		}
+
+		go closeOnDone(ctx, conn)
+		go s.serveV6(ctx, conn, bridge)
+
+		raConn, err := listenRouterSolicitations(bridge)
+		if err != nil {
+			return fmt.Errorf("listening for router solicitations on %s: %w", bridge.Name, err)
+		}
+
+		logger.Infof("serving dhcpv6 and router advertisements on %s", bridge.Name)
+
+		go closeOnDone(ctx, raConn)
+		go s.advertise(ctx, raConn, bridge)
+	} else {
+		logger.Warnf("not serving dhcpv6, bridge %s has no ipv6 link local address", bridge.Name)
+	}
+
+	return nil
+}
+
+// leaseTimes returns the lease time and the times the guest should renew and rebind the
+// lease at, in seconds. They're the usual half and seven eighths of the lease time.
+func (s *server) leaseTimes() (uint32, uint32, uint32) {
+	leaseTime := uint32(s.cfg.LeaseTime.Seconds())
+
+	return leaseTime, leaseTime / renewalDivisor, leaseTime / rebindingDivisor * rebindingMultiplier
+}
+
+// lease returns the lease for the MAC address, if there is one.
+func (s *server) lease(mac net.HardwareAddr) (lease, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	found, ok := s.leases[mac.String()]
+
+	return found, ok
+}
+
+// ipv6Prefixes returns the prefixes of the IPv6 leases.
+func (s *server) ipv6Prefixes() []netip.Prefix {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seen := map[netip.Prefix]bool{}
+	prefixes := []netip.Prefix{}
+
+	for _, l := range s.leases {
+		if !l.prefix.Addr().Is6() {
+			continue
+		}
+
+		prefix := l.prefix.Masked()
+		if !seen[prefix] {
+			seen[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// bridgeAddresses returns the first IPv4 address and the IPv6 link local address of the bridge.
+func bridgeAddresses(bridge *net.Interface) (netip.Addr, netip.Addr, error) {
+	addrs, err := bridge.Addrs()
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("getting addresses of bridge %s: %w", bridge.Name, err)
+	}
+
+	var ipv4, linkLocal netip.Addr
+
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+
+		ip, ok := netip.AddrFromSlice(ipNet.IP)
+		if !ok {
+			continue
+		}
+
+		ip = ip.Unmap()
+
+		switch {
+		case ip.Is4() && !ipv4.IsValid():
+			ipv4 = ip
+		case ip.Is6() && ip.IsLinkLocalUnicast() && !linkLocal.IsValid():
+			linkLocal = ip
+		}
+	}
+
+	return ipv4, linkLocal, nil
+}
+
+// listenOnDevice listens on the address bound to the device, so only packets that arrive
+// on the device are received and replies are sent out of it.
+func listenOnDevice(ctx context.Context, network, address, device string) (net.PacketConn, error) {
+	listenConfig := net.ListenConfig{
+		Control: func(_, _ string, rawConn syscall.RawConn) error {
+			var sockErr error
+
+			err := rawConn.Control(func(fd uintptr) {
+				if sockErr = syscall.BindToDevice(int(fd), device); sockErr != nil {
+					return
+				}
+
+				if sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_REUSEADDR, 1); sockErr != nil {
+					return
+				}
+
+				sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_BROADCAST, 1)
+			})
+			if err != nil {
+				return err //nolint:wrapcheck // wrapped by the caller
+			}
+
+			return sockErr
+		},
+	}
+
+	conn, err := listenConfig.ListenPacket(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s %s: %w", network, address, err)
+	}
+
+	return conn, nil
+}
+
+type closer interface {
+	Close() error
+}
+
+func closeOnDone(ctx context.Context, conn closer) {
+	<-ctx.Done()
+
+	_ = conn.Close()
+}
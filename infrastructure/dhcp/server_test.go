@@ -0,0 +1,192 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/mdlayher/ndp"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+const (
+	testBridgeSide = "fltest-br"
+	testGuestSide  = "fltest-guest"
+)
+
+// TestServer_Netns runs the server on one end of a veth pair in a new network namespace
+// and a guest on the other end. It needs root, so it only runs if NETNS_TESTS is set.
+func TestServer_Netns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping dhcp server network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	// The thread is left in the new network namespace, so it's thrown away when the test
+	// finishes rather than being unlocked.
+	runtime.LockOSThread()
+	g.Expect(syscall.Unshare(syscall.CLONE_NEWNET)).To(Succeed())
+
+	guest := createTestVethPair(g)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := New(&Config{Enabled: true, BridgeName: testBridgeSide, LeaseTime: time.Hour})
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: guest.HardwareAddr.String(),
+		Address: models.StaticAddress{
+			Address: "192.168.100.10/24",
+			Gateway: gatewayAddress("192.168.100.1/24"),
+		},
+		Hostname: "mvm1",
+	})).To(Succeed())
+	g.Expect(srv.Start(ctx)).To(Succeed())
+
+	conn, err := listenOnDevice(ctx, "udp4", fmt.Sprintf(":%d", dhcpv4ClientPort), guest.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer conn.Close()
+
+	discover := newTestRequestV4(dhcpv4.MessageTypeDiscover, guest.HardwareAddr.String())
+	offer := exchangeV4(g, conn, discover)
+	g.Expect(offer.MessageType()).To(Equal(dhcpv4.MessageTypeOffer))
+	g.Expect(offer.YourIPAddr.String()).To(Equal("192.168.100.10"))
+	g.Expect(offer.ServerIdentifier().String()).To(Equal(testServerIP.String()))
+	g.Expect(offer.Router()).To(HaveLen(1))
+	g.Expect(offer.Router()[0].String()).To(Equal("192.168.100.1"))
+
+	request := newTestRequestV4(dhcpv4.MessageTypeRequest, guest.HardwareAddr.String(),
+		dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(offer.YourIPAddr)),
+		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(testServerIP.AsSlice())),
+	)
+
+	ack := exchangeV4(g, conn, request)
+	g.Expect(ack.MessageType()).To(Equal(dhcpv4.MessageTypeAck))
+	g.Expect(ack.YourIPAddr.String()).To(Equal("192.168.100.10"))
+	g.Expect(ack.HostName()).To(Equal("mvm1"))
+
+	g.Expect(srv.AddLease(ctx, ports.DHCPLease{
+		MACAddress: guest.HardwareAddr.String(),
+		Address:    models.StaticAddress{Address: "2001:db8::10/64"},
+	})).To(Succeed())
+
+	conn6, err := listenOnDevice(ctx, "udp6", fmt.Sprintf("[::]:%d", dhcpv6.DefaultClientPort), guest.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer conn6.Close()
+
+	solicit := newTestRequestV6(dhcpv6.MessageTypeSolicit,
+		dhcpv6.WithClientID(&dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: guest.HardwareAddr}),
+		withIANA(),
+		dhcpv6.WithRapidCommit,
+	)
+
+	dst := &net.UDPAddr{IP: dhcpv6.AllDHCPRelayAgentsAndServers, Port: dhcpv6.DefaultServerPort, Zone: guest.Name}
+	_, err = conn6.WriteTo(solicit.ToBytes(), dst)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(conn6.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+
+	buf := make([]byte, 1500)
+	n, _, err := conn6.ReadFrom(buf)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	reply, err := dhcpv6.MessageFromBytes(buf[:n])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reply.MessageType).To(Equal(dhcpv6.MessageTypeReply))
+	g.Expect(reply.TransactionID).To(Equal(solicit.TransactionID))
+	g.Expect(reply.Options.OneIANA()).NotTo(BeNil())
+
+	ndpConn, _, err := ndp.Dial(guest, ndp.LinkLocal)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer ndpConn.Close()
+
+	solicitation := &ndp.RouterSolicitation{
+		Options: []ndp.Option{&ndp.LinkLayerAddress{Direction: ndp.Source, Addr: guest.HardwareAddr}},
+	}
+	g.Expect(ndpConn.WriteTo(solicitation, nil, net.IPv6linklocalallrouters)).To(Succeed())
+	g.Expect(ndpConn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+
+	for {
+		msg, _, _, err := ndpConn.ReadFrom()
+		g.Expect(err).NotTo(HaveOccurred())
+
+		if advert, ok := msg.(*ndp.RouterAdvertisement); ok {
+			g.Expect(advert.ManagedConfiguration).To(BeTrue())
+
+			break
+		}
+	}
+}
+
+func createTestVethPair(g *WithT) *net.Interface {
+	// Skip duplicate address detection so the link local addresses can be used straight away.
+	g.Expect(os.WriteFile("/proc/sys/net/ipv6/conf/default/accept_dad", []byte("0"), 0o644)).To(Succeed())
+
+	veth := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{Name: testBridgeSide},
+		PeerName:  testGuestSide,
+	}
+	g.Expect(netlink.LinkAdd(veth)).To(Succeed())
+
+	for _, name := range []string{"lo", testBridgeSide, testGuestSide} {
+		link, err := netlink.LinkByName(name)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(netlink.LinkSetUp(link)).To(Succeed())
+
+		if name == testBridgeSide {
+			addr, err := netlink.ParseAddr(testServerIP.String() + "/24")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(netlink.AddrAdd(link, addr)).To(Succeed())
+		}
+	}
+
+	// Both ends are in the same namespace, so packets from the other end have a local source
+	// address which is dropped by default.
+	for _, name := range []string{"all", testBridgeSide, testGuestSide} {
+		conf := "/proc/sys/net/ipv4/conf/" + name + "/"
+		g.Expect(os.WriteFile(conf+"accept_local", []byte("1"), 0o644)).To(Succeed())
+		g.Expect(os.WriteFile(conf+"rp_filter", []byte("0"), 0o644)).To(Succeed())
+	}
+
+	guest, err := net.InterfaceByName(testGuestSide)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return guest
+}
+
+// exchangeV4 broadcasts the request from the guest and returns the reply to it.
+func exchangeV4(g *WithT, conn net.PacketConn, req *dhcpv4.DHCPv4) *dhcpv4.DHCPv4 {
+	dst := &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4ServerPort}
+
+	_, err := conn.WriteTo(req.ToBytes(), dst)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		reply, err := dhcpv4.FromBytes(buf[:n])
+		if err == nil && reply.OpCode == dhcpv4.OpcodeBootReply && reply.TransactionID == req.TransactionID {
+			return reply
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package firewall
+
+import "errors"
+
+var (
+	errGuestMACRequired = errors.New("a guest mac address is required for anti-spoofing")
+	errRulesMismatch    = errors.New("firewall rules don't match the interface")
+)
@@ -0,0 +1,345 @@
+package firewall
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	// tableName is the name of the bridge table holding the rules of all interfaces.
+	tableName = "flintlock"
+
+	forwardChain = "forward"
+	inputChain   = "input"
+	outputChain  = "output"
+
+	egressSuffix  = "-egress"
+	ingressSuffix = "-ingress"
+
+	// bridgeFilterPriority is the filter priority of the bridge family.
+	bridgeFilterPriority = -200
+
+	digestLen = 8
+)
+
+// New creates a new firewall service that filters the traffic of tap interfaces with nftables.
+// The rules of each interface are in a pair of chains in a bridge table, one for traffic from
+// the guest and one for traffic to it, jumped to from the forward, input and output chains.
+//
+// Replies to allowed traffic are let through with connection tracking, so a host running
+// interfaces with ingress or egress rules needs the nf_conntrack_bridge kernel module.
+func New() ports.FirewallService {
+	return &firewallService{}
+}
+
+type firewallService struct{}
+
+// Apply will create the rules for a network interface, replacing any existing rules. The
+// rules are all replaced in one transaction, so traffic is never let through unfiltered.
+func (s *firewallService) Apply(ctx context.Context, input ports.FirewallInput) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firewall",
+		"iface":   input.DeviceName,
+	})
+	logger.Debugf("applying firewall rules for %s", input.DeviceName)
+
+	chains, err := chainRules(input)
+	if err != nil {
+		return fmt.Errorf("creating firewall rules for %s: %w", input.DeviceName, err)
+	}
+
+	conn, err := nftables.New()
+	if err != nil {
+		return fmt.Errorf("connecting to nftables: %w", err)
+	}
+
+	table := conn.AddTable(&nftables.Table{Family: nftables.TableFamilyBridge, Name: tableName})
+	base := addBaseChains(conn, table)
+
+	if err := deleteJumps(conn, table, base, input.DeviceName); err != nil {
+		return err
+	}
+
+	digest := inputDigest(input)
+	deviceChains := map[string]*nftables.Chain{}
+
+	for name, rules := range chains {
+		chain := conn.AddChain(&nftables.Chain{Name: name, Table: table})
+		conn.FlushChain(chain)
+
+		for i, exprs := range rules {
+			conn.AddRule(&nftables.Rule{
+				Table:    table,
+				Chain:    chain,
+				Exprs:    exprs,
+				UserData: comment(fmt.Sprintf("%s:%d", digest, i)),
+			})
+		}
+
+		deviceChains[name] = chain
+	}
+
+	for _, jump := range jumps(input.DeviceName) {
+		conn.AddRule(&nftables.Rule{
+			Table: table,
+			Chain: base[jump.from],
+			Exprs: []expr.Any{
+				&expr.Meta{Key: jump.key, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: ifname(input.DeviceName)},
+				&expr.Verdict{Kind: expr.VerdictJump, Chain: deviceChains[jump.to].Name},
+			},
+			UserData: comment(input.DeviceName),
+		})
+	}
+
+	if err := conn.Flush(); err != nil {
+		return fmt.Errorf("applying firewall rules for %s: %w", input.DeviceName, err)
+	}
+
+	return nil
+}
+
+// Verify returns an error if the rules of a network interface aren't the ones for the input.
+// Each rule is tagged with a digest of the input it was created from and its position.
+func (s *firewallService) Verify(ctx context.Context, input ports.FirewallInput) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firewall",
+		"iface":   input.DeviceName,
+	})
+	logger.Debugf("verifying firewall rules for %s", input.DeviceName)
+
+	chains, err := chainRules(input)
+	if err != nil {
+		return fmt.Errorf("creating firewall rules for %s: %w", input.DeviceName, err)
+	}
+
+	conn, err := nftables.New()
+	if err != nil {
+		return fmt.Errorf("connecting to nftables: %w", err)
+	}
+
+	table := &nftables.Table{Family: nftables.TableFamilyBridge, Name: tableName}
+	digest := inputDigest(input)
+
+	for name, expected := range chains {
+		rules, err := listRules(conn, table, name)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) != len(expected) {
+			return fmt.Errorf("%w: chain %s has %d rules, expected %d", errRulesMismatch, name, len(rules), len(expected))
+		}
+
+		for i, rule := range rules {
+			tag, _ := userdata.GetString(rule.UserData, userdata.TypeComment)
+			if tag != fmt.Sprintf("%s:%d", digest, i) {
+				return fmt.Errorf("%w: rule %d of chain %s is out of date", errRulesMismatch, i, name)
+			}
+		}
+	}
+
+	expectedJumps := map[string]int{}
+	for _, jump := range jumps(input.DeviceName) {
+		expectedJumps[jump.from]++
+	}
+
+	for name, expected := range expectedJumps {
+		rules, err := listRules(conn, table, name)
+		if err != nil {
+			return err
+		}
+
+		if count := len(deviceRules(rules, input.DeviceName)); count != expected {
+			return fmt.Errorf("%w: chain %s has %d jumps for %s, expected %d",
+				errRulesMismatch, name, count, input.DeviceName, expected)
+		}
+	}
+
+	return nil
+}
+
+// Remove will remove the rules for a network interface.
+func (s *firewallService) Remove(ctx context.Context, deviceName string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firewall",
+		"iface":   deviceName,
+	})
+	logger.Debugf("removing firewall rules for %s", deviceName)
+
+	conn, err := nftables.New()
+	if err != nil {
+		return fmt.Errorf("connecting to nftables: %w", err)
+	}
+
+	table := &nftables.Table{Family: nftables.TableFamilyBridge, Name: tableName}
+	base := map[string]*nftables.Chain{}
+
+	for _, name := range []string{forwardChain, inputChain, outputChain} {
+		base[name] = &nftables.Chain{Name: name, Table: table}
+	}
+
+	if err := deleteJumps(conn, table, base, deviceName); err != nil {
+		return err
+	}
+
+	chains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyBridge)
+	if err != nil {
+		return fmt.Errorf("listing firewall chains: %w", err)
+	}
+
+	for _, chain := range chains {
+		if chain.Table.Name != tableName {
+			continue
+		}
+
+		if chain.Name == deviceName+egressSuffix || chain.Name == deviceName+ingressSuffix {
+			conn.FlushChain(chain)
+			conn.DelChain(chain)
+		}
+	}
+
+	if err := conn.Flush(); err != nil {
+		return fmt.Errorf("removing firewall rules for %s: %w", deviceName, err)
+	}
+
+	return nil
+}
+
+// chainRules returns the rules of the chains of an interface by chain name.
+func chainRules(input ports.FirewallInput) (map[string][]ruleExprs, error) {
+	egress, err := egressRules(input)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, err := ingressRules(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]ruleExprs{
+		input.DeviceName + egressSuffix:  egress,
+		input.DeviceName + ingressSuffix: ingress,
+	}, nil
+}
+
+// jump is a rule in a base chain that sends the traffic of an interface to one of its chains.
+type jump struct {
+	from string
+	key  expr.MetaKey
+	to   string
+}
+
+// jumps returns the jumps for an interface. Traffic from the guest comes in on the tap device
+// and traffic to it goes out on it, whether it's bridged or to or from the host.
+func jumps(deviceName string) []jump {
+	return []jump{
+		{from: forwardChain, key: expr.MetaKeyIIFNAME, to: deviceName + egressSuffix},
+		{from: forwardChain, key: expr.MetaKeyOIFNAME, to: deviceName + ingressSuffix},
+		{from: inputChain, key: expr.MetaKeyIIFNAME, to: deviceName + egressSuffix},
+		{from: outputChain, key: expr.MetaKeyOIFNAME, to: deviceName + ingressSuffix},
+	}
+}
+
+func addBaseChains(conn *nftables.Conn, table *nftables.Table) map[string]*nftables.Chain {
+	hooks := map[string]*nftables.ChainHook{
+		forwardChain: nftables.ChainHookForward,
+		inputChain:   nftables.ChainHookInput,
+		outputChain:  nftables.ChainHookOutput,
+	}
+	chains := map[string]*nftables.Chain{}
+
+	for name, hook := range hooks {
+		chains[name] = conn.AddChain(&nftables.Chain{
+			Name:     name,
+			Table:    table,
+			Type:     nftables.ChainTypeFilter,
+			Hooknum:  hook,
+			Priority: nftables.ChainPriorityRef(bridgeFilterPriority),
+		})
+	}
+
+	return chains
+}
+
+// deleteJumps queues deleting the jumps for an interface from the base chains.
+func deleteJumps(conn *nftables.Conn, table *nftables.Table, base map[string]*nftables.Chain, deviceName string) error {
+	for name, chain := range base {
+		rules, err := listRules(conn, table, name)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range deviceRules(rules, deviceName) {
+			rule.Table = table
+			rule.Chain = chain
+
+			if err := conn.DelRule(rule); err != nil {
+				return fmt.Errorf("deleting firewall jump for %s from %s: %w", deviceName, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// listRules returns the rules of a chain, or none if the chain doesn't exist.
+func listRules(conn *nftables.Conn, table *nftables.Table, chainName string) ([]*nftables.Rule, error) {
+	rules, err := conn.GetRules(table, &nftables.Chain{Name: chainName, Table: table})
+	if errors.Is(err, unix.ENOENT) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing rules of firewall chain %s: %w", chainName, err)
+	}
+
+	return rules, nil
+}
+
+func deviceRules(rules []*nftables.Rule, deviceName string) []*nftables.Rule {
+	matching := []*nftables.Rule{}
+
+	for _, rule := range rules {
+		if tag, _ := userdata.GetString(rule.UserData, userdata.TypeComment); tag == deviceName {
+			matching = append(matching, rule)
+		}
+	}
+
+	return matching
+}
+
+// inputDigest is a short digest of the input, so rules created from a different input can be
+// told apart.
+func inputDigest(input ports.FirewallInput) string {
+	data, _ := json.Marshal(input)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:digestLen])
+}
+
+func comment(text string) []byte {
+	return userdata.AppendString(nil, userdata.TypeComment, text)
+}
+
+// ifname returns the interface name padded to the size the kernel compares.
+func ifname(name string) []byte {
+	data := make([]byte, unix.IFNAMSIZ)
+	copy(data, name)
+
+	return data
+}
@@ -0,0 +1,75 @@
+package firewall
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"github.com/google/nftables"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+// TestFirewallService_Netns applies, verifies and removes the rules of an interface in a
+// new network namespace. It needs root, so it only runs if NETNS_TESTS is set.
+func TestFirewallService_Netns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping firewall network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	// The thread is left in the new network namespace, so it's thrown away when the test
+	// finishes rather than being unlocked.
+	runtime.LockOSThread()
+	g.Expect(syscall.Unshare(syscall.CLONE_NEWNET)).To(Succeed())
+
+	ctx := context.Background()
+	svc := New()
+
+	input := ports.FirewallInput{
+		DeviceName: "tap0",
+		MACAddress: testGuestMAC,
+		Address:    "192.168.100.10/24",
+	}
+	other := ports.FirewallInput{DeviceName: "tap1", MACAddress: "aa:bb:cc:dd:ee:02"}
+
+	g.Expect(svc.Verify(ctx, input)).To(MatchError(errRulesMismatch), "nothing applied yet")
+
+	g.Expect(svc.Apply(ctx, input)).To(Succeed())
+	g.Expect(svc.Apply(ctx, other)).To(Succeed())
+	g.Expect(svc.Verify(ctx, input)).To(Succeed())
+	g.Expect(svc.Verify(ctx, other)).To(Succeed())
+	g.Expect(countRules(g, forwardChain)).To(Equal(4))
+	g.Expect(countRules(g, "tap0"+egressSuffix)).To(Equal(5))
+
+	changed := input
+	changed.Address = "192.168.100.11/24"
+	g.Expect(svc.Verify(ctx, changed)).To(MatchError(errRulesMismatch))
+
+	g.Expect(svc.Apply(ctx, changed)).To(Succeed())
+	g.Expect(svc.Verify(ctx, changed)).To(Succeed())
+	g.Expect(svc.Verify(ctx, input)).To(MatchError(errRulesMismatch))
+	g.Expect(countRules(g, forwardChain)).To(Equal(4), "jumps are replaced")
+
+	g.Expect(svc.Remove(ctx, "tap0")).To(Succeed())
+	g.Expect(svc.Verify(ctx, changed)).To(MatchError(errRulesMismatch))
+	g.Expect(svc.Verify(ctx, other)).To(Succeed())
+	g.Expect(countRules(g, forwardChain)).To(Equal(2))
+
+	g.Expect(svc.Remove(ctx, "tap0")).To(Succeed(), "removing is idempotent")
+}
+
+func countRules(g *WithT, chainName string) int {
+	conn, err := nftables.New()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	table := &nftables.Table{Family: nftables.TableFamilyBridge, Name: tableName}
+	rules, err := conn.GetRules(table, &nftables.Chain{Name: chainName, Table: table})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return len(rules)
+}
@@ -0,0 +1,348 @@
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeIPv6 = 0x86dd
+
+	etherSourceOffset = 6
+	etherAddressLen   = 6
+	arpSenderHWOffset = 8
+	arpSenderIPOffset = 14
+	ipv4SourceOffset  = 12
+	ipv4DestOffset    = 16
+	ipv6SourceOffset  = 8
+	ipv6DestOffset    = 24
+	sourcePortOffset  = 0
+	destPortOffset    = 2
+	portLen           = 2
+	icmpTypeLen       = 1
+	ctStateLen        = 4
+
+	dhcpv4ServerPort = 67
+	dhcpv4ClientPort = 68
+	dhcpv6ClientPort = 546
+	dhcpv6ServerPort = 547
+
+	// ndpFirstType and ndpLastType are the router and neighbour solicitation and
+	// advertisement ICMPv6 types, which guests need to find each other and their router.
+	ndpFirstType = 133
+	ndpLastType  = 136
+)
+
+var (
+	unspecifiedIPv4 = netip.MustParsePrefix("0.0.0.0/32")
+	unspecifiedIPv6 = netip.MustParsePrefix("::/128")
+	linkLocalIPv6   = netip.MustParsePrefix("fe80::/10")
+)
+
+// ruleExprs are the expressions of a rule.
+type ruleExprs []expr.Any
+
+// egressRules returns the rules of the chain for traffic from the guest. Traffic that isn't
+// dropped returns to the base chain, so the rules of the interface it's going to still apply.
+func egressRules(input ports.FirewallInput) ([]ruleExprs, error) {
+	rules := []ruleExprs{}
+
+	if !input.Firewall.DisableAntiSpoofing {
+		antiSpoofing, err := antiSpoofingRules(input)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, antiSpoofing...)
+	}
+
+	if len(input.Firewall.Egress) == 0 {
+		return rules, nil
+	}
+
+	rules = append(rules,
+		established(),
+		concat(matchEtherType(etherTypeARP), verdict(expr.VerdictReturn)),
+		concat(matchEtherType(etherTypeIPv4), matchL4Proto(unix.IPPROTO_UDP),
+			matchPorts(destPortOffset, dhcpv4ServerPort, dhcpv4ServerPort), verdict(expr.VerdictReturn)),
+		concat(matchEtherType(etherTypeIPv6), matchL4Proto(unix.IPPROTO_UDP),
+			matchPorts(destPortOffset, dhcpv6ServerPort, dhcpv6ServerPort), verdict(expr.VerdictReturn)),
+		neighbourDiscovery(),
+	)
+
+	for _, rule := range input.Firewall.Egress {
+		allowed, err := allowRules(rule, false)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, allowed...)
+	}
+
+	return append(rules, verdict(expr.VerdictDrop)), nil
+}
+
+// ingressRules returns the rules of the chain for traffic to the guest.
+func ingressRules(input ports.FirewallInput) ([]ruleExprs, error) {
+	if len(input.Firewall.Ingress) == 0 {
+		return []ruleExprs{}, nil
+	}
+
+	rules := []ruleExprs{
+		established(),
+		concat(matchEtherType(etherTypeARP), verdict(expr.VerdictReturn)),
+		concat(matchEtherType(etherTypeIPv4), matchL4Proto(unix.IPPROTO_UDP),
+			matchPorts(sourcePortOffset, dhcpv4ServerPort, dhcpv4ServerPort),
+			matchPorts(destPortOffset, dhcpv4ClientPort, dhcpv4ClientPort), verdict(expr.VerdictReturn)),
+		concat(matchEtherType(etherTypeIPv6), matchL4Proto(unix.IPPROTO_UDP),
+			matchPorts(destPortOffset, dhcpv6ClientPort, dhcpv6ClientPort), verdict(expr.VerdictReturn)),
+		neighbourDiscovery(),
+	}
+
+	for _, rule := range input.Firewall.Ingress {
+		allowed, err := allowRules(rule, true)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, allowed...)
+	}
+
+	return append(rules, verdict(expr.VerdictDrop)), nil
+}
+
+// antiSpoofingRules drops traffic from the guest that isn't from its MAC address, and if its
+// address is known, traffic and ARP replies that aren't from its address. Unspecified
+// addresses are allowed for DHCP and duplicate address detection, and IPv6 link local
+// addresses for neighbour discovery.
+func antiSpoofingRules(input ports.FirewallInput) ([]ruleExprs, error) {
+	mac, err := net.ParseMAC(input.MACAddress)
+	if err != nil || len(mac) != etherAddressLen {
+		return nil, fmt.Errorf("%w: %s", errGuestMACRequired, input.MACAddress)
+	}
+
+	rules := []ruleExprs{
+		concat(matchPayload(expr.PayloadBaseLLHeader, etherSourceOffset, expr.CmpOpNeq, mac),
+			verdict(expr.VerdictDrop)),
+		concat(matchEtherType(etherTypeARP),
+			matchPayload(expr.PayloadBaseNetworkHeader, arpSenderHWOffset, expr.CmpOpNeq, mac),
+			verdict(expr.VerdictDrop)),
+	}
+
+	if input.Address == "" {
+		return rules, nil
+	}
+
+	address, err := netip.ParsePrefix(string(input.Address))
+	if err != nil {
+		return nil, fmt.Errorf("parsing guest address %s: %w", input.Address, err)
+	}
+
+	address = netip.PrefixFrom(address.Addr().Unmap(), address.Addr().Unmap().BitLen())
+
+	allowedV4 := []netip.Prefix{unspecifiedIPv4}
+	allowedV6 := []netip.Prefix{unspecifiedIPv6, linkLocalIPv6}
+
+	if address.Addr().Is4() {
+		allowedV4 = append(allowedV4, address)
+	} else {
+		allowedV6 = append(allowedV6, address)
+	}
+
+	ipv4 := ruleExprs(matchEtherType(etherTypeIPv4))
+	arp := ruleExprs(matchEtherType(etherTypeARP))
+	ipv6 := ruleExprs(matchEtherType(etherTypeIPv6))
+
+	for _, prefix := range allowedV4 {
+		ipv4 = append(ipv4, matchPrefix(ipv4SourceOffset, prefix, expr.CmpOpNeq)...)
+		arp = append(arp, matchPrefix(arpSenderIPOffset, prefix, expr.CmpOpNeq)...)
+	}
+
+	for _, prefix := range allowedV6 {
+		ipv6 = append(ipv6, matchPrefix(ipv6SourceOffset, prefix, expr.CmpOpNeq)...)
+	}
+
+	drop := verdict(expr.VerdictDrop)
+
+	return append(rules, concat(ipv4, drop), concat(arp, drop), concat(ipv6, drop)), nil
+}
+
+// allowRules returns the rules that let through the traffic matching a firewall rule. The
+// CIDR is matched against the source address of traffic to the guest, and the destination
+// address of traffic from it.
+func allowRules(rule models.FirewallRule, ingress bool) ([]ruleExprs, error) {
+	portMatch := ruleExprs{}
+
+	if rule.Port != 0 {
+		endPort := rule.EndPort
+		if endPort == 0 {
+			endPort = rule.Port
+		}
+
+		portMatch = matchPorts(destPortOffset, rule.Port, endPort)
+	}
+
+	if rule.CIDR == "" {
+		if rule.Protocol == models.FirewallProtocolICMP {
+			return []ruleExprs{
+				concat(matchEtherType(etherTypeIPv4), matchL4Proto(unix.IPPROTO_ICMP), verdict(expr.VerdictReturn)),
+				concat(matchEtherType(etherTypeIPv6), matchL4Proto(unix.IPPROTO_ICMPV6), verdict(expr.VerdictReturn)),
+			}, nil
+		}
+
+		return []ruleExprs{concat(matchProtocol(rule.Protocol, false), portMatch, verdict(expr.VerdictReturn))}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(rule.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("parsing firewall rule cidr %s: %w", rule.CIDR, err)
+	}
+
+	prefix = prefix.Masked()
+
+	var match ruleExprs
+
+	switch {
+	case prefix.Addr().Is4() && ingress:
+		match = concat(matchEtherType(etherTypeIPv4), matchPrefix(ipv4SourceOffset, prefix, expr.CmpOpEq))
+	case prefix.Addr().Is4():
+		match = concat(matchEtherType(etherTypeIPv4), matchPrefix(ipv4DestOffset, prefix, expr.CmpOpEq))
+	case ingress:
+		match = concat(matchEtherType(etherTypeIPv6), matchPrefix(ipv6SourceOffset, prefix, expr.CmpOpEq))
+	default:
+		match = concat(matchEtherType(etherTypeIPv6), matchPrefix(ipv6DestOffset, prefix, expr.CmpOpEq))
+	}
+
+	protocol := matchProtocol(rule.Protocol, prefix.Addr().Is6())
+
+	return []ruleExprs{concat(match, protocol, portMatch, verdict(expr.VerdictReturn))}, nil
+}
+
+// established lets through replies to connections that have already been allowed.
+func established() ruleExprs {
+	return ruleExprs{
+		&expr.Ct{Register: 1, Key: expr.CtKeySTATE},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            ctStateLen,
+			Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitESTABLISHED | expr.CtStateBitRELATED),
+			Xor:            binaryutil.NativeEndian.PutUint32(0),
+		},
+		&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(0)},
+		&expr.Verdict{Kind: expr.VerdictReturn},
+	}
+}
+
+func neighbourDiscovery() ruleExprs {
+	return concat(
+		matchEtherType(etherTypeIPv6),
+		matchL4Proto(unix.IPPROTO_ICMPV6),
+		ruleExprs{
+			&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Len: icmpTypeLen},
+			&expr.Cmp{Op: expr.CmpOpGte, Register: 1, Data: []byte{ndpFirstType}},
+			&expr.Cmp{Op: expr.CmpOpLte, Register: 1, Data: []byte{ndpLastType}},
+		},
+		verdict(expr.VerdictReturn),
+	)
+}
+
+func matchProtocol(protocol models.FirewallProtocol, ipv6 bool) ruleExprs {
+	switch protocol {
+	case models.FirewallProtocolTCP:
+		return matchL4Proto(unix.IPPROTO_TCP)
+	case models.FirewallProtocolUDP:
+		return matchL4Proto(unix.IPPROTO_UDP)
+	case models.FirewallProtocolICMP:
+		if ipv6 {
+			return matchL4Proto(unix.IPPROTO_ICMPV6)
+		}
+
+		return matchL4Proto(unix.IPPROTO_ICMP)
+	case models.FirewallProtocolAny:
+	}
+
+	return ruleExprs{}
+}
+
+func matchEtherType(etherType uint16) ruleExprs {
+	return ruleExprs{
+		&expr.Meta{Key: expr.MetaKeyPROTOCOL, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: binaryutil.BigEndian.PutUint16(etherType)},
+	}
+}
+
+func matchL4Proto(proto byte) ruleExprs {
+	return ruleExprs{
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{proto}},
+	}
+}
+
+func matchPorts(offset uint32, first, last uint16) ruleExprs {
+	load := &expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: offset, Len: portLen}
+
+	if first == last {
+		return ruleExprs{load, &expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: binaryutil.BigEndian.PutUint16(first)}}
+	}
+
+	return ruleExprs{
+		load,
+		&expr.Cmp{Op: expr.CmpOpGte, Register: 1, Data: binaryutil.BigEndian.PutUint16(first)},
+		&expr.Cmp{Op: expr.CmpOpLte, Register: 1, Data: binaryutil.BigEndian.PutUint16(last)},
+	}
+}
+
+func matchPayload(base expr.PayloadBase, offset uint32, op expr.CmpOp, data []byte) ruleExprs {
+	return ruleExprs{
+		&expr.Payload{DestRegister: 1, Base: base, Offset: offset, Len: uint32(len(data))},
+		&expr.Cmp{Op: op, Register: 1, Data: data},
+	}
+}
+
+// matchPrefix compares the address at the offset in the network header with the prefix.
+func matchPrefix(offset uint32, prefix netip.Prefix, op expr.CmpOp) ruleExprs {
+	addr := prefix.Masked().Addr().AsSlice()
+
+	if prefix.Bits() == prefix.Addr().BitLen() {
+		return matchPayload(expr.PayloadBaseNetworkHeader, offset, op, addr)
+	}
+
+	mask := net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen())
+
+	return ruleExprs{
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: offset, Len: uint32(len(addr))},
+		&expr.Bitwise{
+			SourceRegister: 1,
+			DestRegister:   1,
+			Len:            uint32(len(addr)),
+			Mask:           mask,
+			Xor:            make([]byte, len(addr)),
+		},
+		&expr.Cmp{Op: op, Register: 1, Data: addr},
+	}
+}
+
+func verdict(kind expr.VerdictKind) ruleExprs {
+	return ruleExprs{&expr.Verdict{Kind: kind}}
+}
+
+func concat(parts ...ruleExprs) ruleExprs {
+	rule := ruleExprs{}
+
+	for _, part := range parts {
+		rule = append(rule, part...)
+	}
+
+	return rule
+}
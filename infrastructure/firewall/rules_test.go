@@ -0,0 +1,147 @@
+package firewall
+
+import (
+	"testing"
+
+	"github.com/google/nftables/expr"
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+)
+
+const testGuestMAC = "aa:bb:cc:dd:ee:01"
+
+func TestEgressRules(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         ports.FirewallInput
+		expectedRules int
+		expectErr     bool
+	}{
+		{
+			name:          "anti-spoofing of mac only",
+			input:         ports.FirewallInput{DeviceName: "tap0", MACAddress: testGuestMAC},
+			expectedRules: 2,
+		},
+		{
+			name: "anti-spoofing of mac and address",
+			input: ports.FirewallInput{
+				DeviceName: "tap0",
+				MACAddress: testGuestMAC,
+				Address:    "192.168.100.10/24",
+			},
+			expectedRules: 5,
+		},
+		{
+			name: "anti-spoofing disabled",
+			input: ports.FirewallInput{
+				DeviceName: "tap0",
+				Firewall:   models.Firewall{DisableAntiSpoofing: true},
+			},
+			expectedRules: 0,
+		},
+		{
+			name: "allow rules",
+			input: ports.FirewallInput{
+				DeviceName: "tap0",
+				MACAddress: testGuestMAC,
+				Firewall: models.Firewall{
+					Egress: []models.FirewallRule{
+						{CIDR: "10.0.0.0/8", Protocol: models.FirewallProtocolTCP, Port: 443},
+						{Protocol: models.FirewallProtocolICMP},
+					},
+				},
+			},
+			// anti-spoofing, replies, arp, dhcp, dhcpv6, ndp, the rules and the drop.
+			expectedRules: 2 + 5 + 1 + 2 + 1,
+		},
+		{
+			name:      "no guest mac",
+			input:     ports.FirewallInput{DeviceName: "tap0"},
+			expectErr: true,
+		},
+		{
+			name: "invalid address",
+			input: ports.FirewallInput{
+				DeviceName: "tap0",
+				MACAddress: testGuestMAC,
+				Address:    "192.168.100.10",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid cidr",
+			input: ports.FirewallInput{
+				DeviceName: "tap0",
+				Firewall: models.Firewall{
+					DisableAntiSpoofing: true,
+					Egress:              []models.FirewallRule{{CIDR: "10.0.0.0"}},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rules, err := egressRules(tc.input)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(rules).To(HaveLen(tc.expectedRules))
+
+			for _, rule := range rules {
+				g.Expect(rule[len(rule)-1]).To(BeAssignableToTypeOf(&expr.Verdict{}))
+			}
+		})
+	}
+}
+
+func TestIngressRules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules, err := ingressRules(ports.FirewallInput{DeviceName: "tap0", MACAddress: testGuestMAC})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(BeEmpty(), "everything is allowed without ingress rules")
+
+	rules, err = ingressRules(ports.FirewallInput{
+		DeviceName: "tap0",
+		MACAddress: testGuestMAC,
+		Firewall: models.Firewall{
+			Ingress: []models.FirewallRule{
+				{CIDR: "2001:db8::/32", Protocol: models.FirewallProtocolUDP, Port: 8000, EndPort: 8080},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(HaveLen(7))
+	g.Expect(rules[len(rules)-1]).To(Equal(verdict(expr.VerdictDrop)))
+
+	allow := rules[len(rules)-2]
+	g.Expect(allow).To(ContainElements(
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: ipv6SourceOffset, Len: 16},
+		&expr.Cmp{Op: expr.CmpOpGte, Register: 1, Data: []byte{0x1f, 0x40}},
+		&expr.Cmp{Op: expr.CmpOpLte, Register: 1, Data: []byte{0x1f, 0x90}},
+		&expr.Verdict{Kind: expr.VerdictReturn},
+	))
+}
+
+func TestAllowRules_ICMP(t *testing.T) {
+	g := NewWithT(t)
+
+	rules, err := allowRules(models.FirewallRule{Protocol: models.FirewallProtocolICMP}, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(HaveLen(2), "icmp and icmpv6")
+
+	rules, err = allowRules(models.FirewallRule{CIDR: "2001:db8::/32", Protocol: models.FirewallProtocolICMP}, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules).To(HaveLen(1))
+	g.Expect(rules[0]).To(ContainElement(&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{58}}))
+}
@@ -0,0 +1,293 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/liquidmetal-dev/flintlock/api/types"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/audit"
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const anonymousIdentity = "anonymous"
+
+// readOnlyMethodPrefixes are the prefixes of the names of the methods that don't change
+// anything. Every other method is audited, so new methods are audited unless they're
+// named as queries.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Watch"}
+
+// Auditor writes an audit event for every mutating call to the API.
+type Auditor struct {
+	sink  audit.Sink
+	clock func() time.Time
+}
+
+type auditedCallKey struct{}
+
+// auditedCall is who made a call that's audited. The audit interceptors come before
+// authentication, so the caller is filled in by the caller interceptors after it.
+type auditedCall struct {
+	identity   string
+	authMethod string
+}
+
+type microvmUIDRequest interface {
+	GetMicrovmUid() string
+}
+
+type microvmMessage interface {
+	GetMicrovm() *types.MicroVM
+}
+
+type microvmSpecMessage interface {
+	GetMicrovm() *types.MicroVMSpec
+}
+
+// NewAuditor creates a new auditor that writes to the sink.
+func NewAuditor(sink audit.Sink) *Auditor {
+	return &Auditor{
+		sink:  sink,
+		clock: time.Now,
+	}
+}
+
+// Close closes the sink of the auditor.
+func (a *Auditor) Close() error {
+	return a.sink.Close() //nolint:wrapcheck // the sink errors have enough context
+}
+
+// UnaryServerInterceptor returns an interceptor that audits unary calls. It has to come
+// before authentication so calls that fail it are audited too, with UnaryCallerInterceptor
+// after authentication to record who made the call.
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !IsMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		started := a.clock()
+		ctx = withAuditedCall(ctx)
+		resp, err := handler(ctx, req)
+
+		a.record(ctx, info.FullMethod, started, req, resp, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that audits streaming calls. The digest
+// and microvm of the call come from the first request message. Like the unary interceptor
+// it comes before authentication, with StreamCallerInterceptor after it.
+func (a *Auditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !IsMutatingMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		started := a.clock()
+		recorded := &recordingStream{ServerStream: stream, ctx: withAuditedCall(stream.Context())}
+		err := handler(srv, recorded)
+
+		a.record(recorded.ctx, info.FullMethod, started, recorded.first, nil, err)
+
+		return err
+	}
+}
+
+// UnaryCallerInterceptor returns an interceptor that records who made a unary call for its
+// audit event. It has to come after authentication.
+func (a *Auditor) UnaryCallerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		recordCaller(ctx)
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamCallerInterceptor returns an interceptor that records who made a streaming call for
+// its audit event. It has to come after authentication.
+func (a *Auditor) StreamCallerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		recordCaller(stream.Context())
+
+		return handler(srv, stream)
+	}
+}
+
+// withAuditedCall adds the caller of an audited call to the context, filled in with who
+// made the call if they're already known.
+func withAuditedCall(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, auditedCallKey{}, &auditedCall{identity: anonymousIdentity})
+	recordCaller(ctx)
+
+	return ctx
+}
+
+// recordCaller records who made an audited call from the context of the call.
+func recordCaller(ctx context.Context) {
+	call, ok := ctx.Value(auditedCallKey{}).(*auditedCall)
+	if !ok {
+		return
+	}
+
+	if identity := auth.IdentityFromContext(ctx); identity != nil {
+		call.identity = identity.Name
+	} else if authenticated, _ := ctx.Value(auth.Authenticated).(bool); authenticated {
+		// Callers authenticated with the basic auth token all share the same identity.
+		call.identity = "token"
+	}
+
+	if method, ok := ctx.Value(auth.AuthMethod).(string); ok {
+		call.authMethod = method
+	}
+}
+
+// IsMutatingMethod returns true if a method can change anything, based on its name.
+func IsMutatingMethod(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, reflectionServicePrefix) {
+		return false
+	}
+
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *Auditor) record(
+	ctx context.Context,
+	method string,
+	started time.Time,
+	req interface{},
+	resp interface{},
+	callErr error,
+) {
+	event := &audit.Event{
+		Timestamp:     started.UTC(),
+		Identity:      anonymousIdentity,
+		RPC:           method,
+		VMID:          auditVMID(req, resp),
+		RequestDigest: requestDigest(req),
+		Outcome:       audit.OutcomeSuccess,
+		Code:          status.Code(callErr).String(),
+		DurationMs:    a.clock().Sub(started).Milliseconds(),
+	}
+
+	if call, ok := ctx.Value(auditedCallKey{}).(*auditedCall); ok {
+		event.Identity = call.identity
+		event.AuthMethod = call.authMethod
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.Peer = p.Addr.String()
+	}
+
+	if callErr != nil {
+		event.Outcome = audit.OutcomeFailure
+		event.Error = callErr.Error()
+	}
+
+	if err := a.sink.Write(event); err != nil {
+		log.GetLogger(ctx).WithField("component", "audit").Errorf("failed to write audit event for %s: %s", method, err)
+	}
+}
+
+// auditVMID returns the id of the microvm a call was for. The response is preferred as
+// the uid of a new microvm is only known once it's created.
+func auditVMID(req interface{}, resp interface{}) string {
+	if r, ok := resp.(microvmMessage); ok && r.GetMicrovm().GetSpec() != nil {
+		return specVMID(r.GetMicrovm().GetSpec())
+	}
+
+	switch r := req.(type) {
+	case uidRequest:
+		return r.GetUid()
+	case microvmUIDRequest:
+		return r.GetMicrovmUid()
+	case microvmSpecMessage:
+		if r.GetMicrovm() != nil {
+			return specVMID(r.GetMicrovm())
+		}
+	}
+
+	return ""
+}
+
+func specVMID(spec *types.MicroVMSpec) string {
+	return models.NewVMIDForce(spec.GetId(), spec.GetNamespace(), spec.GetUid()).String()
+}
+
+func requestDigest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// recordingStream keeps the first message received on a stream.
+type recordingStream struct {
+	grpc.ServerStream
+
+	ctx   context.Context //nolint:containedctx // the stream has to return the context of the audited call
+	first interface{}
+}
+
+func (s *recordingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err //nolint:wrapcheck // the error is returned to the handler as is
+	}
+
+	if s.first == nil {
+		s.first = msg
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	grpc_mw "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	. "github.com/onsi/gomega"
+	grpcPkg "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mvm1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
+	"github.com/liquidmetal-dev/flintlock/api/types"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
+	"github.com/liquidmetal-dev/flintlock/pkg/audit"
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+	"github.com/liquidmetal-dev/flintlock/pkg/ptr"
+)
+
+func TestIsMutatingMethod(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(grpc.IsMutatingMethod(mvm1.MicroVM_CreateMicroVM_FullMethodName)).To(BeTrue())
+	Expect(grpc.IsMutatingMethod(mvm1.MicroVM_ExecInMicroVM_FullMethodName)).To(BeTrue())
+	Expect(grpc.IsMutatingMethod(mvm1.MicroVM_GetMicroVM_FullMethodName)).To(BeFalse())
+	Expect(grpc.IsMutatingMethod(mvm1.MicroVM_ListMicroVMsStream_FullMethodName)).To(BeFalse())
+	Expect(grpc.IsMutatingMethod(mvm1.MicroVM_WatchMicroVMs_FullMethodName)).To(BeFalse())
+	Expect(grpc.IsMutatingMethod("/grpc.reflection.v1.ServerReflection/ServerReflectionInfo")).To(BeFalse())
+}
+
+func TestAuditor_Unary(t *testing.T) {
+	tt := []struct {
+		name            string
+		method          string
+		req             interface{}
+		resp            interface{}
+		err             error
+		expectedEvent   bool
+		expectedVMID    string
+		expectedOutcome string
+		expectedCode    string
+	}{
+		{
+			name:   "create should record the vmid from the response",
+			method: mvm1.MicroVM_CreateMicroVM_FullMethodName,
+			req:    &mvm1.CreateMicroVMRequest{Microvm: &types.MicroVMSpec{Id: "mvm1", Namespace: "team-a"}},
+			resp: &mvm1.CreateMicroVMResponse{Microvm: &types.MicroVM{
+				Spec: &types.MicroVMSpec{Id: "mvm1", Namespace: "team-a", Uid: ptr.String("uid1")},
+			}},
+			expectedEvent:   true,
+			expectedVMID:    "team-a/mvm1/uid1",
+			expectedOutcome: audit.OutcomeSuccess,
+			expectedCode:    "OK",
+		},
+		{
+			name:            "failed delete should record the failure",
+			method:          mvm1.MicroVM_DeleteMicroVM_FullMethodName,
+			req:             &mvm1.DeleteMicroVMRequest{Uid: "uid1"},
+			err:             status.Error(codes.PermissionDenied, "not allowed"),
+			expectedEvent:   true,
+			expectedVMID:    "uid1",
+			expectedOutcome: audit.OutcomeFailure,
+			expectedCode:    "PermissionDenied",
+		},
+		{
+			name:   "get should not be recorded",
+			method: mvm1.MicroVM_GetMicroVM_FullMethodName,
+			req:    &mvm1.GetMicroVMRequest{Uid: "uid1"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			sink := &MockAuditSink{}
+			ctx := auth.WithIdentity(context.Background(), &auth.Identity{Name: "team-a-ci"})
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return tc.resp, tc.err
+			}
+
+			interceptor := grpc.NewAuditor(sink).UnaryServerInterceptor()
+			_, err := interceptor(ctx, tc.req, &grpcPkg.UnaryServerInfo{FullMethod: tc.method}, handler)
+			Expect(status.Code(err)).To(Equal(status.Code(tc.err)))
+
+			if !tc.expectedEvent {
+				Expect(sink.events).To(BeEmpty())
+
+				return
+			}
+
+			Expect(sink.events).To(HaveLen(1))
+
+			event := sink.events[0]
+			Expect(event.Identity).To(Equal("team-a-ci"))
+			Expect(event.RPC).To(Equal(tc.method))
+			Expect(event.VMID).To(Equal(tc.expectedVMID))
+			Expect(event.Outcome).To(Equal(tc.expectedOutcome))
+			Expect(event.Code).To(Equal(tc.expectedCode))
+			Expect(event.RequestDigest).To(HavePrefix("sha256:"))
+		})
+	}
+}
+
+func TestAuditor_BeforeAuthentication(t *testing.T) {
+	tt := []struct {
+		name             string
+		authErr          error
+		expectedIdentity string
+		expectedCode     string
+	}{
+		{
+			name:             "failed authentication should be recorded",
+			authErr:          status.Error(codes.Unauthenticated, "invalid token"),
+			expectedIdentity: "anonymous",
+			expectedCode:     "Unauthenticated",
+		},
+		{
+			name:             "authenticated caller should be recorded",
+			expectedIdentity: "team-a-ci",
+			expectedCode:     "OK",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			sink := &MockAuditSink{}
+			auditor := grpc.NewAuditor(sink)
+
+			authFunc := func(ctx context.Context) (context.Context, error) {
+				if tc.authErr != nil {
+					return nil, tc.authErr
+				}
+
+				return auth.WithIdentity(ctx, &auth.Identity{Name: "team-a-ci"}), nil
+			}
+
+			interceptor := grpc_mw.ChainUnaryServer(
+				auditor.UnaryServerInterceptor(),
+				grpc_auth.UnaryServerInterceptor(authFunc),
+				auditor.UnaryCallerInterceptor(),
+			)
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, nil
+			}
+
+			info := &grpcPkg.UnaryServerInfo{FullMethod: mvm1.MicroVM_DeleteMicroVM_FullMethodName}
+			_, err := interceptor(context.Background(), &mvm1.DeleteMicroVMRequest{Uid: "uid1"}, info, handler)
+			Expect(status.Code(err)).To(Equal(status.Code(tc.authErr)))
+
+			Expect(sink.events).To(HaveLen(1))
+			Expect(sink.events[0].Identity).To(Equal(tc.expectedIdentity))
+			Expect(sink.events[0].Code).To(Equal(tc.expectedCode))
+			Expect(sink.events[0].VMID).To(Equal("uid1"))
+		})
+	}
+}
+
+func TestAuditor_Stream(t *testing.T) {
+	RegisterTestingT(t)
+
+	sink := &MockAuditSink{}
+	stream := &MockRecvStream{
+		ctx: context.Background(),
+		msg: &mvm1.ExecInMicroVMRequest{Uid: "uid1", Command: []string{"ls"}},
+	}
+
+	handler := func(_ interface{}, stream grpcPkg.ServerStream) error {
+		if err := stream.RecvMsg(&mvm1.ExecInMicroVMRequest{}); err != nil {
+			return err
+		}
+
+		return errors.New("guest agent not available")
+	}
+
+	interceptor := grpc.NewAuditor(sink).StreamServerInterceptor()
+	err := interceptor(nil, stream, &grpcPkg.StreamServerInfo{FullMethod: mvm1.MicroVM_ExecInMicroVM_FullMethodName}, handler)
+	Expect(err).To(HaveOccurred())
+
+	Expect(sink.events).To(HaveLen(1))
+	Expect(sink.events[0].Identity).To(Equal("anonymous"))
+	Expect(sink.events[0].VMID).To(Equal("uid1"))
+	Expect(sink.events[0].Outcome).To(Equal(audit.OutcomeFailure))
+	Expect(sink.events[0].Error).To(Equal("guest agent not available"))
+}
+
+type MockAuditSink struct {
+	mu     sync.Mutex
+	events []*audit.Event
+}
+
+func (mas *MockAuditSink) Write(event *audit.Event) error {
+	mas.mu.Lock()
+	defer mas.mu.Unlock()
+
+	mas.events = append(mas.events, event)
+
+	return nil
+}
+
+func (mas *MockAuditSink) Close() error {
+	return nil
+}
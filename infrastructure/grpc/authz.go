@@ -0,0 +1,270 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hostv1 "github.com/liquidmetal-dev/flintlock/api/services/host/v1alpha1"
+	mvmv1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const reflectionServicePrefix = "/grpc.reflection."
+
+// Authorizer checks that the authenticated identity of a caller is allowed to make a
+// request before it's handled. The identity must already be in the context, see
+// auth.PolicyAuthFunc.
+type Authorizer struct {
+	queryUC ports.MicroVMQueryUseCases
+	rules   map[string]authzRule
+}
+
+// authzRule is how a request to a method is authorized.
+type authzRule struct {
+	// verb is the action the method takes.
+	verb auth.Verb
+	// namespace returns the namespace the request acts on, with an empty namespace meaning
+	// every namespace. If nil the method isn't for a namespace.
+	namespace func(ctx context.Context, a *Authorizer, req interface{}) (string, error)
+}
+
+type namespacedRequest interface {
+	GetNamespace() string
+}
+
+type uidRequest interface {
+	GetUid() string
+}
+
+// NewAuthorizer creates a new authorizer for the microvm and host services.
+func NewAuthorizer(queryUC ports.MicroVMQueryUseCases) *Authorizer {
+	byNamespace := func(_ context.Context, _ *Authorizer, req interface{}) (string, error) {
+		return req.(namespacedRequest).GetNamespace(), nil
+	}
+	byMicroVM := func(ctx context.Context, a *Authorizer, req interface{}) (string, error) {
+		return a.microvmNamespace(ctx, req.(uidRequest).GetUid())
+	}
+
+	return &Authorizer{
+		queryUC: queryUC,
+		rules: map[string]authzRule{
+			mvmv1.MicroVM_CreateMicroVM_FullMethodName: {
+				verb: auth.VerbCreate,
+				namespace: func(_ context.Context, _ *Authorizer, req interface{}) (string, error) {
+					return req.(*mvmv1.CreateMicroVMRequest).GetMicrovm().GetNamespace(), nil
+				},
+			},
+			mvmv1.MicroVM_UpdateMicroVM_FullMethodName:      {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_StopMicroVM_FullMethodName:        {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_StartMicroVM_FullMethodName:       {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_RestartMicroVM_FullMethodName:     {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_PauseMicroVM_FullMethodName:       {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_ResumeMicroVM_FullMethodName:      {verb: auth.VerbCreate, namespace: byMicroVM},
+			mvmv1.MicroVM_DeleteMicroVM_FullMethodName:      {verb: auth.VerbDelete, namespace: byMicroVM},
+			mvmv1.MicroVM_GetMicroVM_FullMethodName:         {verb: auth.VerbRead, namespace: byMicroVM},
+			mvmv1.MicroVM_GetMicroVMHistory_FullMethodName:  {verb: auth.VerbRead, namespace: byMicroVM},
+			mvmv1.MicroVM_ListMicroVMs_FullMethodName:       {verb: auth.VerbRead, namespace: byNamespace},
+			mvmv1.MicroVM_ListMicroVMsStream_FullMethodName: {verb: auth.VerbRead, namespace: byNamespace},
+			mvmv1.MicroVM_WatchMicroVMs_FullMethodName:      {verb: auth.VerbRead, namespace: byNamespace},
+			mvmv1.MicroVM_GetConsoleLog_FullMethodName:      {verb: auth.VerbRead, namespace: byMicroVM},
+			mvmv1.MicroVM_AttachConsole_FullMethodName:      {verb: auth.VerbExec, namespace: byMicroVM},
+			mvmv1.MicroVM_ExecInMicroVM_FullMethodName:      {verb: auth.VerbExec, namespace: byMicroVM},
+			mvmv1.MicroVM_CopyToMicroVM_FullMethodName:      {verb: auth.VerbExec, namespace: byMicroVM},
+			mvmv1.MicroVM_CopyFromMicroVM_FullMethodName:    {verb: auth.VerbExec, namespace: byMicroVM},
+			mvmv1.MicroVM_CreateSnapshot_FullMethodName: {
+				verb: auth.VerbCreate,
+				namespace: func(ctx context.Context, a *Authorizer, req interface{}) (string, error) {
+					return a.microvmNamespace(ctx, req.(*mvmv1.CreateSnapshotRequest).GetMicrovmUid())
+				},
+			},
+			mvmv1.MicroVM_ListSnapshots_FullMethodName: {verb: auth.VerbRead, namespace: byNamespace},
+			mvmv1.MicroVM_DeleteSnapshot_FullMethodName: {
+				verb: auth.VerbDelete,
+				namespace: func(ctx context.Context, a *Authorizer, req interface{}) (string, error) {
+					return a.snapshotNamespace(ctx, req.(*mvmv1.DeleteSnapshotRequest).GetUid())
+				},
+			},
+			// Setting a quota needs create in every namespace, otherwise callers could raise
+			// the quota of their own namespace.
+			mvmv1.MicroVM_SetNamespaceQuota_FullMethodName: {
+				verb: auth.VerbCreate,
+				namespace: func(_ context.Context, _ *Authorizer, _ interface{}) (string, error) {
+					return "", nil
+				},
+			},
+			mvmv1.MicroVM_GetNamespaceQuota_FullMethodName: {verb: auth.VerbRead, namespace: byNamespace},
+			mvmv1.MicroVM_GetHostCapacity_FullMethodName:   {verb: auth.VerbRead},
+			hostv1.Host_GetHostInfo_FullMethodName:         {verb: auth.VerbRead},
+		},
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that authorizes unary requests.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that authorizes streaming requests. As the
+// microvm a stream is for is in its first message, the first message is authorized when it's
+// received rather than when the stream starts.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		rule, ok := a.ruleFor(info.FullMethod)
+		if ok && rule.namespace == nil {
+			if err := a.authorize(stream.Context(), info.FullMethod, nil); err != nil {
+				return err
+			}
+
+			return handler(srv, stream)
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			authorizer:   a,
+			method:       info.FullMethod,
+		})
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string, req interface{}) error {
+	logger := log.GetLogger(ctx).WithField("component", "authz")
+
+	identity := auth.IdentityFromContext(ctx)
+	if identity == nil {
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.Unauthenticated, "request isn't authenticated")
+	}
+
+	rule, ok := a.ruleFor(method)
+	if !ok {
+		logger.Warnf("denying %s to %s, no authorization rule for the method", method, identity.Name)
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", identity.Name, method)
+	}
+
+	if rule.namespace == nil {
+		if identity.AllowedInAnyNamespace(rule.verb) {
+			return nil
+		}
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to %s", identity.Name, rule.verb)
+	}
+
+	// Identities allowed in every namespace don't need the namespace to be looked up.
+	if identity.Allowed(rule.verb, auth.AllNamespaces) {
+		return nil
+	}
+
+	namespace, err := rule.namespace(ctx, a, req)
+	if err != nil {
+		// Not being able to find the microvm is treated the same as not being allowed to
+		// access it, so callers can't find out about microvms in other namespaces.
+		logger.Debugf("denying %s to %s, finding the namespace: %s", method, identity.Name, err)
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to %s the microvm", identity.Name, rule.verb)
+	}
+
+	if !identity.Allowed(rule.verb, namespace) {
+		logger.Infof("denying %s to %s in namespace %q", method, identity.Name, namespace)
+
+		if namespace == "" {
+			//nolint:wrapcheck // don't wrap grpc errors when using the status package
+			return status.Errorf(codes.PermissionDenied, "%s is not allowed to %s in all namespaces", identity.Name, rule.verb)
+		}
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Errorf(codes.PermissionDenied,
+			"%s is not allowed to %s in namespace %s", identity.Name, rule.verb, namespace)
+	}
+
+	return nil
+}
+
+func (a *Authorizer) ruleFor(method string) (authzRule, bool) {
+	// Reflection only describes the API so anyone that can read can use it.
+	if strings.HasPrefix(method, reflectionServicePrefix) {
+		return authzRule{verb: auth.VerbRead}, true
+	}
+
+	rule, ok := a.rules[method]
+
+	return rule, ok
+}
+
+func (a *Authorizer) microvmNamespace(ctx context.Context, uid string) (string, error) {
+	mvm, err := a.queryUC.GetMicroVM(ctx, uid)
+	if err != nil {
+		return "", fmt.Errorf("getting microvm %s: %w", uid, err)
+	}
+
+	return mvm.ID.Namespace(), nil
+}
+
+func (a *Authorizer) snapshotNamespace(ctx context.Context, uid string) (string, error) {
+	snapshots, err := a.queryUC.GetAllSnapshots(ctx, models.ListSnapshotQuery{})
+	if err != nil {
+		return "", fmt.Errorf("listing snapshots: %w", err)
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.UID == uid {
+			return snapshot.Namespace, nil
+		}
+	}
+
+	return "", fmt.Errorf("snapshot %s not found", uid)
+}
+
+// authorizedStream authorizes the first message received on a stream.
+type authorizedStream struct {
+	grpc.ServerStream
+
+	authorizer *Authorizer
+	method     string
+	authorized bool
+}
+
+func (s *authorizedStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err //nolint:wrapcheck // the error is returned to the handler as is
+	}
+
+	if s.authorized {
+		return nil
+	}
+
+	if err := s.authorizer.authorize(s.Context(), s.method, msg); err != nil {
+		return err
+	}
+
+	s.authorized = true
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	grpcPkg "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	mvm1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
+	"github.com/liquidmetal-dev/flintlock/api/types"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+)
+
+func TestAuthorizer_Unary(t *testing.T) {
+	teamA := &auth.Identity{
+		Name: "team-a",
+		Roles: []auth.RoleConfig{
+			{Namespaces: []string{"team-a"}, Verbs: []auth.Verb{auth.VerbRead, auth.VerbCreate}},
+		},
+	}
+	admin := &auth.Identity{
+		Name: "admin",
+		Roles: []auth.RoleConfig{
+			{Namespaces: []string{auth.AllNamespaces}, Verbs: []auth.Verb{auth.VerbDelete}},
+		},
+	}
+
+	tt := []struct {
+		name         string
+		identity     *auth.Identity
+		method       string
+		req          interface{}
+		expect       func(qm *mock.MockMicroVMQueryUseCasesMockRecorder)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "no identity should be unauthenticated",
+			method:       mvm1.MicroVM_ListMicroVMs_FullMethodName,
+			req:          &mvm1.ListMicroVMsRequest{Namespace: "team-a"},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "create in allowed namespace should succeed",
+			identity:     teamA,
+			method:       mvm1.MicroVM_CreateMicroVM_FullMethodName,
+			req:          &mvm1.CreateMicroVMRequest{Microvm: &types.MicroVMSpec{Namespace: "team-a"}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "create in other namespace should be denied",
+			identity:     teamA,
+			method:       mvm1.MicroVM_CreateMicroVM_FullMethodName,
+			req:          &mvm1.CreateMicroVMRequest{Microvm: &types.MicroVMSpec{Namespace: "team-b"}},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "list all namespaces without wildcard should be denied",
+			identity:     teamA,
+			method:       mvm1.MicroVM_ListMicroVMs_FullMethodName,
+			req:          &mvm1.ListMicroVMsRequest{},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "delete in other namespace should be denied",
+			identity: teamA,
+			method:   mvm1.MicroVM_DeleteMicroVM_FullMethodName,
+			req:      &mvm1.DeleteMicroVMRequest{Uid: "uid1"},
+			expect: func(qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				vmid, _ := models.NewVMID("mvm1", "team-b", "uid1")
+				qm.GetMicroVM(gomock.Any(), "uid1").Return(&models.MicroVM{ID: *vmid}, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "get in allowed namespace should succeed",
+			identity: teamA,
+			method:   mvm1.MicroVM_GetMicroVM_FullMethodName,
+			req:      &mvm1.GetMicroVMRequest{Uid: "uid1"},
+			expect: func(qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				vmid, _ := models.NewVMID("mvm1", "team-a", "uid1")
+				qm.GetMicroVM(gomock.Any(), "uid1").Return(&models.MicroVM{ID: *vmid}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "microvm that can't be found should be denied",
+			identity: teamA,
+			method:   mvm1.MicroVM_GetMicroVM_FullMethodName,
+			req:      &mvm1.GetMicroVMRequest{Uid: "uid1"},
+			expect: func(qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				qm.GetMicroVM(gomock.Any(), "uid1").Return(nil, errors.New("not found"))
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:     "delete snapshot in other namespace should be denied",
+			identity: teamA,
+			method:   mvm1.MicroVM_DeleteSnapshot_FullMethodName,
+			req:      &mvm1.DeleteSnapshotRequest{Uid: "snap1"},
+			expect: func(qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				qm.GetAllSnapshots(gomock.Any(), gomock.Any()).Return([]*models.Snapshot{
+					{UID: "snap1", Namespace: "team-a"},
+				}, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "wildcard namespace should succeed without lookup",
+			identity:     admin,
+			method:       mvm1.MicroVM_DeleteMicroVM_FullMethodName,
+			req:          &mvm1.DeleteMicroVMRequest{Uid: "uid1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "set quota of own namespace should be denied",
+			identity:     teamA,
+			method:       mvm1.MicroVM_SetNamespaceQuota_FullMethodName,
+			req:          &mvm1.SetNamespaceQuotaRequest{Quota: &types.NamespaceQuota{Namespace: "team-a"}},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "get quota of own namespace should succeed",
+			identity:     teamA,
+			method:       mvm1.MicroVM_GetNamespaceQuota_FullMethodName,
+			req:          &mvm1.GetNamespaceQuotaRequest{Namespace: "team-a"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "host request with read should succeed",
+			identity:     teamA,
+			method:       mvm1.MicroVM_GetHostCapacity_FullMethodName,
+			req:          &emptypb.Empty{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "host request without read should be denied",
+			identity:     admin,
+			method:       mvm1.MicroVM_GetHostCapacity_FullMethodName,
+			req:          &emptypb.Empty{},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "unknown method should be denied",
+			identity:     admin,
+			method:       "/some.Service/Method",
+			req:          &emptypb.Empty{},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+			if tc.expect != nil {
+				tc.expect(qm.EXPECT())
+			}
+
+			ctx := context.Background()
+			if tc.identity != nil {
+				ctx = auth.WithIdentity(ctx, tc.identity)
+			}
+
+			called := false
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				called = true
+
+				return nil, nil
+			}
+
+			interceptor := grpc.NewAuthorizer(qm).UnaryServerInterceptor()
+			_, err := interceptor(ctx, tc.req, &grpcPkg.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			Expect(status.Code(err)).To(Equal(tc.expectedCode))
+			Expect(called).To(Equal(tc.expectedCode == codes.OK))
+		})
+	}
+}
+
+func TestAuthorizer_Stream(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	vmid, _ := models.NewVMID("mvm1", "team-b", "uid1")
+	qm.EXPECT().GetMicroVM(gomock.Any(), "uid1").Return(&models.MicroVM{ID: *vmid}, nil)
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{
+		Name: "team-a",
+		Roles: []auth.RoleConfig{
+			{Namespaces: []string{"team-a"}, Verbs: []auth.Verb{auth.VerbExec}},
+		},
+	})
+	stream := &MockRecvStream{
+		ctx: ctx,
+		msg: &mvm1.ExecInMicroVMRequest{Uid: "uid1", Command: []string{"ls"}},
+	}
+
+	handler := func(_ interface{}, stream grpcPkg.ServerStream) error {
+		return stream.RecvMsg(&mvm1.ExecInMicroVMRequest{})
+	}
+
+	interceptor := grpc.NewAuthorizer(qm).StreamServerInterceptor()
+	err := interceptor(nil, stream, &grpcPkg.StreamServerInfo{FullMethod: mvm1.MicroVM_ExecInMicroVM_FullMethodName}, handler)
+
+	Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+}
+
+type MockRecvStream struct {
+	grpcPkg.ServerStream
+	ctx context.Context
+	msg proto.Message
+}
+
+func (mrs *MockRecvStream) Context() context.Context {
+	return mrs.ctx
+}
+
+func (mrs *MockRecvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), mrs.msg)
+
+	return nil
+}
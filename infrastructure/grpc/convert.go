@@ -1,8 +1,16 @@
 package grpc
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hostv1 "github.com/liquidmetal-dev/flintlock/api/services/host/v1alpha1"
+	mvmv1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
 	"github.com/liquidmetal-dev/flintlock/api/types"
 	"github.com/liquidmetal-dev/flintlock/client/cloudinit/instance"
 	"github.com/liquidmetal-dev/flintlock/core/models"
@@ -10,6 +18,11 @@ import (
 	"github.com/liquidmetal-dev/flintlock/pkg/ptr"
 )
 
+var (
+	errPortOutOfRange = errors.New("port is out of range")
+	errVLANOutOfRange = errors.New("vlan id is out of range")
+)
+
 func convertMicroVMToModel(spec *types.MicroVMSpec) (*models.MicroVM, error) {
 	uid := ""
 
@@ -29,8 +42,8 @@ func convertMicroVMToModel(spec *types.MicroVMSpec) (*models.MicroVM, error) {
 
 	convertedModel := &models.MicroVM{
 		ID: *vmid,
-		// Labels
 		Spec: models.MicroVMSpec{
+			Labels: spec.Labels,
 			Kernel: models.Kernel{
 				Image:            models.ContainerImage(spec.Kernel.Image),
 				CmdLine:          spec.Kernel.Cmdline,
@@ -74,7 +87,11 @@ func convertMicroVMToModel(spec *types.MicroVMSpec) (*models.MicroVM, error) {
 	}
 
 	for _, netInt := range spec.Interfaces {
-		convertedNetInt := convertNetworkInterfaceToModel(netInt)
+		convertedNetInt, err := convertNetworkInterfaceToModel(netInt)
+		if err != nil {
+			return nil, fmt.Errorf("converting network interface %s: %w", netInt.DeviceId, err)
+		}
+
 		convertedModel.Spec.NetworkInterfaces = append(convertedModel.Spec.NetworkInterfaces, *convertedNetInt)
 	}
 
@@ -87,10 +104,22 @@ func convertMicroVMToModel(spec *types.MicroVMSpec) (*models.MicroVM, error) {
 		convertedModel.Spec.Provider = *spec.Provider
 	}
 
+	if spec.RestoreFromSnapshot != nil {
+		convertedModel.Spec.RestoreFromSnapshot = *spec.RestoreFromSnapshot
+	}
+
+	switch spec.PowerState {
+	case types.MicroVMSpec_STOPPED:
+		convertedModel.Spec.PowerState = models.PowerStateStopped
+	case types.MicroVMSpec_PAUSED:
+		convertedModel.Spec.PowerState = models.PowerStatePaused
+	case types.MicroVMSpec_RUNNING:
+	}
+
 	return convertedModel, nil
 }
 
-func convertNetworkInterfaceToModel(netInt *types.NetworkInterface) *models.NetworkInterface {
+func convertNetworkInterfaceToModel(netInt *types.NetworkInterface) (*models.NetworkInterface, error) {
 	converted := &models.NetworkInterface{
 		GuestDeviceName:       netInt.DeviceId,
 		AllowMetadataRequests: false,
@@ -115,6 +144,36 @@ func convertNetworkInterfaceToModel(netInt *types.NetworkInterface) *models.Netw
 		converted.BridgeName = *netInt.Overrides.BridgeName
 	}
 
+	if netInt.Network != nil {
+		converted.NetworkName = *netInt.Network
+	}
+
+	if netInt.VlanId != nil {
+		if *netInt.VlanId > math.MaxUint16 {
+			return nil, fmt.Errorf("vlan id %d: %w", *netInt.VlanId, errVLANOutOfRange)
+		}
+
+		converted.VLANID = uint16(*netInt.VlanId)
+	}
+
+	converted.MTU = int(netInt.GetMtu())
+	converted.NumQueues = int(netInt.GetNumQueues())
+
+	if netInt.Offloads != nil {
+		converted.Offloads = &models.NetworkOffloads{
+			TSO:      netInt.Offloads.Tso,
+			UFO:      netInt.Offloads.Ufo,
+			Checksum: netInt.Offloads.Checksum,
+		}
+	}
+
+	if netInt.RateLimits != nil {
+		converted.RateLimits = &models.NetworkRateLimits{
+			Rx: convertRateLimitToModel(netInt.RateLimits.Rx),
+			Tx: convertRateLimitToModel(netInt.RateLimits.Tx),
+		}
+	}
+
 	switch netInt.Type {
 	case types.NetworkInterface_MACVTAP:
 		converted.Type = models.IfaceTypeMacvtap
@@ -122,7 +181,78 @@ func convertNetworkInterfaceToModel(netInt *types.NetworkInterface) *models.Netw
 		converted.Type = models.IfaceTypeTap
 	}
 
-	return converted
+	if netInt.Firewall != nil {
+		ingress, err := convertFirewallRulesToModel(netInt.Firewall.Ingress)
+		if err != nil {
+			return nil, fmt.Errorf("converting firewall ingress rules: %w", err)
+		}
+
+		egress, err := convertFirewallRulesToModel(netInt.Firewall.Egress)
+		if err != nil {
+			return nil, fmt.Errorf("converting firewall egress rules: %w", err)
+		}
+
+		converted.Firewall = &models.Firewall{
+			Ingress:             ingress,
+			Egress:              egress,
+			DisableAntiSpoofing: netInt.Firewall.DisableAntiSpoofing,
+		}
+	}
+
+	return converted, nil
+}
+
+func convertRateLimitToModel(rateLimit *types.RateLimit) *models.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &models.RateLimit{
+		Bandwidth: convertTokenBucketToModel(rateLimit.Bandwidth),
+		Ops:       convertTokenBucketToModel(rateLimit.Ops),
+	}
+}
+
+func convertTokenBucketToModel(bucket *types.TokenBucket) *models.TokenBucket {
+	if bucket == nil {
+		return nil
+	}
+
+	return &models.TokenBucket{
+		Size:         bucket.Size,
+		OneTimeBurst: bucket.GetOneTimeBurst(),
+		RefillTimeMs: bucket.RefillTimeMs,
+	}
+}
+
+func convertFirewallRulesToModel(rules []*types.FirewallRule) ([]models.FirewallRule, error) {
+	converted := make([]models.FirewallRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule.GetPort() > math.MaxUint16 || rule.GetEndPort() > math.MaxUint16 {
+			return nil, fmt.Errorf("port range %d-%d: %w", rule.GetPort(), rule.GetEndPort(), errPortOutOfRange)
+		}
+
+		convertedRule := models.FirewallRule{
+			CIDR:    rule.GetCidr(),
+			Port:    uint16(rule.GetPort()),
+			EndPort: uint16(rule.GetEndPort()),
+		}
+
+		switch rule.Protocol {
+		case types.FirewallRule_TCP:
+			convertedRule.Protocol = models.FirewallProtocolTCP
+		case types.FirewallRule_UDP:
+			convertedRule.Protocol = models.FirewallProtocolUDP
+		case types.FirewallRule_ICMP:
+			convertedRule.Protocol = models.FirewallProtocolICMP
+		case types.FirewallRule_ANY:
+		}
+
+		converted = append(converted, convertedRule)
+	}
+
+	return converted, nil
 }
 
 func convertVolumeToModel(volume *types.Volume) *models.Volume {
@@ -155,15 +285,35 @@ func convertVolumeToModel(volume *types.Volume) *models.Volume {
 		convertedVol.MountPoint = *volume.MountPoint
 	}
 
+	switch volume.CacheMode {
+	case types.Volume_UNSAFE:
+		convertedVol.CacheMode = models.VolumeCacheModeUnsafe
+	case types.Volume_WRITEBACK:
+		convertedVol.CacheMode = models.VolumeCacheModeWriteback
+	case types.Volume_DIRECT:
+		convertedVol.CacheMode = models.VolumeCacheModeDirect
+	case types.Volume_DEFAULT_CACHE_MODE:
+	}
+
+	switch volume.IoEngine {
+	case types.Volume_SYNC:
+		convertedVol.IOEngine = models.VolumeIOEngineSync
+	case types.Volume_ASYNC:
+		convertedVol.IOEngine = models.VolumeIOEngineAsync
+	case types.Volume_DEFAULT_IO_ENGINE:
+	}
+
+	convertedVol.RateLimit = convertRateLimitToModel(volume.RateLimit)
+
 	return convertedVol
 }
 
 func convertModelToMicroVMSpec(mvm *models.MicroVM) *types.MicroVMSpec {
 	converted := &types.MicroVMSpec{
-		Id:        mvm.ID.Name(),
-		Namespace: mvm.ID.Namespace(),
-		Uid:       ptr.String(mvm.ID.UID()),
-		// Labels: ,
+		Id:              mvm.ID.Name(),
+		Namespace:       mvm.ID.Namespace(),
+		Uid:             ptr.String(mvm.ID.UID()),
+		Labels:          mvm.Spec.Labels,
 		Vcpu:            int32(mvm.Spec.VCPU),
 		MemoryInMb:      int32(mvm.Spec.MemoryInMb),
 		AllowGuestAgent: mvm.Spec.AllowGuestAgent,
@@ -202,6 +352,18 @@ func convertModelToMicroVMSpec(mvm *models.MicroVM) *types.MicroVMSpec {
 		converted.Metadata[metadataKey] = metadataValue
 	}
 
+	if mvm.Spec.RestoreFromSnapshot != "" {
+		converted.RestoreFromSnapshot = ptr.String(mvm.Spec.RestoreFromSnapshot)
+	}
+
+	switch mvm.Spec.PowerState {
+	case models.PowerStateStopped:
+		converted.PowerState = types.MicroVMSpec_STOPPED
+	case models.PowerStatePaused:
+		converted.PowerState = types.MicroVMSpec_PAUSED
+	case models.PowerStateRunning:
+	}
+
 	return converted
 }
 
@@ -225,6 +387,24 @@ func convertModelToVolumne(modelVolume *models.Volume) *types.Volume {
 	// Assign the populated VolumeSource to the converted Volume
 	convertedVol.Source = volumeSource
 
+	switch modelVolume.CacheMode {
+	case models.VolumeCacheModeUnsafe:
+		convertedVol.CacheMode = types.Volume_UNSAFE
+	case models.VolumeCacheModeWriteback:
+		convertedVol.CacheMode = types.Volume_WRITEBACK
+	case models.VolumeCacheModeDirect:
+		convertedVol.CacheMode = types.Volume_DIRECT
+	}
+
+	switch modelVolume.IOEngine {
+	case models.VolumeIOEngineSync:
+		convertedVol.IoEngine = types.Volume_SYNC
+	case models.VolumeIOEngineAsync:
+		convertedVol.IoEngine = types.Volume_ASYNC
+	}
+
+	convertedVol.RateLimit = convertModelToRateLimit(modelVolume.RateLimit)
+
 	return convertedVol
 }
 
@@ -256,13 +436,125 @@ func convertModelToNetworkInterface(modelNetInt *models.NetworkInterface) *types
 		converted.Address.Nameservers = append(converted.Address.Nameservers, modelNetInt.StaticAddress.Nameservers...)
 	}
 
+	if modelNetInt.NetworkName != "" {
+		converted.Network = &modelNetInt.NetworkName
+	}
+
+	if modelNetInt.VLANID != 0 {
+		vlanID := uint32(modelNetInt.VLANID)
+		converted.VlanId = &vlanID
+	}
+
+	if modelNetInt.MTU != 0 {
+		mtu := uint32(modelNetInt.MTU)
+		converted.Mtu = &mtu
+	}
+
+	if modelNetInt.NumQueues != 0 {
+		numQueues := uint32(modelNetInt.NumQueues)
+		converted.NumQueues = &numQueues
+	}
+
+	if modelNetInt.Offloads != nil {
+		converted.Offloads = &types.NetworkOffloads{
+			Tso:      modelNetInt.Offloads.TSO,
+			Ufo:      modelNetInt.Offloads.UFO,
+			Checksum: modelNetInt.Offloads.Checksum,
+		}
+	}
+
+	if modelNetInt.RateLimits != nil {
+		converted.RateLimits = &types.NetworkRateLimits{
+			Rx: convertModelToRateLimit(modelNetInt.RateLimits.Rx),
+			Tx: convertModelToRateLimit(modelNetInt.RateLimits.Tx),
+		}
+	}
+
+	if modelNetInt.Firewall != nil {
+		converted.Firewall = &types.Firewall{
+			Ingress:             convertModelToFirewallRules(modelNetInt.Firewall.Ingress),
+			Egress:              convertModelToFirewallRules(modelNetInt.Firewall.Egress),
+			DisableAntiSpoofing: modelNetInt.Firewall.DisableAntiSpoofing,
+		}
+	}
+
+	return converted
+}
+
+func convertModelToRateLimit(rateLimit *models.RateLimit) *types.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &types.RateLimit{
+		Bandwidth: convertModelToTokenBucket(rateLimit.Bandwidth),
+		Ops:       convertModelToTokenBucket(rateLimit.Ops),
+	}
+}
+
+func convertModelToTokenBucket(bucket *models.TokenBucket) *types.TokenBucket {
+	if bucket == nil {
+		return nil
+	}
+
+	converted := &types.TokenBucket{
+		Size:         bucket.Size,
+		RefillTimeMs: bucket.RefillTimeMs,
+	}
+
+	if bucket.OneTimeBurst != 0 {
+		converted.OneTimeBurst = &bucket.OneTimeBurst
+	}
+
+	return converted
+}
+
+func convertModelToFirewallRules(rules []models.FirewallRule) []*types.FirewallRule {
+	converted := make([]*types.FirewallRule, 0, len(rules))
+
+	for _, rule := range rules {
+		convertedRule := &types.FirewallRule{}
+
+		if rule.CIDR != "" {
+			convertedRule.Cidr = &rule.CIDR
+		}
+
+		if rule.Port != 0 {
+			port := uint32(rule.Port)
+			convertedRule.Port = &port
+		}
+
+		if rule.EndPort != 0 {
+			endPort := uint32(rule.EndPort)
+			convertedRule.EndPort = &endPort
+		}
+
+		switch rule.Protocol {
+		case models.FirewallProtocolTCP:
+			convertedRule.Protocol = types.FirewallRule_TCP
+		case models.FirewallProtocolUDP:
+			convertedRule.Protocol = types.FirewallRule_UDP
+		case models.FirewallProtocolICMP:
+			convertedRule.Protocol = types.FirewallRule_ICMP
+		case models.FirewallProtocolAny:
+		}
+
+		converted = append(converted, convertedRule)
+	}
+
 	return converted
 }
 
 func convertModelToMicroVMStatus(mvm *models.MicroVM) *types.MicroVMStatus {
 	converted := &types.MicroVMStatus{
-		Retry:     int32(mvm.Status.Retry),
-		VsockPath: mvm.Status.VSockPath,
+		Retry:            int32(mvm.Status.Retry),
+		VsockPath:        mvm.Status.VSockPath,
+		ExecutionId:      mvm.Status.ExecutionID,
+		NetworkNamespace: mvm.Status.NetworkNamespace,
+	}
+
+	if mvm.Status.GuestReadyAt != 0 {
+		converted.GuestReadyAt = timestamppb.New(time.Unix(mvm.Status.GuestReadyAt, 0))
 	}
 
 	switch mvm.Status.State {
@@ -274,6 +566,10 @@ func convertModelToMicroVMStatus(mvm *models.MicroVM) *types.MicroVMStatus {
 		converted.State = types.MicroVMStatus_FAILED
 	case models.DeletingState:
 		converted.State = types.MicroVMStatus_DELETING
+	case models.StoppedState:
+		converted.State = types.MicroVMStatus_STOPPED
+	case models.PausedState:
+		converted.State = types.MicroVMStatus_PAUSED
 	}
 
 	converted.Volumes = make(map[string]*types.VolumeStatus, len(mvm.Status.Volumes))
@@ -294,9 +590,51 @@ func convertModelToMicroVMStatus(mvm *models.MicroVM) *types.MicroVMStatus {
 		converted.NetworkInterfaces[netIfaceName] = convertModelToNetworkInterfaceStatus(netIfaceStatus)
 	}
 
+	for _, condition := range mvm.Status.Conditions {
+		converted.Conditions = append(converted.Conditions, convertModelToCondition(condition))
+	}
+
+	if mvm.Status.LastError != nil {
+		converted.LastError = convertModelToStepError(*mvm.Status.LastError)
+	}
+
+	converted.StepErrors = make(map[string]*types.StepError, len(mvm.Status.StepErrors))
+	for step, stepErr := range mvm.Status.StepErrors {
+		converted.StepErrors[step] = convertModelToStepError(stepErr)
+	}
+
 	return converted
 }
 
+func convertModelToCondition(condition models.Condition) *types.Condition {
+	converted := &types.Condition{
+		Type:               string(condition.Type),
+		Reason:             condition.Reason,
+		Message:            condition.Message,
+		LastTransitionTime: timestamppb.New(time.Unix(condition.LastTransitionTime, 0)),
+	}
+
+	switch condition.Status {
+	case models.ConditionStatusTrue:
+		converted.Status = types.Condition_TRUE
+	case models.ConditionStatusFalse:
+		converted.Status = types.Condition_FALSE
+	case models.ConditionStatusUnknown:
+		converted.Status = types.Condition_UNKNOWN
+	}
+
+	return converted
+}
+
+func convertModelToStepError(stepErr models.StepError) *types.StepError {
+	return &types.StepError{
+		Step:        stepErr.Step,
+		Message:     stepErr.Message,
+		ExecutionId: stepErr.ExecutionID,
+		OccurredAt:  timestamppb.New(time.Unix(stepErr.OccurredAt, 0)),
+	}
+}
+
 func convertModelToVolumeStatus(volStatus *models.VolumeStatus) *types.VolumeStatus {
 	converted := &types.VolumeStatus{
 		Mount: convertModelToVolumeMount(&volStatus.Mount),
@@ -325,6 +663,218 @@ func convertModelToNetworkInterfaceStatus(netStatus *models.NetworkInterfaceStat
 		HostDeviceName: netStatus.HostDeviceName,
 		Index:          int32(netStatus.Index),
 		MacAddress:     netStatus.MACAddress,
+		BridgeName:     netStatus.BridgeName,
+	}
+
+	if netStatus.AllocatedAddress != nil {
+		converted.AllocatedAddress = &types.StaticAddress{
+			Address:     string(netStatus.AllocatedAddress.Address),
+			Gateway:     (*string)(netStatus.AllocatedAddress.Gateway),
+			Nameservers: netStatus.AllocatedAddress.Nameservers,
+		}
+	}
+
+	return converted
+}
+
+func convertModelToSnapshot(snapshot *models.Snapshot) *types.Snapshot {
+	return &types.Snapshot{
+		Uid:        snapshot.UID,
+		Name:       snapshot.Name,
+		Namespace:  snapshot.Namespace,
+		MicrovmUid: snapshot.MicroVMUID,
+		Provider:   snapshot.Provider,
+		Path:       snapshot.Path,
+		CreatedAt:  timestamppb.New(time.Unix(snapshot.CreatedAt, 0)),
+		Volumes:    snapshot.Volumes,
+	}
+}
+
+func convertListRequestToQuery(req *mvmv1.ListMicroVMsRequest) (models.ListMicroVMQuery, error) {
+	query := models.ListMicroVMQuery{
+		Namespace:  req.Namespace,
+		OrderBy:    models.OrderByName,
+		Descending: req.Descending,
+		PageSize:   int(req.PageSize),
+	}
+
+	if req.Name != nil {
+		query.Name = *req.Name
+	}
+
+	if req.LabelSelector != nil {
+		selector, err := models.ParseLabelSelector(*req.LabelSelector)
+		if err != nil {
+			return query, fmt.Errorf("parsing label selector: %w", err)
+		}
+
+		query.Selector = selector
+	}
+
+	for _, state := range req.States {
+		switch state {
+		case types.MicroVMStatus_PENDING:
+			query.States = append(query.States, models.PendingState)
+		case types.MicroVMStatus_CREATED:
+			query.States = append(query.States, models.CreatedState)
+		case types.MicroVMStatus_FAILED:
+			query.States = append(query.States, models.FailedState)
+		case types.MicroVMStatus_DELETING:
+			query.States = append(query.States, models.DeletingState)
+		case types.MicroVMStatus_STOPPED:
+			query.States = append(query.States, models.StoppedState)
+		case types.MicroVMStatus_PAUSED:
+			query.States = append(query.States, models.PausedState)
+		}
+	}
+
+	if req.OrderBy == mvmv1.ListMicroVMsRequest_CREATED_AT {
+		query.OrderBy = models.OrderByCreatedAt
+	}
+
+	if req.PageToken != nil && *req.PageToken != "" {
+		if _, err := models.DecodePageToken(*req.PageToken); err != nil {
+			return query, fmt.Errorf("decoding page token: %w", err)
+		}
+
+		query.PageToken = *req.PageToken
+	}
+
+	return query, nil
+}
+
+func convertModelToHostResources(resources models.HostResources) *types.HostResources {
+	return &types.HostResources{
+		Vcpu:       resources.VCPU,
+		MemoryInmb: resources.MemoryInMb,
+		DiskInmb:   resources.DiskInMb,
+	}
+}
+
+func convertNamespaceQuotaToModel(quota *types.NamespaceQuota) *models.NamespaceQuota {
+	return &models.NamespaceQuota{
+		Namespace: quota.GetNamespace(),
+		Limits: models.QuotaResources{
+			MicroVMs:          quota.GetLimits().GetMicrovms(),
+			VCPU:              quota.GetLimits().GetVcpu(),
+			MemoryInMb:        quota.GetLimits().GetMemoryInmb(),
+			DiskInMb:          quota.GetLimits().GetDiskInmb(),
+			NetworkInterfaces: quota.GetLimits().GetNetworkInterfaces(),
+		},
+	}
+}
+
+func convertModelToNamespaceQuota(quota *models.NamespaceQuota) *types.NamespaceQuota {
+	return &types.NamespaceQuota{
+		Namespace: quota.Namespace,
+		Limits:    convertModelToQuotaResources(quota.Limits),
+	}
+}
+
+func convertModelToQuotaResources(resources models.QuotaResources) *types.QuotaResources {
+	return &types.QuotaResources{
+		Microvms:          resources.MicroVMs,
+		Vcpu:              resources.VCPU,
+		MemoryInmb:        resources.MemoryInMb,
+		DiskInmb:          resources.DiskInMb,
+		NetworkInterfaces: resources.NetworkInterfaces,
+	}
+}
+
+func convertModelToHostInfo(info *models.HostInfo) *hostv1.HostInfo {
+	converted := &hostv1.HostInfo{
+		Version: &hostv1.FlintlockVersion{
+			Version:    info.Version.Version,
+			CommitHash: info.Version.CommitHash,
+			BuildDate:  info.Version.BuildDate,
+		},
+		Providers:       []*hostv1.Provider{},
+		DefaultProvider: info.DefaultProvider,
+		KernelVersion:   info.KernelVersion,
+		Cpu: &hostv1.CPU{
+			Model:                info.CPU.Model,
+			Flags:                info.CPU.Flags,
+			KvmAvailable:         info.CPU.KVMAvailable,
+			NestedVirtualization: info.CPU.NestedVirtualization,
+		},
+		Network: &hostv1.Network{
+			BridgeName:      info.Network.BridgeName,
+			ParentInterface: info.Network.ParentInterface,
+		},
+		Containerd: &hostv1.Containerd{
+			Namespace:         info.Containerd.Namespace,
+			KernelSnapshotter: info.Containerd.KernelSnapshotter,
+			VolumeSnapshotter: info.Containerd.VolumeSnapshotter,
+		},
+	}
+
+	for _, provider := range info.Providers {
+		capabilities := []string{}
+		for _, capability := range provider.Capabilities {
+			capabilities = append(capabilities, string(capability))
+		}
+
+		converted.Providers = append(converted.Providers, &hostv1.Provider{
+			Name:         provider.Name,
+			Capabilities: capabilities,
+			VmmVersion:   provider.VMMVersion,
+		})
+	}
+
+	return converted
+}
+
+func convertModelToWatchResponse(evt *models.MicroVMEvent) *mvmv1.WatchMicroVMsResponse {
+	converted := &mvmv1.WatchMicroVMsResponse{
+		Microvm: &types.MicroVM{
+			Version: int32(evt.MicroVM.Version),
+			Spec:    convertModelToMicroVMSpec(evt.MicroVM),
+			Status:  convertModelToMicroVMStatus(evt.MicroVM),
+		},
+	}
+
+	switch evt.Type {
+	case models.MicroVMEventAdded:
+		converted.Type = mvmv1.WatchMicroVMsResponse_ADDED
+	case models.MicroVMEventModified:
+		converted.Type = mvmv1.WatchMicroVMsResponse_MODIFIED
+	case models.MicroVMEventDeleted:
+		converted.Type = mvmv1.WatchMicroVMsResponse_DELETED
+	}
+
+	return converted
+}
+
+func convertModelToPlanExecution(execution *models.PlanExecution) *types.PlanExecution {
+	converted := &types.PlanExecution{
+		ExecutionId: execution.ID,
+		Plan:        execution.Plan,
+		StartedAt:   timestamppb.New(time.Unix(execution.StartedAt, 0)),
+		FinishedAt:  timestamppb.New(time.Unix(execution.FinishedAt, 0)),
+		Error:       execution.Error,
+	}
+
+	for _, step := range execution.Steps {
+		convertedStep := &types.StepExecution{
+			Name:     step.Name,
+			Duration: durationpb.New(step.Duration),
+			Error:    step.Error,
+		}
+
+		switch step.Outcome {
+		case models.StepOutcomeSucceeded:
+			convertedStep.Outcome = types.StepExecution_SUCCEEDED
+		case models.StepOutcomeSkipped:
+			convertedStep.Outcome = types.StepExecution_SKIPPED
+		case models.StepOutcomeShouldDoFailed:
+			convertedStep.Outcome = types.StepExecution_SHOULD_DO_FAILED
+		case models.StepOutcomeDoFailed:
+			convertedStep.Outcome = types.StepExecution_DO_FAILED
+		case models.StepOutcomeVerifyFailed:
+			convertedStep.Outcome = types.StepExecution_VERIFY_FAILED
+		}
+
+		converted.Steps = append(converted.Steps, convertedStep)
 	}
 
 	return converted
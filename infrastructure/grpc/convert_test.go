@@ -26,6 +26,202 @@ func TestConvert_AllowGuestAgentRoundTrip(t *testing.T) {
 	g.Expect(back.AllowGuestAgent).To(g.BeTrue())
 }
 
+func TestConvert_FirewallRoundTrip(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	cidr := "10.0.0.0/8"
+	port := uint32(8000)
+	endPort := uint32(8080)
+
+	spec := &types.MicroVMSpec{
+		Id:        "test",
+		Namespace: "ns",
+		Interfaces: []*types.NetworkInterface{
+			{
+				DeviceId: "eth1",
+				Type:     types.NetworkInterface_TAP,
+				Firewall: &types.Firewall{
+					Ingress: []*types.FirewallRule{
+						{Cidr: &cidr, Protocol: types.FirewallRule_TCP, Port: &port, EndPort: &endPort},
+					},
+					Egress:              []*types.FirewallRule{{Protocol: types.FirewallRule_ICMP}},
+					DisableAntiSpoofing: true,
+				},
+			},
+		},
+	}
+
+	model, err := convertMicroVMToModel(spec)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(model.Spec.NetworkInterfaces[0].Firewall).To(g.Equal(&models.Firewall{
+		Ingress: []models.FirewallRule{
+			{CIDR: cidr, Protocol: models.FirewallProtocolTCP, Port: 8000, EndPort: 8080},
+		},
+		Egress:              []models.FirewallRule{{Protocol: models.FirewallProtocolICMP}},
+		DisableAntiSpoofing: true,
+	}))
+
+	back := convertModelToMicroVMSpec(model)
+	g.Expect(back.Interfaces[0].Firewall.Ingress[0].GetCidr()).To(g.Equal(cidr))
+	g.Expect(back.Interfaces[0].Firewall.Ingress[0].GetEndPort()).To(g.Equal(endPort))
+	g.Expect(back.Interfaces[0].Firewall.Egress[0].Protocol).To(g.Equal(types.FirewallRule_ICMP))
+	g.Expect(back.Interfaces[0].Firewall.DisableAntiSpoofing).To(g.BeTrue())
+
+	outOfRange := uint32(70000)
+	spec.Interfaces[0].Firewall.Egress[0].Port = &outOfRange
+
+	_, err = convertMicroVMToModel(spec)
+	g.Expect(err).To(g.MatchError(errPortOutOfRange))
+}
+
+func TestConvert_NetworkAndVLAN(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	networkName := "tenant-a"
+	vlanID := uint32(100)
+
+	spec := &types.MicroVMSpec{
+		Id:        "test",
+		Namespace: "ns",
+		Interfaces: []*types.NetworkInterface{
+			{
+				DeviceId: "eth1",
+				Type:     types.NetworkInterface_TAP,
+				Network:  &networkName,
+				VlanId:   &vlanID,
+			},
+		},
+	}
+
+	model, err := convertMicroVMToModel(spec)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(model.Spec.NetworkInterfaces[0].NetworkName).To(g.Equal(networkName))
+	g.Expect(model.Spec.NetworkInterfaces[0].VLANID).To(g.Equal(uint16(100)))
+
+	back := convertModelToMicroVMSpec(model)
+	g.Expect(back.Interfaces[0].GetNetwork()).To(g.Equal(networkName))
+	g.Expect(back.Interfaces[0].GetVlanId()).To(g.Equal(vlanID))
+
+	outOfRange := uint32(70000)
+	spec.Interfaces[0].VlanId = &outOfRange
+
+	_, err = convertMicroVMToModel(spec)
+	g.Expect(err).To(g.MatchError(errVLANOutOfRange))
+}
+
+func TestConvert_MTUQueuesAndOffloads(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	mtu := uint32(9000)
+	numQueues := uint32(4)
+	disabled := false
+
+	spec := &types.MicroVMSpec{
+		Id:        "test",
+		Namespace: "ns",
+		Interfaces: []*types.NetworkInterface{
+			{
+				DeviceId:  "eth1",
+				Type:      types.NetworkInterface_TAP,
+				Mtu:       &mtu,
+				NumQueues: &numQueues,
+				Offloads:  &types.NetworkOffloads{Tso: &disabled},
+			},
+		},
+	}
+
+	model, err := convertMicroVMToModel(spec)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(model.Spec.NetworkInterfaces[0].MTU).To(g.Equal(9000))
+	g.Expect(model.Spec.NetworkInterfaces[0].NumQueues).To(g.Equal(4))
+	g.Expect(model.Spec.NetworkInterfaces[0].Offloads).To(g.Equal(&models.NetworkOffloads{TSO: &disabled}))
+
+	back := convertModelToMicroVMSpec(model)
+	g.Expect(back.Interfaces[0].GetMtu()).To(g.Equal(mtu))
+	g.Expect(back.Interfaces[0].GetNumQueues()).To(g.Equal(numQueues))
+	g.Expect(back.Interfaces[0].Offloads.Tso).To(g.Equal(&disabled))
+	g.Expect(back.Interfaces[0].Offloads.Ufo).To(g.BeNil())
+}
+
+func TestConvert_RateLimitsRoundTrip(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	burst := int64(1048576)
+
+	spec := &types.MicroVMSpec{
+		Id:        "test",
+		Namespace: "ns",
+		Interfaces: []*types.NetworkInterface{
+			{
+				DeviceId: "eth1",
+				Type:     types.NetworkInterface_TAP,
+				RateLimits: &types.NetworkRateLimits{
+					Rx: &types.RateLimit{
+						Bandwidth: &types.TokenBucket{Size: 1048576, OneTimeBurst: &burst, RefillTimeMs: 100},
+					},
+					Tx: &types.RateLimit{
+						Ops: &types.TokenBucket{Size: 1000, RefillTimeMs: 1000},
+					},
+				},
+			},
+		},
+	}
+
+	model, err := convertMicroVMToModel(spec)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(model.Spec.NetworkInterfaces[0].RateLimits).To(g.Equal(&models.NetworkRateLimits{
+		Rx: &models.RateLimit{
+			Bandwidth: &models.TokenBucket{Size: 1048576, OneTimeBurst: burst, RefillTimeMs: 100},
+		},
+		Tx: &models.RateLimit{
+			Ops: &models.TokenBucket{Size: 1000, RefillTimeMs: 1000},
+		},
+	}))
+
+	back := convertModelToMicroVMSpec(model)
+	g.Expect(back.Interfaces[0].RateLimits.Rx.Bandwidth.GetOneTimeBurst()).To(g.Equal(burst))
+	g.Expect(back.Interfaces[0].RateLimits.Rx.Ops).To(g.BeNil())
+	g.Expect(back.Interfaces[0].RateLimits.Tx.Ops.Size).To(g.Equal(int64(1000)))
+	g.Expect(back.Interfaces[0].RateLimits.Tx.Ops.OneTimeBurst).To(g.BeNil())
+}
+
+func TestConvert_VolumeIOSettingsRoundTrip(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	spec := &types.MicroVMSpec{
+		Id:        "test",
+		Namespace: "ns",
+		RootVolume: &types.Volume{
+			Id:        "root",
+			CacheMode: types.Volume_DIRECT,
+			IoEngine:  types.Volume_ASYNC,
+			RateLimit: &types.RateLimit{
+				Ops: &types.TokenBucket{Size: 5000, RefillTimeMs: 1000},
+			},
+		},
+		AdditionalVolumes: []*types.Volume{
+			{Id: "data"},
+		},
+	}
+
+	model, err := convertMicroVMToModel(spec)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(model.Spec.RootVolume.CacheMode).To(g.Equal(models.VolumeCacheModeDirect))
+	g.Expect(model.Spec.RootVolume.IOEngine).To(g.Equal(models.VolumeIOEngineAsync))
+	g.Expect(model.Spec.RootVolume.RateLimit).To(g.Equal(&models.RateLimit{
+		Ops: &models.TokenBucket{Size: 5000, RefillTimeMs: 1000},
+	}))
+	g.Expect(model.Spec.AdditionalVolumes[0].CacheMode).To(g.BeEmpty())
+	g.Expect(model.Spec.AdditionalVolumes[0].IOEngine).To(g.BeEmpty())
+	g.Expect(model.Spec.AdditionalVolumes[0].RateLimit).To(g.BeNil())
+
+	back := convertModelToMicroVMSpec(model)
+	g.Expect(back.RootVolume.CacheMode).To(g.Equal(types.Volume_DIRECT))
+	g.Expect(back.RootVolume.IoEngine).To(g.Equal(types.Volume_ASYNC))
+	g.Expect(back.RootVolume.RateLimit.Ops.Size).To(g.Equal(int64(5000)))
+	g.Expect(back.AdditionalVolumes[0].CacheMode).To(g.Equal(types.Volume_DEFAULT_CACHE_MODE))
+}
+
 func TestConvert_StatusVsockPath(t *testing.T) {
 	g.RegisterTestingT(t)
 
@@ -36,3 +232,50 @@ func TestConvert_StatusVsockPath(t *testing.T) {
 	status := convertModelToMicroVMStatus(mvm)
 	g.Expect(status.VsockPath).To(g.Equal("/var/lib/flintlock/vm/guest-agent.vsock"))
 }
+
+func TestConvert_StatusNetworkNamespace(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	mvm := &models.MicroVM{
+		Status: models.MicroVMStatus{NetworkNamespace: "flintlock-01HJ2K3M4N5P6Q7R8S9T0V1W2X"},
+	}
+
+	status := convertModelToMicroVMStatus(mvm)
+	g.Expect(status.NetworkNamespace).To(g.Equal("flintlock-01HJ2K3M4N5P6Q7R8S9T0V1W2X"))
+}
+
+func TestConvert_StatusConditionsAndErrors(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	stepErr := models.StepError{
+		Step:        "microvm_create",
+		Message:     "vmm didn't start",
+		ExecutionID: "exec1",
+		OccurredAt:  1700000000,
+	}
+	mvm := &models.MicroVM{
+		Status: models.MicroVMStatus{
+			ExecutionID: "exec1",
+			Conditions: models.Conditions{
+				{
+					Type:               models.ConditionVMMRunning,
+					Status:             models.ConditionStatusFalse,
+					Reason:             "StepFailed",
+					Message:            "vmm didn't start",
+					LastTransitionTime: 1700000000,
+				},
+			},
+			LastError:  &stepErr,
+			StepErrors: map[string]models.StepError{"microvm_create": stepErr},
+		},
+	}
+
+	status := convertModelToMicroVMStatus(mvm)
+	g.Expect(status.ExecutionId).To(g.Equal("exec1"))
+	g.Expect(status.Conditions).To(g.HaveLen(1))
+	g.Expect(status.Conditions[0].Type).To(g.Equal("VMMRunning"))
+	g.Expect(status.Conditions[0].Status).To(g.Equal(types.Condition_FALSE))
+	g.Expect(status.Conditions[0].LastTransitionTime.AsTime().Unix()).To(g.Equal(int64(1700000000)))
+	g.Expect(status.LastError.Step).To(g.Equal("microvm_create"))
+	g.Expect(status.StepErrors).To(g.HaveKey("microvm_create"))
+}
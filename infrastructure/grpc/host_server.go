@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	hostv1 "github.com/liquidmetal-dev/flintlock/api/services/host/v1alpha1"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// NewHostServer creates a new host server instance.
+func NewHostServer(queryUC ports.MicroVMQueryUseCases) ports.HostGRPCService {
+	return &hostServer{
+		queryUC: queryUC,
+	}
+}
+
+type hostServer struct {
+	queryUC ports.MicroVMQueryUseCases
+}
+
+func (s *hostServer) GetHostInfo(ctx context.Context, _ *emptypb.Empty) (*hostv1.GetHostInfoResponse, error) {
+	logger := log.GetLogger(ctx)
+	logger.Info("getting host info")
+
+	info, err := s.queryUC.GetHostInfo(ctx)
+	if err != nil {
+		logger.Errorf("failed to get host info: %s", err)
+
+		return nil, fmt.Errorf("getting host info: %w", err)
+	}
+
+	return &hostv1.GetHostInfoResponse{
+		Info: convertModelToHostInfo(info),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
+)
+
+func TestHostServer_GetHostInfo(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	qm.EXPECT().GetHostInfo(gomock.AssignableToTypeOf(context.Background())).Return(&models.HostInfo{
+		Providers: []models.ProviderInfo{
+			{
+				Name:         "firecracker",
+				Capabilities: models.Capabilities{models.PauseCapability, models.VSockCapability},
+				VMMVersion:   "Firecracker v1.4.1",
+			},
+		},
+		DefaultProvider: "firecracker",
+		CPU:             models.CPUInfo{Model: "test cpu", KVMAvailable: true},
+		Containerd:      models.ContainerdInfo{Namespace: "flintlock"},
+	}, nil)
+	qm.EXPECT().GetHostInfo(gomock.AssignableToTypeOf(context.Background())).Return(nil, errors.New("an error"))
+
+	svr := grpc.NewHostServer(qm)
+
+	resp, err := svr.GetHostInfo(context.Background(), &emptypb.Empty{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Info.DefaultProvider).To(Equal("firecracker"))
+	Expect(resp.Info.Providers).To(HaveLen(1))
+	Expect(resp.Info.Providers[0].Capabilities).To(Equal([]string{"pause", "vsock"}))
+	Expect(resp.Info.Providers[0].VmmVersion).To(Equal("Firecracker v1.4.1"))
+	Expect(resp.Info.Cpu.KvmAvailable).To(BeTrue())
+	Expect(resp.Info.Containerd.Namespace).To(Equal("flintlock"))
+
+	_, err = svr.GetHostInfo(context.Background(), &emptypb.Empty{})
+	Expect(err).To(HaveOccurred())
+}
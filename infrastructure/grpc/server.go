@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,6 +12,7 @@ import (
 
 	mvmv1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
 	"github.com/liquidmetal-dev/flintlock/api/types"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
@@ -23,6 +26,8 @@ func NewServer(commandUC ports.MicroVMCommandUseCases, queryUC ports.MicroVMQuer
 	}
 }
 
+const consoleReadSize = 32 * 1024
+
 type server struct {
 	commandUC ports.MicroVMCommandUseCases
 	queryUC   ports.MicroVMQueryUseCases
@@ -54,6 +59,11 @@ func (s *server) CreateMicroVM(
 	if err != nil {
 		logger.Errorf("failed to create microvm: %s", err)
 
+		if coreerrs.IsInsufficientCapacity(err) || coreerrs.IsQuotaExceeded(err) {
+			//nolint:wrapcheck // don't wrap grpc errors when using the status package
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
 		return nil, fmt.Errorf("creating microvm: %w", err)
 	}
 
@@ -68,6 +78,156 @@ func (s *server) CreateMicroVM(
 	return resp, nil
 }
 
+func (s *server) UpdateMicroVM(
+	ctx context.Context,
+	req *mvmv1.UpdateMicroVMRequest,
+) (*mvmv1.UpdateMicroVMResponse, error) {
+	logger := log.GetLogger(ctx)
+	logger.Trace("converting request to model")
+
+	if req == nil || req.Uid == "" || req.Microvm == nil {
+		logger.Error("invalid update microvm request: uid and MicroVMSpec required")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid update microvm request: uid and MicroVMSpec required")
+	}
+
+	sanitizeMicroVMImageReferences(logger, req.Microvm)
+	modelSpec, err := convertMicroVMToModel(req.Microvm)
+	if err != nil {
+		return nil, fmt.Errorf("converting request: %w", err)
+	}
+
+	logger.Infof("updating microvm %s", req.Uid)
+
+	updatedModel, err := s.commandUC.UpdateMicroVM(ctx, req.Uid, modelSpec)
+	if err != nil {
+		logger.Errorf("failed to update microvm: %s", err)
+
+		if coreerrs.IsInsufficientCapacity(err) || coreerrs.IsQuotaExceeded(err) {
+			//nolint:wrapcheck // don't wrap grpc errors when using the status package
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return nil, fmt.Errorf("updating microvm: %w", err)
+	}
+
+	resp := &mvmv1.UpdateMicroVMResponse{
+		Microvm: &types.MicroVM{
+			Version: int32(updatedModel.Version),
+			Spec:    convertModelToMicroVMSpec(updatedModel),
+			Status:  convertModelToMicroVMStatus(updatedModel),
+		},
+	}
+
+	return resp, nil
+}
+
+func (s *server) StopMicroVM(ctx context.Context, req *mvmv1.StopMicroVMRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid stop microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("stopping microvm %s", req.Uid)
+
+	if err := s.commandUC.StopMicroVM(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to stop microvm: %s", err)
+
+		return nil, fmt.Errorf("stopping microvm: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) StartMicroVM(ctx context.Context, req *mvmv1.StartMicroVMRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid start microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("starting microvm %s", req.Uid)
+
+	if err := s.commandUC.StartMicroVM(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to start microvm: %s", err)
+
+		return nil, fmt.Errorf("starting microvm: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) PauseMicroVM(ctx context.Context, req *mvmv1.PauseMicroVMRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid pause microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("pausing microvm %s", req.Uid)
+
+	if err := s.commandUC.PauseMicroVM(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to pause microvm: %s", err)
+
+		return nil, fmt.Errorf("pausing microvm: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ResumeMicroVM(ctx context.Context, req *mvmv1.ResumeMicroVMRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid resume microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("resuming microvm %s", req.Uid)
+
+	if err := s.commandUC.ResumeMicroVM(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to resume microvm: %s", err)
+
+		return nil, fmt.Errorf("resuming microvm: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) RestartMicroVM(ctx context.Context, req *mvmv1.RestartMicroVMRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid restart microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("restarting microvm %s", req.Uid)
+
+	if err := s.commandUC.RestartMicroVM(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to restart microvm: %s", err)
+
+		return nil, fmt.Errorf("restarting microvm: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *server) DeleteMicroVM(ctx context.Context, req *mvmv1.DeleteMicroVMRequest) (*emptypb.Empty, error) {
 	logger := log.GetLogger(ctx)
 
@@ -121,6 +281,39 @@ func (s *server) GetMicroVM(ctx context.Context, req *mvmv1.GetMicroVMRequest) (
 	return resp, nil
 }
 
+func (s *server) GetMicroVMHistory(
+	ctx context.Context,
+	req *mvmv1.GetMicroVMHistoryRequest,
+) (*mvmv1.GetMicroVMHistoryResponse, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid get microvm history request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("getting history for microvm %s", req.Uid)
+
+	executions, err := s.queryUC.GetMicroVMHistory(ctx, req.Uid)
+	if err != nil {
+		logger.Errorf("failed to get microvm history: %s", err)
+
+		return nil, fmt.Errorf("getting microvm history: %w", err)
+	}
+
+	resp := &mvmv1.GetMicroVMHistoryResponse{
+		Executions: []*types.PlanExecution{},
+	}
+
+	for _, execution := range executions {
+		resp.Executions = append(resp.Executions, convertModelToPlanExecution(execution))
+	}
+
+	return resp, nil
+}
+
 func (s *server) ListMicroVMs(ctx context.Context,
 	req *mvmv1.ListMicroVMsRequest,
 ) (*mvmv1.ListMicroVMsResponse, error) {
@@ -133,14 +326,16 @@ func (s *server) ListMicroVMs(ctx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	logger.Infof("getting all microvms in %s", req.Namespace)
-
-	query := models.ListMicroVMQuery{"namespace": req.Namespace}
+	query, err := convertListRequestToQuery(req)
+	if err != nil {
+		logger.Errorf("invalid list microvms request: %s", err)
 
-	if req.Name != nil {
-		query["name"] = *req.Name
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	logger.Infof("getting all microvms in %s", req.Namespace)
+
 	foundMicrovms, err := s.queryUC.GetAllMicroVM(ctx, query)
 	if err != nil {
 		logger.Errorf("failed to getting all microvm: %s", err)
@@ -151,7 +346,8 @@ func (s *server) ListMicroVMs(ctx context.Context,
 	logger.Trace("converting model to response")
 
 	resp := &mvmv1.ListMicroVMsResponse{
-		Microvm: []*types.MicroVM{},
+		Microvm:       []*types.MicroVM{},
+		NextPageToken: query.NextPageToken(foundMicrovms),
 	}
 
 	for _, mvm := range foundMicrovms {
@@ -166,6 +362,166 @@ func (s *server) ListMicroVMs(ctx context.Context,
 	return resp, nil
 }
 
+func (s *server) CreateSnapshot(
+	ctx context.Context,
+	req *mvmv1.CreateSnapshotRequest,
+) (*mvmv1.CreateSnapshotResponse, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.MicrovmUid == "" || req.Name == "" {
+		logger.Error("invalid create snapshot request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("creating snapshot %s of microvm %s", req.Name, req.MicrovmUid)
+
+	snapshot, err := s.commandUC.CreateSnapshot(ctx, req.MicrovmUid, req.Name)
+	if err != nil {
+		logger.Errorf("failed to create snapshot: %s", err)
+
+		return nil, fmt.Errorf("creating snapshot: %w", err)
+	}
+
+	return &mvmv1.CreateSnapshotResponse{
+		Snapshot: convertModelToSnapshot(snapshot),
+	}, nil
+}
+
+func (s *server) ListSnapshots(
+	ctx context.Context,
+	req *mvmv1.ListSnapshotsRequest,
+) (*mvmv1.ListSnapshotsResponse, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil {
+		logger.Error("invalid list snapshots request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("getting all snapshots in %s", req.Namespace)
+
+	query := models.ListSnapshotQuery{Namespace: req.Namespace}
+
+	if req.MicrovmUid != nil {
+		query.MicroVMUID = *req.MicrovmUid
+	}
+
+	snapshots, err := s.queryUC.GetAllSnapshots(ctx, query)
+	if err != nil {
+		logger.Errorf("failed to get all snapshots: %s", err)
+
+		return nil, fmt.Errorf("getting all snapshots: %w", err)
+	}
+
+	resp := &mvmv1.ListSnapshotsResponse{
+		Snapshots: []*types.Snapshot{},
+	}
+
+	for _, snapshot := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, convertModelToSnapshot(snapshot))
+	}
+
+	return resp, nil
+}
+
+func (s *server) DeleteSnapshot(ctx context.Context, req *mvmv1.DeleteSnapshotRequest) (*emptypb.Empty, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid delete snapshot request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("deleting snapshot %s", req.Uid)
+
+	if err := s.commandUC.DeleteSnapshot(ctx, req.Uid); err != nil {
+		logger.Errorf("failed to delete snapshot: %s", err)
+
+		return nil, fmt.Errorf("deleting snapshot: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) GetHostCapacity(ctx context.Context, _ *emptypb.Empty) (*mvmv1.GetHostCapacityResponse, error) {
+	logger := log.GetLogger(ctx)
+	logger.Info("getting host capacity")
+
+	capacity, err := s.queryUC.GetHostCapacity(ctx)
+	if err != nil {
+		logger.Errorf("failed to get host capacity: %s", err)
+
+		return nil, fmt.Errorf("getting host capacity: %w", err)
+	}
+
+	return &mvmv1.GetHostCapacityResponse{
+		Total:     convertModelToHostResources(capacity.Total),
+		Allocated: convertModelToHostResources(capacity.Allocated),
+		Free:      convertModelToHostResources(capacity.Free),
+	}, nil
+}
+
+func (s *server) SetNamespaceQuota(
+	ctx context.Context,
+	req *mvmv1.SetNamespaceQuotaRequest,
+) (*mvmv1.SetNamespaceQuotaResponse, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Quota == nil || req.Quota.Namespace == "" {
+		logger.Error("invalid set namespace quota request: quota with namespace required")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid set namespace quota request: quota with namespace required")
+	}
+
+	logger.Infof("setting quota for namespace %s", req.Quota.Namespace)
+
+	quota, err := s.commandUC.SetNamespaceQuota(ctx, convertNamespaceQuotaToModel(req.Quota))
+	if err != nil {
+		logger.Errorf("failed to set namespace quota: %s", err)
+
+		return nil, fmt.Errorf("setting namespace quota: %w", err)
+	}
+
+	return &mvmv1.SetNamespaceQuotaResponse{
+		Quota: convertModelToNamespaceQuota(quota),
+	}, nil
+}
+
+func (s *server) GetNamespaceQuota(
+	ctx context.Context,
+	req *mvmv1.GetNamespaceQuotaRequest,
+) (*mvmv1.GetNamespaceQuotaResponse, error) {
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Namespace == "" {
+		logger.Error("invalid get namespace quota request: namespace required")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.InvalidArgument, "invalid get namespace quota request: namespace required")
+	}
+
+	logger.Infof("getting quota for namespace %s", req.Namespace)
+
+	quotaStatus, err := s.queryUC.GetNamespaceQuota(ctx, req.Namespace)
+	if err != nil {
+		logger.Errorf("failed to get namespace quota: %s", err)
+
+		return nil, fmt.Errorf("getting namespace quota: %w", err)
+	}
+
+	return &mvmv1.GetNamespaceQuotaResponse{
+		Quota: convertModelToNamespaceQuota(&quotaStatus.Quota),
+		Used:  convertModelToQuotaResources(quotaStatus.Used),
+	}, nil
+}
+
 func (s *server) ListMicroVMsStream(
 	req *mvmv1.ListMicroVMsRequest,
 	streamServer mvmv1.MicroVM_ListMicroVMsStreamServer,
@@ -180,12 +536,21 @@ func (s *server) ListMicroVMsStream(
 		return status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	query, err := convertListRequestToQuery(req)
+	if err != nil {
+		logger.Errorf("invalid list microvms request: %s", err)
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The results are streamed rather than paged.
+	query.PageSize = 0
+	query.PageToken = ""
+
 	logger.Infof("getting all microvms in %s", req.Namespace)
 
-	foundMicrovms, err := s.queryUC.GetAllMicroVM(
-		ctx,
-		models.ListMicroVMQuery{"namespace": req.Namespace},
-	)
+	foundMicrovms, err := s.queryUC.GetAllMicroVM(ctx, query)
 	if err != nil {
 		logger.Errorf("failed to getting all microvm: %s", err)
 
@@ -212,3 +577,384 @@ func (s *server) ListMicroVMsStream(
 
 	return nil
 }
+
+func (s *server) WatchMicroVMs(
+	req *mvmv1.WatchMicroVMsRequest,
+	streamServer mvmv1.MicroVM_WatchMicroVMsServer,
+) error {
+	ctx := streamServer.Context()
+	logger := log.GetLogger(ctx)
+
+	if req == nil {
+		logger.Error("invalid watch microvms request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("watching microvms in namespace %q", req.Namespace)
+
+	query := models.WatchMicroVMQuery{
+		Namespace:          req.Namespace,
+		Labels:             req.Labels,
+		ResumeFromVersions: map[string]int{},
+	}
+
+	for uid, version := range req.ResumeFromVersions {
+		query.ResumeFromVersions[uid] = int(version)
+	}
+
+	err := s.queryUC.WatchMicroVMs(ctx, query, func(evt *models.MicroVMEvent) error {
+		if err := streamServer.Send(convertModelToWatchResponse(evt)); err != nil {
+			return fmt.Errorf("streaming response to client: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("failed to watch microvms: %s", err)
+
+		return fmt.Errorf("watching microvms: %w", err)
+	}
+
+	return nil
+}
+
+func (s *server) GetConsoleLog(
+	req *mvmv1.GetConsoleLogRequest,
+	streamServer mvmv1.MicroVM_GetConsoleLogServer,
+) error {
+	ctx := streamServer.Context()
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" {
+		logger.Error("invalid get console log request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("getting console log for microvm %s", req.Uid)
+
+	opts := ports.ConsoleOutputOptions{
+		TailLines: int(req.GetTailLines()),
+		Follow:    req.Follow,
+	}
+
+	err := s.queryUC.GetConsoleLog(ctx, req.Uid, opts, func(data []byte) error {
+		if err := streamServer.Send(&mvmv1.ConsoleOutput{Data: data}); err != nil {
+			return fmt.Errorf("streaming response to client: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("failed to get console log: %s", err)
+
+		return fmt.Errorf("getting console log: %w", err)
+	}
+
+	return nil
+}
+
+func (s *server) AttachConsole(streamServer mvmv1.MicroVM_AttachConsoleServer) error {
+	ctx, cancel := context.WithCancel(streamServer.Context())
+	defer cancel()
+
+	logger := log.GetLogger(ctx)
+
+	req, err := streamServer.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("receiving attach console request: %w", err)
+	}
+
+	if req.Uid == "" {
+		logger.Error("invalid attach console request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("attaching to console of microvm %s", req.Uid)
+
+	output, input, err := s.commandUC.AttachConsole(ctx, req.Uid)
+	if err != nil {
+		logger.Errorf("failed to attach to console: %s", err)
+
+		return fmt.Errorf("attaching to console: %w", err)
+	}
+	defer output.Close()
+	defer input.Close()
+
+	outputDone := make(chan error, 1)
+	inputDone := make(chan error, 1)
+
+	go func() {
+		outputDone <- sendConsoleOutput(output, streamServer)
+	}()
+
+	go func() {
+		inputDone <- receiveConsoleInput(req.Data, input, streamServer)
+	}()
+
+	select {
+	case err = <-outputDone:
+	case err = <-inputDone:
+		// Stop following the output and wait for it to finish before closing it.
+		cancel()
+		<-outputDone
+	}
+
+	if err != nil {
+		logger.Errorf("console session for microvm %s failed: %s", req.Uid, err)
+
+		return err
+	}
+
+	logger.Infof("detached from console of microvm %s", req.Uid)
+
+	return nil
+}
+
+func (s *server) ExecInMicroVM(streamServer mvmv1.MicroVM_ExecInMicroVMServer) error {
+	ctx := streamServer.Context()
+	logger := log.GetLogger(ctx)
+
+	req, err := streamServer.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("receiving exec request: %w", err)
+	}
+
+	if req.Uid == "" || len(req.Command) == 0 {
+		logger.Error("invalid exec in microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("running command in microvm %s", req.Uid)
+
+	stdinReader, stdinWriter := io.Pipe()
+	defer stdinReader.Close()
+
+	go receiveExecStdin(req, stdinWriter, streamServer)
+
+	exitCode, err := s.commandUC.ExecInMicroVM(ctx, req.Uid, ports.GuestExecInput{
+		Command:    req.Command,
+		Env:        req.Env,
+		WorkingDir: req.GetWorkingDir(),
+		Stdin:      stdinReader,
+		Stdout: streamWriter(func(data []byte) error {
+			return streamServer.Send(&mvmv1.ExecInMicroVMResponse{Stdout: data})
+		}),
+		Stderr: streamWriter(func(data []byte) error {
+			return streamServer.Send(&mvmv1.ExecInMicroVMResponse{Stderr: data})
+		}),
+	})
+	if err != nil {
+		logger.Errorf("failed to run command in microvm: %s", err)
+
+		return fmt.Errorf("running command in microvm: %w", err)
+	}
+
+	code := int32(exitCode)
+
+	if err := streamServer.Send(&mvmv1.ExecInMicroVMResponse{ExitCode: &code}); err != nil {
+		return fmt.Errorf("streaming exit code to client: %w", err)
+	}
+
+	return nil
+}
+
+func (s *server) CopyToMicroVM(streamServer mvmv1.MicroVM_CopyToMicroVMServer) error {
+	ctx := streamServer.Context()
+	logger := log.GetLogger(ctx)
+
+	req, err := streamServer.Recv()
+	if err != nil {
+		return fmt.Errorf("receiving copy to microvm request: %w", err)
+	}
+
+	if req.Uid == "" || req.Path == "" {
+		logger.Error("invalid copy to microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("copying %s to microvm %s", req.Path, req.Uid)
+
+	contentReader, contentWriter := io.Pipe()
+	defer contentReader.Close()
+
+	go receiveCopyData(req, contentWriter, streamServer)
+
+	err = s.commandUC.CopyToMicroVM(ctx, req.Uid, ports.GuestCopyToInput{
+		Path:    req.Path,
+		Mode:    req.GetMode(),
+		Content: contentReader,
+	})
+	if err != nil {
+		logger.Errorf("failed to copy to microvm: %s", err)
+
+		return fmt.Errorf("copying to microvm: %w", err)
+	}
+
+	if err := streamServer.SendAndClose(&emptypb.Empty{}); err != nil {
+		return fmt.Errorf("sending response to client: %w", err)
+	}
+
+	return nil
+}
+
+func (s *server) CopyFromMicroVM(
+	req *mvmv1.CopyFromMicroVMRequest,
+	streamServer mvmv1.MicroVM_CopyFromMicroVMServer,
+) error {
+	ctx := streamServer.Context()
+	logger := log.GetLogger(ctx)
+
+	if req == nil || req.Uid == "" || req.Path == "" {
+		logger.Error("invalid copy from microvm request")
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logger.Infof("copying %s from microvm %s", req.Path, req.Uid)
+
+	dest := streamWriter(func(data []byte) error {
+		return streamServer.Send(&mvmv1.CopyFromMicroVMResponse{Data: data})
+	})
+
+	if err := s.queryUC.CopyFromMicroVM(ctx, req.Uid, req.Path, dest); err != nil {
+		logger.Errorf("failed to copy from microvm: %s", err)
+
+		return fmt.Errorf("copying from microvm: %w", err)
+	}
+
+	return nil
+}
+
+// streamWriter is an io.Writer that sends each write to a client stream.
+type streamWriter func(data []byte) error
+
+func (w streamWriter) Write(data []byte) (int, error) {
+	if err := w(data); err != nil {
+		return 0, fmt.Errorf("streaming data to client: %w", err)
+	}
+
+	return len(data), nil
+}
+
+func receiveExecStdin(
+	req *mvmv1.ExecInMicroVMRequest,
+	stdin *io.PipeWriter,
+	streamServer mvmv1.MicroVM_ExecInMicroVMServer,
+) {
+	for {
+		if len(req.Stdin) > 0 {
+			if _, err := stdin.Write(req.Stdin); err != nil {
+				return
+			}
+		}
+
+		if req.CloseStdin {
+			stdin.Close()
+
+			return
+		}
+
+		var err error
+
+		req, err = streamServer.Recv()
+		if err != nil {
+			// The client closing its side of the stream closes stdin.
+			if errors.Is(err, io.EOF) {
+				stdin.Close()
+			} else {
+				stdin.CloseWithError(err)
+			}
+
+			return
+		}
+	}
+}
+
+func receiveCopyData(
+	req *mvmv1.CopyToMicroVMRequest,
+	content *io.PipeWriter,
+	streamServer mvmv1.MicroVM_CopyToMicroVMServer,
+) {
+	for {
+		if len(req.Data) > 0 {
+			if _, err := content.Write(req.Data); err != nil {
+				return
+			}
+		}
+
+		var err error
+
+		req, err = streamServer.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				content.Close()
+			} else {
+				content.CloseWithError(err)
+			}
+
+			return
+		}
+	}
+}
+
+func sendConsoleOutput(output io.Reader, streamServer mvmv1.MicroVM_AttachConsoleServer) error {
+	buf := make([]byte, consoleReadSize)
+
+	for {
+		read, err := output.Read(buf)
+		if read > 0 {
+			if sendErr := streamServer.Send(&mvmv1.ConsoleOutput{Data: buf[:read]}); sendErr != nil {
+				return fmt.Errorf("streaming console output to client: %w", sendErr)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading console output: %w", err)
+		}
+	}
+}
+
+func receiveConsoleInput(data []byte, input io.Writer, streamServer mvmv1.MicroVM_AttachConsoleServer) error {
+	for {
+		if len(data) > 0 {
+			if _, err := input.Write(data); err != nil {
+				return fmt.Errorf("writing console input: %w", err)
+			}
+		}
+
+		req, err := streamServer.Recv()
+		if err != nil {
+			// The client closing its side of the stream, or going away, ends the session.
+			if errors.Is(err, io.EOF) || streamServer.Context().Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("receiving console input from client: %w", err)
+		}
+
+		data = req.Data
+	}
+}
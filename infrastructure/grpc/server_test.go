@@ -1,17 +1,24 @@
 package grpc_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	grpcPkg "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	mvm1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
 	"github.com/liquidmetal-dev/flintlock/api/types"
+	coreerrs "github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/mock"
 )
@@ -186,6 +193,253 @@ func TestServer_CreateMicroVM(t *testing.T) {
 	}
 }
 
+func TestServer_UpdateMicroVM(t *testing.T) {
+	tt := []struct {
+		name        string
+		updateReq   *mvm1.UpdateMicroVMRequest
+		expectError bool
+		expect      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder)
+	}{
+		{
+			name:        "nil request should fail with error",
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {},
+		},
+		{
+			name:        "missing uid should fail with error",
+			updateReq:   &mvm1.UpdateMicroVMRequest{Microvm: createTestCreateRequest("mvm1", "default").Microvm},
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {},
+		},
+		{
+			name:        "missing spec should fail with error",
+			updateReq:   &mvm1.UpdateMicroVMRequest{Uid: "testuid"},
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {},
+		},
+		{
+			name: "error from usecase should fail with error",
+			updateReq: &mvm1.UpdateMicroVMRequest{
+				Uid:     "testuid",
+				Microvm: createTestCreateRequest("mvm1", "default").Microvm,
+			},
+			expectError: true,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				cm.UpdateMicroVM(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq("testuid"),
+					gomock.AssignableToTypeOf(&models.MicroVM{}),
+				).Return(
+					nil,
+					errors.New("kernel can't be changed on an existing microvm"),
+				)
+			},
+		},
+		{
+			name: "valid request and no error from update microvm usecase should succeed",
+			updateReq: &mvm1.UpdateMicroVMRequest{
+				Uid:     "testuid",
+				Microvm: createTestCreateRequest("mvm1", "default").Microvm,
+			},
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				vmid, _ := models.NewVMID("mvm1", "default", "testuid")
+				cm.UpdateMicroVM(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq("testuid"),
+					gomock.AssignableToTypeOf(&models.MicroVM{}),
+				).Return(
+					&models.MicroVM{
+						ID:      *vmid,
+						Version: 2,
+						Status: models.MicroVMStatus{
+							State:               models.PendingState,
+							DeviceUpdatePending: true,
+						},
+					},
+					nil,
+				)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+			qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+			tc.expect(cm.EXPECT(), qm.EXPECT())
+
+			ctx := context.Background()
+			svr := grpc.NewServer(cm, qm)
+			resp, err := svr.UpdateMicroVM(ctx, tc.updateReq)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Microvm.Version).To(Equal(int32(2)))
+			}
+		})
+	}
+}
+
+func TestServer_PowerStateMicroVM(t *testing.T) {
+	stop := func(svr ports.MicroVMGRPCService, uid string) error {
+		_, err := svr.StopMicroVM(context.Background(), &mvm1.StopMicroVMRequest{Uid: uid})
+
+		return err
+	}
+	start := func(svr ports.MicroVMGRPCService, uid string) error {
+		_, err := svr.StartMicroVM(context.Background(), &mvm1.StartMicroVMRequest{Uid: uid})
+
+		return err
+	}
+	restart := func(svr ports.MicroVMGRPCService, uid string) error {
+		_, err := svr.RestartMicroVM(context.Background(), &mvm1.RestartMicroVMRequest{Uid: uid})
+
+		return err
+	}
+	pause := func(svr ports.MicroVMGRPCService, uid string) error {
+		_, err := svr.PauseMicroVM(context.Background(), &mvm1.PauseMicroVMRequest{Uid: uid})
+
+		return err
+	}
+	resume := func(svr ports.MicroVMGRPCService, uid string) error {
+		_, err := svr.ResumeMicroVM(context.Background(), &mvm1.ResumeMicroVMRequest{Uid: uid})
+
+		return err
+	}
+
+	tt := []struct {
+		name        string
+		uid         string
+		action      func(svr ports.MicroVMGRPCService, uid string) error
+		expectError bool
+		expect      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder)
+	}{
+		{
+			name:        "stop with missing id should fail with error",
+			action:      stop,
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {},
+		},
+		{
+			name:        "stop with error from usecase should fail with error",
+			uid:         "testuid",
+			action:      stop,
+			expectError: true,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.StopMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).
+					Return(errors.New("a random error occurred"))
+			},
+		},
+		{
+			name:        "valid stop request should succeed",
+			uid:         "testuid",
+			action:      stop,
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.StopMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(nil)
+			},
+		},
+		{
+			name:        "start with missing id should fail with error",
+			action:      start,
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {},
+		},
+		{
+			name:        "valid start request should succeed",
+			uid:         "testuid",
+			action:      start,
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.StartMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(nil)
+			},
+		},
+		{
+			name:        "restart with missing id should fail with error",
+			action:      restart,
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {},
+		},
+		{
+			name:        "valid restart request should succeed",
+			uid:         "testuid",
+			action:      restart,
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.RestartMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(nil)
+			},
+		},
+		{
+			name:        "pause with missing id should fail with error",
+			action:      pause,
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {},
+		},
+		{
+			name:        "pause with error from usecase should fail with error",
+			uid:         "testuid",
+			action:      pause,
+			expectError: true,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.PauseMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).
+					Return(errors.New("a random error occurred"))
+			},
+		},
+		{
+			name:        "valid pause request should succeed",
+			uid:         "testuid",
+			action:      pause,
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.PauseMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(nil)
+			},
+		},
+		{
+			name:        "resume with missing id should fail with error",
+			action:      resume,
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {},
+		},
+		{
+			name:        "valid resume request should succeed",
+			uid:         "testuid",
+			action:      resume,
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder) {
+				cm.ResumeMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+			qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+			tc.expect(cm.EXPECT())
+
+			svr := grpc.NewServer(cm, qm)
+			err := tc.action(svr, tc.uid)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestServer_DeleteMicroVM(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -336,6 +590,40 @@ func TestServer_GetMicroVM(t *testing.T) {
 	}
 }
 
+func TestServer_GetMicroVMHistory(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	qm.EXPECT().GetMicroVMHistory(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("testuid")).Return(
+		[]*models.PlanExecution{
+			{
+				ID:   "exec1",
+				Plan: "microvm_create_update",
+				Steps: []models.StepExecution{
+					{Name: "microvm_create", Outcome: models.StepOutcomeDoFailed, Error: "boom"},
+				},
+				Error: "boom",
+			},
+		},
+		nil,
+	)
+
+	ctx := context.Background()
+	svr := grpc.NewServer(cm, qm)
+
+	_, err := svr.GetMicroVMHistory(ctx, &mvm1.GetMicroVMHistoryRequest{Uid: ""})
+	Expect(err).To(HaveOccurred())
+
+	resp, err := svr.GetMicroVMHistory(ctx, &mvm1.GetMicroVMHistoryRequest{Uid: "testuid"})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Executions).To(HaveLen(1))
+	Expect(resp.Executions[0].ExecutionId).To(Equal("exec1"))
+	Expect(resp.Executions[0].Steps[0].Outcome).To(Equal(types.StepExecution_DO_FAILED))
+}
+
 func TestServer_ListMicroVMs(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -445,6 +733,313 @@ func TestServer_ListMicroVMs(t *testing.T) {
 	}
 }
 
+func TestServer_CreateSnapshot(t *testing.T) {
+	tt := []struct {
+		name        string
+		req         *mvm1.CreateSnapshotRequest
+		expectError bool
+		expect      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder)
+	}{
+		{
+			name:        "nil request should fail with error",
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {},
+		},
+		{
+			name:        "missing name should fail with error",
+			req:         &mvm1.CreateSnapshotRequest{MicrovmUid: "testuid"},
+			expectError: true,
+			expect:      func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {},
+		},
+		{
+			name:        "error from usecase should fail with error",
+			req:         &mvm1.CreateSnapshotRequest{MicrovmUid: "testuid", Name: "warm"},
+			expectError: true,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				cm.CreateSnapshot(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq("testuid"),
+					gomock.Eq("warm"),
+				).Return(
+					nil,
+					errors.New("a random error occurred"),
+				)
+			},
+		},
+		{
+			name:        "valid request should succeed",
+			req:         &mvm1.CreateSnapshotRequest{MicrovmUid: "testuid", Name: "warm"},
+			expectError: false,
+			expect: func(cm *mock.MockMicroVMCommandUseCasesMockRecorder, qm *mock.MockMicroVMQueryUseCasesMockRecorder) {
+				cm.CreateSnapshot(
+					gomock.AssignableToTypeOf(context.Background()),
+					gomock.Eq("testuid"),
+					gomock.Eq("warm"),
+				).Return(
+					&models.Snapshot{UID: "snap1234", Name: "warm", MicroVMUID: "testuid"},
+					nil,
+				)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+			qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+			tc.expect(cm.EXPECT(), qm.EXPECT())
+
+			ctx := context.Background()
+			svr := grpc.NewServer(cm, qm)
+			resp, err := svr.CreateSnapshot(ctx, tc.req)
+
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Snapshot.Uid).To(Equal("snap1234"))
+				Expect(resp.Snapshot.MicrovmUid).To(Equal("testuid"))
+			}
+		})
+	}
+}
+
+func TestServer_ListSnapshots(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	vmUID := "testuid"
+	qm.EXPECT().GetAllSnapshots(
+		gomock.AssignableToTypeOf(context.Background()),
+		gomock.Eq(models.ListSnapshotQuery{Namespace: "default", MicroVMUID: vmUID}),
+	).Return([]*models.Snapshot{{UID: "snap1234"}, {UID: "snap5678"}}, nil)
+
+	ctx := context.Background()
+	svr := grpc.NewServer(cm, qm)
+
+	_, err := svr.ListSnapshots(ctx, nil)
+	Expect(err).To(HaveOccurred())
+
+	resp, err := svr.ListSnapshots(ctx, &mvm1.ListSnapshotsRequest{Namespace: "default", MicrovmUid: &vmUID})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Snapshots).To(HaveLen(2))
+}
+
+func TestServer_DeleteSnapshot(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	cm.EXPECT().DeleteSnapshot(gomock.AssignableToTypeOf(context.Background()), gomock.Eq("snap1234")).Return(nil)
+
+	ctx := context.Background()
+	svr := grpc.NewServer(cm, qm)
+
+	_, err := svr.DeleteSnapshot(ctx, &mvm1.DeleteSnapshotRequest{Uid: ""})
+	Expect(err).To(HaveOccurred())
+
+	_, err = svr.DeleteSnapshot(ctx, &mvm1.DeleteSnapshotRequest{Uid: "snap1234"})
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func TestServer_CreateMicroVM_InsufficientCapacity(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	cm.EXPECT().CreateMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+		Return(nil, coreerrs.NewInsufficientCapacity("vcpu", 2, 1))
+
+	svr := grpc.NewServer(cm, qm)
+
+	_, err := svr.CreateMicroVM(context.Background(), createTestCreateRequest("mvm1", "default"))
+	Expect(err).To(HaveOccurred())
+	Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+}
+
+func TestServer_GetHostCapacity(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	qm.EXPECT().GetHostCapacity(gomock.AssignableToTypeOf(context.Background())).Return(&models.HostCapacity{
+		Total:     models.HostResources{VCPU: 8, MemoryInMb: 16384, DiskInMb: 100000},
+		Allocated: models.HostResources{VCPU: 2, MemoryInMb: 2048, DiskInMb: 20000},
+		Free:      models.HostResources{VCPU: 6, MemoryInMb: 14336, DiskInMb: 80000},
+	}, nil)
+
+	svr := grpc.NewServer(cm, qm)
+
+	resp, err := svr.GetHostCapacity(context.Background(), &emptypb.Empty{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Total.Vcpu).To(Equal(int64(8)))
+	Expect(resp.Allocated.MemoryInmb).To(Equal(int64(2048)))
+	Expect(resp.Free.DiskInmb).To(Equal(int64(80000)))
+}
+
+func TestServer_SetNamespaceQuota(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	quota := &models.NamespaceQuota{
+		Namespace: "team-a",
+		Limits:    models.QuotaResources{MicroVMs: 5, VCPU: 10, NetworkInterfaces: 4},
+	}
+	cm.EXPECT().SetNamespaceQuota(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(quota)).Return(quota, nil)
+
+	svr := grpc.NewServer(cm, qm)
+
+	resp, err := svr.SetNamespaceQuota(context.Background(), &mvm1.SetNamespaceQuotaRequest{
+		Quota: &types.NamespaceQuota{
+			Namespace: "team-a",
+			Limits:    &types.QuotaResources{Microvms: 5, Vcpu: 10, NetworkInterfaces: 4},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Quota.Namespace).To(Equal("team-a"))
+	Expect(resp.Quota.Limits.Vcpu).To(Equal(int64(10)))
+
+	_, err = svr.SetNamespaceQuota(context.Background(), &mvm1.SetNamespaceQuotaRequest{})
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestServer_GetNamespaceQuota(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	qm.EXPECT().GetNamespaceQuota(gomock.AssignableToTypeOf(context.Background()), "team-a").Return(
+		&models.NamespaceQuotaStatus{
+			Quota: models.NamespaceQuota{Namespace: "team-a", Limits: models.QuotaResources{MicroVMs: 5}},
+			Used:  models.QuotaResources{MicroVMs: 2, VCPU: 4},
+		}, nil)
+
+	svr := grpc.NewServer(cm, qm)
+
+	resp, err := svr.GetNamespaceQuota(context.Background(), &mvm1.GetNamespaceQuotaRequest{Namespace: "team-a"})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Quota.Limits.Microvms).To(Equal(int64(5)))
+	Expect(resp.Used.Microvms).To(Equal(int64(2)))
+	Expect(resp.Used.Vcpu).To(Equal(int64(4)))
+
+	_, err = svr.GetNamespaceQuota(context.Background(), &mvm1.GetNamespaceQuotaRequest{})
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestServer_CreateMicroVM_QuotaExceeded(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	cm.EXPECT().CreateMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+		Return(nil, coreerrs.NewQuotaExceeded("team-a", "microvms", 1, 5, 5))
+
+	svr := grpc.NewServer(cm, qm)
+
+	_, err := svr.CreateMicroVM(context.Background(), createTestCreateRequest("mvm1", "team-a"))
+	Expect(err).To(HaveOccurred())
+	Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+}
+
+func TestServer_ListMicroVMs_Query(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	selector := "env=prod,tier notin (db)"
+	expectedSelector, err := models.ParseLabelSelector(selector)
+	Expect(err).NotTo(HaveOccurred())
+
+	vmid, _ := models.NewVMID("mvm2", "default", "uid2")
+
+	qm.EXPECT().GetAllMicroVM(gomock.AssignableToTypeOf(context.Background()), gomock.Eq(models.ListMicroVMQuery{
+		Namespace:  "default",
+		Selector:   expectedSelector,
+		States:     []models.MicroVMState{models.CreatedState, models.StoppedState},
+		OrderBy:    models.OrderByCreatedAt,
+		Descending: true,
+		PageSize:   2,
+	})).Return([]*models.MicroVM{
+		{ID: *vmid, Version: 1, Spec: models.MicroVMSpec{CreatedAt: 10}},
+		{ID: *vmid, Version: 1, Spec: models.MicroVMSpec{CreatedAt: 5}},
+	}, nil)
+
+	svr := grpc.NewServer(cm, qm)
+
+	resp, err := svr.ListMicroVMs(context.Background(), &mvm1.ListMicroVMsRequest{
+		Namespace:     "default",
+		LabelSelector: &selector,
+		States:        []types.MicroVMStatus_MicroVMState{types.MicroVMStatus_CREATED, types.MicroVMStatus_STOPPED},
+		OrderBy:       mvm1.ListMicroVMsRequest_CREATED_AT,
+		Descending:    true,
+		PageSize:      2,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Microvm).To(HaveLen(2))
+
+	cursor, err := models.DecodePageToken(resp.NextPageToken)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cursor.UID).To(Equal("uid2"))
+	Expect(cursor.Key).To(Equal("00000000000000000005"))
+}
+
+func TestServer_ListMicroVMs_InvalidQuery(t *testing.T) {
+	badSelector := "env in prod"
+	badToken := "not a token!"
+
+	tt := []struct {
+		name    string
+		listReq *mvm1.ListMicroVMsRequest
+	}{
+		{
+			name:    "invalid label selector should fail",
+			listReq: &mvm1.ListMicroVMsRequest{Namespace: "default", LabelSelector: &badSelector},
+		},
+		{
+			name:    "invalid page token should fail",
+			listReq: &mvm1.ListMicroVMsRequest{Namespace: "default", PageToken: &badToken},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			mockCtrl := gomock.NewController(t)
+			cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+			qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+			svr := grpc.NewServer(cm, qm)
+
+			_, err := svr.ListMicroVMs(context.Background(), tc.listReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	}
+}
+
 func TestServer_ListMicroVMsStream(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -538,6 +1133,292 @@ func TestServer_ListMicroVMsStream(t *testing.T) {
 	}
 }
 
+func TestServer_WatchMicroVMs(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	ctx := context.Background()
+	sendChan := make(chan *mvm1.WatchMicroVMsResponse, 10)
+	mockStreamServer := &MockWatchStream{ctx: ctx, serverSend: sendChan}
+
+	expectedQuery := models.WatchMicroVMQuery{
+		Namespace:          "default",
+		Labels:             map[string]string{"role": "worker"},
+		ResumeFromVersions: map[string]int{"uid1": 2},
+	}
+
+	qm.EXPECT().WatchMicroVMs(
+		gomock.AssignableToTypeOf(context.Background()),
+		gomock.Eq(expectedQuery),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ models.WatchMicroVMQuery, send func(*models.MicroVMEvent) error) error {
+		Expect(send(&models.MicroVMEvent{
+			Type:    models.MicroVMEventModified,
+			MicroVM: &models.MicroVM{Version: 3, Status: models.MicroVMStatus{State: models.CreatedState}},
+		})).To(Succeed())
+
+		return nil
+	})
+
+	svr := grpc.NewServer(cm, qm)
+
+	Expect(svr.WatchMicroVMs(nil, mockStreamServer)).NotTo(Succeed())
+
+	err := svr.WatchMicroVMs(&mvm1.WatchMicroVMsRequest{
+		Namespace:          "default",
+		Labels:             map[string]string{"role": "worker"},
+		ResumeFromVersions: map[string]int32{"uid1": 2},
+	}, mockStreamServer)
+	Expect(err).NotTo(HaveOccurred())
+
+	close(sendChan)
+
+	msgs := []*mvm1.WatchMicroVMsResponse{}
+	for msg := range sendChan {
+		msgs = append(msgs, msg)
+	}
+
+	Expect(msgs).To(HaveLen(1))
+	Expect(msgs[0].Type).To(Equal(mvm1.WatchMicroVMsResponse_MODIFIED))
+	Expect(msgs[0].Microvm.Version).To(Equal(int32(3)))
+	Expect(msgs[0].Microvm.Status.State).To(Equal(types.MicroVMStatus_CREATED))
+}
+
+func TestServer_GetConsoleLog(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	sendChan := make(chan *mvm1.ConsoleOutput, 10)
+	mockStreamServer := &MockConsoleStream{ctx: context.Background(), serverSend: sendChan}
+
+	tailLines := int32(20)
+
+	qm.EXPECT().GetConsoleLog(
+		gomock.AssignableToTypeOf(context.Background()),
+		"uid1",
+		ports.ConsoleOutputOptions{TailLines: 20, Follow: true},
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ ports.ConsoleOutputOptions, send func([]byte) error) error {
+		Expect(send([]byte("login: "))).To(Succeed())
+
+		return nil
+	})
+
+	svr := grpc.NewServer(cm, qm)
+
+	Expect(svr.GetConsoleLog(nil, mockStreamServer)).NotTo(Succeed())
+	Expect(svr.GetConsoleLog(&mvm1.GetConsoleLogRequest{}, mockStreamServer)).NotTo(Succeed())
+
+	err := svr.GetConsoleLog(&mvm1.GetConsoleLogRequest{
+		Uid:       "uid1",
+		TailLines: &tailLines,
+		Follow:    true,
+	}, mockStreamServer)
+	Expect(err).NotTo(HaveOccurred())
+
+	close(sendChan)
+
+	msgs := []*mvm1.ConsoleOutput{}
+	for msg := range sendChan {
+		msgs = append(msgs, msg)
+	}
+
+	Expect(msgs).To(HaveLen(1))
+	Expect(string(msgs[0].Data)).To(Equal("login: "))
+}
+
+func TestServer_AttachConsole(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	input := &bytes.Buffer{}
+
+	cm.EXPECT().AttachConsole(gomock.Any(), "uid1").
+		DoAndReturn(func(ctx context.Context, _ string) (io.ReadCloser, io.WriteCloser, error) {
+			outputReader, outputWriter := io.Pipe()
+
+			go func() {
+				_, _ = outputWriter.Write([]byte("login: "))
+				<-ctx.Done()
+				outputWriter.Close()
+			}()
+
+			return outputReader, nopWriteCloser{input}, nil
+		})
+
+	svr := grpc.NewServer(cm, qm)
+
+	invalidStream := &MockConsoleStream{
+		ctx:         context.Background(),
+		clientSends: []*mvm1.AttachConsoleRequest{{Data: []byte("root\n")}},
+	}
+	Expect(svr.AttachConsole(invalidStream)).NotTo(Succeed())
+
+	sendChan := make(chan *mvm1.ConsoleOutput, 10)
+	mockStreamServer := &MockConsoleStream{
+		ctx:        context.Background(),
+		serverSend: sendChan,
+		clientSends: []*mvm1.AttachConsoleRequest{
+			{Uid: "uid1", Data: []byte("root\n")},
+			{Data: []byte("uptime\n")},
+		},
+	}
+
+	Expect(svr.AttachConsole(mockStreamServer)).To(Succeed())
+
+	close(sendChan)
+
+	output := ""
+	for msg := range sendChan {
+		output += string(msg.Data)
+	}
+
+	Expect(output).To(Equal("login: "))
+	Expect(input.String()).To(Equal("root\nuptime\n"))
+}
+
+func TestServer_ExecInMicroVM(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	workingDir := "/root"
+
+	cm.EXPECT().ExecInMicroVM(gomock.Any(), "uid1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, input ports.GuestExecInput) (int, error) {
+			Expect(input.Command).To(Equal([]string{"cat"}))
+			Expect(input.WorkingDir).To(Equal(workingDir))
+
+			stdin, err := io.ReadAll(input.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _ = input.Stdout.Write(stdin)
+			_, _ = input.Stderr.Write([]byte("warning"))
+
+			return 1, nil
+		})
+
+	svr := grpc.NewServer(cm, qm)
+
+	invalidStream := &MockExecStream{
+		ctx:         context.Background(),
+		clientSends: []*mvm1.ExecInMicroVMRequest{{Uid: "uid1"}},
+	}
+	Expect(svr.ExecInMicroVM(invalidStream)).NotTo(Succeed())
+
+	sendChan := make(chan *mvm1.ExecInMicroVMResponse, 10)
+	mockStreamServer := &MockExecStream{
+		ctx:        context.Background(),
+		serverSend: sendChan,
+		clientSends: []*mvm1.ExecInMicroVMRequest{
+			{Uid: "uid1", Command: []string{"cat"}, WorkingDir: &workingDir, Stdin: []byte("hello ")},
+			{Stdin: []byte("world"), CloseStdin: true},
+		},
+	}
+
+	Expect(svr.ExecInMicroVM(mockStreamServer)).To(Succeed())
+
+	close(sendChan)
+
+	msgs := []*mvm1.ExecInMicroVMResponse{}
+	for msg := range sendChan {
+		msgs = append(msgs, msg)
+	}
+
+	Expect(msgs).To(HaveLen(3))
+	Expect(string(msgs[0].Stdout)).To(Equal("hello world"))
+	Expect(string(msgs[1].Stderr)).To(Equal("warning"))
+	Expect(msgs[2].ExitCode).NotTo(BeNil())
+	Expect(*msgs[2].ExitCode).To(Equal(int32(1)))
+}
+
+func TestServer_CopyToMicroVM(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	mode := uint32(0o600)
+
+	cm.EXPECT().CopyToMicroVM(gomock.Any(), "uid1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, input ports.GuestCopyToInput) error {
+			Expect(input.Path).To(Equal("/etc/motd"))
+			Expect(input.Mode).To(Equal(mode))
+
+			content, err := io.ReadAll(input.Content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("hello world"))
+
+			return nil
+		})
+
+	svr := grpc.NewServer(cm, qm)
+
+	invalidStream := &MockCopyToStream{
+		ctx:         context.Background(),
+		clientSends: []*mvm1.CopyToMicroVMRequest{{Uid: "uid1"}},
+	}
+	Expect(svr.CopyToMicroVM(invalidStream)).NotTo(Succeed())
+
+	mockStreamServer := &MockCopyToStream{
+		ctx: context.Background(),
+		clientSends: []*mvm1.CopyToMicroVMRequest{
+			{Uid: "uid1", Path: "/etc/motd", Mode: &mode, Data: []byte("hello ")},
+			{Data: []byte("world")},
+		},
+	}
+
+	Expect(svr.CopyToMicroVM(mockStreamServer)).To(Succeed())
+	Expect(mockStreamServer.closed).To(BeTrue())
+}
+
+func TestServer_CopyFromMicroVM(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockCtrl := gomock.NewController(t)
+	cm := mock.NewMockMicroVMCommandUseCases(mockCtrl)
+	qm := mock.NewMockMicroVMQueryUseCases(mockCtrl)
+
+	qm.EXPECT().CopyFromMicroVM(gomock.Any(), "uid1", "/etc/motd", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, dest io.Writer) error {
+			_, err := dest.Write([]byte("hello world"))
+
+			return err
+		})
+
+	sendChan := make(chan *mvm1.CopyFromMicroVMResponse, 10)
+	mockStreamServer := &MockCopyFromStream{ctx: context.Background(), serverSend: sendChan}
+
+	svr := grpc.NewServer(cm, qm)
+
+	Expect(svr.CopyFromMicroVM(nil, mockStreamServer)).NotTo(Succeed())
+	Expect(svr.CopyFromMicroVM(&mvm1.CopyFromMicroVMRequest{Uid: "uid1"}, mockStreamServer)).NotTo(Succeed())
+
+	err := svr.CopyFromMicroVM(&mvm1.CopyFromMicroVMRequest{Uid: "uid1", Path: "/etc/motd"}, mockStreamServer)
+	Expect(err).NotTo(HaveOccurred())
+
+	close(sendChan)
+
+	content := ""
+	for msg := range sendChan {
+		content += string(msg.Data)
+	}
+
+	Expect(content).To(Equal("hello world"))
+}
+
 func createTestCreateRequest(id, namespace string) *mvm1.CreateMicroVMRequest {
 	filename := "kernel"
 	mac := "AA:FF:00:00:00:01"
@@ -599,3 +1480,125 @@ func (mls *MockListStream) Send(resp *mvm1.ListMessage) error {
 
 	return nil
 }
+
+type MockWatchStream struct {
+	grpcPkg.ServerStream
+	ctx        context.Context
+	serverSend chan *mvm1.WatchMicroVMsResponse
+}
+
+func (mws *MockWatchStream) Context() context.Context {
+	return mws.ctx
+}
+
+func (mws *MockWatchStream) Send(resp *mvm1.WatchMicroVMsResponse) error {
+	mws.serverSend <- resp
+
+	return nil
+}
+
+type MockConsoleStream struct {
+	grpcPkg.ServerStream
+	ctx         context.Context
+	serverSend  chan *mvm1.ConsoleOutput
+	clientSends []*mvm1.AttachConsoleRequest
+}
+
+func (mcs *MockConsoleStream) Context() context.Context {
+	return mcs.ctx
+}
+
+func (mcs *MockConsoleStream) Send(resp *mvm1.ConsoleOutput) error {
+	mcs.serverSend <- resp
+
+	return nil
+}
+
+func (mcs *MockConsoleStream) Recv() (*mvm1.AttachConsoleRequest, error) {
+	if len(mcs.clientSends) == 0 {
+		return nil, io.EOF
+	}
+
+	req := mcs.clientSends[0]
+	mcs.clientSends = mcs.clientSends[1:]
+
+	return req, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+type MockExecStream struct {
+	grpcPkg.ServerStream
+	ctx         context.Context
+	serverSend  chan *mvm1.ExecInMicroVMResponse
+	clientSends []*mvm1.ExecInMicroVMRequest
+}
+
+func (mes *MockExecStream) Context() context.Context {
+	return mes.ctx
+}
+
+func (mes *MockExecStream) Send(resp *mvm1.ExecInMicroVMResponse) error {
+	mes.serverSend <- resp
+
+	return nil
+}
+
+func (mes *MockExecStream) Recv() (*mvm1.ExecInMicroVMRequest, error) {
+	if len(mes.clientSends) == 0 {
+		return nil, io.EOF
+	}
+
+	req := mes.clientSends[0]
+	mes.clientSends = mes.clientSends[1:]
+
+	return req, nil
+}
+
+type MockCopyToStream struct {
+	grpcPkg.ServerStream
+	ctx         context.Context
+	clientSends []*mvm1.CopyToMicroVMRequest
+	closed      bool
+}
+
+func (mcs *MockCopyToStream) Context() context.Context {
+	return mcs.ctx
+}
+
+func (mcs *MockCopyToStream) SendAndClose(_ *emptypb.Empty) error {
+	mcs.closed = true
+
+	return nil
+}
+
+func (mcs *MockCopyToStream) Recv() (*mvm1.CopyToMicroVMRequest, error) {
+	if len(mcs.clientSends) == 0 {
+		return nil, io.EOF
+	}
+
+	req := mcs.clientSends[0]
+	mcs.clientSends = mcs.clientSends[1:]
+
+	return req, nil
+}
+
+type MockCopyFromStream struct {
+	grpcPkg.ServerStream
+	ctx        context.Context
+	serverSend chan *mvm1.CopyFromMicroVMResponse
+}
+
+func (mcs *MockCopyFromStream) Context() context.Context {
+	return mcs.ctx
+}
+
+func (mcs *MockCopyFromStream) Send(resp *mvm1.CopyFromMicroVMResponse) error {
+	mcs.serverSend <- resp
+
+	return nil
+}
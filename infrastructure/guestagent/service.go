@@ -0,0 +1,67 @@
+package guestagent
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/guestagent"
+)
+
+// New creates a guest agent service that connects to the guest agent through the vsock
+// socket of a microvm.
+func New() ports.GuestAgentService {
+	return &guestAgentService{
+		port: defaults.GuestAgentVsockPort,
+	}
+}
+
+type guestAgentService struct {
+	port uint32
+}
+
+// Exec runs a command in the microvm and returns its exit code.
+func (s *guestAgentService) Exec(ctx context.Context, vsockPath string, input ports.GuestExecInput) (int, error) {
+	exitCode, err := guestagent.New(vsockPath, s.port).Exec(ctx, guestagent.ExecInput{
+		Command:    input.Command,
+		Env:        input.Env,
+		WorkingDir: input.WorkingDir,
+		Stdin:      input.Stdin,
+		Stdout:     input.Stdout,
+		Stderr:     input.Stderr,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("running command with guest agent: %w", err)
+	}
+
+	return exitCode, nil
+}
+
+// CopyTo writes content to a file in the microvm.
+func (s *guestAgentService) CopyTo(ctx context.Context, vsockPath string, input ports.GuestCopyToInput) error {
+	if err := guestagent.New(vsockPath, s.port).CopyTo(ctx, input.Path, input.Mode, input.Content); err != nil {
+		return fmt.Errorf("copying %s to microvm with guest agent: %w", input.Path, err)
+	}
+
+	return nil
+}
+
+// CopyFrom reads a file from the microvm into dest.
+func (s *guestAgentService) CopyFrom(ctx context.Context, vsockPath string, path string, dest io.Writer) error {
+	if err := guestagent.New(vsockPath, s.port).CopyFrom(ctx, path, dest); err != nil {
+		return fmt.Errorf("copying %s from microvm with guest agent: %w", path, err)
+	}
+
+	return nil
+}
+
+// Ping checks the guest agent in the microvm is up and responding.
+func (s *guestAgentService) Ping(ctx context.Context, vsockPath string) error {
+	if err := guestagent.New(vsockPath, s.port).Ping(ctx); err != nil {
+		return fmt.Errorf("pinging guest agent: %w", err)
+	}
+
+	return nil
+}
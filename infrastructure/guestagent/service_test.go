@@ -0,0 +1,62 @@
+package guestagent_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/guestagent"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/guestagent/fakeguest"
+)
+
+func TestGuestAgentService(t *testing.T) {
+	RegisterTestingT(t)
+
+	// Unix socket paths have a short length limit so don't use t.TempDir.
+	socketDir, err := os.MkdirTemp("", "guestagent")
+	Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(socketDir)
+
+	vsockPath := filepath.Join(socketDir, defaults.GuestAgentVsockName)
+	root := t.TempDir()
+
+	guest, err := fakeguest.New(vsockPath, root, defaults.GuestAgentVsockPort)
+	Expect(err).NotTo(HaveOccurred())
+	defer guest.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	svc := guestagent.New()
+
+	Expect(svc.Ping(ctx, vsockPath)).To(Succeed())
+
+	stdout := &bytes.Buffer{}
+	exitCode, err := svc.Exec(ctx, vsockPath, ports.GuestExecInput{
+		Command: []string{"pwd"},
+		Stdout:  stdout,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(exitCode).To(Equal(0))
+	Expect(strings.TrimSpace(stdout.String())).To(Equal(root))
+
+	Expect(svc.CopyTo(ctx, vsockPath, ports.GuestCopyToInput{
+		Path:    "/hostname",
+		Mode:    0o644,
+		Content: strings.NewReader("mvm1"),
+	})).To(Succeed())
+
+	copied := &bytes.Buffer{}
+	Expect(svc.CopyFrom(ctx, vsockPath, "/hostname", copied)).To(Succeed())
+	Expect(copied.String()).To(Equal("mvm1"))
+
+	Expect(svc.CopyFrom(ctx, filepath.Join(socketDir, "missing.vsock"), "/hostname", copied)).NotTo(Succeed())
+}
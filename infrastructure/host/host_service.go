@@ -0,0 +1,197 @@
+package host
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/internal/version"
+)
+
+const (
+	meminfoPath    = "/proc/meminfo"
+	cpuinfoPath    = "/proc/cpuinfo"
+	osReleasePath  = "/proc/sys/kernel/osrelease"
+	kvmDevicePath  = "/dev/kvm"
+	memTotalName   = "MemTotal:"
+	kbInMb         = 1024
+	cpuinfoFields  = 2
+	nestedEnabledY = "Y"
+	nestedEnabled1 = "1"
+)
+
+var (
+	errMemTotalNotFound = errors.New("MemTotal not found in " + meminfoPath)
+
+	// kvmNestedPaths are the module parameters that show if nested virtualization is enabled.
+	kvmNestedPaths = []string{
+		"/sys/module/kvm_intel/parameters/nested",
+		"/sys/module/kvm_amd/parameters/nested",
+	}
+)
+
+type Config struct {
+	// DiskInMb is the size of the storage microvm volumes are created on, e.g. the devmapper
+	// thin pool of the volume snapshotter. It's zero if the size isn't known.
+	DiskInMb int64
+	// BridgeName is the name of the default bridge for tap interfaces.
+	BridgeName string
+	// ParentIface is the name of the default parent interface for macvtap interfaces.
+	ParentIface string
+	// CtrNamespace is the containerd namespace flintlock uses.
+	CtrNamespace string
+	// KernelSnapshotter is the containerd snapshotter used for kernel images.
+	KernelSnapshotter string
+	// VolumeSnapshotter is the containerd snapshotter used for volume images.
+	VolumeSnapshotter string
+}
+
+func New(cfg *Config, fs afero.Fs) ports.HostService {
+	return &hostService{
+		config: cfg,
+		fs:     fs,
+	}
+}
+
+type hostService struct {
+	config *Config
+	fs     afero.Fs
+}
+
+// Info returns the details of the host. The providers are left for the caller to fill in.
+func (s *hostService) Info(ctx context.Context) (*models.HostInfo, error) {
+	kernelVersion, err := afero.ReadFile(s.fs, osReleasePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading kernel version from %s: %w", osReleasePath, err)
+	}
+
+	cpu, err := s.cpuInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.HostInfo{
+		Version: models.FlintlockVersion{
+			Version:    version.Version,
+			CommitHash: version.CommitHash,
+			BuildDate:  version.BuildDate,
+		},
+		KernelVersion: strings.TrimSpace(string(kernelVersion)),
+		CPU:           *cpu,
+		Network: models.HostNetworkInfo{
+			BridgeName:      s.config.BridgeName,
+			ParentInterface: s.config.ParentIface,
+		},
+		Containerd: models.ContainerdInfo{
+			Namespace:         s.config.CtrNamespace,
+			KernelSnapshotter: s.config.KernelSnapshotter,
+			VolumeSnapshotter: s.config.VolumeSnapshotter,
+		},
+	}, nil
+}
+
+// Resources returns the physical resources of the host.
+func (s *hostService) Resources(ctx context.Context) (*models.HostResources, error) {
+	memory, err := s.totalMemoryInMb()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.HostResources{
+		VCPU:       int64(runtime.NumCPU()),
+		MemoryInMb: memory,
+		DiskInMb:   s.config.DiskInMb,
+	}, nil
+}
+
+func (s *hostService) totalMemoryInMb() (int64, error) {
+	file, err := s.fs.Open(meminfoPath)
+	if err != nil {
+		return 0, fmt.Errorf("opening %s: %w", meminfoPath, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != memTotalName {
+			continue
+		}
+
+		totalKb, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %s %s: %w", memTotalName, fields[1], err)
+		}
+
+		return totalKb / kbInMb, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading %s: %w", meminfoPath, err)
+	}
+
+	return 0, errMemTotalNotFound
+}
+
+// cpuInfo gets the model and flags of the first cpu, and whether kvm is usable.
+func (s *hostService) cpuInfo() (*models.CPUInfo, error) {
+	info := &models.CPUInfo{}
+
+	file, err := s.fs.Open(cpuinfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", cpuinfoPath, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// The details of each cpu are separated by a blank line, only the first is needed.
+		if scanner.Text() == "" && info.Model != "" {
+			break
+		}
+
+		parts := strings.SplitN(scanner.Text(), ":", cpuinfoFields)
+		if len(parts) != cpuinfoFields {
+			continue
+		}
+
+		value := strings.TrimSpace(parts[1])
+
+		switch strings.TrimSpace(parts[0]) {
+		case "model name":
+			info.Model = value
+		case "flags", "Features":
+			info.Flags = strings.Fields(value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", cpuinfoPath, err)
+	}
+
+	if _, err := s.fs.Stat(kvmDevicePath); err == nil {
+		info.KVMAvailable = true
+	}
+
+	for _, nestedPath := range kvmNestedPaths {
+		nested, err := afero.ReadFile(s.fs, nestedPath)
+		if err != nil {
+			continue
+		}
+
+		value := strings.TrimSpace(string(nested))
+		if value == nestedEnabledY || value == nestedEnabled1 {
+			info.NestedVirtualization = true
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,150 @@
+package host_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/infrastructure/host"
+)
+
+const testMeminfo = `MemTotal:       16315704 kB
+MemFree:         8016988 kB
+MemAvailable:   12346060 kB
+`
+
+func TestHostService_Resources(t *testing.T) {
+	testCases := []struct {
+		name        string
+		meminfo     string
+		expectError bool
+		expectMemMb int64
+	}{
+		{
+			name:        "valid meminfo",
+			meminfo:     testMeminfo,
+			expectMemMb: 15933,
+		},
+		{
+			name:        "meminfo without MemTotal, should fail",
+			meminfo:     "MemFree:         8016988 kB\n",
+			expectError: true,
+		},
+		{
+			name:        "missing meminfo, should fail",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			fs := afero.NewMemMapFs()
+			if tc.meminfo != "" {
+				Expect(afero.WriteFile(fs, "/proc/meminfo", []byte(tc.meminfo), 0o644)).To(Succeed())
+			}
+
+			svc := host.New(&host.Config{DiskInMb: 50000}, fs)
+
+			resources, err := svc.Resources(context.Background())
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.VCPU).To(BeEquivalentTo(runtime.NumCPU()))
+			Expect(resources.MemoryInMb).To(Equal(tc.expectMemMb))
+			Expect(resources.DiskInMb).To(BeEquivalentTo(50000))
+		})
+	}
+}
+
+const testCPUInfo = `processor	: 0
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) CPU E5-2686 v4 @ 2.30GHz
+flags		: fpu vme vmx sse4_2
+
+processor	: 1
+vendor_id	: GenuineIntel
+model name	: Another CPU
+flags		: fpu
+`
+
+func TestHostService_Info(t *testing.T) {
+	testCases := []struct {
+		name         string
+		files        map[string]string
+		expectError  bool
+		expectKVM    bool
+		expectNested bool
+	}{
+		{
+			name: "kvm with nested virtualization",
+			files: map[string]string{
+				"/proc/cpuinfo":              testCPUInfo,
+				"/proc/sys/kernel/osrelease": "6.1.0-flintlock\n",
+				"/dev/kvm":                   "",
+				"/sys/module/kvm_intel/parameters/nested": "Y\n",
+			},
+			expectKVM:    true,
+			expectNested: true,
+		},
+		{
+			name: "no kvm",
+			files: map[string]string{
+				"/proc/cpuinfo":                         testCPUInfo,
+				"/proc/sys/kernel/osrelease":            "6.1.0-flintlock\n",
+				"/sys/module/kvm_amd/parameters/nested": "0\n",
+			},
+		},
+		{
+			name: "missing cpuinfo, should fail",
+			files: map[string]string{
+				"/proc/sys/kernel/osrelease": "6.1.0-flintlock\n",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.files {
+				Expect(afero.WriteFile(fs, path, []byte(content), 0o644)).To(Succeed())
+			}
+
+			svc := host.New(&host.Config{
+				BridgeName:        "br0",
+				CtrNamespace:      "flintlock",
+				KernelSnapshotter: "overlayfs",
+				VolumeSnapshotter: "devmapper",
+			}, fs)
+
+			info, err := svc.Info(context.Background())
+			if tc.expectError {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.KernelVersion).To(Equal("6.1.0-flintlock"))
+			Expect(info.CPU.Model).To(Equal("Intel(R) Xeon(R) CPU E5-2686 v4 @ 2.30GHz"))
+			Expect(info.CPU.Flags).To(Equal([]string{"fpu", "vme", "vmx", "sse4_2"}))
+			Expect(info.CPU.KVMAvailable).To(Equal(tc.expectKVM))
+			Expect(info.CPU.NestedVirtualization).To(Equal(tc.expectNested))
+			Expect(info.Network.BridgeName).To(Equal("br0"))
+			Expect(info.Containerd.Namespace).To(Equal("flintlock"))
+			Expect(info.Containerd.KernelSnapshotter).To(Equal("overlayfs"))
+			Expect(info.Containerd.VolumeSnapshotter).To(Equal("devmapper"))
+		})
+	}
+}
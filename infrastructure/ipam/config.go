@@ -0,0 +1,204 @@
+package ipam
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the configuration for the ipam service.
+type Config struct {
+	// PoolsFile is the path to the file of the address pools. If empty there are no pools
+	// and interfaces only get the addresses in their spec.
+	PoolsFile string
+	// StateDir is the directory the allocations are kept in.
+	StateDir string
+	// DefaultBridge is the name of the bridge tap devices are attached to by default.
+	DefaultBridge string
+}
+
+// PoolsConfig is the file format of the address pools.
+type PoolsConfig struct {
+	Pools []PoolConfig `json:"pools"`
+}
+
+// PoolConfig is a pool of addresses for the interfaces attached to a bridge.
+type PoolConfig struct {
+	// Bridge is the name of the bridge the pool is for.
+	Bridge string `json:"bridge"`
+	// CIDR is the subnet of the bridge that addresses are allocated from.
+	CIDR string `json:"cidr"`
+	// Gateway is the address of the default gateway of the subnet, if any. It's never allocated.
+	Gateway string `json:"gateway,omitempty"`
+	// Nameservers are the addresses of the nameservers for the subnet.
+	Nameservers []string `json:"nameservers,omitempty"`
+	// Reserved are the addresses in the subnet that mustn't be allocated. Each is either a
+	// single address or a range of addresses such as 192.168.1.1-192.168.1.20.
+	Reserved []string `json:"reserved,omitempty"`
+}
+
+// pool is a parsed pool of addresses.
+type pool struct {
+	name        string
+	prefix      netip.Prefix
+	gateway     netip.Addr
+	nameservers []string
+	reserved    []addrRange
+}
+
+type addrRange struct {
+	start netip.Addr
+	end   netip.Addr
+}
+
+func (r addrRange) contains(addr netip.Addr) bool {
+	return addr.Compare(r.start) >= 0 && addr.Compare(r.end) <= 0
+}
+
+// loadPools reads and parses the address pools in a file.
+func loadPools(fs afero.Fs, path string) (map[string]*pool, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("reading ipam pools file %s: %w", path, err)
+	}
+
+	return parsePools(data)
+}
+
+// parsePools parses address pools and checks they're valid.
+func parsePools(data []byte) (map[string]*pool, error) {
+	cfg := &PoolsConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("unmarshalling ipam pools: %w", err)
+	}
+
+	pools := map[string]*pool{}
+
+	for _, poolCfg := range cfg.Pools {
+		if poolCfg.Bridge == "" {
+			return nil, errBridgeRequired
+		}
+
+		if _, ok := pools[poolCfg.Bridge]; ok {
+			return nil, fmt.Errorf("bridge %s has more than one pool: %w", poolCfg.Bridge, errDuplicatePool)
+		}
+
+		p, err := parsePool(poolCfg)
+		if err != nil {
+			return nil, fmt.Errorf("parsing pool for bridge %s: %w", poolCfg.Bridge, err)
+		}
+
+		pools[poolCfg.Bridge] = p
+	}
+
+	return pools, nil
+}
+
+func parsePool(cfg PoolConfig) (*pool, error) {
+	prefix, err := netip.ParsePrefix(cfg.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cidr: %w", err)
+	}
+
+	p := &pool{
+		name:        cfg.Bridge,
+		prefix:      prefix.Masked(),
+		nameservers: cfg.Nameservers,
+	}
+
+	if cfg.Gateway != "" {
+		p.gateway, err = netip.ParseAddr(cfg.Gateway)
+		if err != nil {
+			return nil, fmt.Errorf("parsing gateway: %w", err)
+		}
+
+		if !p.prefix.Contains(p.gateway) {
+			return nil, fmt.Errorf("gateway %s: %w", p.gateway, errNotInSubnet)
+		}
+	}
+
+	for _, nameserver := range cfg.Nameservers {
+		if _, err := netip.ParseAddr(nameserver); err != nil {
+			return nil, fmt.Errorf("parsing nameserver: %w", err)
+		}
+	}
+
+	for _, reserved := range cfg.Reserved {
+		r, err := parseRange(reserved)
+		if err != nil {
+			return nil, fmt.Errorf("parsing reserved addresses %s: %w", reserved, err)
+		}
+
+		if !p.prefix.Contains(r.start) || !p.prefix.Contains(r.end) {
+			return nil, fmt.Errorf("reserved addresses %s: %w", reserved, errNotInSubnet)
+		}
+
+		p.reserved = append(p.reserved, r)
+	}
+
+	return p, nil
+}
+
+func parseRange(value string) (addrRange, error) {
+	startValue, endValue, isRange := strings.Cut(value, "-")
+	if !isRange {
+		endValue = startValue
+	}
+
+	start, err := netip.ParseAddr(strings.TrimSpace(startValue))
+	if err != nil {
+		return addrRange{}, fmt.Errorf("parsing start address: %w", err)
+	}
+
+	end, err := netip.ParseAddr(strings.TrimSpace(endValue))
+	if err != nil {
+		return addrRange{}, fmt.Errorf("parsing end address: %w", err)
+	}
+
+	if end.Less(start) {
+		return addrRange{}, errInvalidRange
+	}
+
+	return addrRange{start: start, end: end}, nil
+}
+
+// allocatable returns true if the address can be allocated to an interface.
+func (p *pool) allocatable(addr netip.Addr) bool {
+	if addr == p.prefix.Addr() || addr == p.gateway {
+		return false
+	}
+
+	if addr.Is4() && addr == lastAddr(p.prefix) {
+		// The broadcast address.
+		return false
+	}
+
+	for _, r := range p.reserved {
+		if r.contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lastAddr returns the last address in a subnet.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	bits := prefix.Bits()
+
+	for i := range bytes {
+		for bit := 0; bit < 8; bit++ {
+			if i*8+bit >= bits {
+				bytes[i] |= 1 << (7 - bit)
+			}
+		}
+	}
+
+	addr, _ := netip.AddrFromSlice(bytes)
+
+	return addr
+}
@@ -0,0 +1,12 @@
+package ipam
+
+import "errors"
+
+var (
+	errBridgeRequired = errors.New("a bridge is required for each pool")
+	errDuplicatePool  = errors.New("duplicate pool")
+	errNotInSubnet    = errors.New("not in the subnet of the pool")
+	errInvalidRange   = errors.New("the end of the range is before the start")
+	errNoPool         = errors.New("no address pool for bridge")
+	errPoolExhausted  = errors.New("no free addresses in pool")
+)
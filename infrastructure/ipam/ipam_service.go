@@ -0,0 +1,235 @@
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const allocationFileMode = 0o600
+
+// New creates a new ipam service that allocates addresses from the pools in the config. Each
+// allocation is a file in the state directory named after the address and holding its owner,
+// so allocations are kept when flintlockd restarts.
+func New(cfg *Config, fs afero.Fs) (ports.IPAMService, error) {
+	pools := map[string]*pool{}
+
+	if cfg.PoolsFile != "" {
+		var err error
+
+		pools, err = loadPools(fs, cfg.PoolsFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ipamService{
+		fs:            fs,
+		stateDir:      cfg.StateDir,
+		defaultBridge: cfg.DefaultBridge,
+		pools:         pools,
+	}, nil
+}
+
+type ipamService struct {
+	fs            afero.Fs
+	stateDir      string
+	defaultBridge string
+	pools         map[string]*pool
+
+	// mu serializes allocations so an owner can't be allocated two addresses.
+	mu sync.Mutex
+}
+
+// HasPool returns true if there's a pool of addresses for the bridge.
+func (s *ipamService) HasPool(bridgeName string) bool {
+	_, ok := s.pools[s.poolName(bridgeName)]
+
+	return ok
+}
+
+// Allocate will allocate the first free address in the pool of the bridge to the owner.
+func (s *ipamService) Allocate(ctx context.Context, input ports.IPAllocateInput) (*ports.IPAllocation, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "ipam",
+		"owner":   input.Owner,
+	})
+
+	p, ok := s.pools[s.poolName(input.BridgeName)]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errNoPool, s.poolName(input.BridgeName))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	poolDir := s.poolDir(p)
+	if err := s.fs.MkdirAll(poolDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating ipam state directory %s: %w", poolDir, err)
+	}
+
+	existing, err := s.findOwned(p, input.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.IsValid() {
+		logger.Debugf("owner already has address %s from pool %s", existing, p.name)
+
+		return s.allocation(p, existing), nil
+	}
+
+	for addr := p.prefix.Addr(); p.prefix.Contains(addr); addr = addr.Next() {
+		if !p.allocatable(addr) {
+			continue
+		}
+
+		file, err := s.fs.OpenFile(s.allocationPath(p, addr), os.O_CREATE|os.O_EXCL|os.O_WRONLY, allocationFileMode)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("creating allocation for %s: %w", addr, err)
+		}
+
+		_, err = file.WriteString(input.Owner)
+		closeErr := file.Close()
+
+		if err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			_ = s.fs.Remove(s.allocationPath(p, addr))
+
+			return nil, fmt.Errorf("writing allocation for %s: %w", addr, err)
+		}
+
+		logger.Infof("allocated address %s from pool %s", addr, p.name)
+
+		return s.allocation(p, addr), nil
+	}
+
+	return nil, fmt.Errorf("%w %s", errPoolExhausted, p.name)
+}
+
+// Release will remove the allocation of the address if it's allocated to the owner.
+func (s *ipamService) Release(ctx context.Context, input ports.IPReleaseInput) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "ipam",
+		"owner":   input.Owner,
+	})
+
+	p, ok := s.pools[input.Pool]
+	if !ok {
+		return fmt.Errorf("%w %s", errNoPool, input.Pool)
+	}
+
+	prefix, err := netip.ParsePrefix(string(input.Address))
+	if err != nil {
+		return fmt.Errorf("parsing address %s: %w", input.Address, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.allocationPath(p, prefix.Addr())
+
+	owner, err := afero.ReadFile(s.fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading allocation for %s: %w", prefix.Addr(), err)
+	}
+
+	if string(owner) != input.Owner {
+		logger.Warnf("not releasing address %s, it's allocated to %s", prefix.Addr(), owner)
+
+		return nil
+	}
+
+	if err := s.fs.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing allocation for %s: %w", prefix.Addr(), err)
+	}
+
+	logger.Infof("released address %s to pool %s", prefix.Addr(), p.name)
+
+	return nil
+}
+
+// findOwned returns the address in the pool allocated to the owner, if there is one.
+func (s *ipamService) findOwned(p *pool, owner string) (netip.Addr, error) {
+	poolDir := s.poolDir(p)
+
+	names, err := afero.ReadDir(s.fs, poolDir)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("reading ipam state directory %s: %w", poolDir, err)
+	}
+
+	for _, info := range names {
+		addr, err := netip.ParseAddr(info.Name())
+		if err != nil {
+			continue
+		}
+
+		data, err := afero.ReadFile(s.fs, filepath.Join(poolDir, info.Name()))
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("reading allocation for %s: %w", addr, err)
+		}
+
+		if string(data) == owner {
+			return addr, nil
+		}
+	}
+
+	return netip.Addr{}, nil
+}
+
+func (s *ipamService) allocation(p *pool, addr netip.Addr) *ports.IPAllocation {
+	bits := strconv.Itoa(p.prefix.Bits())
+	allocation := &ports.IPAllocation{
+		Pool: p.name,
+		Address: models.StaticAddress{
+			Address:     models.IPAddressCIDR(addr.String() + "/" + bits),
+			Nameservers: p.nameservers,
+		},
+	}
+
+	if p.gateway.IsValid() {
+		gateway := models.IPAddressCIDR(p.gateway.String() + "/" + bits)
+		allocation.Address.Gateway = &gateway
+	}
+
+	return allocation
+}
+
+func (s *ipamService) poolName(bridgeName string) string {
+	if bridgeName == "" {
+		return s.defaultBridge
+	}
+
+	return bridgeName
+}
+
+func (s *ipamService) poolDir(p *pool) string {
+	return filepath.Join(s.stateDir, p.name)
+}
+
+func (s *ipamService) allocationPath(p *pool, addr netip.Addr) string {
+	return filepath.Join(s.poolDir(p), addr.String())
+}
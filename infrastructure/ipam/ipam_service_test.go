@@ -0,0 +1,195 @@
+package ipam_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/ipam"
+)
+
+const (
+	poolsFile = "/etc/flintlock/pools.yaml"
+	stateDir  = "/var/lib/flintlock/ipam"
+)
+
+const testPools = `
+pools:
+- bridge: br0
+  cidr: 192.168.10.0/29
+  gateway: 192.168.10.1
+  nameservers:
+  - 1.1.1.1
+  reserved:
+  - 192.168.10.2-192.168.10.3
+- bridge: br1
+  cidr: 10.20.0.0/30
+`
+
+func TestIPAM_Allocate(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	fs := newTestFs(g, testPools)
+	svc := newTestService(g, fs)
+
+	g.Expect(svc.HasPool("")).To(BeTrue())
+	g.Expect(svc.HasPool("br1")).To(BeTrue())
+	g.Expect(svc.HasPool("br2")).To(BeFalse())
+
+	first, err := svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm1/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(first.Pool).To(Equal("br0"))
+	g.Expect(first.Address.Address).To(Equal(models.IPAddressCIDR("192.168.10.4/29")))
+	g.Expect(first.Address.Gateway).NotTo(BeNil())
+	g.Expect(*first.Address.Gateway).To(Equal(models.IPAddressCIDR("192.168.10.1/29")))
+	g.Expect(first.Address.Nameservers).To(ConsistOf("1.1.1.1"))
+
+	again, err := svc.Allocate(ctx, ports.IPAllocateInput{BridgeName: "br0", Owner: "vm1/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again.Address.Address).To(Equal(first.Address.Address), "allocating to the same owner again")
+
+	second, err := svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm2/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(second.Address.Address).To(Equal(models.IPAddressCIDR("192.168.10.5/29")))
+
+	third, err := svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm3/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(third.Address.Address).To(Equal(models.IPAddressCIDR("192.168.10.6/29")))
+
+	_, err = svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm4/eth1"})
+	g.Expect(err).To(MatchError(ContainSubstring("no free addresses")))
+
+	other, err := svc.Allocate(ctx, ports.IPAllocateInput{BridgeName: "br1", Owner: "vm4/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(other.Pool).To(Equal("br1"))
+	g.Expect(other.Address.Address).To(Equal(models.IPAddressCIDR("10.20.0.1/30")))
+	g.Expect(other.Address.Gateway).To(BeNil())
+
+	_, err = svc.Allocate(ctx, ports.IPAllocateInput{BridgeName: "br2", Owner: "vm5/eth1"})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestIPAM_AllocationsSurviveRestart(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	fs := newTestFs(g, testPools)
+
+	allocated, err := newTestService(g, fs).Allocate(ctx, ports.IPAllocateInput{Owner: "vm1/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	restarted := newTestService(g, fs)
+
+	again, err := restarted.Allocate(ctx, ports.IPAllocateInput{Owner: "vm1/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again.Address.Address).To(Equal(allocated.Address.Address))
+
+	other, err := restarted.Allocate(ctx, ports.IPAllocateInput{Owner: "vm2/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(other.Address.Address).NotTo(Equal(allocated.Address.Address))
+}
+
+func TestIPAM_Release(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	fs := newTestFs(g, testPools)
+	svc := newTestService(g, fs)
+
+	allocated, err := svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm1/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	release := ports.IPReleaseInput{
+		Pool:    allocated.Pool,
+		Address: allocated.Address.Address,
+		Owner:   "vm2/eth1",
+	}
+	g.Expect(svc.Release(ctx, release)).To(Succeed())
+
+	exists, err := afero.Exists(fs, stateDir+"/br0/192.168.10.4")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue(), "address released by an owner it isn't allocated to")
+
+	release.Owner = "vm1/eth1"
+	g.Expect(svc.Release(ctx, release)).To(Succeed())
+	g.Expect(svc.Release(ctx, release)).To(Succeed(), "releasing an address twice")
+
+	reused, err := svc.Allocate(ctx, ports.IPAllocateInput{Owner: "vm2/eth1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reused.Address.Address).To(Equal(allocated.Address.Address))
+}
+
+func TestIPAM_InvalidPools(t *testing.T) {
+	testCases := []struct {
+		name  string
+		pools string
+	}{
+		{
+			name:  "missing bridge",
+			pools: "pools:\n- cidr: 192.168.10.0/24\n",
+		},
+		{
+			name:  "invalid cidr",
+			pools: "pools:\n- bridge: br0\n  cidr: 192.168.10.0\n",
+		},
+		{
+			name:  "duplicate bridge",
+			pools: "pools:\n- bridge: br0\n  cidr: 192.168.10.0/24\n- bridge: br0\n  cidr: 192.168.11.0/24\n",
+		},
+		{
+			name:  "gateway outside subnet",
+			pools: "pools:\n- bridge: br0\n  cidr: 192.168.10.0/24\n  gateway: 192.168.11.1\n",
+		},
+		{
+			name:  "reversed reserved range",
+			pools: "pools:\n- bridge: br0\n  cidr: 192.168.10.0/24\n  reserved:\n  - 192.168.10.20-192.168.10.10\n",
+		},
+		{
+			name:  "unknown field",
+			pools: "pools:\n- bridge: br0\n  subnet: 192.168.10.0/24\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := ipam.New(&ipam.Config{
+				PoolsFile: poolsFile,
+				StateDir:  stateDir,
+			}, newTestFs(g, tc.pools))
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestIPAM_NoPoolsFile(t *testing.T) {
+	g := NewWithT(t)
+
+	svc, err := ipam.New(&ipam.Config{StateDir: stateDir, DefaultBridge: "br0"}, afero.NewMemMapFs())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svc.HasPool("")).To(BeFalse())
+}
+
+func newTestFs(g *WithT, pools string) afero.Fs {
+	fs := afero.NewMemMapFs()
+	g.Expect(afero.WriteFile(fs, poolsFile, []byte(pools), 0o600)).To(Succeed())
+
+	return fs
+}
+
+func newTestService(g *WithT, fs afero.Fs) ports.IPAMService {
+	svc, err := ipam.New(&ipam.Config{
+		PoolsFile:     poolsFile,
+		StateDir:      stateDir,
+		DefaultBridge: "br0",
+	}, fs)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return svc
+}
@@ -54,3 +54,86 @@ func TestBuildArgs_NoVsockWhenGuestAgentDisabled(t *testing.T) {
 	g.Expect(err).NotTo(g.HaveOccurred())
 	g.Expect(strings.Join(args, " ")).NotTo(g.ContainSubstring("--vsock"))
 }
+
+func TestBuildArgs_NetworkOptions(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	p, _, state := newTestProvider(t)
+
+	disabled := false
+	rateLimit := &models.RateLimit{
+		Bandwidth: &models.TokenBucket{Size: 1048576, OneTimeBurst: 2097152, RefillTimeMs: 100},
+		Ops:       &models.TokenBucket{Size: 1000, RefillTimeMs: 1000},
+	}
+	vm := vmForArgs(false)
+	vm.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{
+			GuestDeviceName: "eth0",
+			GuestMAC:        "AA:FF:00:00:00:01",
+			Type:            models.IfaceTypeTap,
+		},
+		{
+			GuestDeviceName: "eth1",
+			GuestMAC:        "AA:FF:00:00:00:02",
+			Type:            models.IfaceTypeTap,
+			MTU:             9000,
+			NumQueues:       4,
+			Offloads:        &models.NetworkOffloads{TSO: &disabled},
+		},
+		{
+			GuestDeviceName: "eth2",
+			GuestMAC:        "AA:FF:00:00:00:03",
+			Type:            models.IfaceTypeTap,
+			RateLimits:      &models.NetworkRateLimits{Rx: rateLimit, Tx: rateLimit},
+		},
+		{
+			GuestDeviceName: "eth3",
+			Type:            models.IfaceTypeTap,
+		},
+	}
+	vm.Status.NetworkInterfaces = models.NetworkInterfaceStatuses{
+		"eth0": &models.NetworkInterfaceStatus{HostDeviceName: "fltap0"},
+		"eth1": &models.NetworkInterfaceStatus{HostDeviceName: "fltap1"},
+		"eth2": &models.NetworkInterfaceStatus{HostDeviceName: "fltap2"},
+		"eth3": &models.NetworkInterfaceStatus{HostDeviceName: "fltap3", GuestMACAddress: "0a:00:00:00:00:04"},
+	}
+
+	args, err := p.buildArgs(vm, state, nil)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(args).To(g.ContainElements(
+		"tap=fltap0,mac=AA:FF:00:00:00:01,id=net-eth0",
+		"tap=fltap1,mac=AA:FF:00:00:00:02,mtu=9000,num_queues=8,offload_tso=off,id=net-eth1",
+		"tap=fltap2,mac=AA:FF:00:00:00:03,bw_size=1048576,bw_refill_time=100,bw_one_time_burst=2097152,"+
+			"ops_size=1000,ops_refill_time=1000,id=net-eth2",
+		"tap=fltap3,mac=0a:00:00:00:00:04,id=net-eth3",
+	))
+}
+
+func TestBuildArgs_DiskOptions(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	p, _, state := newTestProvider(t)
+
+	vm := vmForArgs(false)
+	vm.Spec.RootVolume.CacheMode = models.VolumeCacheModeDirect
+	vm.Spec.AdditionalVolumes = models.Volumes{
+		{
+			ID:       "data",
+			IOEngine: models.VolumeIOEngineSync,
+			RateLimit: &models.RateLimit{
+				Ops: &models.TokenBucket{Size: 500, RefillTimeMs: 1000},
+			},
+		},
+		{ID: "logs", CacheMode: models.VolumeCacheModeWriteback},
+	}
+	vm.Status.Volumes["data"] = &models.VolumeStatus{Mount: models.Mount{Source: "/data.img"}}
+	vm.Status.Volumes["logs"] = &models.VolumeStatus{Mount: models.Mount{Source: "/logs.img"}}
+
+	args, err := p.buildArgs(vm, state, nil)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(args).To(g.ContainElements(
+		"path=/root.img,direct=on",
+		"path=/data.img,disable_io_uring=on,disable_aio=on,ops_size=500,ops_refill_time=1000,id=disk-data",
+		"path=/logs.img,id=disk-logs",
+	))
+}
@@ -1,11 +1,12 @@
 package cloudhypervisor
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/sirupsen/logrus"
@@ -13,6 +14,7 @@ import (
 
 	cerrors "github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
 	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
 	"github.com/liquidmetal-dev/flintlock/pkg/process"
@@ -20,6 +22,9 @@ import (
 	virtiofs "github.com/liquidmetal-dev/flintlock/infrastructure/virtiofs"
 )
 
+// queuesPerPair is the number of queues of each queue pair of a network interface.
+const queuesPerPair = 2
+
 // Create will create a new microvm.
 func (p *provider) Create(ctx context.Context, vm *models.MicroVM) error {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
@@ -88,12 +93,19 @@ func (p *provider) startCloudHypervisor(_ context.Context,
 	detached bool,
 	logger *logrus.Entry,
 ) (*os.Process, error) {
-	var startErr error
-
 	args, err := p.buildArgs(vm, state, logger)
 	if err != nil {
 		return nil, err
 	}
+
+	return p.startProcess(args, state, detached, vm.Status.NetworkNamespace)
+}
+
+func (p *provider) startProcess(args []string,
+	state State,
+	detached bool,
+	networkNamespace string,
+) (*os.Process, error) {
 	// #nosec
 	cmd := exec.Command(p.config.CloudHypervisorBin, args...)
 
@@ -107,30 +119,27 @@ func (p *provider) startCloudHypervisor(_ context.Context,
 		return nil, fmt.Errorf("opening sterr file %s: %w", state.StderrPath(), err)
 	}
 
+	// The serial console of the guest is wired to stdin/stdout of the vmm. Stdin is a named
+	// pipe in the state directory so input can be sent to the console later on.
+	stdInFile, err := shared.OpenConsoleInput(state.StdinPath())
+	if err != nil {
+		return nil, fmt.Errorf("opening stdin: %w", err)
+	}
+	defer stdInFile.Close()
+
 	cmd.Stderr = stdErrFile
 	cmd.Stdout = stdOutFile
-	cmd.Stdin = &bytes.Buffer{}
-	if detached {
-		startErr = process.DetachedStart(cmd)
-	} else {
-		startErr = cmd.Start()
-	}
+	cmd.Stdin = stdInFile
 
-	if startErr != nil {
-		return nil, fmt.Errorf("starting cloud hypervisor process: %w", startErr)
+	if err := shared.StartProcess(cmd, detached, networkNamespace); err != nil {
+		return nil, fmt.Errorf("starting cloud hypervisor process: %w", err)
 	}
 
 	return cmd.Process, nil
 }
 
 func (p *provider) buildArgs(vm *models.MicroVM, state State, _ *logrus.Entry) ([]string, error) {
-	args := []string{
-		"--api-socket",
-		state.SockPath(),
-		"--log-file",
-		state.LogPath(),
-		"-v",
-	}
+	args := apiArgs(state)
 
 	// Kernel and cmdline args
 	kernelCmdLine := DefaultKernelCmdLine()
@@ -150,7 +159,7 @@ func (p *provider) buildArgs(vm *models.MicroVM, state State, _ *logrus.Entry) (
 	if !volumeStatusFound {
 		return nil, cerrors.NewVolumeNotMounted(vm.Spec.RootVolume.ID)
 	}
-	args = append(args, "--disk", "path="+rootVolumeStatus.Mount.Source)
+	args = append(args, "--disk", "path="+rootVolumeStatus.Mount.Source+diskOptions(&vm.Spec.RootVolume))
 	args = append(args, fmt.Sprintf("path=%s,readonly=on", state.CloudInitImage()))
 
 	hasVirtioFS := false
@@ -164,7 +173,7 @@ func (p *provider) buildArgs(vm *models.MicroVM, state State, _ *logrus.Entry) (
 			args = append(args, "--fs", fmt.Sprintf("tag=user,socket=%s,num_queues=1,queue_size=1024", vfsstate.VirtioFSPath()))
 			hasVirtioFS = true
 		} else {
-			args = append(args, "path="+status.Mount.Source)
+			args = append(args, "path="+status.Mount.Source+diskOptions(&vol)+",id="+diskDevicePrefix+vol.ID)
 		}
 	}
 	if hasVirtioFS {
@@ -189,9 +198,10 @@ func (p *provider) buildArgs(vm *models.MicroVM, state State, _ *logrus.Entry) (
 			if err != nil {
 				return nil, fmt.Errorf("creating macvtap interface: %w", err)
 			}
-			args = append(args, arg)
+			args = append(args, arg+",id="+netDevicePrefix+iface.GuestDeviceName)
 		case iface.Type == models.IfaceTypeTap:
-			args = append(args, fmt.Sprintf("tap=%s,mac=%s", status.HostDeviceName, iface.GuestMAC))
+			tapArg := fmt.Sprintf("tap=%s,mac=%s", status.HostDeviceName, iface.GuestMACAddress(status))
+			args = append(args, tapArg+netOptions(&iface)+",id="+netDevicePrefix+iface.GuestDeviceName)
 		default:
 			return nil, fmt.Errorf("unknown network interface type %v for %s", iface.Type, iface.GuestDeviceName)
 		}
@@ -206,21 +216,124 @@ func (p *provider) buildArgs(vm *models.MicroVM, state State, _ *logrus.Entry) (
 	return args, nil
 }
 
+// apiArgs are the arguments to start cloud-hypervisor with its API socket and logging.
+func apiArgs(state State) []string {
+	return []string{
+		"--api-socket",
+		state.SockPath(),
+		"--log-file",
+		state.LogPath(),
+		"-v",
+	}
+}
+
 func (p *provider) createMacvtapArg(netInt *models.NetworkInterface,
 	status *models.NetworkInterfaceStatus,
 ) (string, error) {
 	hostDevName := fmt.Sprintf("/dev/tap%d", status.Index)
-	fd, err := syscall.Open(hostDevName, syscall.O_RDWR, 755)
-	if err != nil {
-		return "", fmt.Errorf("getting file description for %s: %w", hostDevName, err)
+
+	// Each queue pair of a macvtap device is a file descriptor of the device.
+	numFds := max(netInt.NumQueues, 1)
+	fds := make([]string, 0, numFds)
+
+	for range numFds {
+		fd, err := syscall.Open(hostDevName, syscall.O_RDWR, 755)
+		if err != nil {
+			return "", fmt.Errorf("getting file description for %s: %w", hostDevName, err)
+		}
+
+		fds = append(fds, strconv.Itoa(fd))
+	}
+
+	arg := fmt.Sprintf("fd=%s,mac=", fds[0])
+	if len(fds) > 1 {
+		arg = fmt.Sprintf("fd=[%s],mac=", strings.Join(fds, ","))
 	}
 
-	arg := fmt.Sprintf("fd=%d,mac=", fd)
 	if netInt.GuestMAC != "" {
 		arg += netInt.GuestMAC
 	}
 
-	return arg, nil
+	return arg + netOptions(netInt), nil
+}
+
+// netOptions are the options of the --net argument for the MTU, queues, offloads and rate
+// limits of an interface, if they're set.
+func netOptions(netInt *models.NetworkInterface) string {
+	options := ""
+
+	if netInt.MTU != 0 {
+		options += fmt.Sprintf(",mtu=%d", netInt.MTU)
+	}
+
+	// Cloud Hypervisor counts the receive and transmit queues of each pair.
+	if netInt.NumQueues > 1 {
+		options += fmt.Sprintf(",num_queues=%d", netInt.NumQueues*queuesPerPair)
+	}
+
+	if netInt.Offloads != nil {
+		options += offloadOption("offload_tso", netInt.Offloads.TSO)
+		options += offloadOption("offload_ufo", netInt.Offloads.UFO)
+		options += offloadOption("offload_csum", netInt.Offloads.Checksum)
+	}
+
+	// Cloud Hypervisor limits received and transmitted traffic with the same rate limiter, so
+	// they're only accepted when they're the same.
+	if netInt.RateLimits != nil {
+		options += rateLimitOptions(netInt.RateLimits.Rx)
+	}
+
+	return options
+}
+
+// diskOptions are the options of a --disk argument for the cache mode, I/O engine and rate
+// limit of a volume, if they're set. Cloud Hypervisor uses the page cache of the host and
+// io_uring by default, and has no mode that ignores flushes from the guest.
+func diskOptions(vol *models.Volume) string {
+	options := ""
+
+	if vol.CacheMode == models.VolumeCacheModeDirect {
+		options += ",direct=on"
+	}
+
+	if vol.IOEngine == models.VolumeIOEngineSync {
+		options += ",disable_io_uring=on,disable_aio=on"
+	}
+
+	return options + rateLimitOptions(vol.RateLimit)
+}
+
+// rateLimitOptions are the options of a --net or --disk argument for a rate limit.
+func rateLimitOptions(rateLimit *models.RateLimit) string {
+	if rateLimit == nil {
+		return ""
+	}
+
+	return tokenBucketOptions("bw", rateLimit.Bandwidth) + tokenBucketOptions("ops", rateLimit.Ops)
+}
+
+func tokenBucketOptions(prefix string, bucket *models.TokenBucket) string {
+	if bucket == nil {
+		return ""
+	}
+
+	options := fmt.Sprintf(",%[1]s_size=%[2]d,%[1]s_refill_time=%[3]d", prefix, bucket.Size, bucket.RefillTimeMs)
+	if bucket.OneTimeBurst != 0 {
+		options += fmt.Sprintf(",%s_one_time_burst=%d", prefix, bucket.OneTimeBurst)
+	}
+
+	return options
+}
+
+func offloadOption(name string, enabled *bool) string {
+	switch {
+	case enabled == nil:
+		return ""
+	case *enabled:
+		return fmt.Sprintf(",%s=on", name)
+	default:
+		return fmt.Sprintf(",%s=off", name)
+	}
 }
 
 func (p *provider) ensureState(vmState State) error {
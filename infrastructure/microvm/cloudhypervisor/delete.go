@@ -21,7 +21,7 @@ const (
 	shutdownCheckIntervalMS = 500
 )
 
-// Stop will stop a running microvm.
+// Delete will stop a running microvm and its cloud-hypervisor process.
 func (p *provider) Delete(ctx context.Context, id string) error {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"service": "cloudhypervisor_microvm",
@@ -49,8 +49,32 @@ func (p *provider) Delete(ctx context.Context, id string) error {
 		return nil
 	}
 
-	chClient := cloudhypervisor.New(vmState.SockPath())
+	if stopErr := p.shutdown(ctx, cloudhypervisor.New(vmState.SockPath())); stopErr != nil {
+		return stopErr
+	}
+
+	logger.Debugf("sending SIGHUP to %d", pid)
+
+	if sigErr := process.SendSignal(pid, syscall.SIGHUP); sigErr != nil {
+		return fmt.Errorf("failed to terminate with SIGHUP: %w", sigErr)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, p.deleteVMTimeout)
+	defer cancel()
+
+	// Make sure the microVM is stopped.
+	if err := process.WaitWithContext(ctxTimeout, pid); err != nil {
+		return fmt.Errorf("failed to wait for pid %d: %w", pid, err)
+	}
+
+	logger.Info("deleted microvm")
+
+	return nil
+}
 
+// shutdown will shut down the vm and wait for it to stop. The cloud-hypervisor
+// process is left running.
+func (p *provider) shutdown(ctx context.Context, chClient cloudhypervisor.Client) error {
 	if shutdownErr := chClient.Shutdown(ctx); shutdownErr != nil {
 		return fmt.Errorf("shutting down cloud-hypervisor vm: %w", shutdownErr)
 	}
@@ -73,21 +97,5 @@ func (p *provider) Delete(ctx context.Context, id string) error {
 		}
 	}
 
-	logger.Debugf("sending SIGHUP to %d", pid)
-
-	if sigErr := process.SendSignal(pid, syscall.SIGHUP); sigErr != nil {
-		return fmt.Errorf("failed to terminate with SIGHUP: %w", sigErr)
-	}
-
-	ctxTimeout, cancel := context.WithTimeout(ctx, p.deleteVMTimeout)
-	defer cancel()
-
-	// Make sure the microVM is stopped.
-	if err := process.WaitWithContext(ctxTimeout, pid); err != nil {
-		return fmt.Errorf("failed to wait for pid %d: %w", pid, err)
-	}
-
-	logger.Info("deleted microvm")
-
 	return nil
 }
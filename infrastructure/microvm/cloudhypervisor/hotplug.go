@@ -0,0 +1,276 @@
+package cloudhypervisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	cerrors "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/cloudhypervisor"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	netDevicePrefix  = "net-"
+	diskDevicePrefix = "disk-"
+)
+
+// UpdateDevices will hotplug the network interfaces and volumes of a running microvm so they
+// match its spec. The devices the microvm has are matched by their id, or by their tap device,
+// mac address or path for devices that were added without an id.
+func (p *provider) UpdateDevices(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Debug("updating microvm devices")
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+	chClient := cloudhypervisor.New(vmState.SockPath())
+
+	info, err := chClient.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("getting microvm info: %w", err)
+	}
+
+	// Devices are removed first so a device that's been replaced can be added with the same id.
+	if err := removeDevices(ctx, chClient, vm, &info.Config, logger); err != nil {
+		return err
+	}
+
+	return addDevices(ctx, chClient, vm, &info.Config, logger)
+}
+
+func removeDevices(ctx context.Context,
+	chClient cloudhypervisor.Client,
+	vm *models.MicroVM,
+	config *cloudhypervisor.VMConfig,
+	logger *logrus.Entry,
+) error {
+	for name, status := range vm.Status.NetworkInterfaces {
+		if hasNetworkInterface(vm, name) {
+			continue
+		}
+
+		id, found := findNet(config.Net, netDevicePrefix+name, status.HostDeviceName, "")
+		if !found {
+			continue
+		}
+
+		logger.Debugf("removing network interface %s", name)
+
+		if err := chClient.RemoveDevice(ctx, &cloudhypervisor.VMRemoveDevice{ID: &id}); err != nil {
+			return fmt.Errorf("removing network interface %s: %w", name, err)
+		}
+	}
+
+	for volID, status := range vm.Status.Volumes {
+		if volID == vm.Spec.RootVolume.ID || vm.Spec.AdditionalVolumes.GetByID(volID) != nil {
+			continue
+		}
+
+		id, found := findDisk(config.Disks, diskDevicePrefix+volID, status.Mount.Source)
+		if !found {
+			continue
+		}
+
+		logger.Debugf("removing volume %s", volID)
+
+		if err := chClient.RemoveDevice(ctx, &cloudhypervisor.VMRemoveDevice{ID: &id}); err != nil {
+			return fmt.Errorf("removing volume %s: %w", volID, err)
+		}
+	}
+
+	return nil
+}
+
+func addDevices(ctx context.Context,
+	chClient cloudhypervisor.Client,
+	vm *models.MicroVM,
+	config *cloudhypervisor.VMConfig,
+	logger *logrus.Entry,
+) error {
+	for i := range vm.Spec.NetworkInterfaces {
+		iface := &vm.Spec.NetworkInterfaces[i]
+
+		status, ok := vm.Status.NetworkInterfaces[iface.GuestDeviceName]
+		if !ok {
+			return cerrors.NewNetworkInterfaceStatusMissing(iface.GuestDeviceName)
+		}
+
+		id := netDevicePrefix + iface.GuestDeviceName
+		if _, found := findNet(config.Net, id, status.HostDeviceName, iface.GuestMACAddress(status)); found {
+			continue
+		}
+
+		// A macvtap device is passed to cloud-hypervisor as file descriptors, which can't be
+		// sent with the API.
+		if iface.Type != models.IfaceTypeTap {
+			return cerrors.NewNotSupported("hotplugging macvtap network interfaces")
+		}
+
+		logger.Debugf("adding network interface %s", iface.GuestDeviceName)
+
+		if _, err := chClient.AddNetworkDevice(ctx, netConfig(iface, status, id)); err != nil {
+			return fmt.Errorf("adding network interface %s: %w", iface.GuestDeviceName, err)
+		}
+	}
+
+	for i := range vm.Spec.AdditionalVolumes {
+		vol := &vm.Spec.AdditionalVolumes[i]
+
+		// Virtiofs volumes need shared memory, so they can only be added when the microvm is created.
+		if vol.Source.VirtioFS != nil {
+			continue
+		}
+
+		status, ok := vm.Status.Volumes[vol.ID]
+		if !ok {
+			return cerrors.NewVolumeNotMounted(vol.ID)
+		}
+
+		id := diskDevicePrefix + vol.ID
+		if _, found := findDisk(config.Disks, id, status.Mount.Source); found {
+			continue
+		}
+
+		logger.Debugf("adding volume %s", vol.ID)
+
+		if _, err := chClient.AddDisk(ctx, diskConfig(vol, status, id)); err != nil {
+			return fmt.Errorf("adding volume %s: %w", vol.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// findNet returns the id of the network device with the id, tap device or mac address.
+func findNet(nets []cloudhypervisor.NetConfig, id, tap, mac string) (string, bool) {
+	for _, netConfig := range nets {
+		if netConfig.ID == nil {
+			continue
+		}
+
+		if *netConfig.ID == id ||
+			(tap != "" && netConfig.Tap != nil && *netConfig.Tap == tap) ||
+			(mac != "" && netConfig.Mac != nil && strings.EqualFold(*netConfig.Mac, mac)) {
+			return *netConfig.ID, true
+		}
+	}
+
+	return "", false
+}
+
+// findDisk returns the id of the disk with the id or path.
+func findDisk(disks []cloudhypervisor.DiskConfig, id, path string) (string, bool) {
+	for _, diskConfig := range disks {
+		if diskConfig.ID == nil {
+			continue
+		}
+
+		if *diskConfig.ID == id || (path != "" && diskConfig.Path == path) {
+			return *diskConfig.ID, true
+		}
+	}
+
+	return "", false
+}
+
+// netConfig is the config of a network device with the same options as the --net argument.
+func netConfig(iface *models.NetworkInterface,
+	status *models.NetworkInterfaceStatus,
+	id string,
+) *cloudhypervisor.NetConfig {
+	mac := iface.GuestMACAddress(status)
+
+	config := &cloudhypervisor.NetConfig{
+		ID:  &id,
+		Tap: &status.HostDeviceName,
+		Mac: &mac,
+	}
+
+	if iface.MTU != 0 {
+		mtu := int32(iface.MTU)
+		config.Mtu = &mtu
+	}
+
+	if iface.NumQueues > 1 {
+		numQueues := int32(iface.NumQueues * queuesPerPair)
+		config.NumQueues = &numQueues
+	}
+
+	if iface.Offloads != nil {
+		config.OffloadTso = iface.Offloads.TSO
+		config.OffloadUfo = iface.Offloads.UFO
+		config.OffloadCsum = iface.Offloads.Checksum
+	}
+
+	if iface.RateLimits != nil {
+		config.RateLimiterConfig = rateLimiterConfig(iface.RateLimits.Rx)
+	}
+
+	return config
+}
+
+// diskConfig is the config of a disk with the same options as the --disk argument.
+func diskConfig(vol *models.Volume, status *models.VolumeStatus, id string) *cloudhypervisor.DiskConfig {
+	config := &cloudhypervisor.DiskConfig{
+		ID:                &id,
+		Path:              status.Mount.Source,
+		RateLimiterConfig: rateLimiterConfig(vol.RateLimit),
+	}
+
+	if vol.CacheMode == models.VolumeCacheModeDirect {
+		direct := true
+		config.Direct = &direct
+	}
+
+	if vol.IOEngine == models.VolumeIOEngineSync {
+		disabled := true
+		config.DisableIoUring = &disabled
+		config.DisableAio = &disabled
+	}
+
+	return config
+}
+
+func rateLimiterConfig(rateLimit *models.RateLimit) *cloudhypervisor.RateLimiterConfig {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &cloudhypervisor.RateLimiterConfig{
+		Bandwidth: tokenBucket(rateLimit.Bandwidth),
+		Ops:       tokenBucket(rateLimit.Ops),
+	}
+}
+
+func tokenBucket(bucket *models.TokenBucket) *cloudhypervisor.TokenBucket {
+	if bucket == nil {
+		return nil
+	}
+
+	converted := &cloudhypervisor.TokenBucket{
+		Size:       bucket.Size,
+		RefillTime: bucket.RefillTimeMs,
+	}
+
+	if bucket.OneTimeBurst != 0 {
+		converted.OneTimeBurst = &bucket.OneTimeBurst
+	}
+
+	return converted
+}
+
+func hasNetworkInterface(vm *models.MicroVM, name string) bool {
+	for i := range vm.Spec.NetworkInterfaces {
+		if vm.Spec.NetworkInterfaces[i].GuestDeviceName == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package cloudhypervisor
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/cloudhypervisor"
+)
+
+// fakeCHDevices records the devices added to and removed from a fake cloud-hypervisor.
+type fakeCHDevices struct {
+	nets    []cloudhypervisor.NetConfig
+	disks   []cloudhypervisor.DiskConfig
+	removed []string
+}
+
+// serveFakeCHHotplug stands up a cloud-hypervisor-like API server with the devices in the
+// config that records the devices that are hotplugged.
+func serveFakeCHHotplug(t *testing.T, sockPath string, config cloudhypervisor.VMConfig) *fakeCHDevices {
+	t.Helper()
+
+	listener, err := net.Listen("unix", sockPath)
+	g.Expect(err).NotTo(g.HaveOccurred())
+
+	devices := &fakeCHDevices{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/vm.info", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(cloudhypervisor.VMInfo{Config: config, State: cloudhypervisor.VMStateRunning})
+	})
+	mux.HandleFunc("/api/v1/vm.add-net", func(w http.ResponseWriter, r *http.Request) {
+		netConfig := cloudhypervisor.NetConfig{}
+		_ = json.NewDecoder(r.Body).Decode(&netConfig)
+		devices.nets = append(devices.nets, netConfig)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/vm.add-disk", func(w http.ResponseWriter, r *http.Request) {
+		diskConfig := cloudhypervisor.DiskConfig{}
+		_ = json.NewDecoder(r.Body).Decode(&diskConfig)
+		devices.disks = append(devices.disks, diskConfig)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/vm.remove-device", func(w http.ResponseWriter, r *http.Request) {
+		removeDevice := cloudhypervisor.VMRemoveDevice{}
+		_ = json.NewDecoder(r.Body).Decode(&removeDevice)
+		devices.removed = append(devices.removed, *removeDevice.ID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	srv := &http.Server{Handler: mux} //nolint:gosec // test server
+	go func() { _ = srv.Serve(listener) }()
+
+	t.Cleanup(func() { _ = srv.Close() })
+
+	return devices
+}
+
+func hotplugTestVM(t *testing.T, id string) *models.MicroVM {
+	t.Helper()
+
+	vmid, err := models.NewVMIDFromString(id)
+	g.Expect(err).NotTo(g.HaveOccurred())
+
+	vm := vmForArgs(false)
+	vm.ID = *vmid
+	vm.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{GuestDeviceName: "eth0", GuestMAC: "AA:FF:00:00:00:01", Type: models.IfaceTypeTap},
+		{GuestDeviceName: "eth2", GuestMAC: "AA:FF:00:00:00:03", Type: models.IfaceTypeTap, MTU: 9000},
+	}
+	vm.Spec.AdditionalVolumes = models.Volumes{
+		{ID: "data"},
+		{ID: "logs", CacheMode: models.VolumeCacheModeDirect},
+	}
+	vm.Status.NetworkInterfaces = models.NetworkInterfaceStatuses{
+		"eth0": &models.NetworkInterfaceStatus{HostDeviceName: "fltap0"},
+		"eth1": &models.NetworkInterfaceStatus{HostDeviceName: "fltap1"},
+		"eth2": &models.NetworkInterfaceStatus{HostDeviceName: "fltap2"},
+	}
+	vm.Status.Volumes["data"] = &models.VolumeStatus{Mount: models.Mount{Source: "/data.img"}}
+	vm.Status.Volumes["logs"] = &models.VolumeStatus{Mount: models.Mount{Source: "/logs.img"}}
+	vm.Status.Volumes["old"] = &models.VolumeStatus{Mount: models.Mount{Source: "/old.img"}}
+
+	return vm
+}
+
+func TestProviderUpdateDevices(t *testing.T) {
+	g.RegisterTestingT(t)
+	ctx := context.Background()
+
+	p, id, vmState := newTestProvider(t)
+
+	// eth1 and the old volume were added before devices had ids.
+	devices := serveFakeCHHotplug(t, vmState.SockPath(), cloudhypervisor.VMConfig{
+		Net: []cloudhypervisor.NetConfig{
+			{ID: ptr("net-eth0"), Tap: ptr("fltap0")},
+			{ID: ptr("_net1"), Tap: ptr("fltap1")},
+		},
+		Disks: []cloudhypervisor.DiskConfig{
+			{ID: ptr("_disk0"), Path: "/root.img"},
+			{ID: ptr("disk-data"), Path: "/data.img"},
+			{ID: ptr("_disk3"), Path: "/old.img"},
+		},
+	})
+
+	g.Expect(p.UpdateDevices(ctx, hotplugTestVM(t, id))).To(g.Succeed())
+
+	g.Expect(devices.removed).To(g.ConsistOf("_net1", "_disk3"))
+
+	g.Expect(devices.nets).To(g.HaveLen(1))
+	g.Expect(*devices.nets[0].ID).To(g.Equal("net-eth2"))
+	g.Expect(*devices.nets[0].Tap).To(g.Equal("fltap2"))
+	g.Expect(*devices.nets[0].Mac).To(g.Equal("AA:FF:00:00:00:03"))
+	g.Expect(*devices.nets[0].Mtu).To(g.Equal(int32(9000)))
+
+	g.Expect(devices.disks).To(g.HaveLen(1))
+	g.Expect(*devices.disks[0].ID).To(g.Equal("disk-logs"))
+	g.Expect(devices.disks[0].Path).To(g.Equal("/logs.img"))
+	g.Expect(*devices.disks[0].Direct).To(g.BeTrue())
+}
+
+func TestProviderUpdateDevices_Macvtap(t *testing.T) {
+	g.RegisterTestingT(t)
+	ctx := context.Background()
+
+	p, id, vmState := newTestProvider(t)
+
+	devices := serveFakeCHHotplug(t, vmState.SockPath(), cloudhypervisor.VMConfig{})
+
+	vm := hotplugTestVM(t, id)
+	vm.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{GuestDeviceName: "eth0", Type: models.IfaceTypeMacvtap},
+	}
+
+	g.Expect(p.UpdateDevices(ctx, vm)).NotTo(g.Succeed())
+	g.Expect(devices.nets).To(g.BeEmpty())
+}
+
+func ptr(s string) *string {
+	return &s
+}
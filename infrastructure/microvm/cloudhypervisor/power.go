@@ -0,0 +1,143 @@
+package cloudhypervisor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/cloudhypervisor"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// Start will boot a created or stopped microvm.
+func (p *provider) Start(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Info("starting microvm")
+
+	state, err := p.State(ctx, vm.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state != ports.MicroVMStateConfigured {
+		return fmt.Errorf("microvm must be configured to start, current state %s", state)
+	}
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+	chClient := cloudhypervisor.New(vmState.SockPath())
+
+	if err := chClient.Boot(ctx); err != nil {
+		return fmt.Errorf("booting cloud-hypervisor vm: %w", err)
+	}
+
+	logger.Info("started microvm")
+
+	return nil
+}
+
+// Stop will shut down a running microvm. The cloud-hypervisor process is kept
+// running so the microvm can be booted again.
+func (p *provider) Stop(ctx context.Context, id string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    id,
+	})
+	logger.Info("stopping microvm")
+
+	state, err := p.State(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state != ports.MicroVMStateRunning && state != ports.MicroVMStatePaused {
+		return nil
+	}
+
+	chClient, err := p.newClient(id)
+	if err != nil {
+		return err
+	}
+
+	if err := p.shutdown(ctx, chClient); err != nil {
+		return err
+	}
+
+	logger.Info("stopped microvm")
+
+	return nil
+}
+
+// Restart will reboot a running microvm.
+func (p *provider) Restart(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Info("restarting microvm")
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+	chClient := cloudhypervisor.New(vmState.SockPath())
+
+	if err := chClient.Reboot(ctx); err != nil {
+		return fmt.Errorf("rebooting cloud-hypervisor vm: %w", err)
+	}
+
+	return nil
+}
+
+// Pause will pause a running microvm.
+func (p *provider) Pause(ctx context.Context, id string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    id,
+	})
+	logger.Info("pausing microvm")
+
+	chClient, err := p.newClient(id)
+	if err != nil {
+		return err
+	}
+
+	if err := chClient.Pause(ctx); err != nil {
+		return fmt.Errorf("pausing cloud-hypervisor vm: %w", err)
+	}
+
+	return nil
+}
+
+// Resume will resume a paused microvm.
+func (p *provider) Resume(ctx context.Context, id string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    id,
+	})
+	logger.Info("resuming microvm")
+
+	chClient, err := p.newClient(id)
+	if err != nil {
+		return err
+	}
+
+	if err := chClient.Resume(ctx); err != nil {
+		return fmt.Errorf("resuming cloud-hypervisor vm: %w", err)
+	}
+
+	return nil
+}
+
+func (p *provider) newClient(id string) (cloudhypervisor.Client, error) {
+	vmid, err := models.NewVMIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing vmid: %w", err)
+	}
+
+	vmState := NewState(*vmid, p.config.StateRoot, p.fs)
+
+	return cloudhypervisor.New(vmState.SockPath()), nil
+}
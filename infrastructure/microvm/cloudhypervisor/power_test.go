@@ -0,0 +1,99 @@
+package cloudhypervisor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/cloudhypervisor"
+)
+
+// serveFakeCHPower stands up a cloud-hypervisor-like API server that tracks the
+// vm state across boot, pause, resume and shutdown calls.
+func serveFakeCHPower(t *testing.T, sockPath string, chState *cloudhypervisor.VMState) {
+	t.Helper()
+
+	listener, err := net.Listen("unix", sockPath)
+	g.Expect(err).NotTo(g.HaveOccurred())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/vm.info", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"state":%q}`, *chState)
+	})
+	mux.HandleFunc("/api/v1/vm.boot", func(w http.ResponseWriter, _ *http.Request) {
+		*chState = cloudhypervisor.VMStateRunning
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/vm.pause", func(w http.ResponseWriter, _ *http.Request) {
+		*chState = cloudhypervisor.VMStatePaused
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/vm.resume", func(w http.ResponseWriter, _ *http.Request) {
+		*chState = cloudhypervisor.VMStateRunning
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/vm.shutdown", func(w http.ResponseWriter, _ *http.Request) {
+		*chState = cloudhypervisor.VMStateShutdown
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	srv := &http.Server{Handler: mux} //nolint:gosec // test server
+	go func() { _ = srv.Serve(listener) }()
+
+	t.Cleanup(func() { _ = srv.Close() })
+}
+
+func TestProviderStopStart(t *testing.T) {
+	g.RegisterTestingT(t)
+	ctx := context.Background()
+
+	p, id, vmState := newTestProvider(t)
+	g.Expect(vmState.SetPid(startLiveProcess(t))).To(g.Succeed())
+
+	chState := cloudhypervisor.VMStateRunning
+	serveFakeCHPower(t, vmState.SockPath(), &chState)
+
+	vmid, err := models.NewVMIDFromString(id)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	vm := &models.MicroVM{ID: *vmid}
+
+	g.Expect(p.Start(ctx, vm)).NotTo(g.Succeed(), "starting a running vm should fail")
+
+	g.Expect(p.Stop(ctx, id)).To(g.Succeed())
+	g.Expect(chState).To(g.Equal(cloudhypervisor.VMStateShutdown))
+
+	g.Expect(p.Stop(ctx, id)).To(g.Succeed(), "stopping a stopped vm should be a no-op")
+
+	g.Expect(p.Start(ctx, vm)).To(g.Succeed())
+	g.Expect(chState).To(g.Equal(cloudhypervisor.VMStateRunning))
+}
+
+func TestProviderPauseResume(t *testing.T) {
+	g.RegisterTestingT(t)
+	ctx := context.Background()
+
+	p, id, vmState := newTestProvider(t)
+	g.Expect(vmState.SetPid(startLiveProcess(t))).To(g.Succeed())
+
+	chState := cloudhypervisor.VMStateRunning
+	serveFakeCHPower(t, vmState.SockPath(), &chState)
+
+	g.Expect(p.Pause(ctx, id)).To(g.Succeed())
+
+	state, err := p.State(ctx, id)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(state).To(g.Equal(ports.MicroVMStatePaused))
+
+	g.Expect(p.Resume(ctx, id)).To(g.Succeed())
+
+	state, err = p.State(ctx, id)
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(state).To(g.Equal(ports.MicroVMStateRunning))
+}
@@ -10,9 +10,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 
-	cerrs "github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
 	"github.com/liquidmetal-dev/flintlock/internal/config"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
 	"github.com/liquidmetal-dev/flintlock/pkg/process"
@@ -62,14 +62,15 @@ func (p *provider) Capabilities() models.Capabilities {
 		models.MacvtapCapability,
 		models.VirtioFSCapability,
 		models.VSockCapability,
+		models.PauseCapability,
+		models.SnapshotCapability,
+		models.NetworkQueuesCapability,
+		models.NetworkOffloadsCapability,
+		models.VolumeCacheDirectCapability,
+		models.HotplugCapability,
 	}
 }
 
-// Start will start a created microvm.
-func (p *provider) Start(_ context.Context, _ *models.MicroVM) error {
-	return cerrs.NewNotSupported("start")
-}
-
 // State returns the state of a microvm.
 func (p *provider) State(ctx context.Context, id string) (ports.MicroVMState, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
@@ -135,10 +136,14 @@ func (p *provider) State(ctx context.Context, id string) (ports.MicroVMState, er
 		return ports.MicroVMStateRunning, nil
 	}
 
-	// NOTE: we can support paused/unpause in the future
 	switch vmInfo.State {
 	case cloudhypervisor.VMStateRunning, cloudhypervisor.VMStateCreated:
 		return ports.MicroVMStateRunning, nil
+	case cloudhypervisor.VMStateShutdown:
+		// The vm has been stopped but the process is kept so it can be booted again.
+		return ports.MicroVMStateConfigured, nil
+	case cloudhypervisor.VMStatePaused:
+		return ports.MicroVMStatePaused, nil
 	default:
 		return ports.MicroVMStateUnknown, fmt.Errorf("cloud-hypervisor in an unsupported state: %s", vmInfo.State)
 	}
@@ -183,3 +188,8 @@ func DefaultKernelCmdLine() config.KernelCmdLine {
 		// "i8042.dumbkbd": "",
 	}
 }
+
+// Version returns the version reported by the cloud hypervisor binary.
+func (p *provider) Version(ctx context.Context) (string, error) {
+	return shared.BinaryVersion(ctx, p.config.CloudHypervisorBin)
+}
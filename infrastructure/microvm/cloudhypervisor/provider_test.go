@@ -171,14 +171,25 @@ func TestProviderState(t *testing.T) {
 		g.Expect(state).To(g.Equal(ports.MicroVMStateRunning))
 	})
 
-	t.Run("info shutdown returns unknown with error", func(t *testing.T) {
+	t.Run("info shutdown returns configured", func(t *testing.T) {
 		g.RegisterTestingT(t)
 		p, id, vmState := newTestProvider(t)
 		g.Expect(vmState.SetPid(startLiveProcess(t))).To(g.Succeed())
 		serveFakeCH(t, vmState.SockPath(), cloudhypervisor.VMStateShutdown)
 
 		state, err := p.State(ctx, id)
-		g.Expect(err).To(g.HaveOccurred())
-		g.Expect(state).To(g.Equal(ports.MicroVMStateUnknown))
+		g.Expect(err).NotTo(g.HaveOccurred())
+		g.Expect(state).To(g.Equal(ports.MicroVMStateConfigured))
+	})
+
+	t.Run("info paused returns paused", func(t *testing.T) {
+		g.RegisterTestingT(t)
+		p, id, vmState := newTestProvider(t)
+		g.Expect(vmState.SetPid(startLiveProcess(t))).To(g.Succeed())
+		serveFakeCH(t, vmState.SockPath(), cloudhypervisor.VMStatePaused)
+
+		state, err := p.State(ctx, id)
+		g.Expect(err).NotTo(g.HaveOccurred())
+		g.Expect(state).To(g.Equal(ports.MicroVMStatePaused))
 	})
 }
@@ -0,0 +1,302 @@
+package cloudhypervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+
+	cerrors "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/cloudhypervisor"
+	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/wait"
+
+	virtiofs "github.com/liquidmetal-dev/flintlock/infrastructure/virtiofs"
+)
+
+const (
+	snapshotConfigFile = "config.json"
+
+	apiSocketTimeout       = 5 * time.Second
+	apiSocketCheckInterval = 50 * time.Millisecond
+)
+
+var (
+	errRestoreMacvtap          = errors.New("restoring a microvm with macvtap network interfaces isn't supported")
+	errRestoreSymlinks         = errors.New("filesystem doesn't support symlinks needed to restore a snapshot")
+	errSnapshotDevicesMismatch = errors.New("devices of the microvm don't match the snapshot")
+)
+
+// Snapshot will write the memory and device state of a running or paused microvm to
+// the supplied directory. A running microvm is paused while the snapshot is taken and
+// then resumed.
+func (p *provider) Snapshot(ctx context.Context, id string, path string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "cloudhypervisor_microvm",
+		"vmid":    id,
+	})
+	logger.Infof("snapshotting microvm to %s", path)
+
+	state, err := p.State(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state != ports.MicroVMStateRunning && state != ports.MicroVMStatePaused {
+		return fmt.Errorf("microvm must be running or paused to snapshot, current state %s", state)
+	}
+
+	if state == ports.MicroVMStateRunning {
+		if err := p.Pause(ctx, id); err != nil {
+			return err
+		}
+
+		defer func() {
+			if resumeErr := p.Resume(ctx, id); resumeErr != nil {
+				logger.Errorf("resuming microvm after snapshot: %s", resumeErr)
+			}
+		}()
+	}
+
+	chClient, err := p.newClient(id)
+	if err != nil {
+		return err
+	}
+
+	destination := "file://" + path
+	if err := chClient.Snapshot(ctx, &cloudhypervisor.VMSnapshotConfig{DestinationURL: &destination}); err != nil {
+		return fmt.Errorf("snapshotting cloud-hypervisor vm: %w", err)
+	}
+
+	return nil
+}
+
+// Restore will create a microvm from a snapshot. The snapshot config is rewritten so
+// the restored microvm uses its own volumes, network interfaces and sockets.
+func (p *provider) Restore(ctx context.Context, vm *models.MicroVM, snapshot *models.Snapshot) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":  "cloudhypervisor_microvm",
+		"vmid":     vm.ID.String(),
+		"snapshot": snapshot.UID,
+	})
+	logger.Debugf("restoring microvm")
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+
+	if err := p.ensureState(vmState); err != nil {
+		return fmt.Errorf("ensuring state dir: %w", err)
+	}
+
+	if err := p.createCloudInitImage(ctx, vm, vmState); err != nil {
+		return fmt.Errorf("creating metadata image: %w", err)
+	}
+
+	if vm.Spec.AllowGuestAgent {
+		vm.Status.VSockPath = vmState.VSockPath()
+	} else {
+		vm.Status.VSockPath = ""
+	}
+
+	if err := p.prepareRestoreDir(vm, vmState, snapshot); err != nil {
+		return fmt.Errorf("preparing snapshot for restore: %w", err)
+	}
+
+	proc, err := p.startProcess(apiArgs(vmState), vmState, p.config.RunDetached, vm.Status.NetworkNamespace)
+	if err != nil {
+		return fmt.Errorf("starting cloudhypervisor process: %w", err)
+	}
+
+	if err = vmState.SetPid(proc.Pid); err != nil {
+		return fmt.Errorf("saving pid %d to file: %w", proc.Pid, err)
+	}
+
+	chClient := cloudhypervisor.New(vmState.SockPath())
+
+	if err := p.restoreFromDir(ctx, chClient, vmState); err != nil {
+		if shutdownErr := chClient.VmmShutdown(ctx); shutdownErr != nil {
+			logger.Errorf("shutting down cloud-hypervisor after failed restore: %s", shutdownErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (p *provider) restoreFromDir(ctx context.Context, chClient cloudhypervisor.Client, vmState State) error {
+	if err := wait.ForCondition(
+		wait.FileExistsCondition(vmState.SockPath(), p.fs),
+		apiSocketTimeout,
+		apiSocketCheckInterval,
+	); err != nil {
+		return fmt.Errorf("waiting for cloud-hypervisor api socket: %w", err)
+	}
+
+	if err := chClient.Restore(ctx, &cloudhypervisor.RestoreConfig{SourceURL: "file://" + vmState.RestoreDir()}); err != nil {
+		return fmt.Errorf("restoring cloud-hypervisor vm: %w", err)
+	}
+
+	if err := chClient.Resume(ctx); err != nil {
+		return fmt.Errorf("resuming restored cloud-hypervisor vm: %w", err)
+	}
+
+	return nil
+}
+
+// prepareRestoreDir creates a directory to restore from that links to the snapshot
+// files and has a copy of the snapshot config with the host side of the devices
+// changed to the ones of the microvm.
+func (p *provider) prepareRestoreDir(vm *models.MicroVM, vmState State, snapshot *models.Snapshot) error {
+	linker, ok := p.fs.(afero.Linker)
+	if !ok {
+		return errRestoreSymlinks
+	}
+
+	if err := p.fs.RemoveAll(vmState.RestoreDir()); err != nil {
+		return fmt.Errorf("removing restore directory: %w", err)
+	}
+
+	if err := p.fs.MkdirAll(vmState.RestoreDir(), defaults.DataDirPerm); err != nil {
+		return fmt.Errorf("creating restore directory: %w", err)
+	}
+
+	files, err := afero.ReadDir(p.fs, snapshot.Path)
+	if err != nil {
+		return fmt.Errorf("reading snapshot directory %s: %w", snapshot.Path, err)
+	}
+
+	for _, file := range files {
+		if file.Name() == snapshotConfigFile {
+			continue
+		}
+
+		source := filepath.Join(snapshot.Path, file.Name())
+		if err := linker.SymlinkIfPossible(source, filepath.Join(vmState.RestoreDir(), file.Name())); err != nil {
+			return fmt.Errorf("linking snapshot file %s: %w", source, err)
+		}
+	}
+
+	data, err := afero.ReadFile(p.fs, filepath.Join(snapshot.Path, snapshotConfigFile))
+	if err != nil {
+		return fmt.Errorf("reading snapshot config: %w", err)
+	}
+
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("unmarshalling snapshot config: %w", err)
+	}
+
+	if err := p.rewriteSnapshotConfig(vm, vmState, config); err != nil {
+		return err
+	}
+
+	data, err = json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("marshalling snapshot config: %w", err)
+	}
+
+	configPath := filepath.Join(vmState.RestoreDir(), snapshotConfigFile)
+	if err := afero.WriteFile(p.fs, configPath, data, defaults.DataFilePerm); err != nil {
+		return fmt.Errorf("writing snapshot config: %w", err)
+	}
+
+	return nil
+}
+
+// rewriteSnapshotConfig changes the host side of the devices in a snapshot config. The
+// disks and network interfaces are in the same order as they are passed in buildArgs.
+func (p *provider) rewriteSnapshotConfig(vm *models.MicroVM, vmState State, config map[string]interface{}) error {
+	diskPaths, err := restoreDiskPaths(vm, vmState)
+	if err != nil {
+		return err
+	}
+
+	if err := setDeviceField(config, "disks", "path", diskPaths); err != nil {
+		return err
+	}
+
+	taps := []string{}
+
+	for i := range vm.Spec.NetworkInterfaces {
+		iface := vm.Spec.NetworkInterfaces[i]
+
+		if iface.Type == models.IfaceTypeMacvtap {
+			return errRestoreMacvtap
+		}
+
+		status, ok := vm.Status.NetworkInterfaces[iface.GuestDeviceName]
+		if !ok {
+			return cerrors.NewNetworkInterfaceStatusMissing(iface.GuestDeviceName)
+		}
+
+		taps = append(taps, status.HostDeviceName)
+	}
+
+	if err := setDeviceField(config, "net", "tap", taps); err != nil {
+		return err
+	}
+
+	if fsDevices, _ := config["fs"].([]interface{}); len(fsDevices) > 0 {
+		vfsState := virtiofs.NewState(vm.ID, p.config.StateRoot, p.fs)
+		if err := setDeviceField(config, "fs", "socket", []string{vfsState.VirtioFSPath()}); err != nil {
+			return err
+		}
+	}
+
+	if vsock, ok := config["vsock"].(map[string]interface{}); ok {
+		vsock["socket"] = vmState.VSockPath()
+	}
+
+	return nil
+}
+
+func restoreDiskPaths(vm *models.MicroVM, vmState State) ([]string, error) {
+	rootVolumeStatus, ok := vm.Status.Volumes[vm.Spec.RootVolume.ID]
+	if !ok {
+		return nil, cerrors.NewVolumeNotMounted(vm.Spec.RootVolume.ID)
+	}
+
+	paths := []string{rootVolumeStatus.Mount.Source, vmState.CloudInitImage()}
+
+	for _, vol := range vm.Spec.AdditionalVolumes {
+		if vol.Source.VirtioFS != nil {
+			continue
+		}
+
+		status, ok := vm.Status.Volumes[vol.ID]
+		if !ok {
+			return nil, cerrors.NewVolumeNotMounted(vol.ID)
+		}
+
+		paths = append(paths, status.Mount.Source)
+	}
+
+	return paths, nil
+}
+
+// setDeviceField sets a field on each device in a list of devices in the config.
+func setDeviceField(config map[string]interface{}, devicesKey, field string, values []string) error {
+	devices, _ := config[devicesKey].([]interface{})
+	if len(devices) != len(values) {
+		return fmt.Errorf("%s: %w", devicesKey, errSnapshotDevicesMismatch)
+	}
+
+	for i, device := range devices {
+		deviceConfig, ok := device.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %w", devicesKey, errSnapshotDevicesMismatch)
+		}
+
+		deviceConfig[field] = values[i]
+	}
+
+	return nil
+}
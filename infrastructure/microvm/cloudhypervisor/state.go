@@ -15,8 +15,10 @@ const (
 	logFileName       = "cloudhypervisor.log"
 	stdOutFileName    = "cloudhypervisor.stdout"
 	stdErrFileName    = "cloudhypervisor.stderr"
+	stdInFileName     = "cloudhypervisor.stdin"
 	socketFileName    = "cloudhypervisor.sock"
 	cloudInitFileName = "cloud-init.img"
+	restoreDirName    = "restore"
 )
 
 type State interface {
@@ -29,10 +31,12 @@ type State interface {
 	LogPath() string
 	StdoutPath() string
 	StderrPath() string
+	StdinPath() string
 	SockPath() string
 	VSockPath() string
 
 	CloudInitImage() string
+	RestoreDir() string
 }
 
 func NewState(vmid models.VMID, stateDir string, fs afero.Fs) State {
@@ -71,6 +75,10 @@ func (s *fsState) StderrPath() string {
 	return fmt.Sprintf("%s/%s", s.stateRoot, stdErrFileName)
 }
 
+func (s *fsState) StdinPath() string {
+	return fmt.Sprintf("%s/%s", s.stateRoot, stdInFileName)
+}
+
 func (s *fsState) SockPath() string {
 	return fmt.Sprintf("%s/%s", s.stateRoot, socketFileName)
 }
@@ -83,6 +91,10 @@ func (s *fsState) CloudInitImage() string {
 	return fmt.Sprintf("%s/%s", s.stateRoot, cloudInitFileName)
 }
 
+func (s *fsState) RestoreDir() string {
+	return fmt.Sprintf("%s/%s", s.stateRoot, restoreDirName)
+}
+
 func (s *fsState) SetPid(pid int) error {
 	return shared.PIDWriteToFile(pid, s.PIDPath(), s.fs)
 }
@@ -0,0 +1,20 @@
+package cloudhypervisor
+
+import (
+	"context"
+
+	"github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+// UpdateMetadata isn't supported by cloud-hypervisor. The metadata is written to the
+// cloud-init disk of the microvm when it's created, so it can't be changed.
+func (p *provider) UpdateMetadata(_ context.Context, _ *models.MicroVM) error {
+	return errors.NewNotSupported("live metadata update")
+}
+
+// UpdateNetworkRateLimits isn't supported by cloud-hypervisor. The rate limits are set when the
+// network interfaces are added, so they can't be changed.
+func (p *provider) UpdateNetworkRateLimits(_ context.Context, _ *models.MicroVM) error {
+	return errors.NewNotSupported("live network rate limit update")
+}
@@ -73,13 +73,7 @@ func WithMicroVM(vm *models.MicroVM) ConfigOption {
 			return errors.NewVolumeNotMounted(vm.Spec.RootVolume.ID)
 		}
 
-		cfg.BlockDevices = append(cfg.BlockDevices, BlockDeviceConfig{
-			ID:           vm.Spec.RootVolume.ID,
-			IsReadOnly:   vm.Spec.RootVolume.IsReadOnly,
-			IsRootDevice: true,
-			PathOnHost:   rootVolumeStatus.Mount.Source,
-			CacheType:    CacheTypeUnsafe,
-		})
+		cfg.BlockDevices = append(cfg.BlockDevices, createBlockDevice(&vm.Spec.RootVolume, rootVolumeStatus, true))
 
 		for _, vol := range vm.Spec.AdditionalVolumes {
 			status, ok := vm.Status.Volumes[vol.ID]
@@ -87,15 +81,7 @@ func WithMicroVM(vm *models.MicroVM) ConfigOption {
 				return errors.NewVolumeNotMounted(vol.ID)
 			}
 
-			cfg.BlockDevices = append(cfg.BlockDevices, BlockDeviceConfig{
-				ID:           vol.ID,
-				IsReadOnly:   vol.IsReadOnly,
-				IsRootDevice: false,
-				PathOnHost:   status.Mount.Source,
-				// Partuuid: ,
-				// RateLimiter: ,
-				CacheType: CacheTypeUnsafe,
-			})
+			cfg.BlockDevices = append(cfg.BlockDevices, createBlockDevice(&vol, status, false))
 		}
 
 		kernelCmdLine := DefaultKernelCmdLine()
@@ -204,16 +190,15 @@ func WithState(vmState State) ConfigOption {
 	}
 }
 
+// createNetworkIface creates the config of a network interface. Firecracker has no MTU, queue or
+// offload settings, so the MTU is set on the host device and in the guest network config, and
+// interfaces with queues or offloads are rejected as the provider lacks the capabilities.
 func createNetworkIface(iface *models.NetworkInterface, status *models.NetworkInterfaceStatus) *NetworkInterfaceConfig {
-	macAddr := iface.GuestMAC
+	macAddr := iface.GuestMACAddress(status)
 	hostDevName := status.HostDeviceName
 
 	if iface.Type == models.IfaceTypeMacvtap {
 		hostDevName = fmt.Sprintf("/dev/tap%d", status.Index)
-
-		if macAddr == "" {
-			macAddr = status.MACAddress
-		}
 	}
 
 	netInt := &NetworkInterfaceConfig{
@@ -222,5 +207,64 @@ func createNetworkIface(iface *models.NetworkInterface, status *models.NetworkIn
 		GuestMAC:    macAddr,
 	}
 
+	if iface.RateLimits != nil {
+		netInt.RxRateLimiter = createRateLimiter(iface.RateLimits.Rx)
+		netInt.TxRateLimiter = createRateLimiter(iface.RateLimits.Tx)
+	}
+
 	return netInt
 }
+
+// createBlockDevice creates the config of the drive of a volume. Firecracker doesn't support
+// direct I/O, so volumes with the direct cache mode are rejected as the provider lacks the capability.
+func createBlockDevice(vol *models.Volume, status *models.VolumeStatus, isRoot bool) BlockDeviceConfig {
+	device := BlockDeviceConfig{
+		ID:           vol.ID,
+		IsReadOnly:   vol.IsReadOnly,
+		IsRootDevice: isRoot,
+		PathOnHost:   status.Mount.Source,
+		CacheType:    CacheTypeUnsafe,
+		RateLimiter:  createRateLimiter(vol.RateLimit),
+	}
+
+	if vol.CacheMode == models.VolumeCacheModeWriteback {
+		device.CacheType = CacheTypeWriteBack
+	}
+
+	switch vol.IOEngine {
+	case models.VolumeIOEngineSync:
+		device.IOEngine = FileEngineTypeSync
+	case models.VolumeIOEngineAsync:
+		device.IOEngine = FileEngineTypeAsync
+	}
+
+	return device
+}
+
+func createRateLimiter(rateLimit *models.RateLimit) *RateLimiterConfig {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &RateLimiterConfig{
+		Bandwidth: createTokenBucket(rateLimit.Bandwidth),
+		Ops:       createTokenBucket(rateLimit.Ops),
+	}
+}
+
+func createTokenBucket(bucket *models.TokenBucket) *TokenBucketConfig {
+	if bucket == nil {
+		return nil
+	}
+
+	config := &TokenBucketConfig{
+		Size:       bucket.Size,
+		RefillTime: bucket.RefillTimeMs,
+	}
+
+	if bucket.OneTimeBurst != 0 {
+		config.OneTimeBurst = &bucket.OneTimeBurst
+	}
+
+	return config
+}
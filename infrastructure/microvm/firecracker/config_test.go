@@ -46,3 +46,99 @@ func TestWithVsock_Disabled(t *testing.T) {
 	g.Expect(err).NotTo(g.HaveOccurred())
 	g.Expect(cfg.VsockDevice).To(g.BeNil())
 }
+
+func TestWithMicroVM_NetworkRateLimits(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	vm := &models.MicroVM{
+		Spec: models.MicroVMSpec{
+			VCPU:       1,
+			MemoryInMb: 1024,
+			Kernel:     models.Kernel{Filename: "vmlinux"},
+			RootVolume: models.Volume{ID: "root"},
+			NetworkInterfaces: []models.NetworkInterface{
+				{
+					GuestDeviceName: "eth1",
+					GuestMAC:        "AA:FF:00:00:00:01",
+					Type:            models.IfaceTypeTap,
+					RateLimits: &models.NetworkRateLimits{
+						Tx: &models.RateLimit{
+							Bandwidth: &models.TokenBucket{Size: 1048576, OneTimeBurst: 2097152, RefillTimeMs: 100},
+							Ops:       &models.TokenBucket{Size: 1000, RefillTimeMs: 1000},
+						},
+					},
+				},
+			},
+		},
+		Status: models.MicroVMStatus{
+			KernelMount: &models.Mount{Source: "/kernel"},
+			NetworkInterfaces: models.NetworkInterfaceStatuses{
+				"eth1": &models.NetworkInterfaceStatus{HostDeviceName: "fltap0"},
+			},
+			Volumes: models.VolumeStatuses{
+				"root": &models.VolumeStatus{Mount: models.Mount{Source: "/root.img"}},
+			},
+		},
+	}
+
+	cfg, err := firecracker.CreateConfig(firecracker.WithMicroVM(vm))
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(cfg.NetDevices).To(g.HaveLen(1))
+
+	netDevice := cfg.NetDevices[0]
+	g.Expect(netDevice.RxRateLimiter).To(g.BeNil())
+	g.Expect(netDevice.TxRateLimiter).NotTo(g.BeNil())
+	g.Expect(netDevice.TxRateLimiter.Bandwidth.Size).To(g.Equal(int64(1048576)))
+	g.Expect(*netDevice.TxRateLimiter.Bandwidth.OneTimeBurst).To(g.Equal(int64(2097152)))
+	g.Expect(netDevice.TxRateLimiter.Bandwidth.RefillTime).To(g.Equal(int64(100)))
+	g.Expect(netDevice.TxRateLimiter.Ops.Size).To(g.Equal(int64(1000)))
+	g.Expect(netDevice.TxRateLimiter.Ops.OneTimeBurst).To(g.BeNil())
+}
+
+func TestWithMicroVM_BlockDevices(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	vm := &models.MicroVM{
+		Spec: models.MicroVMSpec{
+			VCPU:       1,
+			MemoryInMb: 1024,
+			Kernel:     models.Kernel{Filename: "vmlinux"},
+			RootVolume: models.Volume{ID: "root"},
+			AdditionalVolumes: models.Volumes{
+				{
+					ID:        "data",
+					CacheMode: models.VolumeCacheModeWriteback,
+					IOEngine:  models.VolumeIOEngineAsync,
+					RateLimit: &models.RateLimit{
+						Bandwidth: &models.TokenBucket{Size: 1048576, RefillTimeMs: 100},
+					},
+				},
+			},
+		},
+		Status: models.MicroVMStatus{
+			KernelMount: &models.Mount{Source: "/kernel"},
+			Volumes: models.VolumeStatuses{
+				"root": &models.VolumeStatus{Mount: models.Mount{Source: "/root.img"}},
+				"data": &models.VolumeStatus{Mount: models.Mount{Source: "/data.img"}},
+			},
+		},
+	}
+
+	cfg, err := firecracker.CreateConfig(firecracker.WithMicroVM(vm))
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(cfg.BlockDevices).To(g.HaveLen(2))
+
+	rootDevice := cfg.BlockDevices[0]
+	g.Expect(rootDevice.IsRootDevice).To(g.BeTrue())
+	g.Expect(rootDevice.CacheType).To(g.Equal(firecracker.CacheTypeUnsafe))
+	g.Expect(rootDevice.IOEngine).To(g.BeEmpty())
+	g.Expect(rootDevice.RateLimiter).To(g.BeNil())
+
+	dataDevice := cfg.BlockDevices[1]
+	g.Expect(dataDevice.IsRootDevice).To(g.BeFalse())
+	g.Expect(dataDevice.PathOnHost).To(g.Equal("/data.img"))
+	g.Expect(dataDevice.CacheType).To(g.Equal(firecracker.CacheTypeWriteBack))
+	g.Expect(dataDevice.IOEngine).To(g.Equal(firecracker.FileEngineTypeAsync))
+	g.Expect(dataDevice.RateLimiter.Bandwidth.Size).To(g.Equal(int64(1048576)))
+	g.Expect(dataDevice.RateLimiter.Ops).To(g.BeNil())
+}
@@ -0,0 +1,37 @@
+package firecracker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
+)
+
+// ConsoleOutput returns a reader for the serial console output of the microvm, which
+// the vmm writes to its stdout.
+func (p *fcProvider) ConsoleOutput(ctx context.Context, id string, opts ports.ConsoleOutputOptions) (io.ReadCloser, error) {
+	vmid, err := models.NewVMIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing vmid: %w", err)
+	}
+
+	vmState := NewState(*vmid, p.config.StateRoot, p.fs)
+
+	return shared.OpenConsoleReader(ctx, p.fs, vmState.StdoutPath(), opts.TailLines, opts.Follow)
+}
+
+// ConsoleInput returns a writer that sends input to the serial console of the running
+// microvm, via the pipe used as the stdin of the vmm.
+func (p *fcProvider) ConsoleInput(_ context.Context, id string) (io.WriteCloser, error) {
+	vmid, err := models.NewVMIDFromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing vmid: %w", err)
+	}
+
+	vmState := NewState(*vmid, p.config.StateRoot, p.fs)
+
+	return shared.OpenConsoleWriter(vmState.StdinPath())
+}
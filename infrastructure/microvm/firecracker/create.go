@@ -1,7 +1,6 @@
 package firecracker
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -12,9 +11,9 @@ import (
 	"github.com/spf13/afero"
 
 	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
 	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
-	"github.com/liquidmetal-dev/flintlock/pkg/process"
 )
 
 // Create will create a new microvm.
@@ -54,8 +53,20 @@ func (p *fcProvider) Create(ctx context.Context, vm *models.MicroVM) error {
 		return fmt.Errorf("saving firecracker metadata: %w", err)
 	}
 
-	args := []string{"--id", vm.ID.UID(), "--boot-timer", "--no-api"}
-	args = append(args, "--config-file", vmState.ConfigPath())
+	return p.startFromState(vm, vmState)
+}
+
+// startFromState starts a firecracker process using the config and metadata
+// files saved in the state directory of the microvm.
+func (p *fcProvider) startFromState(vm *models.MicroVM, vmState State) error {
+	return p.startProcess(vm, vmState, "--config-file", vmState.ConfigPath())
+}
+
+// startProcess starts a firecracker process for the microvm with its API socket
+// and the metadata saved in its state directory.
+func (p *fcProvider) startProcess(vm *models.MicroVM, vmState State, extraArgs ...string) error {
+	args := []string{"--id", vm.ID.UID(), "--boot-timer", "--api-sock", vmState.SockPath()}
+	args = append(args, extraArgs...)
 	args = append(args, "--metadata", vmState.MetadataPath())
 
 	cmd := firecracker.VMCommandBuilder{}.
@@ -63,7 +74,7 @@ func (p *fcProvider) Create(ctx context.Context, vm *models.MicroVM) error {
 		WithArgs(args).
 		Build(context.TODO()) //nolint: contextcheck // Intentional.
 
-	proc, err := p.startFirecracker(cmd, vmState, p.config.RunDetached)
+	proc, err := p.startFirecracker(cmd, vmState, p.config.RunDetached, vm.Status.NetworkNamespace)
 	if err != nil {
 		return fmt.Errorf("starting firecracker process: %w", err)
 	}
@@ -75,7 +86,11 @@ func (p *fcProvider) Create(ctx context.Context, vm *models.MicroVM) error {
 	return nil
 }
 
-func (p *fcProvider) startFirecracker(cmd *exec.Cmd, vmState State, detached bool) (*os.Process, error) {
+func (p *fcProvider) startFirecracker(cmd *exec.Cmd,
+	vmState State,
+	detached bool,
+	networkNamespace string,
+) (*os.Process, error) {
 	stdOutFile, err := p.fs.OpenFile(vmState.StdoutPath(), os.O_WRONLY|os.O_CREATE|os.O_APPEND, defaults.DataFilePerm)
 	if err != nil {
 		return nil, fmt.Errorf("opening stdout file %s: %w", vmState.StdoutPath(), err)
@@ -86,20 +101,20 @@ func (p *fcProvider) startFirecracker(cmd *exec.Cmd, vmState State, detached boo
 		return nil, fmt.Errorf("opening sterr file %s: %w", vmState.StderrPath(), err)
 	}
 
+	// The serial console of the guest is wired to stdin/stdout of the vmm. Stdin is a named
+	// pipe in the state directory so input can be sent to the console later on.
+	stdInFile, err := shared.OpenConsoleInput(vmState.StdinPath())
+	if err != nil {
+		return nil, fmt.Errorf("opening stdin: %w", err)
+	}
+	defer stdInFile.Close()
+
 	cmd.Stderr = stdErrFile
 	cmd.Stdout = stdOutFile
-	cmd.Stdin = &bytes.Buffer{}
-
-	var startErr error
+	cmd.Stdin = stdInFile
 
-	if detached {
-		startErr = process.DetachedStart(cmd)
-	} else {
-		startErr = cmd.Start()
-	}
-
-	if startErr != nil {
-		return nil, fmt.Errorf("starting firecracker process: %w", startErr)
+	if err := shared.StartProcess(cmd, detached, networkNamespace); err != nil {
+		return nil, fmt.Errorf("starting firecracker process: %w", err)
 	}
 
 	return cmd.Process, nil
@@ -128,6 +143,17 @@ func (p *fcProvider) ensureState(vmState State) error {
 		}
 	}
 
+	// Remove any stale API socket, firecracker refuses to start if it exists.
+	sockExists, err := afero.Exists(p.fs, vmState.SockPath())
+	if err != nil {
+		return fmt.Errorf("checking if api socket exists: %w", err)
+	}
+	if sockExists {
+		if delErr := p.fs.Remove(vmState.SockPath()); delErr != nil {
+			return fmt.Errorf("deleting existing api socket: %w", delErr)
+		}
+	}
+
 	logFile, err := p.fs.OpenFile(vmState.LogPath(), os.O_WRONLY|os.O_CREATE|os.O_APPEND, defaults.DataFilePerm)
 	if err != nil {
 		return fmt.Errorf("opening log file %s: %w", vmState.LogPath(), err)
@@ -0,0 +1,48 @@
+package firecracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firecracker-microvm/firecracker-go-sdk"
+	fcmodels "github.com/firecracker-microvm/firecracker-go-sdk/client/models"
+	"github.com/sirupsen/logrus"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// Pause will pause a running microvm using the firecracker API.
+func (p *fcProvider) Pause(ctx context.Context, id string) error {
+	return p.patchVMState(ctx, id, fcmodels.VMStatePaused)
+}
+
+// Resume will resume a paused microvm using the firecracker API.
+func (p *fcProvider) Resume(ctx context.Context, id string) error {
+	return p.patchVMState(ctx, id, fcmodels.VMStateResumed)
+}
+
+func (p *fcProvider) patchVMState(ctx context.Context, id string, state string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    id,
+	})
+	logger.Infof("changing microvm state to %s", state)
+
+	vmid, err := models.NewVMIDFromString(id)
+	if err != nil {
+		return fmt.Errorf("parsing vmid: %w", err)
+	}
+
+	vmState := NewState(*vmid, p.config.StateRoot, p.fs)
+
+	if _, err := p.newClient(vmState, logger).PatchVM(ctx, &fcmodels.VM{State: &state}); err != nil {
+		return fmt.Errorf("changing firecracker vm state to %s: %w", state, err)
+	}
+
+	return nil
+}
+
+func (p *fcProvider) newClient(vmState State, logger *logrus.Entry) *firecracker.Client {
+	return firecracker.NewClient(vmState.SockPath(), logger, false)
+}
@@ -8,6 +8,7 @@ import (
 	"syscall"
 	"time"
 
+	fcmodels "github.com/firecracker-microvm/firecracker-go-sdk/client/models"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 	tailor "github.com/yitsushi/file-tailor"
@@ -56,35 +57,71 @@ type fcProvider struct {
 
 // Capabilities returns a list of the capabilities the Firecracker provider supports.
 func (p *fcProvider) Capabilities() models.Capabilities {
-	return models.Capabilities{models.MetadataServiceCapability, models.VSockCapability}
+	return models.Capabilities{
+		models.MetadataServiceCapability,
+		models.VSockCapability,
+		models.PauseCapability,
+		models.SnapshotCapability,
+		models.NetworkRxTxRateLimitsCapability,
+		models.VolumeCacheUnsafeCapability,
+		models.LiveMetadataUpdateCapability,
+		models.LiveNetworkRateLimitUpdateCapability,
+	}
 }
 
-// Start will start a created microvm.
-// With configuration file, we don't really have start.  A separate Start and
-// Create steps is a good idea, but the right now steps are still coupled with
-// MicroVM.
-//
-// The two options are:
-//
-//	A) Merge Create and Start steps in global scope.
-//	B) Let Start to just call Create.
-//
-// Without heavy refactoring, option A seems more logical and let us keep the
-// separate Create and Start steps.  If we merge them togther, we may face
-// issues when we try to add new MicroVM providers, so that way we would work
-// twice on the same thing, now remove them and then add it back and make a
-// separation here, like option B.
-func (p *fcProvider) Start(_ context.Context, _ *models.MicroVM) error {
-	return cerrs.NewNotSupported("start")
+// Start will start a stopped microvm. Firecracker is configured from a config
+// file so the microvm is started by re-spawning the firecracker process from the
+// config file saved in its state directory.
+func (p *fcProvider) Start(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Info("starting microvm")
+
+	state, err := p.State(ctx, vm.ID.String())
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state == ports.MicroVMStateRunning || state == ports.MicroVMStatePaused {
+		logger.Debug("microvm is already running")
+
+		return nil
+	}
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+
+	configExists, err := afero.Exists(p.fs, vmState.ConfigPath())
+	if err != nil {
+		return fmt.Errorf("checking if firecracker config exists: %w", err)
+	}
+
+	if !configExists {
+		return fmt.Errorf("firecracker config %s not found: %w", vmState.ConfigPath(), cerrs.ErrMissingStatusInfo)
+	}
+
+	if err := p.ensureState(vmState); err != nil {
+		return fmt.Errorf("ensuring state dir: %w", err)
+	}
+
+	if err := p.startFromState(vm, vmState); err != nil {
+		return err
+	}
+
+	logger.Info("started microvm")
+
+	return nil
 }
 
-// Stop will stop a running microvm.
-func (p *fcProvider) Delete(ctx context.Context, id string) error {
+// Stop will stop a running microvm. The state directory is kept so that the
+// microvm can be started again.
+func (p *fcProvider) Stop(ctx context.Context, id string) error {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"service": "firecracker_microvm",
 		"vmid":    id,
 	})
-	logger.Info("deleting microvm")
+	logger.Info("stopping microvm")
 
 	vmid, err := models.NewVMIDFromString(id)
 	if err != nil {
@@ -98,6 +135,15 @@ func (p *fcProvider) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("unable to get PID: %w", pidErr)
 	}
 
+	processExists, err := process.Exists(pid)
+	if err != nil {
+		return fmt.Errorf("checking if firecracker process is running: %w", err)
+	}
+
+	if !processExists {
+		return nil
+	}
+
 	logger.Infof("sending SIGHUP to %d", pid)
 
 	if sigErr := process.SendSignal(pid, syscall.SIGHUP); sigErr != nil {
@@ -112,6 +158,37 @@ func (p *fcProvider) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to wait for pid %d: %w", pid, err)
 	}
 
+	logger.Info("stopped microvm")
+
+	return nil
+}
+
+// Restart will restart a running microvm by stopping and re-spawning the firecracker process.
+func (p *fcProvider) Restart(ctx context.Context, vm *models.MicroVM) error {
+	if err := p.Stop(ctx, vm.ID.String()); err != nil {
+		return fmt.Errorf("stopping microvm for restart: %w", err)
+	}
+
+	if err := p.Start(ctx, vm); err != nil {
+		return fmt.Errorf("starting microvm for restart: %w", err)
+	}
+
+	return nil
+}
+
+// Delete will delete a microvm. Firecracker only needs its process stopping, the
+// state directory is removed separately.
+func (p *fcProvider) Delete(ctx context.Context, id string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    id,
+	})
+	logger.Info("deleting microvm")
+
+	if err := p.Stop(ctx, id); err != nil {
+		return err
+	}
+
 	logger.Info("deleted microvm")
 
 	return nil
@@ -156,6 +233,28 @@ func (p *fcProvider) State(ctx context.Context, id string) (ports.MicroVMState,
 		return ports.MicroVMStatePending, nil
 	}
 
+	// Microvms started before the API socket was enabled don't have one and
+	// can't be paused, so they are always running.
+	sockExists, err := afero.Exists(p.fs, vmState.SockPath())
+	if err != nil {
+		return ports.MicroVMStateUnknown, fmt.Errorf("checking api socket exists: %w", err)
+	}
+
+	if !sockExists {
+		return ports.MicroVMStateRunning, nil
+	}
+
+	info, err := p.newClient(vmState, logger).GetInstanceInfo(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("querying firecracker for instance info, assuming running as process is alive")
+
+		return ports.MicroVMStateRunning, nil
+	}
+
+	if info.Payload != nil && info.Payload.State != nil && *info.Payload.State == fcmodels.InstanceInfoStatePaused {
+		return ports.MicroVMStatePaused, nil
+	}
+
 	return ports.MicroVMStateRunning, nil
 }
 
@@ -189,3 +288,8 @@ func (p *fcProvider) Metrics(_ context.Context, vmid models.VMID) (ports.Machine
 
 	return machineMetrics, nil
 }
+
+// Version returns the version reported by the firecracker binary.
+func (p *fcProvider) Version(ctx context.Context) (string, error) {
+	return shared.BinaryVersion(ctx, p.config.FirecrackerBin)
+}
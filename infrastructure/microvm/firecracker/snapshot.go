@@ -0,0 +1,228 @@
+package firecracker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/firecracker-microvm/firecracker-go-sdk"
+	fcmodels "github.com/firecracker-microvm/firecracker-go-sdk/client/models"
+	"github.com/sirupsen/logrus"
+
+	cerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	"github.com/liquidmetal-dev/flintlock/pkg/wait"
+)
+
+const (
+	snapshotMemoryFile = "memory"
+	snapshotStateFile  = "state"
+
+	apiSocketTimeout       = 5 * time.Second
+	apiSocketCheckInterval = 50 * time.Millisecond
+
+	// The host in the URL isn't used, requests are sent to the api socket.
+	apiSnapshotLoadURL = "http://localhost/snapshot/load"
+)
+
+// Snapshot will write the memory and device state of a running or paused microvm to
+// files in the supplied directory. A running microvm is paused while the snapshot is
+// taken and then resumed.
+func (p *fcProvider) Snapshot(ctx context.Context, id string, path string) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    id,
+	})
+	logger.Infof("snapshotting microvm to %s", path)
+
+	state, err := p.State(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checking microvm state: %w", err)
+	}
+
+	if state != ports.MicroVMStateRunning && state != ports.MicroVMStatePaused {
+		return fmt.Errorf("microvm must be running or paused to snapshot, current state %s", state)
+	}
+
+	vmid, err := models.NewVMIDFromString(id)
+	if err != nil {
+		return fmt.Errorf("parsing vmid: %w", err)
+	}
+
+	vmState := NewState(*vmid, p.config.StateRoot, p.fs)
+
+	if state == ports.MicroVMStateRunning {
+		if err := p.Pause(ctx, id); err != nil {
+			return err
+		}
+
+		defer func() {
+			if resumeErr := p.Resume(ctx, id); resumeErr != nil {
+				logger.Errorf("resuming microvm after snapshot: %s", resumeErr)
+			}
+		}()
+	}
+
+	_, err = p.newClient(vmState, logger).CreateSnapshot(ctx, &fcmodels.SnapshotCreateParams{
+		MemFilePath:  firecracker.String(filepath.Join(path, snapshotMemoryFile)),
+		SnapshotPath: firecracker.String(filepath.Join(path, snapshotStateFile)),
+		SnapshotType: fcmodels.SnapshotCreateParamsSnapshotTypeFull,
+	})
+	if err != nil {
+		return fmt.Errorf("creating firecracker snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Restore will create a microvm by loading a snapshot into a new firecracker process.
+// The drives, network interfaces and vsock device are pointed at the volumes, tap devices
+// and vsock socket of the new microvm. The overrides used for the network interfaces and
+// vsock device need a version of firecracker that supports them, older versions reject
+// the snapshot rather than sharing the devices of the snapshotted microvm.
+func (p *fcProvider) Restore(ctx context.Context, vm *models.MicroVM, snapshot *models.Snapshot) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":  "firecracker_microvm",
+		"vmid":     vm.ID.String(),
+		"snapshot": snapshot.UID,
+	})
+	logger.Debugf("restoring microvm")
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+
+	if err := p.ensureState(vmState); err != nil {
+		return fmt.Errorf("ensuring state dir: %w", err)
+	}
+
+	config, err := CreateConfig(WithMicroVM(vm), WithState(vmState), WithVsock(vm, vmState))
+	if err != nil {
+		return fmt.Errorf("creating firecracker config: %w", err)
+	}
+
+	if vm.Spec.AllowGuestAgent {
+		vm.Status.VSockPath = vmState.VSockPath()
+	} else {
+		vm.Status.VSockPath = ""
+	}
+
+	// The config is saved so the microvm can be booted from it if it's stopped and started again.
+	if err = vmState.SetConfig(config); err != nil {
+		return fmt.Errorf("saving firecracker config: %w", err)
+	}
+
+	if err = vmState.SetMetadata(&Metadata{Latest: vm.Spec.Metadata}); err != nil {
+		return fmt.Errorf("saving firecracker metadata: %w", err)
+	}
+
+	// A snapshot can only be loaded into a firecracker process that hasn't been configured.
+	if err := p.startProcess(vm, vmState); err != nil {
+		return err
+	}
+
+	params, err := snapshotLoadParams(vm, vmState, snapshot)
+	if err != nil {
+		return err
+	}
+
+	if err := p.loadSnapshot(ctx, vmState, config, params, logger); err != nil {
+		if stopErr := p.Stop(ctx, vm.ID.String()); stopErr != nil {
+			logger.Errorf("stopping firecracker after failed restore: %s", stopErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (p *fcProvider) loadSnapshot(ctx context.Context,
+	vmState State,
+	config *VmmConfig,
+	params *SnapshotLoadParams,
+	logger *logrus.Entry,
+) error {
+	if err := wait.ForCondition(
+		wait.FileExistsCondition(vmState.SockPath(), p.fs),
+		apiSocketTimeout,
+		apiSocketCheckInterval,
+	); err != nil {
+		return fmt.Errorf("waiting for firecracker api socket: %w", err)
+	}
+
+	transport := &http.Transport{
+		DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
+			return net.Dial("unix", vmState.SockPath())
+		},
+		DisableKeepAlives: true,
+	}
+	fault := apiFault{}
+
+	err := requests.URL(apiSnapshotLoadURL).
+		Transport(transport).
+		Put().
+		BodyJSON(params).
+		AddValidator(requests.ValidatorHandler(requests.DefaultValidator, requests.ToJSON(&fault))).
+		Fetch(ctx)
+	if err != nil {
+		if fault.FaultMessage != "" {
+			return fmt.Errorf("loading firecracker snapshot: %s: %w", fault.FaultMessage, err)
+		}
+
+		return fmt.Errorf("loading firecracker snapshot: %w", err)
+	}
+
+	client := p.newClient(vmState, logger)
+
+	for _, drive := range config.BlockDevices {
+		if _, err := client.PatchGuestDriveByID(ctx, drive.ID, drive.PathOnHost); err != nil {
+			return fmt.Errorf("updating drive %s: %w", drive.ID, err)
+		}
+	}
+
+	resumed := fcmodels.VMStateResumed
+	if _, err := client.PatchVM(ctx, &fcmodels.VM{State: &resumed}); err != nil {
+		return fmt.Errorf("resuming restored microvm: %w", err)
+	}
+
+	return nil
+}
+
+// snapshotLoadParams returns the parameters to load a snapshot into the microvm, with the
+// network interfaces using its tap devices and the vsock device using its socket.
+func snapshotLoadParams(vm *models.MicroVM, vmState State, snapshot *models.Snapshot) (*SnapshotLoadParams, error) {
+	params := &SnapshotLoadParams{
+		MemFilePath:  filepath.Join(snapshot.Path, snapshotMemoryFile),
+		SnapshotPath: filepath.Join(snapshot.Path, snapshotStateFile),
+	}
+
+	for i := range vm.Spec.NetworkInterfaces {
+		iface := vm.Spec.NetworkInterfaces[i]
+
+		status, ok := vm.Status.NetworkInterfaces[iface.GuestDeviceName]
+		if !ok {
+			return nil, cerrs.NewNetworkInterfaceStatusMissing(iface.GuestDeviceName)
+		}
+
+		params.NetworkOverrides = append(params.NetworkOverrides, NetworkOverride{
+			IfaceID:     iface.GuestDeviceName,
+			HostDevName: status.HostDeviceName,
+		})
+	}
+
+	if vm.Spec.AllowGuestAgent {
+		params.VsockOverride = &VsockOverride{UDSPath: vmState.VSockPath()}
+	}
+
+	return params, nil
+}
+
+// apiFault is the body of an error response from the firecracker API.
+type apiFault struct {
+	FaultMessage string `json:"fault_message"`
+}
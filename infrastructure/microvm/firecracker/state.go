@@ -25,7 +25,9 @@ type State interface {
 	MetricsPath() string
 	StdoutPath() string
 	StderrPath() string
+	StdinPath() string
 	VSockPath() string
+	SockPath() string
 
 	ConfigPath() string
 	Config() (VmmConfig, error)
@@ -76,10 +78,18 @@ func (s *fsState) StderrPath() string {
 	return s.stateRoot + "/firecracker.stderr"
 }
 
+func (s *fsState) StdinPath() string {
+	return s.stateRoot + "/firecracker.stdin"
+}
+
 func (s *fsState) VSockPath() string {
 	return s.stateRoot + "/" + defaults.GuestAgentVsockName
 }
 
+func (s *fsState) SockPath() string {
+	return s.stateRoot + "/firecracker.sock"
+}
+
 func (s *fsState) SetPid(pid int) error {
 	return shared.PIDWriteToFile(pid, s.PIDPath(), s.fs)
 }
@@ -135,7 +145,7 @@ func (s *fsState) SetMetadata(meta *Metadata) error {
 func (s *fsState) Metadata() (Metadata, error) {
 	meta := Metadata{}
 
-	err := s.readJSONFile(&meta, s.ConfigPath())
+	err := s.readJSONFile(&meta, s.MetadataPath())
 	if err != nil {
 		return Metadata{}, fmt.Errorf("firecracker metadata: %w", err)
 	}
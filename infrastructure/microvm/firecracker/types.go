@@ -78,7 +78,9 @@ type BlockDeviceConfig struct {
 	// the guest driver.
 	CacheType CacheType `json:"cache_type"`
 	// RateLimiter is the config for rate limiting the I/O operations.
-	// RateLimiter *RateLimiterConfig `json:"rate_limiter"`
+	RateLimiter *RateLimiterConfig `json:"rate_limiter,omitempty"`
+	// IOEngine is the type of the file engine used by the drive.
+	IOEngine FileEngineType `json:"io_engine,omitempty"`
 }
 
 // BootSourceConfig holds the configuration for the boot source of a microvm.
@@ -101,9 +103,28 @@ type NetworkInterfaceConfig struct {
 	// GuestMAC is the mac address to use.
 	GuestMAC string `json:"guest_mac,omitempty"`
 	// RxRateLimiter is the rate limiter for received packages.
-	// RxRateLimiter *RateLimiterConfig `json:"rx_rate_limiter,omitempty"`
+	RxRateLimiter *RateLimiterConfig `json:"rx_rate_limiter,omitempty"`
 	// TxRateLimiter is the rate limiter for transmitted packages.
-	// TxRateLimiter *RateLimiterConfig `json:"tx_rate_limiter,omitempty"`
+	TxRateLimiter *RateLimiterConfig `json:"tx_rate_limiter,omitempty"`
+}
+
+// RateLimiterConfig is the configuration of a rate limiter with a token bucket for the bandwidth
+// and one for the operations.
+type RateLimiterConfig struct {
+	// Bandwidth is the token bucket with bytes as tokens.
+	Bandwidth *TokenBucketConfig `json:"bandwidth,omitempty"`
+	// Ops is the token bucket with operations as tokens.
+	Ops *TokenBucketConfig `json:"ops,omitempty"`
+}
+
+// TokenBucketConfig is the configuration of a token bucket.
+type TokenBucketConfig struct {
+	// Size is the total number of tokens the bucket can hold.
+	Size int64 `json:"size"`
+	// OneTimeBurst is the initial size of a token bucket.
+	OneTimeBurst *int64 `json:"one_time_burst,omitempty"`
+	// RefillTime is the amount of milliseconds it takes for the bucket to refill.
+	RefillTime int64 `json:"refill_time"`
 }
 
 type LogLevel string
@@ -169,6 +190,33 @@ type VsockDeviceConfig struct {
 	UDSPath string `json:"uds_path"`
 }
 
+// SnapshotLoadParams are the parameters used to load a snapshot. The firecracker SDK
+// doesn't have the overrides, which change the host side of the devices in the snapshot.
+type SnapshotLoadParams struct {
+	// MemFilePath is the path to the file that contains the guest memory.
+	MemFilePath string `json:"mem_file_path"`
+	// SnapshotPath is the path to the file that contains the microvm state.
+	SnapshotPath string `json:"snapshot_path"`
+	// NetworkOverrides change the host devices of the network interfaces.
+	NetworkOverrides []NetworkOverride `json:"network_overrides,omitempty"`
+	// VsockOverride changes the unix socket of the vsock device.
+	VsockOverride *VsockOverride `json:"vsock_override,omitempty"`
+}
+
+// NetworkOverride changes the host device of a network interface in a snapshot.
+type NetworkOverride struct {
+	// IfaceID is the ID of the network interface.
+	IfaceID string `json:"iface_id"`
+	// HostDevName is the name of the host device to use instead.
+	HostDevName string `json:"host_dev_name"`
+}
+
+// VsockOverride changes the unix socket of the vsock device in a snapshot.
+type VsockOverride struct {
+	// UDSPath is the path to the local unix socket to use instead.
+	UDSPath string `json:"uds_path"`
+}
+
 // Metadata represents metadata in the MMDS.
 type Metadata struct {
 	Latest map[string]string `json:"latest"`
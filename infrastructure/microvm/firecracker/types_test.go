@@ -26,3 +26,26 @@ func TestUnmarshallWithFCSample(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMarshalSnapshotLoadParams(t *testing.T) {
+	params := &firecracker.SnapshotLoadParams{
+		MemFilePath:  "/snapshots/snap1234/memory",
+		SnapshotPath: "/snapshots/snap1234/state",
+		NetworkOverrides: []firecracker.NetworkOverride{
+			{IfaceID: "eth0", HostDevName: "fltap1234567"},
+		},
+		VsockOverride: &firecracker.VsockOverride{UDSPath: "/state/vsock.sock"},
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"mem_file_path":"/snapshots/snap1234/memory","snapshot_path":"/snapshots/snap1234/state",` +
+		`"network_overrides":[{"iface_id":"eth0","host_dev_name":"fltap1234567"}],` +
+		`"vsock_override":{"uds_path":"/state/vsock.sock"}}`
+	if string(data) != expected {
+		t.Fatalf("expected %s, got %s", expected, string(data))
+	}
+}
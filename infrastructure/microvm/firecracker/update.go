@@ -0,0 +1,118 @@
+package firecracker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/firecracker-microvm/firecracker-go-sdk"
+	fcmodels "github.com/firecracker-microvm/firecracker-go-sdk/client/models"
+	"github.com/sirupsen/logrus"
+
+	cerrs "github.com/liquidmetal-dev/flintlock/core/errors"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+// UpdateDevices isn't supported by firecracker, network interfaces and volumes can only be
+// added when the microvm is created.
+func (p *fcProvider) UpdateDevices(_ context.Context, _ *models.MicroVM) error {
+	return cerrs.NewNotSupported("hotplug")
+}
+
+// UpdateMetadata will replace the metadata in the MMDS of a running microvm using the
+// firecracker API. The metadata file in the state directory is updated as well, so the
+// metadata is kept when the microvm is started again.
+func (p *fcProvider) UpdateMetadata(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Info("updating microvm metadata")
+
+	vmState := NewState(vm.ID, p.config.StateRoot, p.fs)
+
+	if err := vmState.SetMetadata(&Metadata{Latest: vm.Spec.Metadata}); err != nil {
+		return fmt.Errorf("saving firecracker metadata: %w", err)
+	}
+
+	meta, err := vmState.Metadata()
+	if err != nil {
+		return fmt.Errorf("reading firecracker metadata: %w", err)
+	}
+
+	if _, err := p.newClient(vmState, logger).PutMmds(ctx, meta); err != nil {
+		return fmt.Errorf("replacing firecracker mmds: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateNetworkRateLimits will replace the rate limits of the network interfaces of a running
+// microvm using the firecracker API. The interfaces are created with the rate limits in the spec
+// when the microvm is started again, so nothing else needs updating.
+func (p *fcProvider) UpdateNetworkRateLimits(ctx context.Context, vm *models.MicroVM) error {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "firecracker_microvm",
+		"vmid":    vm.ID.String(),
+	})
+	logger.Info("updating microvm network rate limits")
+
+	client := p.newClient(NewState(vm.ID, p.config.StateRoot, p.fs), logger)
+
+	for i := range vm.Spec.NetworkInterfaces {
+		update := networkRateLimitsUpdate(&vm.Spec.NetworkInterfaces[i])
+
+		if _, err := client.PatchGuestNetworkInterfaceByID(ctx, *update.IfaceID, update); err != nil {
+			return fmt.Errorf("updating rate limits of network interface %s: %w", *update.IfaceID, err)
+		}
+	}
+
+	return nil
+}
+
+// networkRateLimitsUpdate returns the update of the rate limits of a network interface. Firecracker
+// leaves a limit that isn't in the update as it is, so limits that aren't set are sent as a
+// bucket of size zero, which removes them.
+func networkRateLimitsUpdate(iface *models.NetworkInterface) *fcmodels.PartialNetworkInterface {
+	limits := iface.RateLimits
+	if limits == nil {
+		limits = &models.NetworkRateLimits{}
+	}
+
+	return &fcmodels.PartialNetworkInterface{
+		IfaceID:       firecracker.String(iface.GuestDeviceName),
+		RxRateLimiter: rateLimiterUpdate(limits.Rx),
+		TxRateLimiter: rateLimiterUpdate(limits.Tx),
+	}
+}
+
+func rateLimiterUpdate(rateLimit *models.RateLimit) *fcmodels.RateLimiter {
+	if rateLimit == nil {
+		rateLimit = &models.RateLimit{}
+	}
+
+	return &fcmodels.RateLimiter{
+		Bandwidth: tokenBucketUpdate(rateLimit.Bandwidth),
+		Ops:       tokenBucketUpdate(rateLimit.Ops),
+	}
+}
+
+func tokenBucketUpdate(bucket *models.TokenBucket) *fcmodels.TokenBucket {
+	if bucket == nil {
+		return &fcmodels.TokenBucket{
+			Size:       firecracker.Int64(0),
+			RefillTime: firecracker.Int64(0),
+		}
+	}
+
+	update := &fcmodels.TokenBucket{
+		Size:       firecracker.Int64(bucket.Size),
+		RefillTime: firecracker.Int64(bucket.RefillTimeMs),
+	}
+
+	if bucket.OneTimeBurst != 0 {
+		update.OneTimeBurst = firecracker.Int64(bucket.OneTimeBurst)
+	}
+
+	return update
+}
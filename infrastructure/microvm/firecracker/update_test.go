@@ -0,0 +1,37 @@
+package firecracker
+
+import (
+	"testing"
+
+	g "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+)
+
+func TestNetworkRateLimitsUpdate(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	update := networkRateLimitsUpdate(&models.NetworkInterface{
+		GuestDeviceName: "eth1",
+		RateLimits: &models.NetworkRateLimits{
+			Tx: &models.RateLimit{
+				Bandwidth: &models.TokenBucket{Size: 1048576, OneTimeBurst: 2097152, RefillTimeMs: 100},
+			},
+		},
+	})
+
+	g.Expect(*update.IfaceID).To(g.Equal("eth1"))
+	g.Expect(*update.TxRateLimiter.Bandwidth.Size).To(g.Equal(int64(1048576)))
+	g.Expect(*update.TxRateLimiter.Bandwidth.OneTimeBurst).To(g.Equal(int64(2097152)))
+	g.Expect(*update.TxRateLimiter.Bandwidth.RefillTime).To(g.Equal(int64(100)))
+
+	// Limits that aren't set are removed with empty buckets.
+	g.Expect(*update.TxRateLimiter.Ops.Size).To(g.BeZero())
+	g.Expect(*update.RxRateLimiter.Bandwidth.Size).To(g.BeZero())
+	g.Expect(*update.RxRateLimiter.Ops.Size).To(g.BeZero())
+
+	update = networkRateLimitsUpdate(&models.NetworkInterface{GuestDeviceName: "eth0"})
+
+	g.Expect(*update.RxRateLimiter.Bandwidth.Size).To(g.BeZero())
+	g.Expect(*update.TxRateLimiter.Ops.RefillTime).To(g.BeZero())
+}
@@ -0,0 +1,143 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	consoleInputPerm    = 0o600
+	consolePollInterval = 250 * time.Millisecond
+	consoleTailChunk    = 4096
+)
+
+// OpenConsoleInput creates the named pipe used for the console input of a vmm, if it doesn't
+// already exist, and opens it so it can be used as the stdin of the vmm process. It's opened
+// for reading and writing so the vmm never sees the end of its input when nothing is attached.
+func OpenConsoleInput(path string) (*os.File, error) {
+	if err := syscall.Mkfifo(path, consoleInputPerm); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("creating console input pipe %s: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening console input pipe %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// OpenConsoleWriter opens the console input pipe of a running vmm so input can be sent to it.
+func OpenConsoleWriter(path string) (io.WriteCloser, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening console input pipe %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// OpenConsoleReader opens the console output file of a vmm. If tailLines is greater than zero
+// reading starts that many lines from the end of the output. If follow is true the reader waits
+// for more output instead of returning io.EOF, until the context is done.
+func OpenConsoleReader(ctx context.Context, fs afero.Fs, path string, tailLines int, follow bool) (io.ReadCloser, error) {
+	file, err := fs.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening console output %s: %w", path, err)
+	}
+
+	offset, err := tailOffset(file, tailLines)
+	if err != nil {
+		file.Close()
+
+		return nil, fmt.Errorf("finding start of the last %d lines of %s: %w", tailLines, path, err)
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+
+		return nil, fmt.Errorf("seeking in console output %s: %w", path, err)
+	}
+
+	return &consoleReader{
+		ctx:    ctx,
+		file:   file,
+		follow: follow,
+	}, nil
+}
+
+type consoleReader struct {
+	ctx    context.Context
+	file   afero.File
+	follow bool
+}
+
+func (r *consoleReader) Read(data []byte) (int, error) {
+	for {
+		read, err := r.file.Read(data)
+		if read > 0 || !errors.Is(err, io.EOF) || !r.follow {
+			return read, err //nolint:wrapcheck // keep io.EOF as it is
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(consolePollInterval):
+		}
+	}
+}
+
+func (r *consoleReader) Close() error {
+	return r.file.Close() //nolint:wrapcheck // nothing to add
+}
+
+// tailOffset returns the offset of the start of the last n lines of the file.
+func tailOffset(file afero.File, lines int) (int64, error) {
+	if lines <= 0 {
+		return 0, nil
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("getting file info: %w", err)
+	}
+
+	size := info.Size()
+	buf := make([]byte, consoleTailChunk)
+	found := 0
+
+	for end := size; end > 0; {
+		start := end - consoleTailChunk
+		if start < 0 {
+			start = 0
+		}
+
+		chunk := buf[:end-start]
+		if _, err := file.ReadAt(chunk, start); err != nil && !errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("reading file: %w", err)
+		}
+
+		for i := len(chunk) - 1; i >= 0; i-- {
+			// A newline at the very end finishes the last line rather than starting a new one.
+			if chunk[i] != '\n' || start+int64(i) == size-1 {
+				continue
+			}
+
+			found++
+			if found == lines {
+				return start + int64(i) + 1, nil
+			}
+		}
+
+		end = start
+	}
+
+	return 0, nil
+}
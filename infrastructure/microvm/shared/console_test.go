@@ -0,0 +1,158 @@
+package shared_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
+)
+
+const consolePath = "/console.stdout"
+
+func TestOpenConsoleReader_Tail(t *testing.T) {
+	longLine := strings.Repeat("x", 5000)
+
+	testCases := []struct {
+		name      string
+		content   string
+		tailLines int
+		expected  string
+	}{
+		{
+			name:      "no tail returns all output",
+			content:   "one\ntwo\nthree\n",
+			tailLines: 0,
+			expected:  "one\ntwo\nthree\n",
+		},
+		{
+			name:      "tail with trailing newline",
+			content:   "one\ntwo\nthree\n",
+			tailLines: 2,
+			expected:  "two\nthree\n",
+		},
+		{
+			name:      "tail without trailing newline",
+			content:   "one\ntwo\nthree",
+			tailLines: 2,
+			expected:  "two\nthree",
+		},
+		{
+			name:      "tail more lines than exist",
+			content:   "one\ntwo\n",
+			tailLines: 10,
+			expected:  "one\ntwo\n",
+		},
+		{
+			name:      "tail across chunks",
+			content:   "one\n" + longLine + "\n" + longLine + "\n",
+			tailLines: 2,
+			expected:  longLine + "\n" + longLine + "\n",
+		},
+		{
+			name:      "empty output",
+			content:   "",
+			tailLines: 5,
+			expected:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			fs := afero.NewMemMapFs()
+			Expect(afero.WriteFile(fs, consolePath, []byte(tc.content), 0o600)).To(Succeed())
+
+			reader, err := shared.OpenConsoleReader(context.Background(), fs, consolePath, tc.tailLines, false)
+			Expect(err).NotTo(HaveOccurred())
+			defer reader.Close()
+
+			data, err := io.ReadAll(reader)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestOpenConsoleReader_Follow(t *testing.T) {
+	RegisterTestingT(t)
+
+	fs := afero.NewOsFs()
+	path := t.TempDir() + consolePath
+	Expect(afero.WriteFile(fs, path, []byte("one\n"), 0o600)).To(Succeed())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	reader, err := shared.OpenConsoleReader(ctx, fs, path, 0, true)
+	Expect(err).NotTo(HaveOccurred())
+	defer reader.Close()
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+
+		file, err := fs.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return
+		}
+		defer file.Close()
+
+		_, _ = file.WriteString("two\n")
+	}()
+
+	buf := make([]byte, 64)
+	received := ""
+
+	for !strings.Contains(received, "two") {
+		read, err := reader.Read(buf)
+		Expect(err).NotTo(HaveOccurred())
+
+		received += string(buf[:read])
+	}
+
+	Expect(received).To(Equal("one\ntwo\n"))
+
+	cancel()
+
+	_, err = reader.Read(buf)
+	Expect(err).To(MatchError(io.EOF))
+}
+
+func TestOpenConsoleReader_Missing(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := shared.OpenConsoleReader(context.Background(), afero.NewMemMapFs(), consolePath, 0, false)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestConsoleInput(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := t.TempDir() + "/console.stdin"
+
+	_, err := shared.OpenConsoleWriter(path)
+	Expect(err).To(HaveOccurred(), "no pipe exists yet")
+
+	vmmStdin, err := shared.OpenConsoleInput(path)
+	Expect(err).NotTo(HaveOccurred())
+	defer vmmStdin.Close()
+
+	writer, err := shared.OpenConsoleWriter(path)
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = writer.Write([]byte("root\n"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(writer.Close()).To(Succeed())
+
+	buf := make([]byte, 16)
+	read, err := vmmStdin.Read(buf)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(buf[:read])).To(Equal("root\n"))
+}
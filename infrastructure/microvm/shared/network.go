@@ -38,6 +38,7 @@ func GenerateNetworkConfig(vm *models.MicroVM) (string, error) {
 			DHCP4:          firecracker.Bool(true),
 			DHCP6:          firecracker.Bool(true),
 			DHCPIdentifier: firecracker.String(network.DhcpIdentifierMac),
+			MTU:            mtu(&iface, status),
 		}
 
 		if macAddress != "" {
@@ -46,8 +47,14 @@ func GenerateNetworkConfig(vm *models.MicroVM) (string, error) {
 			eth.Match.Name = iface.GuestDeviceName
 		}
 
-		if iface.StaticAddress != nil {
-			if err := configureStaticEthernet(&iface, eth); err != nil {
+		// An address allocated from the pool of the bridge is used like a static address.
+		address := iface.StaticAddress
+		if address == nil {
+			address = status.AllocatedAddress
+		}
+
+		if address != nil {
+			if err := configureStaticEthernet(address, eth); err != nil {
 				return "", fmt.Errorf("configuring static ethernet address: %w", err)
 			}
 		}
@@ -63,16 +70,16 @@ func GenerateNetworkConfig(vm *models.MicroVM) (string, error) {
 	return base64.StdEncoding.EncodeToString(nd), nil
 }
 
-func configureStaticEthernet(iface *models.NetworkInterface, eth *network.Ethernet) error {
-	eth.Addresses = []string{string(iface.StaticAddress.Address)}
+func configureStaticEthernet(address *models.StaticAddress, eth *network.Ethernet) error {
+	eth.Addresses = []string{string(address.Address)}
 
-	if iface.StaticAddress.Gateway != nil {
-		isIPv4, err := iface.StaticAddress.Gateway.IsIPv4()
+	if address.Gateway != nil {
+		isIPv4, err := address.Gateway.IsIPv4()
 		if err != nil {
 			return fmt.Errorf("parsing gateway address: %w", err)
 		}
 
-		ipAddr, err := iface.StaticAddress.Gateway.IP()
+		ipAddr, err := address.Gateway.IP()
 		if err != nil {
 			return fmt.Errorf("parsing gateway address: %w", err)
 		}
@@ -84,12 +91,12 @@ func configureStaticEthernet(iface *models.NetworkInterface, eth *network.Ethern
 		}
 	}
 
-	if len(iface.StaticAddress.Nameservers) > 0 {
+	if len(address.Nameservers) > 0 {
 		eth.Nameservers = network.Nameservers{
 			Addresses: []string{},
 		}
 
-		eth.Nameservers.Addresses = append(eth.Nameservers.Addresses, iface.StaticAddress.Nameservers...)
+		eth.Nameservers.Addresses = append(eth.Nameservers.Addresses, address.Nameservers...)
 	}
 
 	eth.DHCP4 = firecracker.Bool(false)
@@ -98,10 +105,20 @@ func configureStaticEthernet(iface *models.NetworkInterface, eth *network.Ethern
 	return nil
 }
 
+// mtu returns the MTU for the interface in the guest. It's the MTU of the interface if it sets
+// one, otherwise the MTU of its named network that was set on the host device.
+func mtu(iface *models.NetworkInterface, status *models.NetworkInterfaceStatus) int {
+	if iface.MTU != 0 || iface.NetworkName == "" {
+		return iface.MTU
+	}
+
+	return status.MTU
+}
+
 func getMacAddress(iface *models.NetworkInterface, status *models.NetworkInterfaceStatus) string {
 	if iface.Type == models.IfaceTypeMacvtap {
 		return status.MACAddress
 	}
 
-	return iface.GuestMAC
+	return iface.GuestMACAddress(status)
 }
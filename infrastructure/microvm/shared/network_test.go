@@ -0,0 +1,53 @@
+package shared_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/liquidmetal-dev/flintlock/client/cloudinit/network"
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm/shared"
+)
+
+func TestGenerateNetworkConfig_MTU(t *testing.T) {
+	g := NewWithT(t)
+
+	vm := &models.MicroVM{
+		Spec: models.MicroVMSpec{
+			NetworkInterfaces: []models.NetworkInterface{
+				{GuestDeviceName: "eth0", GuestMAC: "AA:FF:00:00:00:01", Type: models.IfaceTypeTap},
+				{GuestDeviceName: "eth1", GuestMAC: "AA:FF:00:00:00:02", Type: models.IfaceTypeTap, MTU: 9000},
+				{GuestDeviceName: "eth2", GuestMAC: "AA:FF:00:00:00:03", Type: models.IfaceTypeTap, NetworkName: "jumbo"},
+				{
+					GuestDeviceName: "eth3", GuestMAC: "AA:FF:00:00:00:04", Type: models.IfaceTypeTap,
+					NetworkName: "jumbo", MTU: 1400,
+				},
+			},
+		},
+		Status: models.MicroVMStatus{
+			NetworkInterfaces: models.NetworkInterfaceStatuses{
+				"eth0": &models.NetworkInterfaceStatus{HostDeviceName: "fltap0"},
+				"eth1": &models.NetworkInterfaceStatus{HostDeviceName: "fltap1"},
+				"eth2": &models.NetworkInterfaceStatus{HostDeviceName: "fltap2", MTU: 9000},
+				"eth3": &models.NetworkInterfaceStatus{HostDeviceName: "fltap3", MTU: 1400},
+			},
+		},
+	}
+
+	encoded, err := shared.GenerateNetworkConfig(vm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring("mtu: 9000"))
+
+	netConf := &network.Network{}
+	g.Expect(yaml.Unmarshal(data, netConf)).To(Succeed())
+	g.Expect(netConf.Ethernet["eth0"].MTU).To(BeZero())
+	g.Expect(netConf.Ethernet["eth1"].MTU).To(Equal(9000))
+	g.Expect(netConf.Ethernet["eth2"].MTU).To(Equal(9000))
+	g.Expect(netConf.Ethernet["eth3"].MTU).To(Equal(1400))
+}
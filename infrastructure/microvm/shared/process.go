@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"os/exec"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/netns"
+	"github.com/liquidmetal-dev/flintlock/pkg/process"
+)
+
+// StartProcess starts a vmm process, detached from flintlock if required. If the microvm has a
+// network namespace the process is started in it, so only its own network interfaces are
+// visible to it.
+func StartProcess(cmd *exec.Cmd, detached bool, networkNamespace string) error {
+	start := cmd.Start
+	if detached {
+		start = func() error {
+			return process.DetachedStart(cmd)
+		}
+	}
+
+	if networkNamespace == "" {
+		return start()
+	}
+
+	return netns.Do(networkNamespace, start)
+}
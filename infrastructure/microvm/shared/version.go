@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// BinaryVersion runs a vmm binary with --version and returns the first line it outputs.
+func BinaryVersion(ctx context.Context, bin string) (string, error) {
+	out, err := exec.CommandContext(ctx, bin, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("getting version of %s: %w", bin, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	if scanner.Scan() {
+		return strings.TrimSpace(scanner.Text()), nil
+	}
+
+	return "", nil
+}
@@ -1,11 +1,12 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/liquidmetal-dev/flintlock/core/ports (interfaces: MicroVMService,MicroVMRepository,EventService,IDService,ImageService,ReconcileMicroVMsUseCase,NetworkService,MicroVMCommandUseCases,MicroVMQueryUseCases)
+// Source: github.com/liquidmetal-dev/flintlock/core/ports (interfaces: MicroVMService,MicroVMRepository,SnapshotRepository,HistoryRepository,QuotaRepository,EventService,IDService,ImageService,ReconcileMicroVMsUseCase,NetworkService,IPAMService,DHCPService,FirewallService,MicroVMCommandUseCases,MicroVMQueryUseCases,GuestAgentService,HostService)
 
 // Package mock is a generated GoMock package.
 package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -50,6 +51,36 @@ func (mr *MockMicroVMServiceMockRecorder) Capabilities() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*MockMicroVMService)(nil).Capabilities))
 }
 
+// ConsoleInput mocks base method.
+func (m *MockMicroVMService) ConsoleInput(arg0 context.Context, arg1 string) (io.WriteCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsoleInput", arg0, arg1)
+	ret0, _ := ret[0].(io.WriteCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsoleInput indicates an expected call of ConsoleInput.
+func (mr *MockMicroVMServiceMockRecorder) ConsoleInput(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsoleInput", reflect.TypeOf((*MockMicroVMService)(nil).ConsoleInput), arg0, arg1)
+}
+
+// ConsoleOutput mocks base method.
+func (m *MockMicroVMService) ConsoleOutput(arg0 context.Context, arg1 string, arg2 ports.ConsoleOutputOptions) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsoleOutput", arg0, arg1, arg2)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsoleOutput indicates an expected call of ConsoleOutput.
+func (mr *MockMicroVMServiceMockRecorder) ConsoleOutput(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsoleOutput", reflect.TypeOf((*MockMicroVMService)(nil).ConsoleOutput), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockMicroVMService) Create(arg0 context.Context, arg1 *models.MicroVM) error {
 	m.ctrl.T.Helper()
@@ -93,6 +124,76 @@ func (mr *MockMicroVMServiceMockRecorder) Metrics(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metrics", reflect.TypeOf((*MockMicroVMService)(nil).Metrics), arg0, arg1)
 }
 
+// Pause mocks base method.
+func (m *MockMicroVMService) Pause(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockMicroVMServiceMockRecorder) Pause(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockMicroVMService)(nil).Pause), arg0, arg1)
+}
+
+// Restart mocks base method.
+func (m *MockMicroVMService) Restart(arg0 context.Context, arg1 *models.MicroVM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockMicroVMServiceMockRecorder) Restart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockMicroVMService)(nil).Restart), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockMicroVMService) Restore(arg0 context.Context, arg1 *models.MicroVM, arg2 *models.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMicroVMServiceMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMicroVMService)(nil).Restore), arg0, arg1, arg2)
+}
+
+// Resume mocks base method.
+func (m *MockMicroVMService) Resume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockMicroVMServiceMockRecorder) Resume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockMicroVMService)(nil).Resume), arg0, arg1)
+}
+
+// Snapshot mocks base method.
+func (m *MockMicroVMService) Snapshot(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockMicroVMServiceMockRecorder) Snapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockMicroVMService)(nil).Snapshot), arg0, arg1, arg2)
+}
+
 // Start mocks base method.
 func (m *MockMicroVMService) Start(arg0 context.Context, arg1 *models.MicroVM) error {
 	m.ctrl.T.Helper()
@@ -122,6 +223,77 @@ func (mr *MockMicroVMServiceMockRecorder) State(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockMicroVMService)(nil).State), arg0, arg1)
 }
 
+// Stop mocks base method.
+func (m *MockMicroVMService) Stop(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockMicroVMServiceMockRecorder) Stop(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockMicroVMService)(nil).Stop), arg0, arg1)
+}
+
+// UpdateDevices mocks base method.
+func (m *MockMicroVMService) UpdateDevices(arg0 context.Context, arg1 *models.MicroVM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDevices", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDevices indicates an expected call of UpdateDevices.
+func (mr *MockMicroVMServiceMockRecorder) UpdateDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevices", reflect.TypeOf((*MockMicroVMService)(nil).UpdateDevices), arg0, arg1)
+}
+
+// UpdateMetadata mocks base method.
+func (m *MockMicroVMService) UpdateMetadata(arg0 context.Context, arg1 *models.MicroVM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMetadata", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMetadata indicates an expected call of UpdateMetadata.
+func (mr *MockMicroVMServiceMockRecorder) UpdateMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetadata", reflect.TypeOf((*MockMicroVMService)(nil).UpdateMetadata), arg0, arg1)
+}
+
+// UpdateNetworkRateLimits mocks base method.
+func (m *MockMicroVMService) UpdateNetworkRateLimits(arg0 context.Context, arg1 *models.MicroVM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkRateLimits", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkRateLimits indicates an expected call of UpdateNetworkRateLimits.
+func (mr *MockMicroVMServiceMockRecorder) UpdateNetworkRateLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkRateLimits", reflect.TypeOf((*MockMicroVMService)(nil).UpdateNetworkRateLimits), arg0, arg1)
+}
+
+// Version mocks base method.
+func (m *MockMicroVMService) Version(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockMicroVMServiceMockRecorder) Version(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockMicroVMService)(nil).Version), arg0)
+}
+
 // MockMicroVMRepository is a mock of MicroVMRepository interface.
 type MockMicroVMRepository struct {
 	ctrl     *gomock.Controller
@@ -233,6 +405,207 @@ func (mr *MockMicroVMRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockMicroVMRepository)(nil).Save), arg0, arg1)
 }
 
+// MockSnapshotRepository is a mock of SnapshotRepository interface.
+type MockSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotRepositoryMockRecorder
+}
+
+// MockSnapshotRepositoryMockRecorder is the mock recorder for MockSnapshotRepository.
+type MockSnapshotRepositoryMockRecorder struct {
+	mock *MockSnapshotRepository
+}
+
+// NewMockSnapshotRepository creates a new mock instance.
+func NewMockSnapshotRepository(ctrl *gomock.Controller) *MockSnapshotRepository {
+	mock := &MockSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotRepository) EXPECT() *MockSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSnapshotRepository) Delete(arg0 context.Context, arg1 *models.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSnapshotRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotRepository)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockSnapshotRepository) Get(arg0 context.Context, arg1 string) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSnapshotRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotRepository)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockSnapshotRepository) GetAll(arg0 context.Context, arg1 models.ListSnapshotQuery) ([]*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSnapshotRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSnapshotRepository)(nil).GetAll), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockSnapshotRepository) Save(arg0 context.Context, arg1 *models.Snapshot) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSnapshotRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSnapshotRepository)(nil).Save), arg0, arg1)
+}
+
+// MockHistoryRepository is a mock of HistoryRepository interface.
+type MockHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryRepositoryMockRecorder
+}
+
+// MockHistoryRepositoryMockRecorder is the mock recorder for MockHistoryRepository.
+type MockHistoryRepositoryMockRecorder struct {
+	mock *MockHistoryRepository
+}
+
+// NewMockHistoryRepository creates a new mock instance.
+func NewMockHistoryRepository(ctrl *gomock.Controller) *MockHistoryRepository {
+	mock := &MockHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryRepository) EXPECT() *MockHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockHistoryRepository) Append(arg0 context.Context, arg1 models.VMID, arg2 *models.PlanExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockHistoryRepositoryMockRecorder) Append(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockHistoryRepository)(nil).Append), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockHistoryRepository) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHistoryRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHistoryRepository)(nil).Delete), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockHistoryRepository) GetAll(arg0 context.Context, arg1 string) ([]*models.PlanExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*models.PlanExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockHistoryRepositoryMockRecorder) GetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockHistoryRepository)(nil).GetAll), arg0, arg1)
+}
+
+// MockQuotaRepository is a mock of QuotaRepository interface.
+type MockQuotaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaRepositoryMockRecorder
+}
+
+// MockQuotaRepositoryMockRecorder is the mock recorder for MockQuotaRepository.
+type MockQuotaRepositoryMockRecorder struct {
+	mock *MockQuotaRepository
+}
+
+// NewMockQuotaRepository creates a new mock instance.
+func NewMockQuotaRepository(ctrl *gomock.Controller) *MockQuotaRepository {
+	mock := &MockQuotaRepository{ctrl: ctrl}
+	mock.recorder = &MockQuotaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaRepository) EXPECT() *MockQuotaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockQuotaRepository) Get(arg0 context.Context, arg1 string) (*models.NamespaceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*models.NamespaceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockQuotaRepositoryMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockQuotaRepository)(nil).Get), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockQuotaRepository) Save(arg0 context.Context, arg1 *models.NamespaceQuota) (*models.NamespaceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(*models.NamespaceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockQuotaRepositoryMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockQuotaRepository)(nil).Save), arg0, arg1)
+}
+
 // MockEventService is a mock of EventService interface.
 type MockEventService struct {
 	ctrl     *gomock.Controller
@@ -435,6 +808,20 @@ func (mr *MockImageServiceMockRecorder) PullAndMount(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullAndMount", reflect.TypeOf((*MockImageService)(nil).PullAndMount), arg0, arg1)
 }
 
+// Unmount mocks base method.
+func (m *MockImageService) Unmount(arg0 context.Context, arg1 *ports.ImageMountSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmount indicates an expected call of Unmount.
+func (mr *MockImageServiceMockRecorder) Unmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmount", reflect.TypeOf((*MockImageService)(nil).Unmount), arg0, arg1)
+}
+
 // MockReconcileMicroVMsUseCase is a mock of ReconcileMicroVMsUseCase interface.
 type MockReconcileMicroVMsUseCase struct {
 	ctrl     *gomock.Controller
@@ -472,6 +859,20 @@ func (mr *MockReconcileMicroVMsUseCaseMockRecorder) ReconcileMicroVM(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileMicroVM", reflect.TypeOf((*MockReconcileMicroVMsUseCase)(nil).ReconcileMicroVM), arg0, arg1)
 }
 
+// RestoreDHCPLeases mocks base method.
+func (m *MockReconcileMicroVMsUseCase) RestoreDHCPLeases(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDHCPLeases", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDHCPLeases indicates an expected call of RestoreDHCPLeases.
+func (mr *MockReconcileMicroVMsUseCaseMockRecorder) RestoreDHCPLeases(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDHCPLeases", reflect.TypeOf((*MockReconcileMicroVMsUseCase)(nil).RestoreDHCPLeases), arg0)
+}
+
 // MockNetworkService is a mock of NetworkService interface.
 type MockNetworkService struct {
 	ctrl     *gomock.Controller
@@ -525,44 +926,311 @@ func (mr *MockNetworkServiceMockRecorder) IfaceDelete(arg0, arg1 interface{}) *g
 }
 
 // IfaceDetails mocks base method.
-func (m *MockNetworkService) IfaceDetails(arg0 context.Context, arg1 string) (*ports.IfaceDetails, error) {
+func (m *MockNetworkService) IfaceDetails(arg0 context.Context, arg1, arg2 string) (*ports.IfaceDetails, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IfaceDetails", arg0, arg1)
+	ret := m.ctrl.Call(m, "IfaceDetails", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*ports.IfaceDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IfaceDetails indicates an expected call of IfaceDetails.
-func (mr *MockNetworkServiceMockRecorder) IfaceDetails(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockNetworkServiceMockRecorder) IfaceDetails(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IfaceDetails", reflect.TypeOf((*MockNetworkService)(nil).IfaceDetails), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IfaceDetails", reflect.TypeOf((*MockNetworkService)(nil).IfaceDetails), arg0, arg1, arg2)
 }
 
 // IfaceExists mocks base method.
-func (m *MockNetworkService) IfaceExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockNetworkService) IfaceExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IfaceExists", arg0, arg1)
+	ret := m.ctrl.Call(m, "IfaceExists", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IfaceExists indicates an expected call of IfaceExists.
-func (mr *MockNetworkServiceMockRecorder) IfaceExists(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockNetworkServiceMockRecorder) IfaceExists(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IfaceExists", reflect.TypeOf((*MockNetworkService)(nil).IfaceExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IfaceExists", reflect.TypeOf((*MockNetworkService)(nil).IfaceExists), arg0, arg1, arg2)
 }
 
-// MockMicroVMCommandUseCases is a mock of MicroVMCommandUseCases interface.
-type MockMicroVMCommandUseCases struct {
-	ctrl     *gomock.Controller
-	recorder *MockMicroVMCommandUseCasesMockRecorder
+// NamespaceCreate mocks base method.
+func (m *MockNetworkService) NamespaceCreate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockMicroVMCommandUseCasesMockRecorder is the mock recorder for MockMicroVMCommandUseCases.
-type MockMicroVMCommandUseCasesMockRecorder struct {
-	mock *MockMicroVMCommandUseCases
+// NamespaceCreate indicates an expected call of NamespaceCreate.
+func (mr *MockNetworkServiceMockRecorder) NamespaceCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceCreate", reflect.TypeOf((*MockNetworkService)(nil).NamespaceCreate), arg0, arg1)
+}
+
+// NamespaceDelete mocks base method.
+func (m *MockNetworkService) NamespaceDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NamespaceDelete indicates an expected call of NamespaceDelete.
+func (mr *MockNetworkServiceMockRecorder) NamespaceDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceDelete", reflect.TypeOf((*MockNetworkService)(nil).NamespaceDelete), arg0, arg1)
+}
+
+// NamespaceExists mocks base method.
+func (m *MockNetworkService) NamespaceExists(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamespaceExists indicates an expected call of NamespaceExists.
+func (mr *MockNetworkServiceMockRecorder) NamespaceExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceExists", reflect.TypeOf((*MockNetworkService)(nil).NamespaceExists), arg0, arg1)
+}
+
+// MockIPAMService is a mock of IPAMService interface.
+type MockIPAMService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPAMServiceMockRecorder
+}
+
+// MockIPAMServiceMockRecorder is the mock recorder for MockIPAMService.
+type MockIPAMServiceMockRecorder struct {
+	mock *MockIPAMService
+}
+
+// NewMockIPAMService creates a new mock instance.
+func NewMockIPAMService(ctrl *gomock.Controller) *MockIPAMService {
+	mock := &MockIPAMService{ctrl: ctrl}
+	mock.recorder = &MockIPAMServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPAMService) EXPECT() *MockIPAMServiceMockRecorder {
+	return m.recorder
+}
+
+// Allocate mocks base method.
+func (m *MockIPAMService) Allocate(arg0 context.Context, arg1 ports.IPAllocateInput) (*ports.IPAllocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allocate", arg0, arg1)
+	ret0, _ := ret[0].(*ports.IPAllocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allocate indicates an expected call of Allocate.
+func (mr *MockIPAMServiceMockRecorder) Allocate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allocate", reflect.TypeOf((*MockIPAMService)(nil).Allocate), arg0, arg1)
+}
+
+// HasPool mocks base method.
+func (m *MockIPAMService) HasPool(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPool", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasPool indicates an expected call of HasPool.
+func (mr *MockIPAMServiceMockRecorder) HasPool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPool", reflect.TypeOf((*MockIPAMService)(nil).HasPool), arg0)
+}
+
+// Release mocks base method.
+func (m *MockIPAMService) Release(arg0 context.Context, arg1 ports.IPReleaseInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIPAMServiceMockRecorder) Release(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIPAMService)(nil).Release), arg0, arg1)
+}
+
+// MockDHCPService is a mock of DHCPService interface.
+type MockDHCPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDHCPServiceMockRecorder
+}
+
+// MockDHCPServiceMockRecorder is the mock recorder for MockDHCPService.
+type MockDHCPServiceMockRecorder struct {
+	mock *MockDHCPService
+}
+
+// NewMockDHCPService creates a new mock instance.
+func NewMockDHCPService(ctrl *gomock.Controller) *MockDHCPService {
+	mock := &MockDHCPService{ctrl: ctrl}
+	mock.recorder = &MockDHCPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDHCPService) EXPECT() *MockDHCPServiceMockRecorder {
+	return m.recorder
+}
+
+// AddLease mocks base method.
+func (m *MockDHCPService) AddLease(arg0 context.Context, arg1 ports.DHCPLease) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLease", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLease indicates an expected call of AddLease.
+func (mr *MockDHCPServiceMockRecorder) AddLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLease", reflect.TypeOf((*MockDHCPService)(nil).AddLease), arg0, arg1)
+}
+
+// HasLease mocks base method.
+func (m *MockDHCPService) HasLease(arg0 string, arg1 models.IPAddressCIDR) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasLease", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasLease indicates an expected call of HasLease.
+func (mr *MockDHCPServiceMockRecorder) HasLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasLease", reflect.TypeOf((*MockDHCPService)(nil).HasLease), arg0, arg1)
+}
+
+// RemoveLease mocks base method.
+func (m *MockDHCPService) RemoveLease(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLease", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLease indicates an expected call of RemoveLease.
+func (mr *MockDHCPServiceMockRecorder) RemoveLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLease", reflect.TypeOf((*MockDHCPService)(nil).RemoveLease), arg0, arg1)
+}
+
+// Serves mocks base method.
+func (m *MockDHCPService) Serves(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serves", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Serves indicates an expected call of Serves.
+func (mr *MockDHCPServiceMockRecorder) Serves(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serves", reflect.TypeOf((*MockDHCPService)(nil).Serves), arg0)
+}
+
+// Start mocks base method.
+func (m *MockDHCPService) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockDHCPServiceMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDHCPService)(nil).Start), arg0)
+}
+
+// MockFirewallService is a mock of FirewallService interface.
+type MockFirewallService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallServiceMockRecorder
+}
+
+// MockFirewallServiceMockRecorder is the mock recorder for MockFirewallService.
+type MockFirewallServiceMockRecorder struct {
+	mock *MockFirewallService
+}
+
+// NewMockFirewallService creates a new mock instance.
+func NewMockFirewallService(ctrl *gomock.Controller) *MockFirewallService {
+	mock := &MockFirewallService{ctrl: ctrl}
+	mock.recorder = &MockFirewallServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallService) EXPECT() *MockFirewallServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockFirewallService) Apply(arg0 context.Context, arg1 ports.FirewallInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockFirewallServiceMockRecorder) Apply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockFirewallService)(nil).Apply), arg0, arg1)
+}
+
+// Remove mocks base method.
+func (m *MockFirewallService) Remove(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockFirewallServiceMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockFirewallService)(nil).Remove), arg0, arg1)
+}
+
+// Verify mocks base method.
+func (m *MockFirewallService) Verify(arg0 context.Context, arg1 ports.FirewallInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockFirewallServiceMockRecorder) Verify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockFirewallService)(nil).Verify), arg0, arg1)
+}
+
+// MockMicroVMCommandUseCases is a mock of MicroVMCommandUseCases interface.
+type MockMicroVMCommandUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockMicroVMCommandUseCasesMockRecorder
+}
+
+// MockMicroVMCommandUseCasesMockRecorder is the mock recorder for MockMicroVMCommandUseCases.
+type MockMicroVMCommandUseCasesMockRecorder struct {
+	mock *MockMicroVMCommandUseCases
 }
 
 // NewMockMicroVMCommandUseCases creates a new mock instance.
@@ -577,6 +1245,36 @@ func (m *MockMicroVMCommandUseCases) EXPECT() *MockMicroVMCommandUseCasesMockRec
 	return m.recorder
 }
 
+// AttachConsole mocks base method.
+func (m *MockMicroVMCommandUseCases) AttachConsole(arg0 context.Context, arg1 string) (io.ReadCloser, io.WriteCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachConsole", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(io.WriteCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AttachConsole indicates an expected call of AttachConsole.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) AttachConsole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachConsole", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).AttachConsole), arg0, arg1)
+}
+
+// CopyToMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) CopyToMicroVM(arg0 context.Context, arg1 string, arg2 ports.GuestCopyToInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToMicroVM", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyToMicroVM indicates an expected call of CopyToMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) CopyToMicroVM(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).CopyToMicroVM), arg0, arg1, arg2)
+}
+
 // CreateMicroVM mocks base method.
 func (m *MockMicroVMCommandUseCases) CreateMicroVM(arg0 context.Context, arg1 *models.MicroVM) (*models.MicroVM, error) {
 	m.ctrl.T.Helper()
@@ -592,6 +1290,21 @@ func (mr *MockMicroVMCommandUseCasesMockRecorder) CreateMicroVM(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).CreateMicroVM), arg0, arg1)
 }
 
+// CreateSnapshot mocks base method.
+func (m *MockMicroVMCommandUseCases) CreateSnapshot(arg0 context.Context, arg1, arg2 string) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) CreateSnapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).CreateSnapshot), arg0, arg1, arg2)
+}
+
 // DeleteMicroVM mocks base method.
 func (m *MockMicroVMCommandUseCases) DeleteMicroVM(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -606,6 +1319,135 @@ func (mr *MockMicroVMCommandUseCasesMockRecorder) DeleteMicroVM(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).DeleteMicroVM), arg0, arg1)
 }
 
+// DeleteSnapshot mocks base method.
+func (m *MockMicroVMCommandUseCases) DeleteSnapshot(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) DeleteSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).DeleteSnapshot), arg0, arg1)
+}
+
+// ExecInMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) ExecInMicroVM(arg0 context.Context, arg1 string, arg2 ports.GuestExecInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecInMicroVM", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecInMicroVM indicates an expected call of ExecInMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) ExecInMicroVM(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecInMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).ExecInMicroVM), arg0, arg1, arg2)
+}
+
+// PauseMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) PauseMicroVM(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseMicroVM", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseMicroVM indicates an expected call of PauseMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) PauseMicroVM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).PauseMicroVM), arg0, arg1)
+}
+
+// RestartMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) RestartMicroVM(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartMicroVM", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartMicroVM indicates an expected call of RestartMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) RestartMicroVM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).RestartMicroVM), arg0, arg1)
+}
+
+// ResumeMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) ResumeMicroVM(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeMicroVM", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeMicroVM indicates an expected call of ResumeMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) ResumeMicroVM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).ResumeMicroVM), arg0, arg1)
+}
+
+// SetNamespaceQuota mocks base method.
+func (m *MockMicroVMCommandUseCases) SetNamespaceQuota(arg0 context.Context, arg1 *models.NamespaceQuota) (*models.NamespaceQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNamespaceQuota", arg0, arg1)
+	ret0, _ := ret[0].(*models.NamespaceQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNamespaceQuota indicates an expected call of SetNamespaceQuota.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) SetNamespaceQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuota", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).SetNamespaceQuota), arg0, arg1)
+}
+
+// StartMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) StartMicroVM(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMicroVM", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartMicroVM indicates an expected call of StartMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) StartMicroVM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).StartMicroVM), arg0, arg1)
+}
+
+// StopMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) StopMicroVM(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopMicroVM", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopMicroVM indicates an expected call of StopMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) StopMicroVM(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).StopMicroVM), arg0, arg1)
+}
+
+// UpdateMicroVM mocks base method.
+func (m *MockMicroVMCommandUseCases) UpdateMicroVM(arg0 context.Context, arg1 string, arg2 *models.MicroVM) (*models.MicroVM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMicroVM", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.MicroVM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMicroVM indicates an expected call of UpdateMicroVM.
+func (mr *MockMicroVMCommandUseCasesMockRecorder) UpdateMicroVM(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMicroVM", reflect.TypeOf((*MockMicroVMCommandUseCases)(nil).UpdateMicroVM), arg0, arg1, arg2)
+}
+
 // MockMicroVMQueryUseCases is a mock of MicroVMQueryUseCases interface.
 type MockMicroVMQueryUseCases struct {
 	ctrl     *gomock.Controller
@@ -629,6 +1471,20 @@ func (m *MockMicroVMQueryUseCases) EXPECT() *MockMicroVMQueryUseCasesMockRecorde
 	return m.recorder
 }
 
+// CopyFromMicroVM mocks base method.
+func (m *MockMicroVMQueryUseCases) CopyFromMicroVM(arg0 context.Context, arg1, arg2 string, arg3 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFromMicroVM", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFromMicroVM indicates an expected call of CopyFromMicroVM.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) CopyFromMicroVM(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFromMicroVM", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).CopyFromMicroVM), arg0, arg1, arg2, arg3)
+}
+
 // GetAllMicroVM mocks base method.
 func (m *MockMicroVMQueryUseCases) GetAllMicroVM(arg0 context.Context, arg1 models.ListMicroVMQuery) ([]*models.MicroVM, error) {
 	m.ctrl.T.Helper()
@@ -644,6 +1500,65 @@ func (mr *MockMicroVMQueryUseCasesMockRecorder) GetAllMicroVM(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMicroVM", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetAllMicroVM), arg0, arg1)
 }
 
+// GetAllSnapshots mocks base method.
+func (m *MockMicroVMQueryUseCases) GetAllSnapshots(arg0 context.Context, arg1 models.ListSnapshotQuery) ([]*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSnapshots indicates an expected call of GetAllSnapshots.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetAllSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSnapshots", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetAllSnapshots), arg0, arg1)
+}
+
+// GetConsoleLog mocks base method.
+func (m *MockMicroVMQueryUseCases) GetConsoleLog(arg0 context.Context, arg1 string, arg2 ports.ConsoleOutputOptions, arg3 func([]byte) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleLog", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetConsoleLog indicates an expected call of GetConsoleLog.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetConsoleLog(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleLog", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetConsoleLog), arg0, arg1, arg2, arg3)
+}
+
+// GetHostCapacity mocks base method.
+func (m *MockMicroVMQueryUseCases) GetHostCapacity(arg0 context.Context) (*models.HostCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostCapacity", arg0)
+	ret0, _ := ret[0].(*models.HostCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostCapacity indicates an expected call of GetHostCapacity.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetHostCapacity(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostCapacity", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetHostCapacity), arg0)
+}
+
+// GetHostInfo mocks base method.
+func (m *MockMicroVMQueryUseCases) GetHostInfo(arg0 context.Context) (*models.HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInfo", arg0)
+	ret0, _ := ret[0].(*models.HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInfo indicates an expected call of GetHostInfo.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetHostInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInfo", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetHostInfo), arg0)
+}
+
 // GetMicroVM mocks base method.
 func (m *MockMicroVMQueryUseCases) GetMicroVM(arg0 context.Context, arg1 string) (*models.MicroVM, error) {
 	m.ctrl.T.Helper()
@@ -658,3 +1573,180 @@ func (mr *MockMicroVMQueryUseCasesMockRecorder) GetMicroVM(arg0, arg1 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMicroVM", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetMicroVM), arg0, arg1)
 }
+
+// GetMicroVMHistory mocks base method.
+func (m *MockMicroVMQueryUseCases) GetMicroVMHistory(arg0 context.Context, arg1 string) ([]*models.PlanExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMicroVMHistory", arg0, arg1)
+	ret0, _ := ret[0].([]*models.PlanExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMicroVMHistory indicates an expected call of GetMicroVMHistory.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetMicroVMHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMicroVMHistory", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetMicroVMHistory), arg0, arg1)
+}
+
+// GetNamespaceQuota mocks base method.
+func (m *MockMicroVMQueryUseCases) GetNamespaceQuota(arg0 context.Context, arg1 string) (*models.NamespaceQuotaStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceQuota", arg0, arg1)
+	ret0, _ := ret[0].(*models.NamespaceQuotaStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceQuota indicates an expected call of GetNamespaceQuota.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) GetNamespaceQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceQuota", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).GetNamespaceQuota), arg0, arg1)
+}
+
+// WatchMicroVMs mocks base method.
+func (m *MockMicroVMQueryUseCases) WatchMicroVMs(arg0 context.Context, arg1 models.WatchMicroVMQuery, arg2 func(*models.MicroVMEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMicroVMs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchMicroVMs indicates an expected call of WatchMicroVMs.
+func (mr *MockMicroVMQueryUseCasesMockRecorder) WatchMicroVMs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMicroVMs", reflect.TypeOf((*MockMicroVMQueryUseCases)(nil).WatchMicroVMs), arg0, arg1, arg2)
+}
+
+// MockGuestAgentService is a mock of GuestAgentService interface.
+type MockGuestAgentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuestAgentServiceMockRecorder
+}
+
+// MockGuestAgentServiceMockRecorder is the mock recorder for MockGuestAgentService.
+type MockGuestAgentServiceMockRecorder struct {
+	mock *MockGuestAgentService
+}
+
+// NewMockGuestAgentService creates a new mock instance.
+func NewMockGuestAgentService(ctrl *gomock.Controller) *MockGuestAgentService {
+	mock := &MockGuestAgentService{ctrl: ctrl}
+	mock.recorder = &MockGuestAgentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuestAgentService) EXPECT() *MockGuestAgentServiceMockRecorder {
+	return m.recorder
+}
+
+// CopyFrom mocks base method.
+func (m *MockGuestAgentService) CopyFrom(arg0 context.Context, arg1, arg2 string, arg3 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFrom", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFrom indicates an expected call of CopyFrom.
+func (mr *MockGuestAgentServiceMockRecorder) CopyFrom(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFrom", reflect.TypeOf((*MockGuestAgentService)(nil).CopyFrom), arg0, arg1, arg2, arg3)
+}
+
+// CopyTo mocks base method.
+func (m *MockGuestAgentService) CopyTo(arg0 context.Context, arg1 string, arg2 ports.GuestCopyToInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTo indicates an expected call of CopyTo.
+func (mr *MockGuestAgentServiceMockRecorder) CopyTo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTo", reflect.TypeOf((*MockGuestAgentService)(nil).CopyTo), arg0, arg1, arg2)
+}
+
+// Exec mocks base method.
+func (m *MockGuestAgentService) Exec(arg0 context.Context, arg1 string, arg2 ports.GuestExecInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockGuestAgentServiceMockRecorder) Exec(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockGuestAgentService)(nil).Exec), arg0, arg1, arg2)
+}
+
+// Ping mocks base method.
+func (m *MockGuestAgentService) Ping(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockGuestAgentServiceMockRecorder) Ping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockGuestAgentService)(nil).Ping), arg0, arg1)
+}
+
+// MockHostService is a mock of HostService interface.
+type MockHostService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHostServiceMockRecorder
+}
+
+// MockHostServiceMockRecorder is the mock recorder for MockHostService.
+type MockHostServiceMockRecorder struct {
+	mock *MockHostService
+}
+
+// NewMockHostService creates a new mock instance.
+func NewMockHostService(ctrl *gomock.Controller) *MockHostService {
+	mock := &MockHostService{ctrl: ctrl}
+	mock.recorder = &MockHostServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHostService) EXPECT() *MockHostServiceMockRecorder {
+	return m.recorder
+}
+
+// Info mocks base method.
+func (m *MockHostService) Info(arg0 context.Context) (*models.HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Info", arg0)
+	ret0, _ := ret[0].(*models.HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Info indicates an expected call of Info.
+func (mr *MockHostServiceMockRecorder) Info(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockHostService)(nil).Info), arg0)
+}
+
+// Resources mocks base method.
+func (m *MockHostService) Resources(arg0 context.Context) (*models.HostResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", arg0)
+	ret0, _ := ret[0].(*models.HostResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockHostServiceMockRecorder) Resources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockHostService)(nil).Resources), arg0)
+}
@@ -0,0 +1,84 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+const maxVLANID = 4094
+
+// Config is the configuration for the network service.
+type Config struct {
+	// ParentDeviceName is the name of the parent device of macvtap devices by default.
+	ParentDeviceName string
+	// BridgeName is the name of the bridge to attach tap devices to by default.
+	BridgeName string
+	// NetworksFile is the path to the file of the named networks. If empty interfaces can't
+	// be attached to a network by name.
+	NetworksFile string
+}
+
+// NetworksConfig is the file format of the named networks.
+type NetworksConfig struct {
+	Networks []NetworkConfig `json:"networks"`
+}
+
+// NetworkConfig is a named network that interfaces can be attached to. Anything that isn't
+// set falls back to the flintlockd defaults.
+type NetworkConfig struct {
+	// Name is the name interfaces reference the network by.
+	Name string `json:"name"`
+	// Bridge is the name of the bridge to attach tap devices to.
+	Bridge string `json:"bridge,omitempty"`
+	// Parent is the name of the parent device of macvtap devices.
+	Parent string `json:"parent,omitempty"`
+	// VLAN is the VLAN ID of the interfaces on the network. The bridge needs VLAN filtering
+	// enabled for tap devices.
+	VLAN uint16 `json:"vlan,omitempty"`
+	// MTU is the MTU of the interfaces on the network.
+	MTU int `json:"mtu,omitempty"`
+}
+
+// loadNetworks reads and parses the named networks in a file.
+func loadNetworks(fs afero.Fs, path string) (map[string]NetworkConfig, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("reading networks file %s: %w", path, err)
+	}
+
+	return parseNetworks(data)
+}
+
+// parseNetworks parses named networks and checks they're valid.
+func parseNetworks(data []byte) (map[string]NetworkConfig, error) {
+	cfg := &NetworksConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("unmarshalling networks: %w", err)
+	}
+
+	networks := map[string]NetworkConfig{}
+
+	for _, network := range cfg.Networks {
+		if network.Name == "" {
+			return nil, errNetworkNameRequired
+		}
+
+		if _, ok := networks[network.Name]; ok {
+			return nil, fmt.Errorf("network %s: %w", network.Name, errDuplicateNetwork)
+		}
+
+		if network.VLAN > maxVLANID {
+			return nil, fmt.Errorf("network %s vlan %d: %w", network.Name, network.VLAN, errInvalidVLAN)
+		}
+
+		if network.MTU < 0 {
+			return nil, fmt.Errorf("network %s mtu %d: %w", network.Name, network.MTU, errInvalidMTU)
+		}
+
+		networks[network.Name] = network
+	}
+
+	return networks, nil
+}
@@ -1,6 +1,9 @@
 package network
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // InterfaceError occurs when something went wrong
 // with network interface magic.
@@ -13,3 +16,14 @@ func (e InterfaceError) Error() string {
 func interfaceErrorf(format string, params ...interface{}) InterfaceError {
 	return InterfaceError(fmt.Sprintf(format, params...))
 }
+
+var (
+	errNetworkNameRequired   = errors.New("a name is required for each network")
+	errDuplicateNetwork      = errors.New("network is defined more than once")
+	errInvalidVLAN           = errors.New("vlan id must be between 1 and 4094")
+	errInvalidMTU            = errors.New("mtu can't be negative")
+	errNetworkNotFound       = errors.New("network not found")
+	errVLANFilteringRequired = errors.New("vlan filtering must be enabled on the bridge to attach an interface to a vlan")
+	errVLANDeviceNameTooLong = errors.New("vlan device name is too long")
+	errNsDeviceNameTooLong   = errors.New("network namespace device name is too long")
+)
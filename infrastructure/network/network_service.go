@@ -5,32 +5,55 @@ import (
 	ierror "errors"
 	"fmt"
 	"net"
+	"os"
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
 
 	"github.com/liquidmetal-dev/flintlock/core/errors"
 	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
+	pkgnetns "github.com/liquidmetal-dev/flintlock/pkg/netns"
 )
 
-type Config struct {
-	ParentDeviceName string
-	BridgeName       string
-}
+// New creates a new network service that creates tap and macvtap devices with netlink. The
+// named networks are read from the networks file in the config, if there is one.
+func New(cfg *Config, fs afero.Fs) (ports.NetworkService, error) {
+	networks := map[string]NetworkConfig{}
+
+	if cfg.NetworksFile != "" {
+		var err error
+
+		networks, err = loadNetworks(fs, cfg.NetworksFile)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-func New(cfg *Config) ports.NetworkService {
 	return &networkService{
 		parentDeviceName: cfg.ParentDeviceName,
 		bridgeName:       cfg.BridgeName,
-	}
+		networks:         networks,
+	}, nil
 }
 
 type networkService struct {
 	parentDeviceName string
 	bridgeName       string
+	networks         map[string]NetworkConfig
+}
+
+// ifaceSettings are the settings of an interface from its network and overrides.
+type ifaceSettings struct {
+	bridgeName       string
+	parentDeviceName string
+	vlanID           uint16
+	mtu              int
 }
 
 // IfaceCreate will create the network interface.
@@ -41,19 +64,25 @@ func (n *networkService) IfaceCreate(ctx context.Context,
 		"service": "netlink_network",
 		"iface":   input.DeviceName,
 	})
+	settings, err := n.ifaceSettings(input)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debugf(
 		"creating network interface with type %s and MAC %s using parent %s",
 		input.Type,
 		input.MAC,
-		n.parentDeviceName,
+		settings.parentDeviceName,
 	)
 
-	var (
-		parentLink netlink.Link
-		err        error
-	)
+	var parentLink netlink.Link
+
+	parentDeviceName := settings.parentDeviceName
+	if input.Type == models.IfaceTypeTap {
+		parentDeviceName = settings.bridgeName
+	}
 
-	parentDeviceName := n.getParentIfaceName(input)
 	if parentDeviceName == "" {
 		if input.Type == models.IfaceTypeMacvtap {
 			return nil, errors.ErrParentIfaceRequiredForMacvtap
@@ -70,18 +99,280 @@ func (n *networkService) IfaceCreate(ctx context.Context,
 		}
 	}
 
-	var link netlink.Link
+	if input.Type == models.IfaceTypeMacvtap && settings.vlanID != 0 {
+		parentLink, err = n.vlanDevice(ctx, parentLink, settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	link, err := newLink(input, settings, parentLink)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.NetworkNamespace != "" {
+		return n.createInNamespace(ctx, input, settings, link, parentLink)
+	}
+
+	if err = netlink.LinkAdd(link); err != nil {
+		return nil, fmt.Errorf("creating interface %s using netlink: %w", link.Attrs().Name, err)
+	}
+
+	details, err := setupOnHost(ctx, input, settings, link.Attrs().Name, parentLink)
+	if err != nil {
+		// Remove what was created, otherwise the next attempt takes it for the existing interface.
+		if deleteErr := n.IfaceDelete(ctx, ports.DeleteIfaceInput{DeviceName: input.DeviceName}); deleteErr != nil {
+			logger.Errorf("failed to remove interface after setting it up failed: %s", deleteErr)
+		}
+
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// setupOnHost enables a device created on the host and, if it's an attached tap device,
+// attaches it to the bridge.
+func setupOnHost(ctx context.Context,
+	input ports.IfaceCreateInput,
+	settings *ifaceSettings,
+	name string,
+	parentLink netlink.Link,
+) (*ports.IfaceDetails, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service": "netlink_network",
+		"iface":   input.DeviceName,
+	})
+
+	macIf, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting interface %s using netlink: %w", name, err)
+	}
+
+	if err := enableLink(&netlink.Handle{}, macIf, input.Type, settings.mtu); err != nil {
+		return nil, err
+	}
+
+	logger.Debugf("created interface with mac %s", macIf.Attrs().HardwareAddr.String())
+
+	if input.Type == models.IfaceTypeTap && input.Attach {
+		if err := attachToBridge(ctx, macIf, parentLink, settings.vlanID); err != nil {
+			return nil, err
+		}
+	}
+
+	details := &ports.IfaceDetails{
+		DeviceName: input.DeviceName,
+		Type:       input.Type,
+		MAC:        strings.ToUpper(macIf.Attrs().HardwareAddr.String()),
+		Index:      macIf.Attrs().Index,
+		MTU:        linkMTU(macIf, settings.mtu),
+	}
+
+	if input.Type == models.IfaceTypeTap && input.Attach {
+		details.BridgeName = parentLink.Attrs().Name
+	}
+
+	return details, nil
+}
+
+// createInNamespace creates the interface in a network namespace. A tap device is created in
+// the namespace and, if it's attached, is bridged in the namespace to a veth pair. The host end
+// of the pair has the name of the tap device and is attached to the bridge on the host instead,
+// so the firewall and addresses of the interface work the same as without a namespace. A macvtap
+// device is created on its parent on the host and moved into the namespace.
+func (n *networkService) createInNamespace(ctx context.Context,
+	input ports.IfaceCreateInput,
+	settings *ifaceSettings,
+	link netlink.Link,
+	parentLink netlink.Link,
+) (*ports.IfaceDetails, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":   "netlink_network",
+		"iface":     input.DeviceName,
+		"namespace": input.NetworkNamespace,
+	})
+
+	nsHandle, err := netns.GetFromName(input.NetworkNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("getting network namespace %s: %w", input.NetworkNamespace, err)
+	}
+	defer nsHandle.Close()
+
+	handle, err := netlink.NewHandleAt(nsHandle)
+	if err != nil {
+		return nil, fmt.Errorf("creating netlink handle in network namespace %s: %w", input.NetworkNamespace, err)
+	}
+	defer handle.Close()
+
+	switch input.Type {
+	case models.IfaceTypeTap:
+		// Tap devices are created in the namespace of the calling thread.
+		err = pkgnetns.Do(input.NetworkNamespace, func() error {
+			return netlink.LinkAdd(link)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating interface %s using netlink: %w", link.Attrs().Name, err)
+		}
+	default:
+		if err := netlink.LinkAdd(link); err != nil {
+			return nil, fmt.Errorf("creating interface %s using netlink: %w", link.Attrs().Name, err)
+		}
+
+		if err := netlink.LinkSetNsFd(link, int(nsHandle)); err != nil {
+			if deleteErr := netlink.LinkDel(link); deleteErr != nil {
+				logger.Errorf("failed to remove interface after moving it failed: %s", deleteErr)
+			}
+
+			return nil, fmt.Errorf("moving interface %s to network namespace %s: %w",
+				link.Attrs().Name, input.NetworkNamespace, err)
+		}
+	}
+
+	details, err := setupInNamespace(ctx, handle, input, settings, link.Attrs().Name, parentLink)
+	if err != nil {
+		// Remove what was created, otherwise the next attempt takes it for the existing interface.
+		deleteInput := ports.DeleteIfaceInput{DeviceName: input.DeviceName, NetworkNamespace: input.NetworkNamespace}
+		if deleteErr := n.IfaceDelete(ctx, deleteInput); deleteErr != nil {
+			logger.Errorf("failed to remove interface after setting it up failed: %s", deleteErr)
+		}
+
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// setupInNamespace enables a device created in a network namespace and, if it's an attached tap
+// device, connects it to the bridge on the host.
+func setupInNamespace(ctx context.Context,
+	handle *netlink.Handle,
+	input ports.IfaceCreateInput,
+	settings *ifaceSettings,
+	name string,
+	parentLink netlink.Link,
+) (*ports.IfaceDetails, error) {
+	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":   "netlink_network",
+		"iface":     input.DeviceName,
+		"namespace": input.NetworkNamespace,
+	})
+
+	macIf, err := handle.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting interface %s using netlink: %w", name, err)
+	}
+
+	if err := enableLink(handle, macIf, input.Type, settings.mtu); err != nil {
+		return nil, err
+	}
+
+	logger.Debugf("created interface with mac %s", macIf.Attrs().HardwareAddr.String())
+
+	details := &ports.IfaceDetails{
+		DeviceName: input.DeviceName,
+		Type:       input.Type,
+		MAC:        strings.ToUpper(macIf.Attrs().HardwareAddr.String()),
+		Index:      macIf.Attrs().Index,
+		MTU:        linkMTU(macIf, settings.mtu),
+	}
+
+	if input.Type != models.IfaceTypeTap || !input.Attach {
+		return details, nil
+	}
+
+	hostEnd, err := connectToHost(handle, macIf, settings.mtu)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debugf("connected interface to the host with veth %s", hostEnd.Attrs().Name)
+
+	if err := attachToBridge(ctx, hostEnd, parentLink, settings.vlanID); err != nil {
+		return nil, err
+	}
+
+	details.BridgeName = parentLink.Attrs().Name
+
+	return details, nil
+}
+
+// connectToHost connects a tap device in a network namespace to the host. The tap device is
+// bridged to a veth pair in the namespace, and the host end of the pair, which has the name of
+// the tap device, is returned.
+func connectToHost(handle *netlink.Handle, tap netlink.Link, mtu int) (netlink.Link, error) {
+	name := tap.Attrs().Name
+
+	vethName, bridgeName := namespaceDeviceNames(name)
+	if len(vethName) > maxIfaceNameLength {
+		return nil, fmt.Errorf("%w: %s", errNsDeviceNameTooLong, vethName)
+	}
+
+	hostNs, err := netns.Get()
+	if err != nil {
+		return nil, fmt.Errorf("getting host network namespace: %w", err)
+	}
+	defer hostNs.Close()
+
+	veth := &netlink.Veth{
+		LinkAttrs:     netlink.LinkAttrs{Name: vethName, MTU: mtu},
+		PeerName:      name,
+		PeerNamespace: netlink.NsFd(hostNs),
+	}
+	if err := handle.LinkAdd(veth); err != nil {
+		return nil, fmt.Errorf("creating veth pair %s using netlink: %w", vethName, err)
+	}
+
+	bridge := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}
+	if err := handle.LinkAdd(bridge); err != nil {
+		return nil, fmt.Errorf("creating bridge %s using netlink: %w", bridgeName, err)
+	}
+
+	for _, port := range []netlink.Link{tap, veth} {
+		if err := handle.LinkSetMaster(port, bridge); err != nil {
+			return nil, fmt.Errorf("setting master for %s to %s: %w", port.Attrs().Name, bridgeName, err)
+		}
+	}
+
+	for _, link := range []netlink.Link{veth, bridge} {
+		if err := handle.LinkSetUp(link); err != nil {
+			return nil, fmt.Errorf("enabling device %s: %w", link.Attrs().Name, err)
+		}
+	}
+
+	hostEnd, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting veth %s using netlink: %w", name, err)
+	}
+
+	if err := enableLink(&netlink.Handle{}, hostEnd, models.IfaceTypeTap, mtu); err != nil {
+		return nil, err
+	}
 
+	return hostEnd, nil
+}
+
+// newLink returns the tap or macvtap device to create for the interface.
+func newLink(input ports.IfaceCreateInput, settings *ifaceSettings, parentLink netlink.Link) (netlink.Link, error) {
 	switch input.Type {
 	case models.IfaceTypeTap:
-		link = &netlink.Tuntap{
+		tap := &netlink.Tuntap{
 			LinkAttrs: netlink.LinkAttrs{
 				Name: input.DeviceName,
 			},
 			Mode: netlink.TUNTAP_MODE_TAP,
 		}
+
+		// The hypervisor opens a queue for each of the queue pairs of the interface, which it
+		// can only do if the device is created as a multi-queue device.
+		if input.NumQueues > 1 {
+			tap.Flags = netlink.TUNTAP_MULTI_QUEUE_DEFAULTS | netlink.TUNTAP_VNET_HDR
+		}
+
+		return tap, nil
 	case models.IfaceTypeMacvtap:
-		link = &netlink.Macvtap{
+		link := &netlink.Macvtap{
 			Macvlan: netlink.Macvlan{
 				LinkAttrs: netlink.LinkAttrs{
 					Name:        input.DeviceName,
@@ -94,50 +385,72 @@ func (n *networkService) IfaceCreate(ctx context.Context,
 			},
 		}
 
+		if settings.mtu != 0 {
+			link.Attrs().MTU = settings.mtu
+		}
+
 		if input.MAC != "" {
-			addr, parseErr := net.ParseMAC(input.MAC)
+			addr, err := net.ParseMAC(input.MAC)
 			if err != nil {
-				return nil, fmt.Errorf("parsing mac address %s: %w", input.MAC, parseErr)
+				return nil, fmt.Errorf("parsing mac address %s: %w", input.MAC, err)
 			}
 
 			link.Attrs().HardwareAddr = addr
-			logger.Tracef("added mac address %s to interface", addr)
 		}
+
+		return link, nil
 	case models.IfaceTypeUnsupported:
 		return nil, errors.NewErrUnsupportedInterface(string(input.Type))
 	default:
 		return nil, errors.NewErrUnsupportedInterface(string(input.Type))
 	}
+}
 
-	if err = netlink.LinkAdd(link); err != nil {
-		return nil, fmt.Errorf("creating interface %s using netlink: %w", link.Attrs().Name, err)
+// enableLink sets the MTU of a tap device, which can't be set when it's created, and sets
+// the device up.
+func enableLink(handle *netlink.Handle, link netlink.Link, ifaceType models.IfaceType, mtu int) error {
+	if ifaceType == models.IfaceTypeTap && mtu != 0 {
+		if err := handle.LinkSetMTU(link, mtu); err != nil {
+			return fmt.Errorf("setting mtu of %s to %d: %w", link.Attrs().Name, mtu, err)
+		}
 	}
 
-	macIf, err := netlink.LinkByName(link.Attrs().Name)
-	if err != nil {
-		return nil, fmt.Errorf("getting interface %s using netlink: %w", link.Attrs().Name, err)
+	if err := handle.LinkSetUp(link); err != nil {
+		return fmt.Errorf("enabling device %s: %w", link.Attrs().Name, err)
 	}
 
-	if err := netlink.LinkSetUp(macIf); err != nil {
-		return nil, fmt.Errorf("enabling device %s: %w", macIf.Attrs().Name, err)
+	return nil
+}
+
+// linkMTU returns the MTU of a device that was just created, which is the mtu it was created
+// with if one was set.
+func linkMTU(link netlink.Link, mtu int) int {
+	if mtu != 0 {
+		return mtu
 	}
 
-	logger.Debugf("created interface with mac %s", macIf.Attrs().HardwareAddr.String())
+	return link.Attrs().MTU
+}
 
-	if input.Type == models.IfaceTypeTap && input.Attach {
-		if err := netlink.LinkSetMaster(macIf, parentLink); err != nil {
-			return nil, fmt.Errorf("setting master for %s to %s: %w", macIf.Attrs().Name, parentLink.Attrs().Name, err)
+// attachToBridge attaches the device to the bridge on the host, and to the VLAN if there is one.
+func attachToBridge(ctx context.Context, link, bridge netlink.Link, vlanID uint16) error {
+	logger := log.GetLogger(ctx)
+
+	if err := netlink.LinkSetMaster(link, bridge); err != nil {
+		return fmt.Errorf("setting master for %s to %s: %w", link.Attrs().Name, bridge.Attrs().Name, err)
+	}
+
+	logger.Debugf("added interface %s to bridge %s", link.Attrs().Name, bridge.Attrs().Name)
+
+	if vlanID != 0 {
+		if err := setPortVLAN(link, bridge, vlanID); err != nil {
+			return err
 		}
 
-		logger.Debugf("added interface %s to bridge %s", macIf.Attrs().Name, parentLink.Attrs().Name)
+		logger.Debugf("set vlan of interface %s to %d", link.Attrs().Name, vlanID)
 	}
 
-	return &ports.IfaceDetails{
-		DeviceName: input.DeviceName,
-		Type:       input.Type,
-		MAC:        strings.ToUpper(macIf.Attrs().HardwareAddr.String()),
-		Index:      macIf.Attrs().Index,
-	}, nil
+	return nil
 }
 
 // IfaceDelete is used to delete a network interface.
@@ -148,7 +461,19 @@ func (n *networkService) IfaceDelete(ctx context.Context, input ports.DeleteIfac
 	})
 	logger.Debug("deleting network interface")
 
-	link, err := netlink.LinkByName(input.DeviceName)
+	handle, err := linkHandle(input.NetworkNamespace)
+	if err != nil {
+		return err
+	}
+
+	if handle == nil {
+		logger.Debug("network namespace doesn't exist, no action")
+
+		return nil
+	}
+	defer handle.Close()
+
+	link, err := handle.LinkByName(input.DeviceName)
 	if err != nil {
 		if ierror.Is(err, netlink.LinkNotFoundError{}) {
 			return fmt.Errorf("failed to lookup network interface %s: %w", input.DeviceName, err)
@@ -159,21 +484,36 @@ func (n *networkService) IfaceDelete(ctx context.Context, input ports.DeleteIfac
 		return nil
 	}
 
-	if err = netlink.LinkDel(link); err != nil {
+	if err = handle.LinkDel(link); err != nil {
 		return fmt.Errorf("deleting interface %s: %w", link.Attrs().Name, err)
 	}
 
+	if input.NetworkNamespace == "" {
+		return nil
+	}
+
+	// Deleting the veth pair connecting a tap device to the host deletes both of its ends.
+	vethName, bridgeName := namespaceDeviceNames(input.DeviceName)
+
+	for _, name := range []string{vethName, bridgeName} {
+		if link, err := handle.LinkByName(name); err == nil {
+			if err := handle.LinkDel(link); err != nil {
+				return fmt.Errorf("deleting interface %s: %w", name, err)
+			}
+		}
+	}
+
 	return nil
 }
 
-func (n *networkService) IfaceExists(ctx context.Context, name string) (bool, error) {
+func (n *networkService) IfaceExists(ctx context.Context, networkNamespace, name string) (bool, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"service": "netlink_network",
 		"iface":   name,
 	})
 	logger.Debug("checking if network interface exists")
 
-	found, _, err := n.getIface(name)
+	found, _, err := n.getIface(networkNamespace, name)
 	if err != nil {
 		return false, fmt.Errorf("getting interface %s: %w", name, err)
 	}
@@ -182,14 +522,14 @@ func (n *networkService) IfaceExists(ctx context.Context, name string) (bool, er
 }
 
 // IfaceDetails will get the details of the supplied network interface.
-func (n *networkService) IfaceDetails(ctx context.Context, name string) (*ports.IfaceDetails, error) {
+func (n *networkService) IfaceDetails(ctx context.Context, networkNamespace, name string) (*ports.IfaceDetails, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"service": "netlink_network",
 		"iface":   name,
 	})
 	logger.Debug("getting network interface details")
 
-	found, link, err := n.getIface(name)
+	found, link, err := n.getIface(networkNamespace, name)
 	if err != nil {
 		return nil, fmt.Errorf("getting interface %s: %w", name, err)
 	}
@@ -202,6 +542,7 @@ func (n *networkService) IfaceDetails(ctx context.Context, name string) (*ports.
 		DeviceName: name,
 		MAC:        strings.ToUpper(link.Attrs().HardwareAddr.String()),
 		Index:      link.Attrs().Index,
+		MTU:        link.Attrs().MTU,
 	}
 
 	switch link.(type) {
@@ -213,11 +554,69 @@ func (n *networkService) IfaceDetails(ctx context.Context, name string) (*ports.
 		details.Type = models.IfaceTypeUnsupported
 	}
 
+	// A tap device in a network namespace is attached to the bridge on the host by the host
+	// end of its veth pair.
+	bridgePort := link
+	if networkNamespace != "" {
+		found, bridgePort, err = n.getIface("", name)
+		if err != nil {
+			return nil, fmt.Errorf("getting veth %s: %w", name, err)
+		}
+
+		if !found {
+			return details, nil
+		}
+	}
+
+	if bridgePort.Attrs().MasterIndex != 0 {
+		master, err := netlink.LinkByIndex(bridgePort.Attrs().MasterIndex)
+		if err != nil {
+			return nil, fmt.Errorf("getting bridge of interface %s: %w", name, err)
+		}
+
+		details.BridgeName = master.Attrs().Name
+	}
+
 	return details, nil
 }
 
-func (n *networkService) getIface(name string) (bool, netlink.Link, error) {
-	link, err := netlink.LinkByName(name)
+// NamespaceCreate will create a named network namespace.
+func (n *networkService) NamespaceCreate(ctx context.Context, name string) error {
+	log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":   "netlink_network",
+		"namespace": name,
+	}).Debug("creating network namespace")
+
+	return pkgnetns.Create(name)
+}
+
+// NamespaceDelete will delete a named network namespace and the interfaces in it.
+func (n *networkService) NamespaceDelete(ctx context.Context, name string) error {
+	log.GetLogger(ctx).WithFields(logrus.Fields{
+		"service":   "netlink_network",
+		"namespace": name,
+	}).Debug("deleting network namespace")
+
+	return pkgnetns.Delete(name)
+}
+
+// NamespaceExists will check if a named network namespace exists.
+func (n *networkService) NamespaceExists(_ context.Context, name string) (bool, error) {
+	return pkgnetns.Exists(name)
+}
+
+func (n *networkService) getIface(networkNamespace, name string) (bool, netlink.Link, error) {
+	handle, err := linkHandle(networkNamespace)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if handle == nil {
+		return false, nil, nil
+	}
+	defer handle.Close()
+
+	link, err := handle.LinkByName(name)
 	if err != nil {
 		if ierror.Is(err, netlink.LinkNotFoundError{}) {
 			return false, nil, fmt.Errorf("failed to lookup network interface %s: %w", name, err)
@@ -229,14 +628,149 @@ func (n *networkService) getIface(name string) (bool, netlink.Link, error) {
 	return true, link, nil
 }
 
-func (n *networkService) getParentIfaceName(input ports.IfaceCreateInput) string {
-	if input.Type == models.IfaceTypeMacvtap {
-		return n.parentDeviceName
+// linkHandle returns a netlink handle for the network namespace, or for the namespace of the
+// host if it's empty. It returns nil if the network namespace doesn't exist.
+func linkHandle(networkNamespace string) (*netlink.Handle, error) {
+	if networkNamespace == "" {
+		return &netlink.Handle{}, nil
+	}
+
+	nsHandle, err := netns.GetFromName(networkNamespace)
+	if err != nil {
+		if ierror.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting network namespace %s: %w", networkNamespace, err)
+	}
+	defer nsHandle.Close()
+
+	handle, err := netlink.NewHandleAt(nsHandle)
+	if err != nil {
+		return nil, fmt.Errorf("creating netlink handle in network namespace %s: %w", networkNamespace, err)
+	}
+
+	return handle, nil
+}
+
+// ifaceSettings returns the settings of an interface. The network of the interface overrides
+// the defaults, and the bridge, VLAN and MTU of the interface override its network.
+func (n *networkService) ifaceSettings(input ports.IfaceCreateInput) (*ifaceSettings, error) {
+	settings := &ifaceSettings{
+		bridgeName:       n.bridgeName,
+		parentDeviceName: n.parentDeviceName,
+	}
+
+	if input.NetworkName != "" {
+		network, ok := n.networks[input.NetworkName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errNetworkNotFound, input.NetworkName)
+		}
+
+		if network.Bridge != "" {
+			settings.bridgeName = network.Bridge
+		}
+
+		if network.Parent != "" {
+			settings.parentDeviceName = network.Parent
+		}
+
+		settings.vlanID = network.VLAN
+		settings.mtu = network.MTU
 	}
 
 	if input.BridgeName != "" {
-		return input.BridgeName
+		settings.bridgeName = input.BridgeName
+	}
+
+	if input.VLANID != 0 {
+		settings.vlanID = input.VLANID
+	}
+
+	if input.MTU != 0 {
+		settings.mtu = input.MTU
 	}
 
-	return n.bridgeName
+	return settings, nil
+}
+
+// vlanDevice returns the VLAN sub-interface of the parent device for macvtap devices on a VLAN,
+// creating it if it doesn't exist. It's shared by all the macvtap devices on the VLAN, so it's
+// left in place when they're deleted.
+func (n *networkService) vlanDevice(ctx context.Context,
+	parent netlink.Link,
+	settings *ifaceSettings,
+) (netlink.Link, error) {
+	name := fmt.Sprintf("%s.%d", parent.Attrs().Name, settings.vlanID)
+	if len(name) > maxIfaceNameLength {
+		return nil, fmt.Errorf("%w: %s", errVLANDeviceNameTooLong, name)
+	}
+
+	link, err := netlink.LinkByName(name)
+	if err == nil {
+		return link, nil
+	}
+
+	var notFound netlink.LinkNotFoundError
+	if !ierror.As(err, &notFound) {
+		return nil, fmt.Errorf("failed to lookup vlan network interface %s: %w", name, err)
+	}
+
+	log.GetLogger(ctx).Debugf("creating vlan interface %s", name)
+
+	vlan := &netlink.Vlan{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:        name,
+			ParentIndex: parent.Attrs().Index,
+			MTU:         settings.mtu,
+		},
+		VlanId: int(settings.vlanID),
+	}
+
+	// Another macvtap device on the VLAN may be created at the same time.
+	if err := netlink.LinkAdd(vlan); err != nil && !ierror.Is(err, unix.EEXIST) {
+		return nil, fmt.Errorf("creating vlan interface %s using netlink: %w", name, err)
+	}
+
+	link, err = netlink.LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting vlan interface %s using netlink: %w", name, err)
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return nil, fmt.Errorf("enabling device %s: %w", name, err)
+	}
+
+	return link, nil
+}
+
+// setPortVLAN makes the VLAN the only VLAN of a bridge port, untagged and as its PVID, so the
+// guest only sees the traffic of its VLAN without tags.
+func setPortVLAN(port, bridge netlink.Link, vlanID uint16) error {
+	br, ok := bridge.(*netlink.Bridge)
+	if !ok || br.VlanFiltering == nil || !*br.VlanFiltering {
+		return fmt.Errorf("%w: %s", errVLANFilteringRequired, bridge.Attrs().Name)
+	}
+
+	vlans, err := netlink.BridgeVlanList()
+	if err != nil {
+		return fmt.Errorf("listing bridge vlans: %w", err)
+	}
+
+	// Ports are added to the default VLAN of the bridge when they're attached.
+	for _, vlan := range vlans[int32(port.Attrs().Index)] { //nolint:gosec // interface indexes fit in an int32
+		if vlan.Vid == vlanID {
+			continue
+		}
+
+		if err := netlink.BridgeVlanDel(port, vlan.Vid, false, false, false, true); err != nil {
+			return fmt.Errorf("removing vlan %d from %s: %w", vlan.Vid, port.Attrs().Name, err)
+		}
+	}
+
+	if err := netlink.BridgeVlanAdd(port, vlanID, true, true, false, true); err != nil {
+		return fmt.Errorf("adding vlan %d to %s: %w", vlanID, port.Attrs().Name, err)
+	}
+
+	return nil
 }
@@ -0,0 +1,326 @@
+package network_test
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/vishvananda/netlink"
+
+	"github.com/liquidmetal-dev/flintlock/core/models"
+	"github.com/liquidmetal-dev/flintlock/core/ports"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/network"
+)
+
+const networksFile = "/etc/flintlock/networks.yaml"
+
+const testNetworks = `
+networks:
+- name: tenant-a
+  bridge: br-tenants
+  vlan: 100
+  mtu: 1400
+- name: tenant-b
+  parent: bond0
+  vlan: 200
+`
+
+func TestNew_InvalidNetworks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		networks string
+	}{
+		{
+			name:     "missing name",
+			networks: "networks:\n- bridge: br0\n",
+		},
+		{
+			name:     "duplicate name",
+			networks: "networks:\n- name: a\n  bridge: br0\n- name: a\n  bridge: br1\n",
+		},
+		{
+			name:     "vlan out of range",
+			networks: "networks:\n- name: a\n  vlan: 4095\n",
+		},
+		{
+			name:     "negative mtu",
+			networks: "networks:\n- name: a\n  mtu: -1\n",
+		},
+		{
+			name:     "unknown field",
+			networks: "networks:\n- name: a\n  vid: 100\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := network.New(&network.Config{NetworksFile: networksFile}, newTestFs(g, tc.networks))
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestNew_MissingNetworksFile(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := network.New(&network.Config{NetworksFile: networksFile}, afero.NewMemMapFs())
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestIfaceCreate_UnknownNetwork(t *testing.T) {
+	g := NewWithT(t)
+
+	svc, err := network.New(&network.Config{NetworksFile: networksFile}, newTestFs(g, testNetworks))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = svc.IfaceCreate(context.Background(), ports.IfaceCreateInput{
+		DeviceName:  "tap0",
+		Type:        models.IfaceTypeTap,
+		Attach:      true,
+		NetworkName: "tenant-c",
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("tenant-c")))
+}
+
+// TestIfaceCreate_VLANNetns creates interfaces on VLANs in a new network namespace. It needs
+// root and a kernel with 8021q and bridge VLAN filtering, so it only runs if NETNS_TESTS is set.
+func TestIfaceCreate_VLANNetns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	// The thread is left in the new network namespace, so it's thrown away when the test
+	// finishes rather than being unlocked.
+	runtime.LockOSThread()
+	g.Expect(syscall.Unshare(syscall.CLONE_NEWNET)).To(Succeed())
+
+	vlanFiltering := true
+	g.Expect(netlink.LinkAdd(&netlink.Bridge{
+		LinkAttrs:     netlink.LinkAttrs{Name: "br-tenants"},
+		VlanFiltering: &vlanFiltering,
+	})).To(Succeed())
+	g.Expect(netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br0"}})).To(Succeed())
+	g.Expect(netlink.LinkAdd(&netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "bond0"}, PeerName: "bond0-peer"})).
+		To(Succeed())
+
+	ctx := context.Background()
+	svc, err := network.New(&network.Config{
+		BridgeName:   "br0",
+		NetworksFile: networksFile,
+	}, newTestFs(g, testNetworks))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tap, err := svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+		DeviceName:  "tap0",
+		Type:        models.IfaceTypeTap,
+		Attach:      true,
+		NetworkName: "tenant-a",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tap.BridgeName).To(Equal("br-tenants"))
+
+	link, err := netlink.LinkByName("tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(link.Attrs().MTU).To(Equal(1400))
+
+	vlans, err := netlink.BridgeVlanList()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vlans[int32(link.Attrs().Index)]).To(HaveLen(1))
+	g.Expect(vlans[int32(link.Attrs().Index)][0].Vid).To(Equal(uint16(100)))
+	g.Expect(vlans[int32(link.Attrs().Index)][0].PortVID()).To(BeTrue())
+	g.Expect(vlans[int32(link.Attrs().Index)][0].EngressUntag()).To(BeTrue())
+
+	details, err := svc.IfaceDetails(ctx, "", "tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(details.BridgeName).To(Equal("br-tenants"))
+
+	_, err = svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+		DeviceName: "tap1",
+		Type:       models.IfaceTypeTap,
+		Attach:     true,
+		VLANID:     300,
+	})
+	g.Expect(err).To(HaveOccurred(), "br0 doesn't filter vlans")
+
+	for _, name := range []string{"vtap0", "vtap1"} {
+		_, err = svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+			DeviceName:  name,
+			Type:        models.IfaceTypeMacvtap,
+			NetworkName: "tenant-b",
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	vlan, err := netlink.LinkByName("bond0.200")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vlan).To(BeAssignableToTypeOf(&netlink.Vlan{}))
+	g.Expect(vlan.(*netlink.Vlan).VlanId).To(Equal(200))
+
+	macvtap, err := netlink.LinkByName("vtap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(macvtap.Attrs().ParentIndex).To(Equal(vlan.Attrs().Index))
+}
+
+// TestIfaceCreate_VLANRetryNetns attaches a tap device to a VLAN on a bridge that doesn't filter
+// VLANs and retries it. It needs root, so it only runs if NETNS_TESTS is set.
+func TestIfaceCreate_VLANRetryNetns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	// The thread is left in the new network namespace, so it's thrown away when the test
+	// finishes rather than being unlocked.
+	runtime.LockOSThread()
+	g.Expect(syscall.Unshare(syscall.CLONE_NEWNET)).To(Succeed())
+
+	g.Expect(netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br0"}})).To(Succeed())
+
+	ctx := context.Background()
+	svc, err := network.New(&network.Config{
+		BridgeName:   "br0",
+		NetworksFile: networksFile,
+	}, newTestFs(g, testNetworks))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	input := ports.IfaceCreateInput{
+		DeviceName: "tap0",
+		Type:       models.IfaceTypeTap,
+		Attach:     true,
+		VLANID:     300,
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		_, err = svc.IfaceCreate(ctx, input)
+		g.Expect(err).To(MatchError(ContainSubstring("vlan filtering must be enabled")))
+
+		exists, err := svc.IfaceExists(ctx, "", "tap0")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(exists).To(BeFalse(), "tap device is removed when setting its vlan fails")
+	}
+}
+
+// TestIfaceCreate_NamespaceNetns creates interfaces in a network namespace of a microvm. It
+// needs root, so it only runs if NETNS_TESTS is set.
+func TestIfaceCreate_NamespaceNetns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	// The thread is left in the new network namespace, so it's thrown away when the test
+	// finishes rather than being unlocked.
+	runtime.LockOSThread()
+	g.Expect(syscall.Unshare(syscall.CLONE_NEWNET)).To(Succeed())
+
+	g.Expect(netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br0"}})).To(Succeed())
+	g.Expect(netlink.LinkAdd(&netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}, PeerName: "eth0-peer"})).
+		To(Succeed())
+
+	const namespace = "flintlock-network-test"
+
+	ctx := context.Background()
+	svc, err := network.New(&network.Config{
+		BridgeName:       "br0",
+		ParentDeviceName: "eth0",
+		NetworksFile:     networksFile,
+	}, newTestFs(g, testNetworks))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(svc.NamespaceCreate(ctx, namespace)).To(Succeed())
+	defer svc.NamespaceDelete(ctx, namespace) //nolint:errcheck // deleted below as well
+
+	tap, err := svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+		DeviceName:       "tap0",
+		Type:             models.IfaceTypeTap,
+		Attach:           true,
+		NetworkNamespace: namespace,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tap.BridgeName).To(Equal("br0"))
+
+	bridge, err := netlink.LinkByName("br0")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hostEnd, err := netlink.LinkByName("tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hostEnd).To(BeAssignableToTypeOf(&netlink.Veth{}))
+	g.Expect(hostEnd.Attrs().MasterIndex).To(Equal(bridge.Attrs().Index))
+
+	exists, err := svc.IfaceExists(ctx, namespace, "tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+
+	details, err := svc.IfaceDetails(ctx, namespace, "tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(details.MAC).To(Equal(tap.MAC))
+	g.Expect(details.BridgeName).To(Equal("br0"))
+
+	macvtap, err := svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+		DeviceName:       "vtap0",
+		Type:             models.IfaceTypeMacvtap,
+		NetworkNamespace: namespace,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = netlink.LinkByName("vtap0")
+	g.Expect(err).To(HaveOccurred(), "macvtap is moved into the namespace")
+
+	details, err = svc.IfaceDetails(ctx, namespace, "vtap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(details.Index).To(Equal(macvtap.Index))
+
+	// The name of the veth pair is too long, so the tap device can't be connected to the host.
+	_, err = svc.IfaceCreate(ctx, ports.IfaceCreateInput{
+		DeviceName:       "tap0123456789",
+		Type:             models.IfaceTypeTap,
+		Attach:           true,
+		NetworkNamespace: namespace,
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	exists, err = svc.IfaceExists(ctx, namespace, "tap0123456789")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse(), "tap device is removed when connecting it fails")
+
+	for _, name := range []string{"tap0", "vtap0"} {
+		g.Expect(svc.IfaceDelete(ctx, ports.DeleteIfaceInput{
+			DeviceName:       name,
+			NetworkNamespace: namespace,
+		})).To(Succeed())
+
+		exists, err = svc.IfaceExists(ctx, namespace, name)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(exists).To(BeFalse())
+	}
+
+	_, err = netlink.LinkByName("tap0")
+	g.Expect(err).To(HaveOccurred(), "host end of the veth pair is deleted")
+
+	g.Expect(svc.NamespaceDelete(ctx, namespace)).To(Succeed())
+
+	exists, err = svc.NamespaceExists(ctx, namespace)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+
+	exists, err = svc.IfaceExists(ctx, namespace, "tap0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func newTestFs(g *WithT, networks string) afero.Fs {
+	fs := afero.NewMemMapFs()
+	g.Expect(afero.WriteFile(fs, networksFile, []byte(networks), 0o600)).To(Succeed())
+
+	return fs
+}
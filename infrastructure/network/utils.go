@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"net"
 	"strings"
 
 	"github.com/vishvananda/netlink"
@@ -19,7 +20,17 @@ const (
 	prefix            = "fl"
 	tapPrefix         = "tap"
 	// It's vtap only to save space.
-	macvtapPrefix = "vtap"
+	macvtapPrefix    = "vtap"
+	macAddressLength = 6
+	// The bits of the first octet of a MAC address that make it locally administered and
+	// multicast.
+	localMACBit     = 0x02
+	multicastMACBit = 0x01
+	// maxIfaceNameLength is the longest interface name the kernel allows.
+	maxIfaceNameLength = 15
+	// The veth and bridge that connect a tap device in a network namespace to the host.
+	nsVethPrefix   = "ve-"
+	nsBridgePrefix = "br-"
 )
 
 func NewIfaceName(ifaceType models.IfaceType) (string, error) {
@@ -63,3 +74,22 @@ func generateRandomName(prefix string) (string, error) {
 
 	return prefix + hex.EncodeToString(id)[:ifaceLength], nil
 }
+
+// NewGuestMAC generates a random MAC address for the guest end of an interface. It's a locally
+// administered unicast address, so it won't clash with a vendor assigned one.
+func NewGuestMAC() (string, error) {
+	mac := make(net.HardwareAddr, macAddressLength)
+	if _, err := io.ReadFull(rand.Reader, mac); err != nil {
+		return "", interfaceErrorf("random generator error: %s", err.Error())
+	}
+
+	mac[0] = (mac[0] | localMACBit) &^ multicastMACBit
+
+	return mac.String(), nil
+}
+
+// namespaceDeviceNames returns the names of the veth and bridge in a network namespace that
+// connect the tap device to the host.
+func namespaceDeviceNames(name string) (string, string) {
+	return nsVethPrefix + name, nsBridgePrefix + name
+}
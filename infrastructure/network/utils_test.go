@@ -46,3 +46,17 @@ func TestNewIfaceName_unknownValue(t *testing.T) {
 	g.Expect(err).To(g.HaveOccurred())
 	g.Expect(name).To(g.BeEmpty())
 }
+
+func TestNewGuestMAC(t *testing.T) {
+	g.RegisterTestingT(t)
+
+	mac, err := network.NewGuestMAC()
+
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(mac).To(g.MatchRegexp("^[0-9a-f][26ae](:[0-9a-f]{2}){5}$"), "locally administered unicast")
+
+	other, err := network.NewGuestMAC()
+
+	g.Expect(err).NotTo(g.HaveOccurred())
+	g.Expect(other).NotTo(g.Equal(mac))
+}
@@ -15,6 +15,11 @@ const (
 	httpEndpointFlag          = "http-endpoint"
 	parentIfaceFlag           = "parent-iface"
 	bridgeNameFlag            = "bridge-name"
+	networksFileFlag          = "networks-file"
+	ipamPoolsFileFlag         = "ipam-pools-file"
+	dhcpServerFlag            = "dhcp-server"
+	dhcpLeaseTimeFlag         = "dhcp-lease-time"
+	networkNamespacesFlag     = "network-namespaces"
 	disableReconcileFlag      = "disable-reconcile"
 	disableAPIFlag            = "disable-api"
 	firecrackerBinFlag        = "firecracker-bin"
@@ -24,6 +29,14 @@ const (
 	containerdNamespace       = "containerd-ns"
 	maximumRetryFlag          = "maximum-retry"
 	basicAuthTokenFlag        = "basic-auth-token" //nolint: gosec // This is a flag name
+	authPolicyFileFlag        = "auth-policy-file"
+	jwtJWKSFileFlag           = "jwt-jwks-file"
+	jwtJWKSURLFlag            = "jwt-jwks-url"
+	jwtIssuerFlag             = "jwt-issuer"
+	jwtAudienceFlag           = "jwt-audience"
+	jwtIdentityClaimFlag      = "jwt-identity-claim"
+	jwtNamespacesClaimFlag    = "jwt-namespaces-claim"
+	jwtVerbsClaimFlag         = "jwt-verbs-claim"
 	insecureFlag              = "insecure"
 	tlsCertFlag               = "tls-cert"
 	tlsKeyFlag                = "tls-key"
@@ -33,6 +46,17 @@ const (
 	cloudHypervisorBinFlag    = "cloudhypervisor-bin"
 	cloudHypervisorDetachFlag = "cloudhypervisor-detach"
 	virtioFSBinFlag           = "virtiofs-bin"
+	reservedVCPUFlag          = "reserved-vcpu"
+	reservedMemoryFlag        = "reserved-memory-mb"
+	reservedDiskFlag          = "reserved-disk-mb"
+	diskSizeFlag              = "disk-size-mb"
+	cpuOvercommitFlag         = "cpu-overcommit-ratio"
+	memoryOvercommitFlag      = "memory-overcommit-ratio"
+	diskOvercommitFlag        = "disk-overcommit-ratio"
+	auditLogFileFlag          = "audit-log-file"
+	auditLogMaxSizeFlag       = "audit-log-max-size-mb"
+	auditLogMaxBackupsFlag    = "audit-log-max-backups"
+	auditSocketFlag           = "audit-socket"
 )
 
 // AddGRPCServerFlagsToCommand will add gRPC server flags to the supplied command.
@@ -56,6 +80,17 @@ func AddGRPCServerFlagsToCommand(cmd *cobra.Command, cfg *config.Config) {
 		"deleteMicroVM-timeout",
 		defaults.DeleteVMTimeout,
 		"The timeout for deleting a microvm.")
+
+	cmd.Flags().DurationVar(&cfg.GuestBootDeadline,
+		"guest-boot-deadline",
+		defaults.GuestBootDeadline,
+		"How long a microvm with the guest agent has to boot before it's marked as failed and restarted. "+
+			"Zero doesn't wait for the guest to boot.")
+
+	cmd.Flags().IntVar(&cfg.HistorySize,
+		"history-size",
+		defaults.MicroVMHistorySize,
+		"The number of plan executions to keep in the history of each microvm.")
 }
 
 // AddGWServerFlagsToCommand will add gRPC HTTP gateway flags to the supplied command.
@@ -77,6 +112,46 @@ func AddAuthFlagsToCommand(cmd *cobra.Command, cfg *config.Config) {
 		basicAuthTokenFlag,
 		"",
 		"The token to use for very basic token based authentication.")
+
+	cmd.Flags().StringVar(&cfg.AuthPolicyFile,
+		authPolicyFileFlag,
+		"",
+		"Path to a file of the tokens and client certificate names allowed to use the API and the namespaces and verbs each of them is allowed.")
+
+	cmd.Flags().StringVar(&cfg.JWT.JWKSFile,
+		jwtJWKSFileFlag,
+		"",
+		"Path to a JSON web key set file used to verify JWT bearer tokens.")
+
+	cmd.Flags().StringVar(&cfg.JWT.JWKSURL,
+		jwtJWKSURLFlag,
+		"",
+		"URL of a JSON web key set used to verify JWT bearer tokens.")
+
+	cmd.Flags().StringVar(&cfg.JWT.Issuer,
+		jwtIssuerFlag,
+		"",
+		"The issuer (iss) JWT bearer tokens must have.")
+
+	cmd.Flags().StringVar(&cfg.JWT.Audience,
+		jwtAudienceFlag,
+		"",
+		"The audience (aud) JWT bearer tokens must be for.")
+
+	cmd.Flags().StringVar(&cfg.JWT.IdentityClaim,
+		jwtIdentityClaimFlag,
+		defaults.JWTIdentityClaim,
+		"The JWT claim with the name of the caller.")
+
+	cmd.Flags().StringVar(&cfg.JWT.NamespacesClaim,
+		jwtNamespacesClaimFlag,
+		defaults.JWTNamespacesClaim,
+		"The JWT claim with the namespaces the caller is allowed to use, use * for all namespaces.")
+
+	cmd.Flags().StringVar(&cfg.JWT.VerbsClaim,
+		jwtVerbsClaimFlag,
+		defaults.JWTVerbsClaim,
+		"The JWT claim with the verbs (read, create, delete, exec) the caller is allowed in the namespaces.")
 }
 
 // AddTLSFlagsToCommand will add TLS-related flags to the given command.
@@ -120,6 +195,36 @@ func AddNetworkFlagsToCommand(cmd *cobra.Command, cfg *config.Config) error {
 		"",
 		"The name of the Linux bridge to attach tap devices to by default")
 
+	cmd.Flags().StringVar(
+		&cfg.NetworksFile,
+		networksFileFlag,
+		"",
+		"Path to a file of named networks with a bridge, parent, VLAN and MTU that interfaces can reference")
+
+	cmd.Flags().StringVar(
+		&cfg.IPAMPoolsFile,
+		ipamPoolsFileFlag,
+		"",
+		"Path to a file of address pools per bridge to allocate addresses to tap devices without a static address")
+
+	cmd.Flags().BoolVar(
+		&cfg.DHCP.Enabled,
+		dhcpServerFlag,
+		false,
+		"Run a DHCP server on the bridge that serves the addresses of the microvms attached to it")
+
+	cmd.Flags().DurationVar(
+		&cfg.DHCP.LeaseTime,
+		dhcpLeaseTimeFlag,
+		defaults.DHCPLeaseTime,
+		"How long a lease from the DHCP server is valid for")
+
+	cmd.Flags().BoolVar(
+		&cfg.NetworkNamespaces,
+		networkNamespacesFlag,
+		false,
+		"Create a network namespace for each new microvm to run its vmm and network interfaces in")
+
 	return nil
 }
 
@@ -216,3 +321,65 @@ func addCloudHypervisorFlagsToCommand(cmd *cobra.Command, cfg *config.Config) {
 		defaults.CloudHypervisorDetach,
 		"If true the child cloud hypervisor processes will be detached from the parent flintlock process.")
 }
+
+// AddCapacityFlagsToCommand will add the host capacity flags to the supplied command.
+func AddCapacityFlagsToCommand(cmd *cobra.Command, cfg *config.Config) {
+	cmd.Flags().Int64Var(&cfg.Capacity.ReservedVCPU,
+		reservedVCPUFlag,
+		0,
+		"The number of host cpus to keep back for the host and not allocate to microvms.")
+
+	cmd.Flags().Int64Var(&cfg.Capacity.ReservedMemoryInMb,
+		reservedMemoryFlag,
+		0,
+		"The amount of host memory in megabytes to keep back for the host and not allocate to microvms.")
+
+	cmd.Flags().Int64Var(&cfg.Capacity.DiskInMb,
+		diskSizeFlag,
+		0,
+		"The size in megabytes of the storage microvm volumes are created on, e.g. the devmapper thin pool. "+
+			"Disk capacity isn't checked if it's not set.")
+
+	cmd.Flags().Int64Var(&cfg.Capacity.ReservedDiskInMb,
+		reservedDiskFlag,
+		0,
+		"The amount of host disk in megabytes to keep back for the host and not allocate to microvms.")
+
+	cmd.Flags().Float64Var(&cfg.Capacity.CPUOvercommitRatio,
+		cpuOvercommitFlag,
+		defaults.OvercommitRatio,
+		"The number of vcpus that can be allocated to microvms per host cpu.")
+
+	cmd.Flags().Float64Var(&cfg.Capacity.MemoryOvercommitRatio,
+		memoryOvercommitFlag,
+		defaults.OvercommitRatio,
+		"The amount of memory that can be allocated to microvms per megabyte of host memory.")
+
+	cmd.Flags().Float64Var(&cfg.Capacity.DiskOvercommitRatio,
+		diskOvercommitFlag,
+		defaults.OvercommitRatio,
+		"The amount of disk that can be allocated to microvms per megabyte of host disk.")
+}
+
+// AddAuditFlagsToCommand will add the audit log flags to the supplied command.
+func AddAuditFlagsToCommand(cmd *cobra.Command, cfg *config.Config) {
+	cmd.Flags().StringVar(&cfg.Audit.File,
+		auditLogFileFlag,
+		"",
+		"Path to a file to write an audit log of the mutating API calls to, as JSON lines.")
+
+	cmd.Flags().IntVar(&cfg.Audit.MaxSizeInMb,
+		auditLogMaxSizeFlag,
+		defaults.AuditLogMaxSizeInMb,
+		"The size in megabytes the audit log file can grow to before it's rotated. Use 0 to disable rotation.")
+
+	cmd.Flags().IntVar(&cfg.Audit.MaxBackups,
+		auditLogMaxBackupsFlag,
+		defaults.AuditLogMaxBackups,
+		"The number of rotated audit log files to keep.")
+
+	cmd.Flags().StringVar(&cfg.Audit.Socket,
+		auditSocketFlag,
+		"",
+		"Path to a unix socket to send an audit log of the mutating API calls to, as JSON lines.")
+}
@@ -133,8 +133,8 @@ func serveMachinesByName(aports *ports.Collection) serveFunc {
 			context.Background(),
 			aports,
 			models.ListMicroVMQuery{
-				"namespace": vars["namespace"],
-				"name":      vars["name"],
+				Namespace: vars["namespace"],
+				Name:      vars["name"],
 			},
 		)
 		if err != nil {
@@ -156,7 +156,7 @@ func serveMachinesByNamespace(aports *ports.Collection) serveFunc {
 	return func(response http.ResponseWriter, request *http.Request) {
 		vars := mux.Vars(request)
 
-		mms, err := getAllMachineMetrics(context.Background(), aports, models.ListMicroVMQuery{"namespace": vars["namespace"]})
+		mms, err := getAllMachineMetrics(context.Background(), aports, models.ListMicroVMQuery{Namespace: vars["namespace"]})
 		if err != nil {
 			response.WriteHeader(http.StatusInternalServerError)
 			_, _ = response.Write([]byte(err.Error()))
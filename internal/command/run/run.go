@@ -23,12 +23,15 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	hostv1 "github.com/liquidmetal-dev/flintlock/api/services/host/v1alpha1"
 	mvmv1 "github.com/liquidmetal-dev/flintlock/api/services/microvm/v1alpha1"
+	microvmgrpc "github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm"
 	cmdflags "github.com/liquidmetal-dev/flintlock/internal/command/flags"
 	"github.com/liquidmetal-dev/flintlock/internal/config"
 	"github.com/liquidmetal-dev/flintlock/internal/inject"
 	"github.com/liquidmetal-dev/flintlock/internal/version"
+	"github.com/liquidmetal-dev/flintlock/pkg/audit"
 	"github.com/liquidmetal-dev/flintlock/pkg/auth"
 	"github.com/liquidmetal-dev/flintlock/pkg/flags"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
@@ -82,6 +85,8 @@ func NewCommand(cfg *config.Config) (*cobra.Command, error) {
 	cmdflags.AddDebugFlagsToCommand(cmd, cfg)
 	cmdflags.AddGWServerFlagsToCommand(cmd, cfg)
 	cmdflags.AddVirtioFSFlagsToCommand(cmd, cfg)
+	cmdflags.AddCapacityFlagsToCommand(cmd, cfg)
+	cmdflags.AddAuditFlagsToCommand(cmd, cfg)
 
 	if err := cmdflags.AddNetworkFlagsToCommand(cmd, cfg); err != nil {
 		return nil, fmt.Errorf("adding network flags to run command: %w", err)
@@ -177,6 +182,14 @@ func serveAPI(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("validating tls config: %w", err)
 	}
 
+	if err := cfg.JWT.Validate(); err != nil {
+		return fmt.Errorf("validating jwt config: %w", err)
+	}
+
+	if err := cfg.Audit.Validate(); err != nil {
+		return fmt.Errorf("validating audit config: %w", err)
+	}
+
 	ports, err := inject.InitializePorts(cfg)
 	if err != nil {
 		return fmt.Errorf("initialising ports for application: %w", err)
@@ -184,8 +197,27 @@ func serveAPI(ctx context.Context, cfg *config.Config) error {
 
 	app := inject.InitializeApp(cfg, ports)
 	server := inject.InitializeGRPCServer(app)
+	hostServer := inject.InitializeHostGRPCServer(app)
+	authorizer := inject.InitializeAuthorizer(app)
+
+	var auditor *microvmgrpc.Auditor
+
+	if cfg.Audit.Enabled() {
+		sink, err := audit.NewSinkFromConfig(&cfg.Audit)
+		if err != nil {
+			return fmt.Errorf("creating audit log: %w", err)
+		}
 
-	serverOpts, err := generateOpts(ctx, cfg)
+		auditor = microvmgrpc.NewAuditor(sink)
+
+		defer func() {
+			if err := auditor.Close(); err != nil {
+				logger.Errorf("failed to close audit log: %v", err)
+			}
+		}()
+	}
+
+	serverOpts, err := generateOpts(ctx, cfg, authorizer, auditor)
 	if err != nil {
 		return err
 	}
@@ -193,6 +225,7 @@ func serveAPI(ctx context.Context, cfg *config.Config) error {
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	mvmv1.RegisterMicroVMServer(grpcServer, server)
+	hostv1.RegisterHostServer(grpcServer, hostServer)
 	grpc_prometheus.Register(grpcServer)
 	http.Handle("/metrics", promhttp.Handler())
 
@@ -230,6 +263,18 @@ func runControllers(ctx context.Context, cfg *config.Config) error {
 	app := inject.InitializeApp(cfg, ports)
 	mvmControllers := inject.InializeController(app, ports)
 
+	// The DHCP server runs with the controller as its leases are added by the reconciler. The
+	// leases of the existing microvms are restored first, as guests can renew them at any time.
+	if cfg.DHCP.Enabled {
+		if err := app.RestoreDHCPLeases(ctx); err != nil {
+			return fmt.Errorf("restoring dhcp leases: %w", err)
+		}
+
+		if err := ports.DHCPService.Start(ctx); err != nil {
+			return fmt.Errorf("starting dhcp server: %w", err)
+		}
+	}
+
 	logger.Info("starting microvm controller")
 
 	if err := mvmControllers.Run(ctx, 1, cfg.ResyncPeriod, true); err != nil {
@@ -239,31 +284,68 @@ func runControllers(ctx context.Context, cfg *config.Config) error {
 	return nil
 }
 
-func generateOpts(ctx context.Context, cfg *config.Config) ([]grpc.ServerOption, error) {
+func generateOpts(
+	ctx context.Context,
+	cfg *config.Config,
+	authorizer *microvmgrpc.Authorizer,
+	auditor *microvmgrpc.Auditor,
+) ([]grpc.ServerOption, error) {
 	logger := log.GetLogger(ctx)
 
-	opts := []grpc.ServerOption{
-		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
-		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+
+	// Callers authenticated with a policy or a JWT have an identity that's authorized.
+	authorize := cfg.AuthPolicyFile != "" || cfg.JWT.Enabled()
+
+	if cfg.BasicAuthToken != "" && authorize {
+		return nil, errors.New("the basic auth token can't be used with an auth policy file or jwt authentication")
 	}
 
-	if cfg.BasicAuthToken != "" {
-		logger.Info("basic authentication is enabled")
+	// The audit log comes before authentication so that calls that fail authentication or
+	// authorization are recorded too.
+	if auditor != nil {
+		logger.Info("audit log is enabled")
 
-		opts = []grpc.ServerOption{
-			grpc.StreamInterceptor(grpc_mw.ChainStreamServer(
-				grpc_prometheus.StreamServerInterceptor,
-				grpc_auth.StreamServerInterceptor(auth.BasicAuthFunc(cfg.BasicAuthToken)),
-			)),
-			grpc.UnaryInterceptor(grpc_mw.ChainUnaryServer(
-				grpc_prometheus.UnaryServerInterceptor,
-				grpc_auth.UnaryServerInterceptor(auth.BasicAuthFunc(cfg.BasicAuthToken)),
-			)),
+		streamInterceptors = append(streamInterceptors, auditor.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryServerInterceptor())
+	}
+
+	switch {
+	case authorize:
+		authFunc, err := identityAuthFunc(ctx, cfg)
+		if err != nil {
+			return nil, err
 		}
-	} else {
+
+		streamInterceptors = append(streamInterceptors, grpc_auth.StreamServerInterceptor(authFunc))
+		unaryInterceptors = append(unaryInterceptors, grpc_auth.UnaryServerInterceptor(authFunc))
+	case cfg.BasicAuthToken != "":
+		logger.Info("basic authentication is enabled")
+
+		streamInterceptors = append(streamInterceptors,
+			grpc_auth.StreamServerInterceptor(auth.BasicAuthFunc(cfg.BasicAuthToken)))
+		unaryInterceptors = append(unaryInterceptors,
+			grpc_auth.UnaryServerInterceptor(auth.BasicAuthFunc(cfg.BasicAuthToken)))
+	default:
 		logger.Warn("basic authentication is DISABLED")
 	}
 
+	if auditor != nil {
+		streamInterceptors = append(streamInterceptors, auditor.StreamCallerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryCallerInterceptor())
+	}
+
+	if authorize {
+		streamInterceptors = append(streamInterceptors, authorizer.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, authorizer.UnaryServerInterceptor())
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.StreamInterceptor(grpc_mw.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_mw.ChainUnaryServer(unaryInterceptors...)),
+	}
+
 	if !cfg.TLS.Insecure {
 		logger.Info("TLS is enabled")
 
@@ -280,6 +362,41 @@ func generateOpts(ctx context.Context, cfg *config.Config) ([]grpc.ServerOption,
 	return opts, nil
 }
 
+// identityAuthFunc returns the auth func for the configured ways of authenticating callers
+// with an identity.
+func identityAuthFunc(ctx context.Context, cfg *config.Config) (grpc_auth.AuthFunc, error) {
+	logger := log.GetLogger(ctx)
+	authFuncs := []grpc_auth.AuthFunc{}
+
+	if cfg.JWT.Enabled() {
+		logger.Infof("jwt authentication for tokens issued by %s is enabled", cfg.JWT.Issuer)
+
+		verifier, err := auth.NewJWTVerifier(ctx, &cfg.JWT)
+		if err != nil {
+			return nil, fmt.Errorf("creating jwt verifier: %w", err)
+		}
+
+		authFuncs = append(authFuncs, auth.JWTAuthFunc(verifier))
+	}
+
+	if cfg.AuthPolicyFile != "" {
+		logger.Infof("authentication and authorization with the policy in %s is enabled", cfg.AuthPolicyFile)
+
+		policy, err := auth.LoadPolicy(cfg.AuthPolicyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading auth policy: %w", err)
+		}
+
+		authFuncs = append(authFuncs, auth.PolicyAuthFunc(policy))
+	}
+
+	if len(authFuncs) == 1 {
+		return authFuncs[0], nil
+	}
+
+	return auth.AnyAuthFunc(authFuncs...), nil
+}
+
 func runPProf(ctx context.Context, cfg *config.Config) error {
 	logger := log.GetLogger(ctx)
 	logger.Warnf("Debug endpoint is ENABLED at %s", cfg.DebugEndpoint)
@@ -323,6 +440,10 @@ func serveHTTP(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("could not register microvm server: %w", err)
 	}
 
+	if err := hostv1.RegisterHostHandlerFromEndpoint(ctx, mux, cfg.GRPCAPIEndpoint, opts); err != nil {
+		return fmt.Errorf("could not register host server: %w", err)
+	}
+
 	server := &http.Server{
 		Addr:              cfg.HTTPAPIEndpoint,
 		Handler:           mux,
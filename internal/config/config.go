@@ -34,6 +34,16 @@ type Config struct {
 	ParentIface string
 	// BridgeName is the name of the Linux bridge to attach tap devices to be default.
 	BridgeName string
+	// NetworksFile is the path to a file of the named networks that network interfaces can be
+	// attached to.
+	NetworksFile string
+	// IPAMPoolsFile is the path to a file of the address pools to allocate addresses to tap
+	// devices from, keyed by bridge.
+	IPAMPoolsFile string
+	// DHCP holds the configuration for the embedded DHCP server.
+	DHCP DHCPConfig
+	// NetworkNamespaces indicates if new microvms are isolated in a network namespace of their own.
+	NetworkNamespaces bool
 	// CtrSnapshotterKernel is the name of the containerd snapshotter to use for kernel images.
 	CtrSnapshotterKernel string
 	// CtrSocketPath is the path to the containerd socket.
@@ -48,16 +58,30 @@ type Config struct {
 	ResyncPeriod time.Duration
 	// MaximumRetry defined how many times we retry if reconciliation failed.
 	MaximumRetry int
+	// HistorySize is the number of plan executions to keep in the history of each microvm.
+	HistorySize int
 	// DeleteVMTimeout defines the timeout for the delete vm operation.
 	DeleteVMTimeout time.Duration
+	// GuestBootDeadline is how long a microvm with the guest agent has for its guest to respond after booting.
+	// Zero disables waiting for the guest.
+	GuestBootDeadline time.Duration
 	// BasicAuthToken is the static token to use for very basic authentication.
 	BasicAuthToken string
+	// AuthPolicyFile is the path to a file of the identities allowed to use the API and what
+	// each of them is allowed to do. It can't be used with BasicAuthToken.
+	AuthPolicyFile string
+	// JWT holds the configuration for JWT bearer token authentication.
+	JWT JWTConfig
 	// TLS holds the TLS related configuration.
 	TLS TLSConfig
 	// DebugEndpoint is the endpoint for the debug web server. An empty string means disable the debug endpoint.
 	DebugEndpoint string
 	// DefaultVMProvider specifies the name of the microvm provider to use by default.
 	DefaultVMProvider string
+	// Capacity holds the host capacity related configuration.
+	Capacity CapacityConfig
+	// Audit holds the audit log related configuration.
+	Audit AuditConfig
 }
 
 // TLSConfig holds the configuration for TLS.
@@ -73,3 +97,60 @@ type TLSConfig struct {
 	// ClientCAFile is the path to a CA certificate file to use when validating client certificates.
 	ClientCAFile string
 }
+
+// CapacityConfig holds the configuration for host capacity accounting.
+type CapacityConfig struct {
+	// ReservedVCPU is the number of host cpus kept back for the host.
+	ReservedVCPU int64
+	// ReservedMemoryInMb is the amount of host memory kept back for the host.
+	ReservedMemoryInMb int64
+	// DiskInMb is the size of the storage microvm volumes are created on. Disk capacity isn't
+	// checked if it's zero.
+	DiskInMb int64
+	// ReservedDiskInMb is the amount of host disk kept back for the host.
+	ReservedDiskInMb int64
+	// CPUOvercommitRatio is how many vcpus can be allocated per host cpu.
+	CPUOvercommitRatio float64
+	// MemoryOvercommitRatio is how much memory can be allocated per megabyte of host memory.
+	MemoryOvercommitRatio float64
+	// DiskOvercommitRatio is how much disk can be allocated per megabyte of host disk.
+	DiskOvercommitRatio float64
+}
+
+// DHCPConfig holds the configuration for the embedded DHCP server.
+type DHCPConfig struct {
+	// Enabled indicates if the DHCP server should serve the leases of the microvms on the bridge.
+	Enabled bool
+	// LeaseTime is how long a lease is valid for before the guest has to renew it.
+	LeaseTime time.Duration
+}
+
+// AuditConfig holds the configuration for the audit log of API calls.
+type AuditConfig struct {
+	// File is the path of the file to write the audit log to.
+	File string
+	// MaxSizeInMb is the size the audit log file can grow to before it's rotated.
+	MaxSizeInMb int
+	// MaxBackups is the number of rotated audit log files to keep.
+	MaxBackups int
+	// Socket is the path of a unix socket to send the audit log to.
+	Socket string
+}
+
+// JWTConfig holds the configuration for JWT bearer token authentication.
+type JWTConfig struct {
+	// JWKSFile is the path to a file with the JSON web key set the tokens are signed with.
+	JWKSFile string
+	// JWKSURL is the URL of the JSON web key set the tokens are signed with.
+	JWKSURL string
+	// Issuer is the expected issuer (iss) of the tokens.
+	Issuer string
+	// Audience is the audience (aud) the tokens must be for.
+	Audience string
+	// IdentityClaim is the claim with the name of the identity.
+	IdentityClaim string
+	// NamespacesClaim is the claim with the namespaces the identity is allowed to use.
+	NamespacesClaim string
+	// VerbsClaim is the claim with the verbs the identity is allowed in the namespaces.
+	VerbsClaim string
+}
@@ -10,6 +10,13 @@ var (
 	errCertRequired       = errors.New("certificate file path is required when running securely")
 	errKeyRequired        = errors.New("certificate key file path is required when running securely")
 	errClientCARequired   = errors.New("client certificate key file path is required when running mTLS")
+
+	errAuditFileAndSocket    = errors.New("only one of an audit log file and an audit socket can be used")
+	errAuditNegativeRotation = errors.New("audit log max size and max backups can't be negative")
+
+	errJWKSFileAndURL            = errors.New("only one of a jwks file and a jwks url can be used")
+	errJWTIssuerAudienceRequired = errors.New("jwt issuer and audience are required when using jwt authentication")
+	errJWTClaimsRequired         = errors.New("jwt identity, namespaces and verbs claims are required")
 )
 
 type certMissingError struct {
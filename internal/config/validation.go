@@ -44,6 +44,50 @@ func (t TLSConfig) Validate() error {
 	return nil
 }
 
+// Enabled returns true if an audit log is configured.
+func (a AuditConfig) Enabled() bool {
+	return isSet(a.File) || isSet(a.Socket)
+}
+
+// Validate will validate the audit config.
+func (a AuditConfig) Validate() error {
+	if isSet(a.File) && isSet(a.Socket) {
+		return errAuditFileAndSocket
+	}
+
+	if a.MaxSizeInMb < 0 || a.MaxBackups < 0 {
+		return errAuditNegativeRotation
+	}
+
+	return nil
+}
+
+// Enabled returns true if JWT authentication is configured.
+func (j JWTConfig) Enabled() bool {
+	return isSet(j.JWKSFile) || isSet(j.JWKSURL)
+}
+
+// Validate will validate the JWT config.
+func (j JWTConfig) Validate() error {
+	if !j.Enabled() {
+		return nil
+	}
+
+	if isSet(j.JWKSFile) && isSet(j.JWKSURL) {
+		return errJWKSFileAndURL
+	}
+
+	if isNotSet(j.Issuer) || isNotSet(j.Audience) {
+		return errJWTIssuerAudienceRequired
+	}
+
+	if isNotSet(j.IdentityClaim) || isNotSet(j.NamespacesClaim) || isNotSet(j.VerbsClaim) {
+		return errJWTClaimsRequired
+	}
+
+	return nil
+}
+
 func isSet(val string) bool {
 	return val != ""
 }
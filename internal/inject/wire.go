@@ -4,17 +4,24 @@
 package inject
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/google/wire"
 	"github.com/spf13/afero"
 
 	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/containerd"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/controllers"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/dhcp"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/firewall"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/godisk"
 	microvmgrpc "github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/guestagent"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/host"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/ipam"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/network"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/ulid"
@@ -27,15 +34,26 @@ func InitializePorts(cfg *config.Config) (*ports.Collection, error) {
 	wire.Build(containerd.NewEventService,
 		containerd.NewImageService,
 		containerd.NewMicroVMRepo,
+		containerd.NewSnapshotRepo,
+		containerd.NewHistoryRepo,
+		containerd.NewQuotaRepo,
 		ulid.New,
 		microvm.NewFromConfig,
 		network.New,
+		ipam.New,
+		dhcp.New,
+		firewall.New,
 		godisk.New,
 		appPorts,
 		containerdConfig,
 		networkConfig,
+		ipamConfig,
+		dhcpConfig,
 		afero.NewOsFs,
-		virtiofs.New)
+		virtiofs.New,
+		guestagent.New,
+		host.New,
+		hostConfig)
 
 	return nil, nil
 }
@@ -58,12 +76,25 @@ func InitializeGRPCServer(app application.App) ports.MicroVMGRPCService {
 	return nil
 }
 
+func InitializeHostGRPCServer(app application.App) ports.HostGRPCService {
+	wire.Build(microvmgrpc.NewHostServer, queryUCFromApp)
+
+	return nil
+}
+
+func InitializeAuthorizer(app application.App) *microvmgrpc.Authorizer {
+	wire.Build(microvmgrpc.NewAuthorizer, queryUCFromApp)
+
+	return nil
+}
+
 func containerdConfig(cfg *config.Config) *containerd.Config {
 	return &containerd.Config{
 		SnapshotterKernel: cfg.CtrSnapshotterKernel,
 		SnapshotterVolume: defaults.ContainerdVolumeSnapshotter,
 		SocketPath:        cfg.CtrSocketPath,
 		Namespace:         cfg.CtrNamespace,
+		HistorySize:       cfg.HistorySize,
 	}
 }
 
@@ -71,29 +102,75 @@ func networkConfig(cfg *config.Config) *network.Config {
 	return &network.Config{
 		ParentDeviceName: cfg.ParentIface,
 		BridgeName:       cfg.BridgeName,
+		NetworksFile:     cfg.NetworksFile,
+	}
+}
+
+func ipamConfig(cfg *config.Config) *ipam.Config {
+	return &ipam.Config{
+		PoolsFile:     cfg.IPAMPoolsFile,
+		StateDir:      filepath.Join(cfg.StateRootDir, "ipam"),
+		DefaultBridge: cfg.BridgeName,
+	}
+}
+
+func dhcpConfig(cfg *config.Config) *dhcp.Config {
+	return &dhcp.Config{
+		Enabled:    cfg.DHCP.Enabled,
+		BridgeName: cfg.BridgeName,
+		LeaseTime:  cfg.DHCP.LeaseTime,
+	}
+}
+
+func hostConfig(cfg *config.Config) *host.Config {
+	return &host.Config{
+		DiskInMb:          cfg.Capacity.DiskInMb,
+		BridgeName:        cfg.BridgeName,
+		ParentIface:       cfg.ParentIface,
+		CtrNamespace:      cfg.CtrNamespace,
+		KernelSnapshotter: cfg.CtrSnapshotterKernel,
+		VolumeSnapshotter: defaults.ContainerdVolumeSnapshotter,
 	}
 }
 
 func appConfig(cfg *config.Config) *application.Config {
 	return &application.Config{
-		RootStateDir:    cfg.StateRootDir,
-		MaximumRetry:    cfg.MaximumRetry,
-		DefaultProvider: cfg.DefaultVMProvider,
+		RootStateDir:      cfg.StateRootDir,
+		MaximumRetry:      cfg.MaximumRetry,
+		DefaultProvider:   cfg.DefaultVMProvider,
+		GuestBootDeadline: cfg.GuestBootDeadline,
+		NetworkNamespaces: cfg.NetworkNamespaces,
+		ReservedResources: models.HostResources{
+			VCPU:       cfg.Capacity.ReservedVCPU,
+			MemoryInMb: cfg.Capacity.ReservedMemoryInMb,
+			DiskInMb:   cfg.Capacity.ReservedDiskInMb,
+		},
+		CPUOvercommitRatio:    cfg.Capacity.CPUOvercommitRatio,
+		MemoryOvercommitRatio: cfg.Capacity.MemoryOvercommitRatio,
+		DiskOvercommitRatio:   cfg.Capacity.DiskOvercommitRatio,
 	}
 }
 
-func appPorts(repo ports.MicroVMRepository, providers map[string]ports.MicroVMService, es ports.EventService, is ports.IDService, ns ports.NetworkService, ims ports.ImageService, fs afero.Fs, ds ports.DiskService, vfs ports.VirtioFSService) *ports.Collection {
+func appPorts(repo ports.MicroVMRepository, snapshotRepo ports.SnapshotRepository, historyRepo ports.HistoryRepository, quotaRepo ports.QuotaRepository, providers map[string]ports.MicroVMService, es ports.EventService, is ports.IDService, ns ports.NetworkService, ipamSvc ports.IPAMService, dhcpSvc ports.DHCPService, fws ports.FirewallService, ims ports.ImageService, fs afero.Fs, ds ports.DiskService, vfs ports.VirtioFSService, gas ports.GuestAgentService, hs ports.HostService) *ports.Collection {
 	return &ports.Collection{
 		Repo:              repo,
+		SnapshotRepo:      snapshotRepo,
+		HistoryRepo:       historyRepo,
+		QuotaRepo:         quotaRepo,
 		MicrovmProviders:  providers,
 		EventService:      es,
 		IdentifierService: is,
 		NetworkService:    ns,
+		IPAMService:       ipamSvc,
+		DHCPService:       dhcpSvc,
+		FirewallService:   fws,
 		ImageService:      ims,
 		FileSystem:        fs,
 		Clock:             time.Now,
 		DiskService:       ds,
 		VirtioFSService:   vfs,
+		GuestAgentService: gas,
+		HostService:       hs,
 	}
 }
 
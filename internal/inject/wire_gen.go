@@ -8,11 +8,17 @@ package inject
 
 import (
 	"github.com/liquidmetal-dev/flintlock/core/application"
+	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/core/ports"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/containerd"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/controllers"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/dhcp"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/firewall"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/godisk"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/grpc"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/guestagent"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/host"
+	"github.com/liquidmetal-dev/flintlock/infrastructure/ipam"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/microvm"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/network"
 	"github.com/liquidmetal-dev/flintlock/infrastructure/ulid"
@@ -20,6 +26,7 @@ import (
 	"github.com/liquidmetal-dev/flintlock/internal/config"
 	"github.com/liquidmetal-dev/flintlock/pkg/defaults"
 	"github.com/spf13/afero"
+	"path/filepath"
 	"time"
 )
 
@@ -31,9 +38,24 @@ func InitializePorts(cfg *config.Config) (*ports.Collection, error) {
 	if err != nil {
 		return nil, err
 	}
+	snapshotRepository, err := containerd.NewSnapshotRepo(config2)
+	if err != nil {
+		return nil, err
+	}
+	historyRepository, err := containerd.NewHistoryRepo(config2)
+	if err != nil {
+		return nil, err
+	}
+	quotaRepository, err := containerd.NewQuotaRepo(config2)
+	if err != nil {
+		return nil, err
+	}
 	config3 := networkConfig(cfg)
-	networkService := network.New(config3)
 	fs := afero.NewOsFs()
+	networkService, err := network.New(config3, fs)
+	if err != nil {
+		return nil, err
+	}
 	diskService := godisk.New(fs)
 	v, err := microvm.NewFromConfig(cfg, networkService, diskService, fs)
 	if err != nil {
@@ -44,12 +66,23 @@ func InitializePorts(cfg *config.Config) (*ports.Collection, error) {
 		return nil, err
 	}
 	idService := ulid.New()
+	config4 := ipamConfig(cfg)
+	ipamService, err := ipam.New(config4, fs)
+	if err != nil {
+		return nil, err
+	}
+	config5 := dhcpConfig(cfg)
+	dhcpService := dhcp.New(config5)
+	firewallService := firewall.New()
 	imageService, err := containerd.NewImageService(config2)
 	if err != nil {
 		return nil, err
 	}
 	virtioFSService := virtiofs.New(cfg, fs)
-	collection := appPorts(microVMRepository, v, eventService, idService, networkService, imageService, fs, diskService, virtioFSService)
+	guestAgentService := guestagent.New()
+	config6 := hostConfig(cfg)
+	hostService := host.New(config6, fs)
+	collection := appPorts(microVMRepository, snapshotRepository, historyRepository, quotaRepository, v, eventService, idService, networkService, ipamService, dhcpService, firewallService, imageService, fs, diskService, virtioFSService, guestAgentService, hostService)
 	return collection, nil
 }
 
@@ -74,6 +107,18 @@ func InitializeGRPCServer(app application.App) ports.MicroVMGRPCService {
 	return microVMGRPCService
 }
 
+func InitializeHostGRPCServer(app application.App) ports.HostGRPCService {
+	microVMQueryUseCases := queryUCFromApp(app)
+	hostGRPCService := grpc.NewHostServer(microVMQueryUseCases)
+	return hostGRPCService
+}
+
+func InitializeAuthorizer(app application.App) *grpc.Authorizer {
+	microVMQueryUseCases := queryUCFromApp(app)
+	authorizer := grpc.NewAuthorizer(microVMQueryUseCases)
+	return authorizer
+}
+
 // wire.go:
 
 func containerdConfig(cfg *config.Config) *containerd.Config {
@@ -82,6 +127,7 @@ func containerdConfig(cfg *config.Config) *containerd.Config {
 		SnapshotterVolume: defaults.ContainerdVolumeSnapshotter,
 		SocketPath:        cfg.CtrSocketPath,
 		Namespace:         cfg.CtrNamespace,
+		HistorySize:       cfg.HistorySize,
 	}
 }
 
@@ -89,29 +135,75 @@ func networkConfig(cfg *config.Config) *network.Config {
 	return &network.Config{
 		ParentDeviceName: cfg.ParentIface,
 		BridgeName:       cfg.BridgeName,
+		NetworksFile:     cfg.NetworksFile,
+	}
+}
+
+func ipamConfig(cfg *config.Config) *ipam.Config {
+	return &ipam.Config{
+		PoolsFile:     cfg.IPAMPoolsFile,
+		StateDir:      filepath.Join(cfg.StateRootDir, "ipam"),
+		DefaultBridge: cfg.BridgeName,
+	}
+}
+
+func dhcpConfig(cfg *config.Config) *dhcp.Config {
+	return &dhcp.Config{
+		Enabled:    cfg.DHCP.Enabled,
+		BridgeName: cfg.BridgeName,
+		LeaseTime:  cfg.DHCP.LeaseTime,
+	}
+}
+
+func hostConfig(cfg *config.Config) *host.Config {
+	return &host.Config{
+		DiskInMb:          cfg.Capacity.DiskInMb,
+		BridgeName:        cfg.BridgeName,
+		ParentIface:       cfg.ParentIface,
+		CtrNamespace:      cfg.CtrNamespace,
+		KernelSnapshotter: cfg.CtrSnapshotterKernel,
+		VolumeSnapshotter: defaults.ContainerdVolumeSnapshotter,
 	}
 }
 
 func appConfig(cfg *config.Config) *application.Config {
 	return &application.Config{
-		RootStateDir:    cfg.StateRootDir,
-		MaximumRetry:    cfg.MaximumRetry,
-		DefaultProvider: cfg.DefaultVMProvider,
+		RootStateDir:      cfg.StateRootDir,
+		MaximumRetry:      cfg.MaximumRetry,
+		DefaultProvider:   cfg.DefaultVMProvider,
+		GuestBootDeadline: cfg.GuestBootDeadline,
+		NetworkNamespaces: cfg.NetworkNamespaces,
+		ReservedResources: models.HostResources{
+			VCPU:       cfg.Capacity.ReservedVCPU,
+			MemoryInMb: cfg.Capacity.ReservedMemoryInMb,
+			DiskInMb:   cfg.Capacity.ReservedDiskInMb,
+		},
+		CPUOvercommitRatio:    cfg.Capacity.CPUOvercommitRatio,
+		MemoryOvercommitRatio: cfg.Capacity.MemoryOvercommitRatio,
+		DiskOvercommitRatio:   cfg.Capacity.DiskOvercommitRatio,
 	}
 }
 
-func appPorts(repo ports.MicroVMRepository, providers map[string]ports.MicroVMService, es ports.EventService, is ports.IDService, ns ports.NetworkService, ims ports.ImageService, fs afero.Fs, ds ports.DiskService, vfs ports.VirtioFSService) *ports.Collection {
+func appPorts(repo ports.MicroVMRepository, snapshotRepo ports.SnapshotRepository, historyRepo ports.HistoryRepository, quotaRepo ports.QuotaRepository, providers map[string]ports.MicroVMService, es ports.EventService, is ports.IDService, ns ports.NetworkService, ipamSvc ports.IPAMService, dhcpSvc ports.DHCPService, fws ports.FirewallService, ims ports.ImageService, fs afero.Fs, ds ports.DiskService, vfs ports.VirtioFSService, gas ports.GuestAgentService, hs ports.HostService) *ports.Collection {
 	return &ports.Collection{
 		Repo:              repo,
+		SnapshotRepo:      snapshotRepo,
+		HistoryRepo:       historyRepo,
+		QuotaRepo:         quotaRepo,
 		MicrovmProviders:  providers,
 		EventService:      es,
 		IdentifierService: is,
 		NetworkService:    ns,
+		IPAMService:       ipamSvc,
+		DHCPService:       dhcpSvc,
+		FirewallService:   fws,
 		ImageService:      ims,
 		FileSystem:        fs,
 		Clock:             time.Now,
 		DiskService:       ds,
 		VirtioFSService:   vfs,
+		GuestAgentService: gas,
+		HostService:       hs,
 	}
 }
 
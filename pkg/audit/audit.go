@@ -0,0 +1,60 @@
+// Package audit records the calls made to the flintlock API.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// OutcomeSuccess is the outcome of a call that succeeded.
+	OutcomeSuccess = "success"
+	// OutcomeFailure is the outcome of a call that returned an error.
+	OutcomeFailure = "failure"
+)
+
+// Event is a single entry in the audit log.
+type Event struct {
+	// Timestamp is when the call was made.
+	Timestamp time.Time `json:"timestamp"`
+	// Identity is who made the call.
+	Identity string `json:"identity"`
+	// AuthMethod is how the caller was authenticated, if they were.
+	AuthMethod string `json:"auth_method,omitempty"`
+	// Peer is the address the call came from.
+	Peer string `json:"peer,omitempty"`
+	// RPC is the full name of the method called.
+	RPC string `json:"rpc"`
+	// VMID is the microvm the call was for. It's namespace/name/uid when the call has the
+	// full id of the microvm and only the uid otherwise.
+	VMID string `json:"vmid,omitempty"`
+	// RequestDigest is the sha256 digest of the request, or the first request message of a stream.
+	RequestDigest string `json:"request_digest,omitempty"`
+	// Outcome is either success or failure.
+	Outcome string `json:"outcome"`
+	// Code is the gRPC status code of the call.
+	Code string `json:"code"`
+	// Error is the error message of a call that failed.
+	Error string `json:"error,omitempty"`
+	// DurationMs is how long the call took in milliseconds.
+	DurationMs int64 `json:"duration_ms"`
+}
+
+// Sink is somewhere audit events are written to. Sinks must be safe to use concurrently.
+type Sink interface {
+	// Write writes an event to the sink.
+	Write(event *Event) error
+	// Close closes the sink.
+	Close() error
+}
+
+// encodeEvent encodes an event as a line of JSON.
+func encodeEvent(event *Event) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling audit event: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,152 @@
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/audit"
+)
+
+func TestFileSink_Rotation(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// Rotation is in whole megabytes, so start with a file that's nearly full.
+	g.Expect(os.WriteFile(path, make([]byte, 1024*1024-10), 0o600)).To(Succeed())
+
+	sink, err := audit.NewFileSink(path, 1, 2)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	event := &audit.Event{
+		Timestamp: time.Unix(0, 0).UTC(),
+		Identity:  "team-a",
+		RPC:       "/microvm.services.api.v1alpha1.MicroVM/DeleteMicroVM",
+		VMID:      "uid1",
+		Outcome:   audit.OutcomeSuccess,
+		Code:      "OK",
+	}
+
+	g.Expect(sink.Write(event)).To(Succeed())
+	g.Expect(sink.Close()).To(Succeed())
+
+	backup, err := os.Stat(path + ".1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(backup.Size()).To(Equal(int64(1024*1024 - 10)))
+
+	data, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	written := &audit.Event{}
+	g.Expect(json.Unmarshal(data, written)).To(Succeed())
+	g.Expect(written).To(Equal(event))
+
+	g.Expect(sink.Write(event)).To(HaveOccurred())
+}
+
+func TestFileSink_RotationFailed(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	g.Expect(os.WriteFile(path, make([]byte, 1024*1024-10), 0o600)).To(Succeed())
+
+	// The log can't be moved over a directory that isn't empty.
+	g.Expect(os.MkdirAll(filepath.Join(path+".1", "keep"), 0o700)).To(Succeed())
+
+	sink, err := audit.NewFileSink(path, 1, 1)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	event := &audit.Event{Identity: "team-a", Outcome: audit.OutcomeSuccess}
+
+	g.Expect(sink.Write(event)).To(Succeed())
+	g.Expect(sink.Write(event)).To(Succeed())
+	g.Expect(sink.Close()).To(Succeed())
+
+	info, err := os.Stat(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.Size()).To(BeNumerically(">", 1024*1024-10))
+}
+
+func TestSocketSink(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.sock")
+
+	listener, err := net.Listen("unix", path)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer listener.Close()
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	sink := audit.NewSocketSink(path)
+	defer sink.Close()
+
+	g.Expect(sink.Write(&audit.Event{Identity: "team-a", Outcome: audit.OutcomeFailure})).To(Succeed())
+
+	var line string
+	g.Eventually(received).Should(Receive(&line))
+
+	event := &audit.Event{}
+	g.Expect(json.Unmarshal([]byte(line), event)).To(Succeed())
+	g.Expect(event.Identity).To(Equal("team-a"))
+	g.Expect(event.Outcome).To(Equal(audit.OutcomeFailure))
+}
+
+func TestSocketSink_NoListener(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.sock")
+
+	sink := audit.NewSocketSink(path)
+
+	// Nothing is listening, so the event is dropped in the background rather than
+	// holding up the write.
+	g.Expect(sink.Write(&audit.Event{Identity: "team-a"})).To(Succeed())
+
+	listener, err := net.Listen("unix", path)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer listener.Close()
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	g.Eventually(func() error {
+		return sink.Write(&audit.Event{Identity: "team-b"})
+	}).Should(Succeed())
+
+	var line string
+	g.Eventually(received).Should(Receive(&line))
+	g.Expect(line).To(ContainSubstring("team-"))
+
+	g.Expect(sink.Close()).To(Succeed())
+	g.Expect(sink.Write(&audit.Event{Identity: "team-a"})).To(HaveOccurred())
+}
@@ -0,0 +1,9 @@
+package audit
+
+import "errors"
+
+var (
+	errSinkClosed     = errors.New("audit sink is closed")
+	errSinkFull       = errors.New("audit sink buffer is full, event dropped")
+	errSinkNotFlushed = errors.New("audit sink closed before all events were sent")
+)
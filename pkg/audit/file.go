@@ -0,0 +1,178 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	fileMode   = 0o600
+	bytesPerMb = 1024 * 1024
+)
+
+// NewFileSink creates a sink that appends events to a file as JSON lines. When the file
+// would grow past maxSizeMb it's rotated to path.1, with older files moved to path.2 and
+// so on up to maxBackups files. A maxSizeMb of zero disables rotation.
+func NewFileSink(path string, maxSizeMb int, maxBackups int) (Sink, error) {
+	sink := &fileSink{
+		path:       path,
+		maxSize:    int64(maxSizeMb) * bytesPerMb,
+		maxBackups: maxBackups,
+	}
+
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu     sync.Mutex
+	file   *os.File
+	size   int64
+	closed bool
+}
+
+func (s *fileSink) Write(event *Event) error {
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errSinkClosed
+	}
+
+	// The log isn't open if opening it after the last rotation failed.
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			if s.file == nil {
+				return err
+			}
+
+			// The log is still open, so the event is written to it rather than dropped.
+			log.GetLogger(context.Background()).WithField("component", "audit").
+				Errorf("failed to rotate audit log %s: %s", s.path, err)
+		}
+	}
+
+	written, err := s.file.Write(data)
+	s.size += int64(written)
+
+	if err != nil {
+		return fmt.Errorf("writing audit event to %s: %w", s.path, err)
+	}
+
+	return nil
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	if err != nil {
+		return fmt.Errorf("closing audit log %s: %w", s.path, err)
+	}
+
+	return nil
+}
+
+func (s *fileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+	if err != nil {
+		return fmt.Errorf("opening audit log %s: %w", s.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return fmt.Errorf("getting size of audit log %s: %w", s.path, err)
+	}
+
+	s.file = file
+	s.size = info.Size()
+
+	return nil
+}
+
+// rotate moves the log to the first backup and opens a new one. If the log can't be moved
+// it's opened again, so events are still written to it.
+func (s *fileSink) rotate() error {
+	err := s.file.Close()
+	s.file = nil
+
+	if err != nil {
+		err = fmt.Errorf("closing audit log %s: %w", s.path, err)
+	} else {
+		err = s.moveToBackups()
+	}
+
+	if openErr := s.open(); openErr != nil {
+		if err != nil {
+			return fmt.Errorf("%w, then %w", err, openErr)
+		}
+
+		return openErr
+	}
+
+	return err
+}
+
+func (s *fileSink) moveToBackups() error {
+	if s.maxBackups == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing audit log %s: %w", s.path, err)
+		}
+
+		return nil
+	}
+
+	// The oldest backup is overwritten by the one before it.
+	for i := s.maxBackups - 1; i > 0; i-- {
+		if err := renameIfExists(backupPath(s.path, i), backupPath(s.path, i+1)); err != nil {
+			return err
+		}
+	}
+
+	return renameIfExists(s.path, backupPath(s.path, 1))
+}
+
+func backupPath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+func renameIfExists(from, to string) error {
+	if err := os.Rename(from, to); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("renaming %s to %s: %w", from, to, err)
+	}
+
+	return nil
+}
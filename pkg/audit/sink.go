@@ -0,0 +1,14 @@
+package audit
+
+import (
+	"github.com/liquidmetal-dev/flintlock/internal/config"
+)
+
+// NewSinkFromConfig creates the sink for the audit config. The config must be enabled.
+func NewSinkFromConfig(cfg *config.AuditConfig) (Sink, error) {
+	if cfg.Socket != "" {
+		return NewSocketSink(cfg.Socket), nil
+	}
+
+	return NewFileSink(cfg.File, cfg.MaxSizeInMb, cfg.MaxBackups)
+}
@@ -0,0 +1,149 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	socketWriteTimeout = 5 * time.Second
+	// socketBufferSize is how many events can be waiting to be sent to the socket.
+	socketBufferSize = 1024
+)
+
+// NewSocketSink creates a sink that sends events as JSON lines to a unix socket, for
+// example one a log shipper is listening on. Events are buffered and sent in the
+// background, so a slow or missing listener doesn't hold up the API calls. When the
+// buffer is full the event is dropped and Write returns an error. The connection is
+// made when the first event is sent and made again if it breaks.
+func NewSocketSink(path string) Sink {
+	sink := &socketSink{
+		path:   path,
+		events: make(chan []byte, socketBufferSize),
+		done:   make(chan struct{}),
+	}
+
+	go sink.run()
+
+	return sink
+}
+
+type socketSink struct {
+	path   string
+	events chan []byte
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+
+	// conn is only used by run.
+	conn net.Conn
+}
+
+func (s *socketSink) Write(event *Event) error {
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return errSinkClosed
+	}
+
+	select {
+	case s.events <- data:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errSinkFull, s.path)
+	}
+}
+
+// Close stops the sink. Events that are already buffered are sent first, for as long as
+// it takes to write one event.
+func (s *socketSink) Close() error {
+	s.mu.Lock()
+
+	if s.closed {
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	s.closed = true
+	close(s.events)
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-time.After(socketWriteTimeout):
+		return fmt.Errorf("%w: %s", errSinkNotFlushed, s.path)
+	}
+}
+
+// run sends the buffered events to the socket until the sink is closed.
+func (s *socketSink) run() {
+	defer close(s.done)
+
+	logger := log.GetLogger(context.Background()).WithField("component", "audit")
+
+	for data := range s.events {
+		if err := s.send(data); err != nil {
+			logger.Errorf("failed to send audit event: %s", err)
+		}
+	}
+
+	if s.conn != nil {
+		if err := s.conn.Close(); err != nil {
+			logger.Errorf("failed to close audit socket %s: %s", s.path, err)
+		}
+	}
+}
+
+func (s *socketSink) send(data []byte) error {
+	// If the existing connection has broken the write is tried again on a new one.
+	if s.conn != nil {
+		if err := s.write(data); err == nil {
+			return nil
+		}
+
+		s.conn.Close()
+		s.conn = nil
+	}
+
+	conn, err := net.Dial("unix", s.path)
+	if err != nil {
+		return fmt.Errorf("connecting to audit socket %s: %w", s.path, err)
+	}
+
+	s.conn = conn
+
+	if err := s.write(data); err != nil {
+		s.conn.Close()
+		s.conn = nil
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *socketSink) write(data []byte) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout)); err != nil {
+		return fmt.Errorf("setting audit socket write deadline: %w", err)
+	}
+
+	if _, err := s.conn.Write(data); err != nil {
+		return fmt.Errorf("writing audit event to %s: %w", s.path, err)
+	}
+
+	return nil
+}
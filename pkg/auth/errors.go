@@ -3,7 +3,14 @@ package auth
 import "errors"
 
 var (
-	errEmptyAuthToken        = errors.New("empty authentication token")
-	errExpectedTokenRequired = errors.New("expected auth token is required")
-	errFailedBasicAuth       = errors.New("failed basic authentication. Check the token supplied")
+	errEmptyAuthToken              = errors.New("empty authentication token")
+	errExpectedTokenRequired       = errors.New("expected auth token is required")
+	errFailedBasicAuth             = errors.New("failed basic authentication. Check the token supplied")
+	errRoleNameRequired            = errors.New("role name is required")
+	errDuplicateRole               = errors.New("role is defined more than once")
+	errIdentityNameRequired        = errors.New("identity name is required")
+	errIdentityCredentialsRequired = errors.New("identity needs at least one token or certificate name")
+	errDuplicateToken              = errors.New("token is already used by another identity")
+	errNoAuthFuncs                 = errors.New("no authentication methods configured")
+	errNoSigningKeys               = errors.New("jwks has no supported signing keys")
 )
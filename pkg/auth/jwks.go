@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/time/rate"
+
+	"github.com/liquidmetal-dev/flintlock/internal/config"
+	"github.com/liquidmetal-dev/flintlock/pkg/log"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// jwksRefreshInterval is how often keys from a URL are loaded again.
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefresh is the shortest time between refreshes of the keys for tokens signed with
+	// an unknown key id, so they can't be used to make us hammer the JWKS endpoint.
+	jwksMinRefresh = time.Minute
+)
+
+// newKeyStorage creates the storage of the keys from the JWKS file or URL in the config. Keys
+// from a URL are loaded again periodically and when a token is signed with a key that isn't
+// known, so keys can be rotated.
+func newKeyStorage(ctx context.Context, cfg *config.JWTConfig) (jwkset.Storage, error) {
+	if cfg.JWKSURL == "" {
+		return loadKeyFile(cfg.JWKSFile)
+	}
+
+	logger := log.GetLogger(ctx)
+
+	urlStorage, err := jwkset.NewStorageFromHTTP(cfg.JWKSURL, jwkset.HTTPClientStorageOptions{
+		Client:          &http.Client{Timeout: jwksFetchTimeout},
+		Ctx:             ctx,
+		HTTPTimeout:     jwksFetchTimeout,
+		RefreshInterval: jwksRefreshInterval,
+		RefreshErrorHandler: func(_ context.Context, err error) {
+			logger.Errorf("refreshing jwks from %s: %s", cfg.JWKSURL, err)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching jwks from %s: %w", cfg.JWKSURL, err)
+	}
+
+	storage, err := jwkset.NewHTTPClient(jwkset.HTTPClientOptions{
+		HTTPURLs:          map[string]jwkset.Storage{cfg.JWKSURL: urlStorage},
+		RateLimitWaitMax:  jwksFetchTimeout,
+		RefreshUnknownKID: rate.NewLimiter(rate.Every(jwksMinRefresh), 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating jwks client: %w", err)
+	}
+
+	return storage, nil
+}
+
+// loadKeyFile loads the keys from a JWKS file. Keys that aren't for signing or are of an
+// unsupported type are skipped.
+func loadKeyFile(path string) (jwkset.Storage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading jwks file %s: %w", path, err)
+	}
+
+	set := jwkset.JWKSMarshal{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("unmarshalling jwks: %w", err)
+	}
+
+	storage := jwkset.NewMemoryStorage()
+
+	for _, marshal := range set.Keys {
+		if !signingKeyUse(marshal.USE) {
+			continue
+		}
+
+		jwk, err := jwkset.NewJWKFromMarshal(marshal, jwkset.JWKMarshalOptions{}, jwkset.JWKValidateOptions{})
+		if errors.Is(err, jwkset.ErrUnsupportedKey) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing jwk %q: %w", marshal.KID, err)
+		}
+
+		if err := storage.KeyWrite(context.Background(), jwk); err != nil {
+			return nil, fmt.Errorf("storing jwk %q: %w", marshal.KID, err)
+		}
+	}
+
+	return storage, nil
+}
+
+// verificationKeys returns the keys in the storage that could have signed a token with the
+// key id and algorithm. Tokens without a key id could have been signed with any of the keys.
+func verificationKeys(ctx context.Context, storage jwkset.Storage, kid, alg string) ([]jwt.VerificationKey, error) {
+	var jwks []jwkset.JWK
+
+	if kid != "" {
+		jwk, err := storage.KeyRead(ctx, kid)
+		if err != nil {
+			return nil, fmt.Errorf("reading jwk %q: %w", kid, err)
+		}
+
+		jwks = []jwkset.JWK{jwk}
+	} else {
+		all, err := storage.KeyReadAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("reading jwks: %w", err)
+		}
+
+		jwks = all
+	}
+
+	keys := []jwt.VerificationKey{}
+
+	for _, jwk := range jwks {
+		marshal := jwk.Marshal()
+
+		if !signingKeyUse(marshal.USE) || !asymmetricKeyType(marshal.KTY) {
+			continue
+		}
+
+		if alg != "" && marshal.ALG != "" && marshal.ALG.String() != alg {
+			continue
+		}
+
+		keys = append(keys, jwk.Key())
+	}
+
+	if len(keys) == 0 {
+		return nil, errNoSigningKeys
+	}
+
+	return keys, nil
+}
+
+func signingKeyUse(use jwkset.USE) bool {
+	return use == "" || use == jwkset.UseSig
+}
+
+// asymmetricKeyType is whether keys of the type have a public key. Symmetric keys are never
+// used, so a shared secret can't be used to sign tokens.
+func asymmetricKeyType(kty jwkset.KTY) bool {
+	return kty == jwkset.KtyRSA || kty == jwkset.KtyEC || kty == jwkset.KtyOKP
+}
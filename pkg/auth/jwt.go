@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liquidmetal-dev/flintlock/internal/config"
+)
+
+const (
+	bearer    = "bearer"
+	methodJWT = "jwt"
+
+	// jwtLeeway is how far the clocks of the token issuer and the host can be apart.
+	jwtLeeway = time.Minute
+)
+
+// jwtAlgorithms are the algorithms tokens can be signed with. Only algorithms that use a
+// public key are allowed.
+var jwtAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// JWTVerifier verifies JWT bearer tokens and maps their claims to an identity.
+type JWTVerifier struct {
+	keys            jwkset.Storage
+	issuer          string
+	audience        string
+	identityClaim   string
+	namespacesClaim string
+	verbsClaim      string
+	clock           func() time.Time
+}
+
+// NewJWTVerifier creates a verifier from the JWT config. The keys are loaded straight
+// away so a bad JWKS is found at startup.
+func NewJWTVerifier(ctx context.Context, cfg *config.JWTConfig) (*JWTVerifier, error) {
+	keys, err := newKeyStorage(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("loading jwt signing keys: %w", err)
+	}
+
+	if _, err := verificationKeys(ctx, keys, "", ""); err != nil {
+		return nil, fmt.Errorf("loading jwt signing keys: %w", err)
+	}
+
+	return &JWTVerifier{
+		keys:            keys,
+		issuer:          cfg.Issuer,
+		audience:        cfg.Audience,
+		identityClaim:   cfg.IdentityClaim,
+		namespacesClaim: cfg.NamespacesClaim,
+		verbsClaim:      cfg.VerbsClaim,
+		clock:           time.Now,
+	}, nil
+}
+
+// Verify checks the signature and claims of a token and returns the identity it's for. The
+// identity has a single role for the namespaces and verbs in the claims of the token.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(jwtAlgorithms),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithAudience(v.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+		jwt.WithTimeFunc(v.clock),
+	)
+
+	claims := jwt.MapClaims{}
+
+	_, err := parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		keys, err := verificationKeys(ctx, v.keys, kid, token.Method.Alg())
+		if err != nil {
+			return nil, fmt.Errorf("getting jwt signing keys: %w", err)
+		}
+
+		return jwt.VerificationKeySet{Keys: keys}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parsing jwt: %w", err)
+	}
+
+	return v.identityFromClaims(claims)
+}
+
+func (v *JWTVerifier) identityFromClaims(claims map[string]interface{}) (*Identity, error) {
+	name, _ := claims[v.identityClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("jwt has no %s claim", v.identityClaim)
+	}
+
+	role := RoleConfig{
+		Name:       methodJWT,
+		Namespaces: stringsClaim(claims, v.namespacesClaim),
+	}
+
+	for _, verb := range stringsClaim(claims, v.verbsClaim) {
+		if !Verb(verb).valid() {
+			return nil, fmt.Errorf("jwt has unknown verb %q", verb)
+		}
+
+		role.Verbs = append(role.Verbs, Verb(verb))
+	}
+
+	return &Identity{
+		Name:  name,
+		Roles: []RoleConfig{role},
+	}, nil
+}
+
+// JWTAuthFunc authenticates callers with a JWT bearer token.
+func JWTAuthFunc(verifier *JWTVerifier) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		token, err := grpc_auth.AuthFromMD(ctx, bearer)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract token from request header: %w", err)
+		}
+
+		identity, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+		}
+
+		return authenticatedContext(ctx, identity, methodJWT), nil
+	}
+}
+
+// AnyAuthFunc authenticates callers with the first of the auth funcs that succeeds. If
+// none succeed the errors from all of them are returned.
+func AnyAuthFunc(authFuncs ...grpc_auth.AuthFunc) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		if len(authFuncs) == 0 {
+			return nil, errNoAuthFuncs
+		}
+
+		messages := []string{}
+
+		for _, authFunc := range authFuncs {
+			newCtx, err := authFunc(ctx)
+			if err == nil {
+				return newCtx, nil
+			}
+
+			messages = append(messages, status.Convert(err).Message())
+		}
+
+		//nolint:wrapcheck // don't wrap grpc errors when using the status package
+		return nil, status.Error(codes.Unauthenticated, strings.Join(messages, "; "))
+	}
+}
+
+// stringsClaim returns a claim that's either a list of strings or a single string. A single
+// string is split on spaces, as with the OAuth scope claim.
+func stringsClaim(claims map[string]interface{}, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		values := []string{}
+
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,414 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/liquidmetal-dev/flintlock/internal/config"
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "flintlock"
+)
+
+type testSigningKeys struct {
+	rsa     *rsa.PrivateKey
+	ec      *ecdsa.PrivateKey
+	ed25519 ed25519.PrivateKey
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	g := NewWithT(t)
+
+	keys := generateSigningKeys(g)
+	verifier := newTestVerifier(t, g, keys)
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":        testIssuer,
+			"aud":        []string{"other", testAudience},
+			"sub":        "team-a-ci",
+			"exp":        time.Now().Add(time.Hour).Unix(),
+			"namespaces": []string{"team-a"},
+			"verbs":      "read create",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		token       func() string
+		expectError bool
+	}{
+		{
+			name:  "valid rsa token",
+			token: func() string { return signToken(g, keys.rsa, "RS256", "rsa", validClaims()) },
+		},
+		{
+			name:  "valid rsa pss token",
+			token: func() string { return signToken(g, keys.rsa, "PS256", "rsa", validClaims()) },
+		},
+		{
+			name:  "valid ec token",
+			token: func() string { return signToken(g, keys.ec, "ES256", "ec", validClaims()) },
+		},
+		{
+			name:  "valid ed25519 token",
+			token: func() string { return signToken(g, keys.ed25519, "EdDSA", "ed25519", validClaims()) },
+		},
+		{
+			name: "expired token",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "token without expiry",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "exp")
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "token not yet valid",
+			token: func() string {
+				claims := validClaims()
+				claims["nbf"] = time.Now().Add(time.Hour).Unix()
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://other.example.com"
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown verb",
+			token: func() string {
+				claims := validClaims()
+				claims["verbs"] = []string{"destroy"}
+
+				return signToken(g, keys.rsa, "RS256", "rsa", claims)
+			},
+			expectError: true,
+		},
+		{
+			name: "signed with another key",
+			token: func() string {
+				other, err := rsa.GenerateKey(rand.Reader, 2048)
+				g.Expect(err).NotTo(HaveOccurred())
+
+				return signToken(g, other, "RS256", "rsa", validClaims())
+			},
+			expectError: true,
+		},
+		{
+			name:        "unknown key id",
+			token:       func() string { return signToken(g, keys.rsa, "RS256", "unknown", validClaims()) },
+			expectError: true,
+		},
+		{
+			name:        "encryption key",
+			token:       func() string { return signToken(g, keys.rsa, "RS256", "encryption", validClaims()) },
+			expectError: true,
+		},
+		{
+			name:        "algorithm doesn't match key",
+			token:       func() string { return signToken(g, keys.rsa, "RS384", "rsa", validClaims()) },
+			expectError: true,
+		},
+		{
+			name:        "malformed token",
+			token:       func() string { return "not-a-token" },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			identity, err := verifier.Verify(context.Background(), tc.token())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(identity.Name).To(Equal("team-a-ci"))
+			g.Expect(identity.Allowed(auth.VerbCreate, "team-a")).To(BeTrue())
+			g.Expect(identity.Allowed(auth.VerbDelete, "team-a")).To(BeFalse())
+			g.Expect(identity.Allowed(auth.VerbRead, "team-b")).To(BeFalse())
+		})
+	}
+}
+
+func TestJWTAuth(t *testing.T) {
+	g := NewWithT(t)
+
+	keys := generateSigningKeys(g)
+	verifier := newTestVerifier(t, g, keys)
+
+	token := signToken(g, keys.ec, "ES256", "ec", map[string]interface{}{
+		"iss":        testIssuer,
+		"aud":        testAudience,
+		"sub":        "admin",
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"namespaces": []string{auth.AllNamespaces},
+		"verbs":      []string{"read", "delete"},
+	})
+
+	authFn := auth.JWTAuthFunc(verifier)
+
+	ctx, err := authFn(newBearerContext(token))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ctx.Value(auth.AuthMethod)).To(Equal("jwt"))
+	g.Expect(auth.IdentityFromContext(ctx).Name).To(Equal("admin"))
+	g.Expect(auth.IdentityFromContext(ctx).Allowed(auth.VerbDelete, "")).To(BeTrue())
+
+	_, err = authFn(newBearerContext("not-a-token"))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid bearer token")))
+
+	policy, err := auth.ParsePolicy([]byte(testPolicy))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	anyFn := auth.AnyAuthFunc(authFn, auth.PolicyAuthFunc(policy))
+
+	ctx, err = anyFn(newIncomingContext(base64.StdEncoding.EncodeToString([]byte("team-a-token"))))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(auth.IdentityFromContext(ctx).Name).To(Equal("team-a-ci"))
+
+	ctx, err = anyFn(newBearerContext(token))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(auth.IdentityFromContext(ctx).Name).To(Equal("admin"))
+
+	_, err = anyFn(context.Background())
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewJWTVerifier_InvalidJWKS(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	g.Expect(os.WriteFile(path, []byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`), 0o600)).To(Succeed())
+
+	_, err := auth.NewJWTVerifier(context.Background(), &config.JWTConfig{
+		JWKSFile: path,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewJWTVerifier_JWKSURL(t *testing.T) {
+	g := NewWithT(t)
+
+	keys := generateSigningKeys(g)
+
+	var jwks atomic.Value
+	jwks.Store(testJWKS(g, keys))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(jwks.Load().([]byte))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	verifier, err := auth.NewJWTVerifier(ctx, &config.JWTConfig{
+		JWKSURL:       server.URL,
+		Issuer:        testIssuer,
+		Audience:      testAudience,
+		IdentityClaim: "sub",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	claims := map[string]interface{}{
+		"iss": testIssuer,
+		"aud": testAudience,
+		"sub": "team-a-ci",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+
+	identity, err := verifier.Verify(ctx, signToken(g, keys.ec, "ES256", "ec", claims))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(identity.Name).To(Equal("team-a-ci"))
+
+	// Tokens signed with a rotated key are verified once the keys have been fetched again.
+	rotated := map[string]interface{}{}
+	rotatedKeys := generateSigningKeys(g)
+	g.Expect(json.Unmarshal(testJWKS(g, rotatedKeys), &rotated)).To(Succeed())
+
+	for _, key := range rotated["keys"].([]interface{}) {
+		key := key.(map[string]interface{})
+		key["kid"] = fmt.Sprintf("%s-rotated", key["kid"])
+	}
+
+	data, err := json.Marshal(rotated)
+	g.Expect(err).NotTo(HaveOccurred())
+	jwks.Store(data)
+
+	identity, err = verifier.Verify(ctx, signToken(g, rotatedKeys.ec, "ES256", "ec-rotated", claims))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(identity.Name).To(Equal("team-a-ci"))
+}
+
+func generateSigningKeys(g *WithT) *testSigningKeys {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &testSigningKeys{rsa: rsaKey, ec: ecKey, ed25519: edKey}
+}
+
+func newTestVerifier(t *testing.T, g *WithT, keys *testSigningKeys) *auth.JWTVerifier {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	g.Expect(os.WriteFile(path, testJWKS(g, keys), 0o600)).To(Succeed())
+
+	verifier, err := auth.NewJWTVerifier(context.Background(), &config.JWTConfig{
+		JWKSFile:        path,
+		Issuer:          testIssuer,
+		Audience:        testAudience,
+		IdentityClaim:   "sub",
+		NamespacesClaim: "namespaces",
+		VerbsClaim:      "verbs",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return verifier
+}
+
+func testJWKS(g *WithT, keys *testSigningKeys) []byte {
+	encode := base64.RawURLEncoding.EncodeToString
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"use": "sig",
+				"n":   encode(keys.rsa.N.Bytes()),
+				"e":   encode(big.NewInt(int64(keys.rsa.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"alg": "ES256",
+				"crv": "P-256",
+				"x":   encode(keys.ec.X.FillBytes(make([]byte, 32))),
+				"y":   encode(keys.ec.Y.FillBytes(make([]byte, 32))),
+			},
+			{
+				"kty": "OKP",
+				"kid": "ed25519",
+				"crv": "Ed25519",
+				"x":   encode(keys.ed25519.Public().(ed25519.PublicKey)),
+			},
+			{
+				"kty": "RSA",
+				"kid": "encryption",
+				"use": "enc",
+				"n":   encode(keys.rsa.N.Bytes()),
+				"e":   encode(big.NewInt(int64(keys.rsa.E)).Bytes()),
+			},
+		},
+	}
+
+	data, err := json.Marshal(jwks)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return data
+}
+
+func signToken(g *WithT, key crypto.Signer, alg, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	payload, err := json.Marshal(claims)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if alg == "PS256" {
+			signature, err = rsa.SignPSS(rand.Reader, k, crypto.SHA256, digest[:],
+				&rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(k, []byte(signed))
+	}
+
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newBearerContext(token string) context.Context {
+	md := metadata.Pairs("authorization", "bearer "+token)
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
@@ -0,0 +1,330 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+)
+
+// Verb is an action an identity can be allowed to take on the microvms in a namespace.
+type Verb string
+
+const (
+	// VerbRead allows getting, listing and watching microvms and reading their console output.
+	VerbRead Verb = "read"
+	// VerbCreate allows creating microvms and changing existing ones, including their power state.
+	VerbCreate Verb = "create"
+	// VerbDelete allows deleting microvms and snapshots.
+	VerbDelete Verb = "delete"
+	// VerbExec allows running commands in microvms, copying files to and from them and attaching
+	// to their console.
+	VerbExec Verb = "exec"
+
+	// AllNamespaces is the namespace in a role that allows access to every namespace.
+	AllNamespaces = "*"
+
+	methodToken = "token"
+	methodTLS   = "tls"
+)
+
+type identityKey struct{}
+
+// Policy is the set of identities allowed to use the API and what each of them can do.
+type Policy struct {
+	tokens           map[[sha256.Size]byte]*Identity
+	certificateNames map[string]*Identity
+}
+
+// PolicyFile is the format of the file a policy is loaded from.
+type PolicyFile struct {
+	// Roles are the named sets of permissions that identities can be given.
+	Roles []RoleConfig `json:"roles"`
+	// Identities are the callers of the API.
+	Identities []IdentityConfig `json:"identities"`
+}
+
+// RoleConfig is a named set of permissions.
+type RoleConfig struct {
+	// Name is the name identities use to refer to the role.
+	Name string `json:"name"`
+	// Namespaces are the namespaces the role applies to. Use * for every namespace.
+	Namespaces []string `json:"namespaces"`
+	// Verbs are the actions allowed in the namespaces.
+	Verbs []Verb `json:"verbs"`
+}
+
+// IdentityConfig is a caller of the API and how it is recognised.
+type IdentityConfig struct {
+	// Name is the name of the identity, used in logs.
+	Name string `json:"name"`
+	// Tokens are the tokens the identity authenticates with.
+	Tokens []string `json:"tokens,omitempty"`
+	// CertificateNames are the common names or subject alternative names of the client
+	// certificates the identity authenticates with.
+	CertificateNames []string `json:"certificate_names,omitempty"`
+	// Roles are the names of the roles the identity has.
+	Roles []string `json:"roles"`
+}
+
+// Identity is an authenticated caller of the API.
+type Identity struct {
+	// Name is the name of the identity.
+	Name string
+	// Roles are the permissions of the identity.
+	Roles []RoleConfig
+}
+
+// LoadPolicy will load a policy from a yaml or json file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading auth policy file %s: %w", path, err)
+	}
+
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing auth policy file %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// ParsePolicy will parse a policy from yaml or json.
+func ParsePolicy(data []byte) (*Policy, error) {
+	file := &PolicyFile{}
+	if err := yaml.UnmarshalStrict(data, file); err != nil {
+		return nil, fmt.Errorf("unmarshalling policy: %w", err)
+	}
+
+	return NewPolicy(file)
+}
+
+// NewPolicy creates a policy from the roles and identities in a policy file.
+func NewPolicy(file *PolicyFile) (*Policy, error) {
+	roles := map[string]RoleConfig{}
+
+	for _, role := range file.Roles {
+		if role.Name == "" {
+			return nil, errRoleNameRequired
+		}
+
+		if _, ok := roles[role.Name]; ok {
+			return nil, fmt.Errorf("role %s: %w", role.Name, errDuplicateRole)
+		}
+
+		for _, verb := range role.Verbs {
+			if !verb.valid() {
+				return nil, fmt.Errorf("role %s: unknown verb %q", role.Name, verb)
+			}
+		}
+
+		roles[role.Name] = role
+	}
+
+	policy := &Policy{
+		tokens:           map[[sha256.Size]byte]*Identity{},
+		certificateNames: map[string]*Identity{},
+	}
+
+	for _, cfg := range file.Identities {
+		if cfg.Name == "" {
+			return nil, errIdentityNameRequired
+		}
+
+		if len(cfg.Tokens) == 0 && len(cfg.CertificateNames) == 0 {
+			return nil, fmt.Errorf("identity %s: %w", cfg.Name, errIdentityCredentialsRequired)
+		}
+
+		identity := &Identity{Name: cfg.Name}
+
+		for _, name := range cfg.Roles {
+			role, ok := roles[name]
+			if !ok {
+				return nil, fmt.Errorf("identity %s: unknown role %s", cfg.Name, name)
+			}
+
+			identity.Roles = append(identity.Roles, role)
+		}
+
+		for _, token := range cfg.Tokens {
+			if token == "" {
+				return nil, fmt.Errorf("identity %s: %w", cfg.Name, errEmptyAuthToken)
+			}
+
+			hash := sha256.Sum256([]byte(token))
+			if _, ok := policy.tokens[hash]; ok {
+				return nil, fmt.Errorf("identity %s: %w", cfg.Name, errDuplicateToken)
+			}
+
+			policy.tokens[hash] = identity
+		}
+
+		for _, name := range cfg.CertificateNames {
+			if existing, ok := policy.certificateNames[name]; ok {
+				return nil, fmt.Errorf("identity %s: certificate name %s is already used by %s", cfg.Name, name, existing.Name)
+			}
+
+			policy.certificateNames[name] = identity
+		}
+	}
+
+	return policy, nil
+}
+
+// IdentityForToken returns the identity that authenticates with the token, or nil if
+// there isn't one.
+func (p *Policy) IdentityForToken(token string) *Identity {
+	// The tokens are looked up by their hash so the lookup doesn't leak the token
+	// through timing.
+	return p.tokens[sha256.Sum256([]byte(token))]
+}
+
+// IdentityForCertificate returns the identity that authenticates with the certificate, or
+// nil if there isn't one. The common name is checked first and then the DNS, email and URI
+// subject alternative names.
+func (p *Policy) IdentityForCertificate(cert *x509.Certificate) *Identity {
+	names := []string{cert.Subject.CommonName}
+	names = append(names, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+
+	for _, name := range names {
+		if identity, ok := p.certificateNames[name]; ok && name != "" {
+			return identity
+		}
+	}
+
+	return nil
+}
+
+// Allowed returns true if the identity can take the action in the namespace. An empty
+// namespace means every namespace, which is only allowed by roles for all namespaces.
+func (i *Identity) Allowed(verb Verb, namespace string) bool {
+	for _, role := range i.Roles {
+		if !role.hasVerb(verb) {
+			continue
+		}
+
+		for _, ns := range role.Namespaces {
+			if ns == AllNamespaces || (namespace != "" && ns == namespace) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AllowedInAnyNamespace returns true if the identity can take the action in at least one
+// namespace. It's used for requests that aren't for a namespace, like getting the details
+// of the host.
+func (i *Identity) AllowedInAnyNamespace(verb Verb) bool {
+	for _, role := range i.Roles {
+		if role.hasVerb(verb) && len(role.Namespaces) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r RoleConfig) hasVerb(verb Verb) bool {
+	for _, v := range r.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v Verb) valid() bool {
+	switch v {
+	case VerbRead, VerbCreate, VerbDelete, VerbExec:
+		return true
+	default:
+		return false
+	}
+}
+
+// WithIdentity returns a copy of the context with the identity of the caller.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the caller, or nil if the caller wasn't
+// authenticated with a policy.
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityKey{}).(*Identity)
+
+	return identity
+}
+
+// PolicyAuthFunc authenticates callers against a policy. A verified client certificate that
+// matches an identity is used first, otherwise the token in the request header is used.
+func PolicyAuthFunc(policy *Policy) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		if cert := verifiedClientCertificate(ctx); cert != nil {
+			if identity := policy.IdentityForCertificate(cert); identity != nil {
+				return authenticatedContext(ctx, identity, methodTLS), nil
+			}
+		}
+
+		token, err := grpc_auth.AuthFromMD(ctx, basic)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract token from request header: %w", err)
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			//nolint:wrapcheck // don't wrap grpc errors when using the status package
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token: token isn't base64 encoded")
+		}
+
+		identity := policy.IdentityForToken(string(decoded))
+		if identity == nil {
+			//nolint:wrapcheck // don't wrap grpc errors when using the status package
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token: unknown token")
+		}
+
+		return authenticatedContext(ctx, identity, methodToken), nil
+	}
+}
+
+func authenticatedContext(ctx context.Context, identity *Identity, method string) context.Context {
+	ctx = context.WithValue(ctx, Authenticated, true)
+	ctx = context.WithValue(ctx, AuthMethod, method)
+
+	return WithIdentity(ctx, identity)
+}
+
+func verifiedClientCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0]
+}
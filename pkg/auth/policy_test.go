@@ -0,0 +1,175 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/auth"
+)
+
+const testPolicy = `
+roles:
+  - name: team-a
+    namespaces: ["team-a"]
+    verbs: ["read", "create", "delete", "exec"]
+  - name: viewer
+    namespaces: ["*"]
+    verbs: ["read"]
+identities:
+  - name: team-a-ci
+    tokens: ["team-a-token"]
+    roles: ["team-a"]
+  - name: ops
+    tokens: ["ops-token"]
+    certificate_names: ["ops.example.com"]
+    roles: ["team-a", "viewer"]
+`
+
+func TestParsePolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policy      string
+		expectError bool
+	}{
+		{
+			name:   "valid policy",
+			policy: testPolicy,
+		},
+		{
+			name: "unknown verb",
+			policy: `
+roles:
+  - name: team-a
+    namespaces: ["team-a"]
+    verbs: ["destroy"]
+`,
+			expectError: true,
+		},
+		{
+			name: "unknown role",
+			policy: `
+identities:
+  - name: team-a-ci
+    tokens: ["token"]
+    roles: ["team-a"]
+`,
+			expectError: true,
+		},
+		{
+			name: "no credentials",
+			policy: `
+identities:
+  - name: team-a-ci
+`,
+			expectError: true,
+		},
+		{
+			name: "duplicate token",
+			policy: `
+identities:
+  - name: team-a-ci
+    tokens: ["token"]
+  - name: team-b-ci
+    tokens: ["token"]
+`,
+			expectError: true,
+		},
+		{
+			name: "unknown field",
+			policy: `
+identities:
+  - name: team-a-ci
+    token: "token"
+`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := auth.ParsePolicy([]byte(tc.policy))
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestIdentity_Allowed(t *testing.T) {
+	g := NewWithT(t)
+
+	policy, err := auth.ParsePolicy([]byte(testPolicy))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ci := policy.IdentityForToken("team-a-token")
+	g.Expect(ci).NotTo(BeNil())
+	g.Expect(ci.Name).To(Equal("team-a-ci"))
+	g.Expect(ci.Allowed(auth.VerbDelete, "team-a")).To(BeTrue())
+	g.Expect(ci.Allowed(auth.VerbDelete, "team-b")).To(BeFalse())
+	g.Expect(ci.Allowed(auth.VerbRead, "")).To(BeFalse())
+	g.Expect(ci.AllowedInAnyNamespace(auth.VerbRead)).To(BeTrue())
+
+	ops := policy.IdentityForToken("ops-token")
+	g.Expect(ops).NotTo(BeNil())
+	g.Expect(ops.Allowed(auth.VerbRead, "team-b")).To(BeTrue())
+	g.Expect(ops.Allowed(auth.VerbRead, "")).To(BeTrue())
+	g.Expect(ops.Allowed(auth.VerbDelete, "team-b")).To(BeFalse())
+
+	g.Expect(policy.IdentityForToken("unknown")).To(BeNil())
+}
+
+func TestPolicyAuth_Token(t *testing.T) {
+	g := NewWithT(t)
+
+	policy, err := auth.ParsePolicy([]byte(testPolicy))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	authFn := auth.PolicyAuthFunc(policy)
+
+	ctx, err := authFn(newIncomingContext(base64.StdEncoding.EncodeToString([]byte("team-a-token"))))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ctx.Value(auth.AuthMethod)).To(Equal("token"))
+	g.Expect(auth.IdentityFromContext(ctx).Name).To(Equal("team-a-ci"))
+
+	_, err = authFn(newIncomingContext(base64.StdEncoding.EncodeToString([]byte("unknown"))))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid auth token")))
+
+	_, err = authFn(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("could not extract token from request header")))
+}
+
+func TestPolicyAuth_ClientCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	policy, err := auth.ParsePolicy([]byte(testPolicy))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cert := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "client"},
+		DNSNames: []string{"ops.example.com"},
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			},
+		},
+	})
+
+	ctx, err = auth.PolicyAuthFunc(policy)(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ctx.Value(auth.AuthMethod)).To(Equal("tls"))
+	g.Expect(auth.IdentityFromContext(ctx).Name).To(Equal("ops"))
+}
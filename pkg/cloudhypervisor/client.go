@@ -299,7 +299,7 @@ func (c *client) AddDevice(ctx context.Context, _ *VMAddDevice) (*PciDeviceInfo,
 }
 
 // RemoveDevice is used to remove a device from the VM.
-func (c *client) RemoveDevice(ctx context.Context, _ *VMRemoveDevice) error {
+func (c *client) RemoveDevice(ctx context.Context, config *VMRemoveDevice) error {
 	return c.
 		builder.
 		Clone().
@@ -308,11 +308,12 @@ func (c *client) RemoveDevice(ctx context.Context, _ *VMRemoveDevice) error {
 			404: "The device could not be removed from the VM instance",
 		})).
 		Put().
+		BodyJSON(config).
 		Fetch(ctx)
 }
 
 // AddDisk will add a new disk to the VM.
-func (c *client) AddDisk(ctx context.Context, _ *DiskConfig) (*PciDeviceInfo, error) {
+func (c *client) AddDisk(ctx context.Context, config *DiskConfig) (*PciDeviceInfo, error) {
 	data := &PciDeviceInfo{}
 	if err := c.
 		builder.
@@ -321,7 +322,7 @@ func (c *client) AddDisk(ctx context.Context, _ *DiskConfig) (*PciDeviceInfo, er
 		AddValidator(CustomErrValidator(map[int]string{
 			500: "The new disk could not be added to the VM instance",
 		})).
-		Put().Handle(ToJSONForCode(200, data)).
+		Put().BodyJSON(config).Handle(ToJSONForCode(200, data)).
 		Fetch(ctx); err != nil {
 		return nil, err
 	}
@@ -366,7 +367,7 @@ func (c *client) AddPmemDevice(ctx context.Context, _ *PmemConfig) (*PciDeviceIn
 }
 
 // AddNetworkDevice will add a new network device to the VM.
-func (c *client) AddNetworkDevice(ctx context.Context, _ *NetConfig) (*PciDeviceInfo, error) {
+func (c *client) AddNetworkDevice(ctx context.Context, config *NetConfig) (*PciDeviceInfo, error) {
 	data := &PciDeviceInfo{}
 	if err := c.
 		builder.
@@ -375,7 +376,7 @@ func (c *client) AddNetworkDevice(ctx context.Context, _ *NetConfig) (*PciDevice
 		AddValidator(CustomErrValidator(map[int]string{
 			500: "The new network device could not be added to the VM instance",
 		})).
-		Put().Handle(ToJSONForCode(200, data)).
+		Put().BodyJSON(config).Handle(ToJSONForCode(200, data)).
 		Fetch(ctx); err != nil {
 		return nil, err
 	}
@@ -420,7 +421,7 @@ func (c *client) AddVdpaDevice(ctx context.Context, _ *VdpaConfig) (*PciDeviceIn
 }
 
 // Snapshot will create a snapshot of the VM.
-func (c *client) Snapshot(ctx context.Context, _ *VMSnapshotConfig) error {
+func (c *client) Snapshot(ctx context.Context, config *VMSnapshotConfig) error {
 	return c.
 		builder.
 		Clone().
@@ -430,6 +431,7 @@ func (c *client) Snapshot(ctx context.Context, _ *VMSnapshotConfig) error {
 			405: "The VM instance could not be snapshotted because it is not booted",
 		})).
 		Put().
+		BodyJSON(config).
 		Fetch(ctx)
 }
 
@@ -448,7 +450,7 @@ func (c *client) CoreDump(ctx context.Context, _ *VMCoreDumpData) error {
 }
 
 // Restore will restore a VM from a snapshot.
-func (c *client) Restore(ctx context.Context, _ *RestoreConfig) error {
+func (c *client) Restore(ctx context.Context, config *RestoreConfig) error {
 	return c.
 		builder.
 		Clone().
@@ -457,6 +459,7 @@ func (c *client) Restore(ctx context.Context, _ *RestoreConfig) error {
 			404: "The VM instance could not be restored because it is already created",
 		})).
 		Put().
+		BodyJSON(config).
 		Fetch(ctx)
 }
 
@@ -124,6 +124,8 @@ type DiskConfig struct {
 	Path              string             `json:"path"`
 	Readonly          *bool              `json:"readonly,omitempty"`
 	Direct            *bool              `json:"direct,omitempty"`
+	DisableIoUring    *bool              `json:"disable_io_uring,omitempty"`
+	DisableAio        *bool              `json:"disable_aio,omitempty"`
 	Iommu             *bool              `json:"iommu,omitempty"`
 	NumQueues         *int32             `json:"num_queues,omitempty"`
 	QueueSize         *int32             `json:"queue_size,omitempty"`
@@ -179,6 +181,10 @@ type NetConfig struct {
 	IP                *string            `json:"ip,omitempty"`
 	Mask              *string            `json:"mask,omitempty"`
 	Mac               *string            `json:"mac,omitempty"`
+	Mtu               *int32             `json:"mtu,omitempty"`
+	OffloadTso        *bool              `json:"offload_tso,omitempty"`
+	OffloadUfo        *bool              `json:"offload_ufo,omitempty"`
+	OffloadCsum       *bool              `json:"offload_csum,omitempty"`
 	Iommu             *bool              `json:"iommu,omitempty"`
 	NumQueues         *int32             `json:"num_queues,omitempty"`
 	QueueSize         *int32             `json:"queue_size,omitempty"`
@@ -61,6 +61,19 @@ const (
 	// DeleteVMTimeout is the default timeout for deleting a microvm.
 	DeleteVMTimeout time.Duration = 10 * time.Second
 
+	// GuestBootDeadline is the default time a microvm with the guest agent has to boot
+	// before it's marked as failed.
+	GuestBootDeadline time.Duration = 2 * time.Minute
+
+	// GuestPingInterval is how often the guest agent of a booting microvm is pinged.
+	GuestPingInterval time.Duration = 5 * time.Second
+
+	// NetworkNamespacePrefix is the prefix of the names of the network namespaces of microvms.
+	NetworkNamespacePrefix = "flintlock-"
+
+	// DHCPLeaseTime is the default time a lease from the embedded DHCP server is valid for.
+	DHCPLeaseTime time.Duration = time.Hour
+
 	// DataDirPerm is the permissions to use for data folders.
 	DataDirPerm = 0o755
 
@@ -70,6 +83,28 @@ const (
 	// MaximumRetry is the default value how many times we retry failed reconciliation.
 	MaximumRetry = 10
 
+	// MicroVMHistorySize is the default number of plan executions kept for each microvm.
+	MicroVMHistorySize = 50
+
+	// OvercommitRatio is the default ratio of resources that can be allocated to microvms
+	// to the host resources, i.e. no overcommit.
+	OvercommitRatio = 1.0
+
+	// AuditLogMaxSizeInMb is the default size the audit log file can grow to before it's rotated.
+	AuditLogMaxSizeInMb = 100
+
+	// AuditLogMaxBackups is the default number of rotated audit log files to keep.
+	AuditLogMaxBackups = 5
+
+	// JWTIdentityClaim is the default JWT claim with the name of the caller.
+	JWTIdentityClaim = "sub"
+
+	// JWTNamespacesClaim is the default JWT claim with the namespaces the caller is allowed to use.
+	JWTNamespacesClaim = "namespaces"
+
+	// JWTVerbsClaim is the default JWT claim with the verbs the caller is allowed.
+	JWTVerbsClaim = "verbs"
+
 	// Namespace is the default MicroVM namespace if one is not provided by the user.
 	Namespace = "default"
 
@@ -85,4 +120,7 @@ const (
 
 	// GuestAgentVsockName is the host unix-socket filename for the guest-agent vsock device.
 	GuestAgentVsockName = "guest-agent.vsock"
+
+	// GuestAgentVsockPort is the vsock port the guest-agent listens on in the guest.
+	GuestAgentVsockPort = 10000
 )
@@ -0,0 +1,42 @@
+package filecopy
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/afero"
+)
+
+// Copy copies the contents of a file to another file, replacing its contents. Either can be
+// a block device, which is how the volumes of microvms are copied to and from snapshots.
+func Copy(fs afero.Fs, from, to string, perm os.FileMode) error {
+	src, err := fs.Open(from)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", from, err)
+	}
+	defer src.Close()
+
+	dst, err := fs.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", to, err)
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+
+		return fmt.Errorf("copying %s to %s: %w", from, to, err)
+	}
+
+	if err := dst.Sync(); err != nil {
+		dst.Close()
+
+		return fmt.Errorf("syncing %s: %w", to, err)
+	}
+
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("closing %s: %w", to, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package filecopy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/filecopy"
+)
+
+func TestCopy(t *testing.T) {
+	RegisterTestingT(t)
+
+	fs := afero.NewMemMapFs()
+
+	Expect(afero.WriteFile(fs, "/dev/vol", []byte("volume contents"), 0o600)).To(Succeed())
+	Expect(afero.WriteFile(fs, "/snapshot/vol", []byte("older and longer contents"), 0o600)).To(Succeed())
+
+	Expect(filecopy.Copy(fs, "/dev/vol", "/snapshot/vol", 0o600)).To(Succeed())
+
+	data, err := afero.ReadFile(fs, "/snapshot/vol")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(data)).To(Equal("volume contents"))
+
+	Expect(filecopy.Copy(fs, "/dev/missing", "/snapshot/missing", 0o600)).NotTo(Succeed())
+}
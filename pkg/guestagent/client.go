@@ -0,0 +1,252 @@
+package guestagent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+var errUnexpectedEnd = errors.New("guest agent closed the connection unexpectedly")
+
+// RemoteError is an error reported by the guest agent.
+type RemoteError struct {
+	Message string
+}
+
+// Error returns the error message.
+func (e RemoteError) Error() string {
+	return "guest agent: " + e.Message
+}
+
+// ExecInput is the input to run a command in the guest.
+type ExecInput struct {
+	Command    []string
+	Env        map[string]string
+	WorkingDir string
+	// Stdin is sent to the command, it's closed when Stdin returns io.EOF. Can be nil. A read
+	// from Stdin that is blocked when the command exits isn't interrupted.
+	Stdin io.Reader
+	// Stdout receives the stdout of the command. Can be nil.
+	Stdout io.Writer
+	// Stderr receives the stderr of the command. Can be nil.
+	Stderr io.Writer
+}
+
+// Client is a client for the guest agent running inside a microvm.
+type Client interface {
+	// Exec runs a command in the guest and returns its exit code.
+	Exec(ctx context.Context, input ExecInput) (int, error)
+	// CopyTo writes content to a file in the guest, creating it with mode if it doesn't exist.
+	CopyTo(ctx context.Context, path string, mode uint32, content io.Reader) error
+	// CopyFrom reads a file from the guest into dest.
+	CopyFrom(ctx context.Context, path string, dest io.Writer) error
+	// Ping checks that the guest agent is up and responding.
+	Ping(ctx context.Context) error
+}
+
+// New creates a new guest agent client that connects to port using the vsock unix socket.
+func New(udsPath string, port uint32) Client {
+	return &client{
+		udsPath: udsPath,
+		port:    port,
+	}
+}
+
+type client struct {
+	udsPath string
+	port    uint32
+}
+
+// Exec runs a command in the guest and returns its exit code.
+func (c *client) Exec(ctx context.Context, input ExecInput) (int, error) {
+	conn, err := c.open(ctx, &Request{
+		Operation:  OperationExec,
+		Command:    input.Command,
+		Env:        input.Env,
+		WorkingDir: input.WorkingDir,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	// Only this goroutine writes to the connection once the request has been sent.
+	go sendStdin(conn, input.Stdin)
+
+	for {
+		frame, err := ReadFrame(conn)
+		if err != nil {
+			return 0, connError(ctx, err)
+		}
+
+		switch frame.Type {
+		case FrameStdout:
+			writeOutput(input.Stdout, frame.Payload)
+		case FrameStderr:
+			writeOutput(input.Stderr, frame.Payload)
+		case FrameExit:
+			status := &ExitStatus{}
+			if err := json.Unmarshal(frame.Payload, status); err != nil {
+				return 0, fmt.Errorf("unmarshalling exit status: %w", err)
+			}
+
+			return status.ExitCode, nil
+		case FrameError:
+			return 0, RemoteError{Message: string(frame.Payload)}
+		default:
+			return 0, fmt.Errorf("unexpected frame type %d from guest agent", frame.Type)
+		}
+	}
+}
+
+// CopyTo writes content to a file in the guest, creating it with mode if it doesn't exist.
+func (c *client) CopyTo(ctx context.Context, path string, mode uint32, content io.Reader) error {
+	conn, err := c.open(ctx, &Request{
+		Operation: OperationCopyTo,
+		Path:      path,
+		Mode:      mode,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := WriteData(conn, FrameData, content); err != nil {
+		return connError(ctx, err)
+	}
+
+	if err := WriteFrame(conn, FrameDataEnd, nil); err != nil {
+		return connError(ctx, err)
+	}
+
+	return waitForDone(ctx, conn)
+}
+
+// CopyFrom reads a file from the guest into dest.
+func (c *client) CopyFrom(ctx context.Context, path string, dest io.Writer) error {
+	conn, err := c.open(ctx, &Request{
+		Operation: OperationCopyFrom,
+		Path:      path,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for {
+		frame, err := ReadFrame(conn)
+		if err != nil {
+			return connError(ctx, err)
+		}
+
+		switch frame.Type {
+		case FrameData:
+			if _, err := dest.Write(frame.Payload); err != nil {
+				return fmt.Errorf("writing file content: %w", err)
+			}
+		case FrameDataEnd:
+			return nil
+		case FrameError:
+			return RemoteError{Message: string(frame.Payload)}
+		default:
+			return fmt.Errorf("unexpected frame type %d from guest agent", frame.Type)
+		}
+	}
+}
+
+// Ping checks that the guest agent is up and responding.
+func (c *client) Ping(ctx context.Context) error {
+	conn, err := c.open(ctx, &Request{
+		Operation: OperationPing,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return waitForDone(ctx, conn)
+}
+
+// open connects to the guest agent and sends the request. The connection is closed
+// when the context is done so that blocked reads and writes return.
+func (c *client) open(ctx context.Context, req *Request) (net.Conn, error) {
+	conn, err := Dial(ctx, c.udsPath, c.port)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WriteJSONFrame(conn, FrameRequest, req); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("sending %s request: %w", req.Operation, err)
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+
+	return &session{Conn: conn, stop: stop}, nil
+}
+
+// session is a connection to the guest agent for a single request.
+type session struct {
+	net.Conn
+	stop func() bool
+}
+
+func (s *session) Close() error {
+	s.stop()
+
+	return s.Conn.Close() //nolint:wrapcheck // nothing to add
+}
+
+func waitForDone(ctx context.Context, conn net.Conn) error {
+	frame, err := ReadFrame(conn)
+	if err != nil {
+		return connError(ctx, err)
+	}
+
+	switch frame.Type {
+	case FrameDone:
+		return nil
+	case FrameError:
+		return RemoteError{Message: string(frame.Payload)}
+	default:
+		return fmt.Errorf("unexpected frame type %d from guest agent", frame.Type)
+	}
+}
+
+func sendStdin(conn net.Conn, stdin io.Reader) {
+	if stdin != nil {
+		if err := WriteData(conn, FrameStdin, stdin); err != nil {
+			return
+		}
+	}
+
+	_ = WriteFrame(conn, FrameStdinClose, nil)
+}
+
+func writeOutput(dest io.Writer, data []byte) {
+	if dest == nil {
+		return
+	}
+
+	// The command keeps running if the output can't be written, the caller finds out
+	// from the writer.
+	_, _ = dest.Write(data)
+}
+
+func connError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("guest agent request cancelled: %w", ctx.Err())
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errUnexpectedEnd
+	}
+
+	return err
+}
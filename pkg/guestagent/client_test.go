@@ -0,0 +1,108 @@
+package guestagent_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/guestagent"
+	"github.com/liquidmetal-dev/flintlock/pkg/guestagent/fakeguest"
+)
+
+const testPort = 10000
+
+func startFakeGuest(t *testing.T) (string, string) {
+	t.Helper()
+
+	// Unix socket paths have a short length limit so don't use t.TempDir.
+	socketDir, err := os.MkdirTemp("", "guestagent")
+	Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { os.RemoveAll(socketDir) })
+
+	socketPath := filepath.Join(socketDir, "vsock")
+	root := t.TempDir()
+
+	guest, err := fakeguest.New(socketPath, root, testPort)
+	Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { guest.Close() })
+
+	return socketPath, root
+}
+
+func TestClient_Exec(t *testing.T) {
+	RegisterTestingT(t)
+
+	socketPath, _ := startFakeGuest(t)
+	client := guestagent.New(socketPath, testPort)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	exitCode, err := client.Exec(ctx, guestagent.ExecInput{
+		Command: []string{"sh", "-c", "cat; echo \"$GREETING\" >&2; exit 3"},
+		Env:     map[string]string{"GREETING": "hello"},
+		Stdin:   strings.NewReader("from stdin"),
+		Stdout:  stdout,
+		Stderr:  stderr,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(exitCode).To(Equal(3))
+	Expect(stdout.String()).To(Equal("from stdin"))
+	Expect(stderr.String()).To(Equal("hello\n"))
+
+	_, err = client.Exec(ctx, guestagent.ExecInput{Command: []string{"/does/not/exist"}})
+	Expect(err).To(BeAssignableToTypeOf(guestagent.RemoteError{}))
+}
+
+func TestClient_Copy(t *testing.T) {
+	RegisterTestingT(t)
+
+	socketPath, root := startFakeGuest(t)
+	client := guestagent.New(socketPath, testPort)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	content := strings.Repeat("flintlock", 10000)
+
+	Expect(client.CopyTo(ctx, "/config.txt", 0o600, strings.NewReader(content))).To(Succeed())
+
+	written, err := os.ReadFile(filepath.Join(root, "config.txt"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(written)).To(Equal(content))
+
+	copied := &bytes.Buffer{}
+	Expect(client.CopyFrom(ctx, "/config.txt", copied)).To(Succeed())
+	Expect(copied.String()).To(Equal(content))
+
+	err = client.CopyFrom(ctx, "/missing.txt", &bytes.Buffer{})
+	Expect(err).To(BeAssignableToTypeOf(guestagent.RemoteError{}))
+}
+
+func TestClient_Ping(t *testing.T) {
+	RegisterTestingT(t)
+
+	socketPath, _ := startFakeGuest(t)
+
+	Expect(guestagent.New(socketPath, testPort).Ping(context.Background())).To(Succeed())
+	Expect(guestagent.New(socketPath, testPort+1).Ping(context.Background())).NotTo(Succeed())
+}
+
+func TestClient_WrongPort(t *testing.T) {
+	RegisterTestingT(t)
+
+	socketPath, _ := startFakeGuest(t)
+	client := guestagent.New(socketPath, testPort+1)
+
+	_, err := client.Exec(context.Background(), guestagent.ExecInput{Command: []string{"true"}})
+	Expect(err).To(HaveOccurred())
+}
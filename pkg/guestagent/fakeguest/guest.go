@@ -0,0 +1,292 @@
+// Package fakeguest is a fake guest agent for tests. It listens on a unix socket and does
+// the same handshake as the vsock socket of a vmm, then runs commands and copies files on
+// the host under a root directory.
+package fakeguest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/guestagent"
+)
+
+// Guest is a fake guest agent.
+type Guest struct {
+	listener net.Listener
+	root     string
+	port     uint32
+	wg       sync.WaitGroup
+}
+
+// New starts a fake guest agent listening on socketPath for connections to port. Files are
+// copied to and from root, which is also where commands run by default.
+func New(socketPath, root string, port uint32) (*Guest, error) {
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", socketPath, err)
+	}
+
+	guest := &Guest{
+		listener: listener,
+		root:     root,
+		port:     port,
+	}
+
+	guest.wg.Add(1)
+
+	go guest.serve()
+
+	return guest, nil
+}
+
+// Close stops the fake guest agent and waits for open connections to finish.
+func (g *Guest) Close() error {
+	err := g.listener.Close()
+	g.wg.Wait()
+
+	return err //nolint:wrapcheck // test helper
+}
+
+func (g *Guest) serve() {
+	defer g.wg.Done()
+
+	for {
+		conn, err := g.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		g.wg.Add(1)
+
+		go func() {
+			defer g.wg.Done()
+			defer conn.Close()
+
+			g.handle(conn)
+		}()
+	}
+}
+
+func (g *Guest) handle(conn net.Conn) {
+	line, err := readLine(conn)
+	if err != nil {
+		return
+	}
+
+	var port uint32
+	if _, err := fmt.Sscanf(line, "CONNECT %d", &port); err != nil || port != g.port {
+		// The vmm closes the connection if nothing in the guest accepts it.
+		return
+	}
+
+	if _, err := io.WriteString(conn, "OK 1073741824\n"); err != nil {
+		return
+	}
+
+	frame, err := guestagent.ReadFrame(conn)
+	if err != nil || frame.Type != guestagent.FrameRequest {
+		return
+	}
+
+	req := &guestagent.Request{}
+	if err := json.Unmarshal(frame.Payload, req); err != nil {
+		sendError(conn, err)
+
+		return
+	}
+
+	switch req.Operation {
+	case guestagent.OperationExec:
+		err = g.exec(conn, req)
+	case guestagent.OperationCopyTo:
+		err = g.copyTo(conn, req)
+	case guestagent.OperationCopyFrom:
+		err = g.copyFrom(conn, req)
+	case guestagent.OperationPing:
+		err = guestagent.WriteFrame(conn, guestagent.FrameDone, nil)
+	default:
+		err = fmt.Errorf("unknown operation %q", req.Operation)
+	}
+
+	if err != nil {
+		sendError(conn, err)
+	}
+}
+
+func (g *Guest) exec(conn net.Conn, req *guestagent.Request) error {
+	if len(req.Command) == 0 {
+		return errors.New("command is required")
+	}
+
+	out := &frameWriter{conn: conn}
+
+	//nolint:gosec // runs what the test asks it to
+	cmd := exec.Command(req.Command[0], req.Command[1:]...)
+	cmd.Dir = g.root
+	if req.WorkingDir != "" {
+		cmd.Dir = g.path(req.WorkingDir)
+	}
+
+	cmd.Env = os.Environ()
+	for name, value := range req.Env {
+		cmd.Env = append(cmd.Env, name+"="+value)
+	}
+
+	cmd.Stdout = out.stream(guestagent.FrameStdout)
+	cmd.Stderr = out.stream(guestagent.FrameStderr)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("creating stdin pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting command: %w", err)
+	}
+
+	go receiveStdin(conn, stdin)
+
+	exitCode := 0
+
+	if err := cmd.Wait(); err != nil {
+		exitErr := &exec.ExitError{}
+		if !errors.As(err, &exitErr) {
+			return fmt.Errorf("running command: %w", err)
+		}
+
+		exitCode = exitErr.ExitCode()
+	}
+
+	return out.writeJSON(guestagent.FrameExit, &guestagent.ExitStatus{ExitCode: exitCode})
+}
+
+func (g *Guest) copyTo(conn net.Conn, req *guestagent.Request) error {
+	mode := os.FileMode(req.Mode)
+	if mode == 0 {
+		mode = 0o644
+	}
+
+	file, err := os.OpenFile(g.path(req.Path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", req.Path, err)
+	}
+	defer file.Close()
+
+	for {
+		frame, err := guestagent.ReadFrame(conn)
+		if err != nil {
+			return fmt.Errorf("receiving file content: %w", err)
+		}
+
+		switch frame.Type {
+		case guestagent.FrameData:
+			if _, err := file.Write(frame.Payload); err != nil {
+				return fmt.Errorf("writing %s: %w", req.Path, err)
+			}
+		case guestagent.FrameDataEnd:
+			return guestagent.WriteFrame(conn, guestagent.FrameDone, nil)
+		default:
+			return fmt.Errorf("unexpected frame type %d", frame.Type)
+		}
+	}
+}
+
+func (g *Guest) copyFrom(conn net.Conn, req *guestagent.Request) error {
+	file, err := os.Open(g.path(req.Path))
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", req.Path, err)
+	}
+	defer file.Close()
+
+	if err := guestagent.WriteData(conn, guestagent.FrameData, file); err != nil {
+		return fmt.Errorf("sending %s: %w", req.Path, err)
+	}
+
+	return guestagent.WriteFrame(conn, guestagent.FrameDataEnd, nil)
+}
+
+// path maps a path in the guest to a path under the root directory.
+func (g *Guest) path(guestPath string) string {
+	return filepath.Join(g.root, filepath.Clean("/"+guestPath))
+}
+
+func receiveStdin(conn net.Conn, stdin io.WriteCloser) {
+	defer stdin.Close()
+
+	for {
+		frame, err := guestagent.ReadFrame(conn)
+		if err != nil || frame.Type != guestagent.FrameStdin {
+			return
+		}
+
+		if _, err := stdin.Write(frame.Payload); err != nil {
+			return
+		}
+	}
+}
+
+func sendError(conn net.Conn, err error) {
+	_ = guestagent.WriteFrame(conn, guestagent.FrameError, []byte(err.Error()))
+}
+
+func readLine(conn net.Conn) (string, error) {
+	line := strings.Builder{}
+	buf := make([]byte, 1)
+
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			return "", err //nolint:wrapcheck // test helper
+		}
+
+		if buf[0] == '\n' {
+			return line.String(), nil
+		}
+
+		line.WriteByte(buf[0])
+	}
+}
+
+// frameWriter serialises the frames written by the stdout and stderr of a command.
+type frameWriter struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (w *frameWriter) write(frameType guestagent.FrameType, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return guestagent.WriteFrame(w.conn, frameType, data)
+}
+
+func (w *frameWriter) writeJSON(frameType guestagent.FrameType, payload interface{}) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return guestagent.WriteJSONFrame(w.conn, frameType, payload)
+}
+
+func (w *frameWriter) stream(frameType guestagent.FrameType) io.Writer {
+	return &streamWriter{frameWriter: w, frameType: frameType}
+}
+
+type streamWriter struct {
+	frameWriter *frameWriter
+	frameType   guestagent.FrameType
+}
+
+func (w *streamWriter) Write(data []byte) (int, error) {
+	if err := w.frameWriter.write(w.frameType, data); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
@@ -0,0 +1,165 @@
+package guestagent
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// FrameType is the type of a frame sent between the host and the guest agent.
+//
+// After the vsock handshake every message in either direction is a frame made up of a
+// 1 byte type, a 4 byte big endian payload length and then the payload.
+type FrameType byte
+
+const (
+	// FrameRequest is the first frame sent by the host, its payload is a JSON Request.
+	FrameRequest FrameType = 1
+	// FrameStdin carries data for the stdin of a command, host to guest.
+	FrameStdin FrameType = 2
+	// FrameStdinClose closes the stdin of a command, host to guest.
+	FrameStdinClose FrameType = 3
+	// FrameStdout carries data written to stdout by a command, guest to host.
+	FrameStdout FrameType = 4
+	// FrameStderr carries data written to stderr by a command, guest to host.
+	FrameStderr FrameType = 5
+	// FrameExit ends a command, its payload is a JSON ExitStatus, guest to host.
+	FrameExit FrameType = 6
+	// FrameData carries file content in either direction.
+	FrameData FrameType = 7
+	// FrameDataEnd marks the end of file content in either direction.
+	FrameDataEnd FrameType = 8
+	// FrameDone acknowledges a completed copy to the guest or a ping, guest to host.
+	FrameDone FrameType = 9
+	// FrameError ends a request that failed, its payload is the error message, guest to host.
+	FrameError FrameType = 10
+)
+
+const (
+	// MaxFramePayload is the largest payload a frame can have.
+	MaxFramePayload = 1024 * 1024
+	// DataChunkSize is the size data is split into when sending it in frames.
+	DataChunkSize = 32 * 1024
+
+	frameHeaderSize = 5
+)
+
+// Operation is the operation requested of the guest agent.
+type Operation string
+
+const (
+	// OperationExec runs a command in the guest.
+	OperationExec Operation = "exec"
+	// OperationCopyTo writes a file in the guest.
+	OperationCopyTo Operation = "copy_to"
+	// OperationCopyFrom reads a file from the guest.
+	OperationCopyFrom Operation = "copy_from"
+	// OperationPing checks the guest agent is up, it's used as the guest heartbeat.
+	OperationPing Operation = "ping"
+)
+
+var errFrameTooLarge = errors.New("frame payload is too large")
+
+// Request is the request sent to the guest agent at the start of a connection.
+type Request struct {
+	// Operation is what the guest agent is being asked to do.
+	Operation Operation `json:"op"`
+	// Command is the command and arguments to run for an exec.
+	Command []string `json:"command,omitempty"`
+	// Env is additional environment variables for an exec.
+	Env map[string]string `json:"env,omitempty"`
+	// WorkingDir is the directory to run an exec in.
+	WorkingDir string `json:"working_dir,omitempty"`
+	// Path is the path of the file in the guest for a copy.
+	Path string `json:"path,omitempty"`
+	// Mode is the permissions of the file created by a copy to the guest.
+	Mode uint32 `json:"mode,omitempty"`
+}
+
+// ExitStatus is the payload of an exit frame.
+type ExitStatus struct {
+	// ExitCode is the exit code of the command.
+	ExitCode int `json:"exit_code"`
+}
+
+// Frame is a single message between the host and the guest agent.
+type Frame struct {
+	Type    FrameType
+	Payload []byte
+}
+
+// WriteFrame writes a frame to w.
+func WriteFrame(w io.Writer, frameType FrameType, payload []byte) error {
+	if len(payload) > MaxFramePayload {
+		return errFrameTooLarge
+	}
+
+	buf := make([]byte, frameHeaderSize+len(payload))
+	buf[0] = byte(frameType)
+	binary.BigEndian.PutUint32(buf[1:frameHeaderSize], uint32(len(payload)))
+	copy(buf[frameHeaderSize:], payload)
+
+	if _, err := w.Write(buf); err != nil {
+		return fmt.Errorf("writing frame: %w", err)
+	}
+
+	return nil
+}
+
+// WriteJSONFrame writes a frame with a JSON encoded payload to w.
+func WriteJSONFrame(w io.Writer, frameType FrameType, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshalling frame payload: %w", err)
+	}
+
+	return WriteFrame(w, frameType, data)
+}
+
+// ReadFrame reads the next frame from r.
+func ReadFrame(r io.Reader) (*Frame, error) {
+	header := make([]byte, frameHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("reading frame header: %w", err)
+	}
+
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > MaxFramePayload {
+		return nil, errFrameTooLarge
+	}
+
+	frame := &Frame{
+		Type:    FrameType(header[0]),
+		Payload: make([]byte, size),
+	}
+
+	if _, err := io.ReadFull(r, frame.Payload); err != nil {
+		return nil, fmt.Errorf("reading frame payload: %w", err)
+	}
+
+	return frame, nil
+}
+
+// WriteData writes everything from r to w as data frames of type frameType.
+func WriteData(w io.Writer, frameType FrameType, r io.Reader) error {
+	buf := make([]byte, DataChunkSize)
+
+	for {
+		read, err := r.Read(buf)
+		if read > 0 {
+			if writeErr := WriteFrame(w, frameType, buf[:read]); writeErr != nil {
+				return writeErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading data: %w", err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package guestagent
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const handshakeTimeout = 5 * time.Second
+
+// Dial connects to a port in the guest through the unix socket that firecracker and
+// cloud-hypervisor expose for a vsock device. Both use the same handshake where the host
+// sends "CONNECT <port>" and the vmm replies with "OK <host port>" once the guest accepts.
+func Dial(ctx context.Context, udsPath string, port uint32) (net.Conn, error) {
+	dialer := net.Dialer{}
+
+	conn, err := dialer.DialContext(ctx, "unix", udsPath)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to vsock socket %s: %w", udsPath, err)
+	}
+
+	if err := handshake(conn, port); err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func handshake(conn net.Conn, port uint32) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return fmt.Errorf("setting handshake deadline: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(conn, "CONNECT %d\n", port); err != nil {
+		return fmt.Errorf("sending vsock connect: %w", err)
+	}
+
+	// Read a byte at a time so nothing after the reply is consumed.
+	reader := bufio.NewReaderSize(&byteReader{conn: conn}, 16)
+
+	reply, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("reading vsock connect reply: %w", err)
+	}
+
+	if !strings.HasPrefix(reply, "OK ") {
+		return fmt.Errorf("vsock connect to port %d refused: %q", port, strings.TrimSpace(reply))
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("clearing handshake deadline: %w", err)
+	}
+
+	return nil
+}
+
+type byteReader struct {
+	conn net.Conn
+}
+
+func (r *byteReader) Read(data []byte) (int, error) {
+	if len(data) > 1 {
+		data = data[:1]
+	}
+
+	return r.conn.Read(data) //nolint:wrapcheck // passed straight through
+}
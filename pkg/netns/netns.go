@@ -0,0 +1,125 @@
+// Package netns manages named network namespaces and runs functions inside them. The
+// namespaces are bind mounted in /run/netns, the same as ones created by `ip netns add`,
+// so they outlive the processes in them.
+package netns
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/vishvananda/netns"
+)
+
+// Create creates a named network namespace.
+func Create(name string) error {
+	// Creating the namespace moves the calling thread into it, so the thread is moved back
+	// afterwards.
+	runtime.LockOSThread()
+
+	current, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+
+		return fmt.Errorf("getting current network namespace: %w", err)
+	}
+	defer current.Close()
+
+	created, createErr := netns.NewNamed(name)
+	if createErr == nil {
+		created.Close()
+	}
+
+	if err := restore(current); err != nil {
+		return err
+	}
+
+	if createErr != nil {
+		return fmt.Errorf("creating network namespace %s: %w", name, createErr)
+	}
+
+	return nil
+}
+
+// Delete deletes a named network namespace. The devices in the namespace are deleted with
+// it once no processes are left in it.
+func Delete(name string) error {
+	exists, err := Exists(name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	if err := netns.DeleteNamed(name); err != nil {
+		return fmt.Errorf("deleting network namespace %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Exists returns true if there's a named network namespace with the name.
+func Exists(name string) (bool, error) {
+	handle, err := netns.GetFromName(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("getting network namespace %s: %w", name, err)
+	}
+
+	handle.Close()
+
+	return true, nil
+}
+
+// Do runs the function in the named network namespace. Processes started by the function
+// are started in the namespace.
+func Do(name string, fn func() error) error {
+	target, err := netns.GetFromName(name)
+	if err != nil {
+		return fmt.Errorf("getting network namespace %s: %w", name, err)
+	}
+	defer target.Close()
+
+	runtime.LockOSThread()
+
+	current, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+
+		return fmt.Errorf("getting current network namespace: %w", err)
+	}
+	defer current.Close()
+
+	if err := netns.Set(target); err != nil {
+		runtime.UnlockOSThread()
+
+		return fmt.Errorf("entering network namespace %s: %w", name, err)
+	}
+
+	fnErr := fn()
+
+	if err := restore(current); err != nil {
+		return err
+	}
+
+	return fnErr
+}
+
+// restore moves the locked thread back to the network namespace and unlocks it. If it can't
+// be moved back it's left locked, so it's thrown away when the goroutine exits rather than
+// being reused in the wrong namespace.
+func restore(ns netns.NsHandle) error {
+	if err := netns.Set(ns); err != nil {
+		return fmt.Errorf("restoring network namespace: %w", err)
+	}
+
+	runtime.UnlockOSThread()
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package netns_test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/liquidmetal-dev/flintlock/pkg/netns"
+)
+
+// TestNetns creates a named network namespace and starts a process in it. It needs root, so it
+// only runs if NETNS_TESTS is set.
+func TestNetns(t *testing.T) {
+	if os.Getenv("NETNS_TESTS") == "" {
+		t.Skip("skipping network namespace test")
+	}
+
+	g := NewWithT(t)
+
+	const name = "flintlock-netns-test"
+
+	exists, err := netns.Exists(name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+
+	g.Expect(netns.Create(name)).To(Succeed())
+	defer netns.Delete(name) //nolint:errcheck // deleted below as well
+
+	exists, err = netns.Exists(name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+
+	hostNs, err := os.Readlink("/proc/thread-self/ns/net")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var childNs []byte
+
+	g.Expect(netns.Do(name, func() error {
+		var cmdErr error
+		childNs, cmdErr = exec.Command("readlink", "/proc/self/ns/net").Output()
+
+		return cmdErr
+	})).To(Succeed())
+	g.Expect(string(childNs)).NotTo(HavePrefix(hostNs))
+
+	g.Expect(netns.Do("flintlock-missing", func() error { return nil })).NotTo(Succeed())
+
+	g.Expect(netns.Delete(name)).To(Succeed())
+	g.Expect(netns.Delete(name)).To(Succeed(), "deleting a missing namespace")
+
+	exists, err = netns.Exists(name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
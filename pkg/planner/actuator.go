@@ -7,13 +7,14 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/liquidmetal-dev/flintlock/core/models"
 	"github.com/liquidmetal-dev/flintlock/pkg/log"
 )
 
 // Actuator will execute the given plan.
 type Actuator interface {
-	// Execute the plan.
-	Execute(ctx context.Context, p Plan, executionID string) (int, error)
+	// Execute the plan. A record of the steps that were run is returned even if the plan fails.
+	Execute(ctx context.Context, p Plan, executionID string) (*models.PlanExecution, error)
 }
 
 // NewActuator creates a new actuator.
@@ -24,100 +25,174 @@ func NewActuator() Actuator {
 type actuatorImpl struct{}
 
 // Execute will execute the plan.
-func (e *actuatorImpl) Execute(ctx context.Context, plan Plan, executionID string) (int, error) {
+func (e *actuatorImpl) Execute(ctx context.Context, plan Plan, executionID string) (*models.PlanExecution, error) {
 	logger := log.GetLogger(ctx).WithFields(logrus.Fields{
 		"execution_id": executionID,
 		"plan_name":    plan.Name(),
 	})
 
 	start := time.Now().UTC()
+	execution := &models.PlanExecution{
+		ID:        executionID,
+		Plan:      plan.Name(),
+		StartedAt: start.Unix(),
+		Steps:     []models.StepExecution{},
+	}
 
 	logger.Infof("started executing plan")
 
-	numStepsExecuted, err := e.executePlan(ctx, plan, logger)
+	err := e.executePlan(ctx, plan, execution, logger)
+	execution.FinishedAt = time.Now().UTC().Unix()
+
 	if err != nil {
+		execution.Error = err.Error()
+
 		logger.WithFields(logrus.Fields{
 			"execution_time": time.Since(start),
-			"num_steps":      numStepsExecuted,
+			"num_steps":      execution.StepsExecuted(),
 		}).Error("failed executing plan")
 
-		return numStepsExecuted, fmt.Errorf("executing plan steps: %w", err)
+		return execution, fmt.Errorf("executing plan steps: %w", err)
 	}
 
 	logger.WithFields(logrus.Fields{
 		"execution_time": time.Since(start),
-		"num_steps":      numStepsExecuted,
+		"num_steps":      execution.StepsExecuted(),
 	}).Info("finished executing plan")
 
-	return numStepsExecuted, nil
+	return execution, nil
 }
 
-func (e *actuatorImpl) executePlan(ctx context.Context, plan Plan, logger *logrus.Entry) (int, error) {
-	numStepsExecuted := 0
-
+func (e *actuatorImpl) executePlan(
+	ctx context.Context,
+	plan Plan,
+	execution *models.PlanExecution,
+	logger *logrus.Entry,
+) error {
 	for {
 		steps, err := plan.Create(ctx)
 		if err != nil {
-			return numStepsExecuted, fmt.Errorf("creating plan for %s: %w", plan.Name(), err)
+			return fmt.Errorf("creating plan for %s: %w", plan.Name(), err)
 		}
 
 		if len(steps) == 0 {
 			logger.Debug("no more steps to execute")
 
-			return numStepsExecuted, nil
+			return nil
 		}
 
-		executed, err := e.react(ctx, steps, logger)
-		numStepsExecuted += executed
-
-		if err != nil {
-			return numStepsExecuted, fmt.Errorf("executing steps: %w", err)
+		if err := e.react(ctx, steps, execution, logger); err != nil {
+			return fmt.Errorf("executing steps: %w", err)
 		}
 	}
 }
 
-func (e *actuatorImpl) react(ctx context.Context, steps []Procedure, logger *logrus.Entry) (int, error) {
+func (e *actuatorImpl) react(
+	ctx context.Context,
+	steps []Procedure,
+	execution *models.PlanExecution,
+	logger *logrus.Entry,
+) error {
 	var childSteps []Procedure
 
-	numStepsExecuted := 0
-
 	for _, step := range steps {
 		select {
 		case <-ctx.Done():
 			logger.WithField("step_name", step.Name()).Info("step not executed due to context done")
 
-			return numStepsExecuted, ctx.Err() //nolint:wrapcheck // It's ok ;)
+			return ctx.Err() //nolint:wrapcheck // It's ok ;)
 		default:
-			shouldDo, err := step.ShouldDo(ctx)
+			var err error
+
+			childSteps, err = e.runStep(ctx, step, execution, logger)
 			if err != nil {
-				return numStepsExecuted, fmt.Errorf("checking if step %s should be executed: %w", step.Name(), err)
+				return err
 			}
+		}
 
-			if shouldDo {
-				logger.WithField("step", step.Name()).Debug("execute step")
+		if len(childSteps) > 0 {
+			if err := e.react(ctx, childSteps, execution, logger); err != nil {
+				return err
+			}
+		}
+	}
 
-				numStepsExecuted++
+	return nil
+}
 
-				childSteps, err = step.Do(ctx)
-				if err != nil {
-					return numStepsExecuted, fmt.Errorf("executing step %s: %w", step.Name(), err)
-				}
+// runStep runs a single step and adds a record of it to the execution.
+func (e *actuatorImpl) runStep(
+	ctx context.Context,
+	step Procedure,
+	execution *models.PlanExecution,
+	logger *logrus.Entry,
+) ([]Procedure, error) {
+	start := time.Now()
+	record := func(outcome models.StepOutcome, err error) {
+		stepExecution := models.StepExecution{
+			Name:     step.Name(),
+			Outcome:  outcome,
+			Duration: time.Since(start),
+		}
 
-				if verifyErr := step.Verify(ctx); verifyErr != nil {
-					return numStepsExecuted, fmt.Errorf("verifying step %s: %w", step.Name(), verifyErr)
-				}
-			}
+		if err != nil {
+			stepExecution.Error = err.Error()
 		}
 
-		if len(childSteps) > 0 {
-			executed, err := e.react(ctx, childSteps, logger)
-			numStepsExecuted += executed
+		execution.Steps = append(execution.Steps, stepExecution)
+	}
 
-			if err != nil {
-				return numStepsExecuted, err
-			}
+	shouldDo, err := step.ShouldDo(ctx)
+	if err != nil {
+		record(models.StepOutcomeShouldDoFailed, err)
+
+		return nil, &StepError{
+			Step:      step.Name(),
+			Condition: stepCondition(step),
+			Err:       fmt.Errorf("checking if step %s should be executed: %w", step.Name(), err),
+		}
+	}
+
+	if !shouldDo {
+		record(models.StepOutcomeSkipped, nil)
+
+		return nil, nil
+	}
+
+	logger.WithField("step", step.Name()).Debug("execute step")
+
+	childSteps, err := step.Do(ctx)
+	if err != nil {
+		record(models.StepOutcomeDoFailed, err)
+
+		return nil, &StepError{
+			Step:      step.Name(),
+			Condition: stepCondition(step),
+			Err:       fmt.Errorf("executing step %s: %w", step.Name(), err),
+		}
+	}
+
+	if verifyErr := step.Verify(ctx); verifyErr != nil {
+		record(models.StepOutcomeVerifyFailed, verifyErr)
+
+		return nil, &StepError{
+			Step:      step.Name(),
+			Condition: stepCondition(step),
+			Err:       fmt.Errorf("verifying step %s: %w", step.Name(), verifyErr),
 		}
 	}
 
-	return numStepsExecuted, nil
+	record(models.StepOutcomeSucceeded, nil)
+
+	return childSteps, nil
+}
+
+// stepCondition returns the condition a failure of the step is reported against, if any.
+func stepCondition(step Procedure) models.ConditionType {
+	reporter, ok := step.(ConditionReporter)
+	if !ok {
+		return ""
+	}
+
+	return reporter.Condition()
 }
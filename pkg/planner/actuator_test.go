@@ -2,6 +2,7 @@ package planner_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -25,13 +26,13 @@ func TestActuator_SingleProc(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	act := planner.NewActuator()
-	stepCount, err := act.Execute(ctx, testPlan, execID)
+	execution, err := act.Execute(ctx, testPlan, execID)
 
 	Expect(err).NotTo(HaveOccurred())
 	testProc, ok := testProcs[0].(*testProc)
 	Expect(ok).To(BeTrue())
 	Expect(testProc.Executed).To(BeTrue())
-	Expect(stepCount).To(Equal(1))
+	Expect(execution.StepsExecuted()).To(Equal(1))
 }
 
 func TestActuator_MultipleProcs(t *testing.T) {
@@ -47,9 +48,9 @@ func TestActuator_MultipleProcs(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	act := planner.NewActuator()
-	stepCount, err := act.Execute(ctx, testPlan, execID)
+	execution, err := act.Execute(ctx, testPlan, execID)
 	Expect(err).NotTo(HaveOccurred())
-	Expect(stepCount).To(Equal(2))
+	Expect(execution.StepsExecuted()).To(Equal(2))
 
 	for _, proc := range testProcs {
 		testProc, ok := proc.(*testProc)
@@ -71,9 +72,9 @@ func TestActuator_ChildProcs(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	act := planner.NewActuator()
-	stepCount, err := act.Execute(ctx, testPlan, execID)
+	execution, err := act.Execute(ctx, testPlan, execID)
 	Expect(err).NotTo(HaveOccurred())
-	Expect(stepCount).To(Equal(2))
+	Expect(execution.StepsExecuted()).To(Equal(2))
 
 	parentProc, ok := testProcs[0].(*testProc)
 	Expect(ok).To(BeTrue())
@@ -102,8 +103,8 @@ func TestActuator_Timeout(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	act := planner.NewActuator()
-	stepCount, err := act.Execute(ctx, testPlan, execID)
-	Expect(stepCount).To(Equal(1))
+	execution, err := act.Execute(ctx, testPlan, execID)
+	Expect(execution.StepsExecuted()).To(Equal(1))
 
 	Expect(err).To(HaveOccurred())
 	Expect(err).To(MatchError(context.DeadlineExceeded))
@@ -117,6 +118,47 @@ func TestActuator_Timeout(t *testing.T) {
 	Expect(proc2.Executed).To(BeFalse())
 }
 
+func TestActuator_StepError(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	failingProc := &testProc{Err: errors.New("something bad happened"), Cond: models.ConditionNetworkReady}
+	testPlan := newTestPlan([]planner.Procedure{failingProc})
+
+	act := planner.NewActuator()
+	_, err := act.Execute(ctx, testPlan, "execid")
+	Expect(err).To(HaveOccurred())
+
+	stepErr := &planner.StepError{}
+	Expect(errors.As(err, &stepErr)).To(BeTrue())
+	Expect(stepErr.Step).To(Equal("test_proc"))
+	Expect(stepErr.Condition).To(Equal(models.ConditionNetworkReady))
+	Expect(stepErr).To(MatchError(failingProc.Err))
+}
+
+func TestActuator_History(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	failingProc := &testProc{Err: errors.New("something bad happened")}
+	testPlan := newTestPlan([]planner.Procedure{newTestProc(10*time.Millisecond, []planner.Procedure{}), failingProc})
+
+	act := planner.NewActuator()
+	execution, err := act.Execute(ctx, testPlan, "execid")
+	Expect(err).To(HaveOccurred())
+
+	Expect(execution.ID).To(Equal("execid"))
+	Expect(execution.Plan).To(Equal("test_plan"))
+	Expect(execution.Error).To(ContainSubstring("something bad happened"))
+	Expect(execution.Steps).To(HaveLen(2))
+	Expect(execution.Steps[0].Outcome).To(Equal(models.StepOutcomeSucceeded))
+	Expect(execution.Steps[0].Duration).To(BeNumerically(">=", 10*time.Millisecond))
+	Expect(execution.Steps[1].Outcome).To(Equal(models.StepOutcomeDoFailed))
+	Expect(execution.Steps[1].Error).To(Equal("something bad happened"))
+}
+
 func newTestPlan(procs []planner.Procedure) planner.Plan {
 	return &testPlan{
 		testProcs: procs,
@@ -157,16 +199,26 @@ type testProc struct {
 	DoDelay    time.Duration
 	ChildProcs []planner.Procedure
 	Executed   bool
+	Err        error
+	Cond       models.ConditionType
 }
 
 func (p *testProc) Name() string {
 	return "test_proc"
 }
 
+func (p *testProc) Condition() models.ConditionType {
+	return p.Cond
+}
+
 func (p *testProc) Do(ctx context.Context) ([]planner.Procedure, error) {
 	p.Executed = true
 	time.Sleep(p.DoDelay)
 
+	if p.Err != nil {
+		return nil, p.Err
+	}
+
 	return p.ChildProcs, nil
 }
 
@@ -0,0 +1,24 @@
+package planner
+
+import "github.com/liquidmetal-dev/flintlock/core/models"
+
+// StepError is returned by the actuator when a step of a plan fails. It records the
+// name of the step so callers can report where the plan failed.
+type StepError struct {
+	// Step is the name of the step that failed.
+	Step string
+	// Condition is the condition the failure is reported against, if the step reports one.
+	Condition models.ConditionType
+	// Err is the error from the step.
+	Err error
+}
+
+// Error returns the error message.
+func (e *StepError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error from the step.
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
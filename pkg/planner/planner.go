@@ -40,3 +40,10 @@ type Procedure interface {
 	// and report back an error if the state is not the desired state.
 	Verify(ctx context.Context) error
 }
+
+// ConditionReporter is implemented by procedures whose failure is reflected in a condition
+// of the microvm.
+type ConditionReporter interface {
+	// Condition is the type of the condition a failure of the procedure is reported against.
+	Condition() models.ConditionType
+}
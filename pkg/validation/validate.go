@@ -30,6 +30,9 @@ func NewValidator() Validator {
 	_ = validator.RegisterValidation("onlyOneVirtioFS", customOnlyOneVirtioFSValidator, false)
 	_ = validator.RegisterValidation("multipleVolSources", customMultipleVolSources, false)
 	validator.RegisterStructValidation(customMicroVMSpecStructLevelValidation, models.MicroVMSpec{})
+	validator.RegisterStructValidation(customNetworkInterfaceStructLevelValidation, models.NetworkInterface{})
+	validator.RegisterStructValidation(customFirewallRuleStructLevelValidation, models.FirewallRule{})
+	validator.RegisterStructValidation(customVolumeStructLevelValidation, models.Volume{})
 
 	return &validate{
 		validator: validator,
@@ -82,6 +85,55 @@ func customMicroVMSpecStructLevelValidation(structLevel playgroundValidator.Stru
 	}
 }
 
+// The firewall rules are bound to the tap device on the host and anti-spoofing needs to know the
+// MAC address of the guest, so a firewall needs both.
+func customNetworkInterfaceStructLevelValidation(structLevel playgroundValidator.StructLevel) {
+	iface, _ := structLevel.Current().Interface().(models.NetworkInterface)
+
+	if iface.Firewall == nil {
+		return
+	}
+
+	if iface.Type != models.IfaceTypeTap {
+		structLevel.ReportError(iface.Firewall, "firewall", "Firewall", "firewallRequiresTap", "")
+	}
+
+	if iface.GuestMAC == "" {
+		structLevel.ReportError(iface.Firewall, "firewall", "Firewall", "firewallRequiresGuestMAC", "")
+	}
+}
+
+func customFirewallRuleStructLevelValidation(structLevel playgroundValidator.StructLevel) {
+	rule, _ := structLevel.Current().Interface().(models.FirewallRule)
+
+	hasPorts := rule.Port != 0 || rule.EndPort != 0
+	if hasPorts && rule.Protocol != models.FirewallProtocolTCP && rule.Protocol != models.FirewallProtocolUDP {
+		structLevel.ReportError(rule.Port, "port", "Port", "portRequiresTCPOrUDP", "")
+	}
+}
+
+// The cache mode, I/O engine and rate limit are settings of the block device of a volume, so
+// they don't apply to volumes shared with virtiofs.
+func customVolumeStructLevelValidation(structLevel playgroundValidator.StructLevel) {
+	volume, _ := structLevel.Current().Interface().(models.Volume)
+
+	if volume.Source.VirtioFS == nil {
+		return
+	}
+
+	if volume.CacheMode != "" {
+		structLevel.ReportError(volume.CacheMode, "cache_mode", "CacheMode", "cacheModeRequiresBlockDevice", "")
+	}
+
+	if volume.IOEngine != "" {
+		structLevel.ReportError(volume.IOEngine, "io_engine", "IOEngine", "ioEngineRequiresBlockDevice", "")
+	}
+
+	if volume.RateLimit != nil {
+		structLevel.ReportError(volume.RateLimit, "rate_limit", "RateLimit", "rateLimitRequiresBlockDevice", "")
+	}
+}
+
 func customNoVirtioFSValidator(fieldLevel playgroundValidator.FieldLevel) bool {
 	field, _ := fieldLevel.Field().Interface().(models.Volume)
 
@@ -40,9 +40,54 @@ func TestValidation_Invalid(t *testing.T) {
 		},
 	}
 
+	invalidFirewall := basicMicroVM
+	invalidFirewall.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{
+			GuestDeviceName: "eth0",
+			Type:            "macvtap",
+			Firewall: &models.Firewall{
+				Ingress: []models.FirewallRule{
+					{CIDR: "10.0.0.0/8", Protocol: models.FirewallProtocolICMP, Port: 22},
+					{Protocol: models.FirewallProtocolTCP, Port: 8080, EndPort: 80},
+					{CIDR: "not-a-cidr"},
+				},
+			},
+		},
+	}
+
+	invalidRateLimits := basicMicroVM
+	invalidRateLimits.Spec.NetworkInterfaces = []models.NetworkInterface{
+		{
+			GuestDeviceName: "eth0",
+			Type:            "tap",
+			RateLimits: &models.NetworkRateLimits{
+				Rx: &models.RateLimit{
+					Bandwidth: &models.TokenBucket{Size: 1048576},
+					Ops:       &models.TokenBucket{Size: -1, OneTimeBurst: -1, RefillTimeMs: 1000},
+				},
+			},
+		},
+	}
+
 	invalidVolumes := basicMicroVM
 	invalidVolumes.Spec.RootVolume = models.Volume{}
 
+	invalidVolumeIO := basicMicroVM
+	invalidVolumeIO.Spec.RootVolume = models.Volume{
+		ID:        "root",
+		CacheMode: "none",
+		IOEngine:  "libaio",
+		RateLimit: &models.RateLimit{Bandwidth: &models.TokenBucket{RefillTimeMs: 100}},
+	}
+	invalidVolumeIO.Spec.AdditionalVolumes = models.Volumes{
+		{
+			ID:        "shared",
+			Source:    models.VolumeSource{VirtioFS: &models.VirtioFSVolumeSource{Path: "/srv/shared"}},
+			CacheMode: models.VolumeCacheModeDirect,
+			IOEngine:  models.VolumeIOEngineAsync,
+		},
+	}
+
 	tt := []struct {
 		name      string
 		numErrors int
@@ -68,6 +113,21 @@ func TestValidation_Invalid(t *testing.T) {
 			numErrors: 1,
 			vmspec:    invalidNetworkGuestDeviceName,
 		},
+		{
+			name:      "invalid firewall should fail validation",
+			numErrors: 5,
+			vmspec:    invalidFirewall,
+		},
+		{
+			name:      "invalid rate limits should fail validation",
+			numErrors: 3,
+			vmspec:    invalidRateLimits,
+		},
+		{
+			name:      "invalid volume io settings should fail validation",
+			numErrors: 5,
+			vmspec:    invalidVolumeIO,
+		},
 		{
 			name:      "should fail validation when there is no root volume",
 			numErrors: 1,